@@ -0,0 +1,178 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "sort"
+    "strings"
+    "unicode"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Read-only workspace administration reports
+
+var adminCmd = &cobra.Command{
+    Use:   "admin",
+    Short: "Workspace administration reports (read-only)",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var adminAuditCmd = &cobra.Command{
+    Use:   "audit",
+    Short: "Compare labels and workflow states across teams",
+    Long: `Compare labels and workflow states across all teams and flag near-duplicates
+(e.g. "Bug" vs "bug" vs "bugs"), color mismatches and inconsistent state types.
+These commands only read data.`,
+    RunE: func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var adminAuditLabelsCmd = &cobra.Command{
+    Use:   "labels",
+    Short: "Report label name/color inconsistencies across teams",
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        labels, err := client.ListAllLabels()
+        if err != nil { return err }
+        entries := make([]auditEntry, 0, len(labels))
+        for _, l := range labels {
+            team := "workspace"
+            if l.Team != nil { team = l.Team.Key }
+            entries = append(entries, auditEntry{Team: team, Name: l.Name, Color: l.Color})
+        }
+        return printAudit(cmd, "label", entries, 0)
+    },
+}
+
+var adminAuditStatesCmd = &cobra.Command{
+    Use:   "states",
+    Short: "Report workflow state inconsistencies across teams",
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        teams, err := client.ListAllTeams()
+        if err != nil { return err }
+        states, err := client.ListAllWorkflowStates()
+        if err != nil { return err }
+        entries := make([]auditEntry, 0, len(states))
+        for _, s := range states {
+            if s.Team == nil { continue }
+            entries = append(entries, auditEntry{Team: s.Team.Key, Name: s.Name, Color: s.Color, Type: s.Type})
+        }
+        return printAudit(cmd, "state", entries, len(teams))
+    },
+}
+
+// auditEntry is one label or state as it exists in a specific team
+type auditEntry struct {
+    Team  string
+    Name  string
+    Color string
+    Type  string
+}
+
+// auditGroup collects entries whose names normalize to the same key
+type auditGroup struct {
+    Key      string   `json:"key"`
+    Variants []string `json:"variants"`
+    Colors   []string `json:"colors,omitempty"`
+    Types    []string `json:"types,omitempty"`
+    Teams    []string `json:"teams"`
+    Findings []string `json:"findings,omitempty"`
+}
+
+// normalizeAuditName folds case, drops punctuation/spaces and a trailing plural "s"
+// so that "Bug", "bug", "bugs" and "In-Progress"/"In Progress" collide.
+func normalizeAuditName(s string) string {
+    var b strings.Builder
+    for _, r := range strings.ToLower(s) {
+        if unicode.IsLetter(r) || unicode.IsDigit(r) { b.WriteRune(r) }
+    }
+    k := b.String()
+    if len(k) > 3 && strings.HasSuffix(k, "s") && !strings.HasSuffix(k, "ss") { k = strings.TrimSuffix(k, "s") }
+    return k
+}
+
+func buildAuditGroups(entries []auditEntry) []auditGroup {
+    type acc struct {
+        variants, colors, types, teams map[string]struct{}
+    }
+    byKey := map[string]*acc{}
+    for _, e := range entries {
+        k := normalizeAuditName(e.Name)
+        if k == "" { continue }
+        a := byKey[k]
+        if a == nil {
+            a = &acc{variants: map[string]struct{}{}, colors: map[string]struct{}{}, types: map[string]struct{}{}, teams: map[string]struct{}{}}
+            byKey[k] = a
+        }
+        a.variants[e.Name] = struct{}{}
+        if e.Color != "" { a.colors[strings.ToLower(e.Color)] = struct{}{} }
+        if e.Type != "" { a.types[e.Type] = struct{}{} }
+        a.teams[e.Team] = struct{}{}
+    }
+    keys := func(m map[string]struct{}) []string {
+        out := make([]string, 0, len(m))
+        for k := range m { out = append(out, k) }
+        sort.Strings(out)
+        return out
+    }
+    groups := make([]auditGroup, 0, len(byKey))
+    for k, a := range byKey {
+        g := auditGroup{Key: k, Variants: keys(a.variants), Colors: keys(a.colors), Types: keys(a.types), Teams: keys(a.teams)}
+        if len(g.Variants) > 1 { g.Findings = append(g.Findings, "name variants: "+strings.Join(g.Variants, " / ")) }
+        if len(g.Colors) > 1 { g.Findings = append(g.Findings, fmt.Sprintf("%d different colors", len(g.Colors))) }
+        if len(g.Types) > 1 { g.Findings = append(g.Findings, "types differ: "+strings.Join(g.Types, ", ")) }
+        groups = append(groups, g)
+    }
+    sort.Slice(groups, func(i, j int) bool {
+        if (len(groups[i].Findings) > 0) != (len(groups[j].Findings) > 0) { return len(groups[i].Findings) > 0 }
+        return groups[i].Key < groups[j].Key
+    })
+    return groups
+}
+
+func printAudit(cmd *cobra.Command, kind string, entries []auditEntry, teamCount int) error {
+    all, _ := cmd.Flags().GetBool("all")
+    groups := buildAuditGroups(entries)
+    flagged := 0
+    shown := make([]auditGroup, 0, len(groups))
+    for _, g := range groups {
+        if len(g.Findings) > 0 { flagged++ }
+        if all || len(g.Findings) > 0 { shown = append(shown, g) }
+    }
+    p := printer(cmd)
+    if p.JSONEnabled() {
+        return p.PrintJSON(map[string]any{"kind": kind, "groups": shown, "flagged": flagged, "total": len(groups)})
+    }
+    if len(shown) == 0 {
+        fmt.Printf("No %s inconsistencies found across %d distinct %ss\n", kind, len(groups), kind)
+        return nil
+    }
+    rows := make([][]string, 0, len(shown))
+    for _, g := range shown {
+        teams := fmt.Sprintf("%d", len(g.Teams))
+        if teamCount > 0 { teams = fmt.Sprintf("%d/%d", len(g.Teams), teamCount) }
+        findings := strings.Join(g.Findings, "; ")
+        if findings == "" { findings = "ok" }
+        rows = append(rows, []string{g.Variants[0], teams, strings.Join(g.Teams, ","), findings})
+    }
+    if err := p.Table([]string{"Name", "Teams", "Team Keys", "Findings"}, rows); err != nil { return err }
+    fmt.Printf("\n%d of %d distinct %ss flagged\n", flagged, len(groups), kind)
+    return nil
+}
+
+func init() {
+    rootCmd.AddCommand(adminCmd)
+    adminCmd.AddCommand(adminAuditCmd)
+    adminAuditCmd.AddCommand(adminAuditLabelsCmd)
+    adminAuditCmd.AddCommand(adminAuditStatesCmd)
+    for _, c := range []*cobra.Command{adminAuditLabelsCmd, adminAuditStatesCmd} {
+        c.Flags().Bool("all", false, "Show consistent entries too, not only flagged ones")
+    }
+}
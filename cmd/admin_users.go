@@ -0,0 +1,171 @@
+package cmd
+
+import (
+    "encoding/csv"
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "sort"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var adminUsersCmd = &cobra.Command{
+    Use:   "users",
+    Short: "Workspace user reports",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var adminUsersReportCmd = &cobra.Command{
+    Use:   "report --inactive <period>",
+    Short: "List users with no activity in a period, to reclaim seats",
+    Long: `List the workspace's active users who, within the --inactive period, created no
+issues, changed no issues and wrote no comments: candidates for freeing a seat.
+Users who joined during the period are not flagged. --all lists everyone with
+their counts; --csv writes the rows to a file ('-' for stdout).
+
+Changes are read from issue history, which needs a key that can see every
+team; issues in private teams the key cannot read do not count.`,
+    Example: `  linear-cli admin users report --inactive 60d
+  linear-cli admin users report --inactive 90d --csv inactive-users.csv
+  linear-cli admin users report --inactive 30d --all --json`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        inactive, _ := cmd.Flags().GetString("inactive")
+        all, _ := cmd.Flags().GetBool("all")
+        csvPath, _ := cmd.Flags().GetString("csv")
+        now := time.Now()
+        since, err := parseSince(inactive, now)
+        if err != nil { return err }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        prog := ui.StartProgress("Reading users and activity", 2)
+        users, err := client.ListActiveUsers()
+        if err != nil { prog.Done("Reading users failed"); return err }
+        prog.Step("%d users", len(users))
+        activity, err := client.UserActivitySince(since)
+        if err != nil { prog.Done("Reading activity failed"); return err }
+        prog.Step("activity since %s", since.Format("2006-01-02"))
+        prog.Done("Read %d users", len(users))
+
+        rows := buildUserReport(users, activity, since)
+        flagged := 0
+        shown := make([]userReportRow, 0, len(rows))
+        for _, r := range rows {
+            if r.Inactive { flagged++ }
+            if all || r.Inactive { shown = append(shown, r) }
+        }
+
+        if csvPath != "" {
+            var w io.Writer = os.Stdout
+            if csvPath != "-" {
+                f, err := os.Create(expandUserPath(csvPath))
+                if err != nil { return err }
+                defer f.Close()
+                w = f
+            }
+            if err := writeUserReportCSV(w, shown); err != nil { return err }
+            if csvPath == "-" { return nil }
+            ui.Infof("Wrote %d row(s) to %s", len(shown), csvPath)
+        }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"since": since, "users": shown, "inactive": flagged, "total": len(rows)}) }
+        if len(shown) == 0 {
+            fmt.Printf("All %d active users did something since %s\n", len(rows), since.Format("2006-01-02"))
+            return nil
+        }
+        table := make([][]string, 0, len(shown))
+        for _, r := range shown {
+            table = append(table, []string{r.Name, r.Email, r.Role, dateOr(r.LastSeen, "never"), dateOr(r.LastActivity, "-"), strconv.Itoa(r.IssuesCreated), strconv.Itoa(r.IssuesUpdated), strconv.Itoa(r.Comments)})
+        }
+        if err := p.Table([]string{"Name", "Email", "Role", "Last Seen", "Last Activity", "Created", "Updated", "Comments"}, table); err != nil { return err }
+        fmt.Printf("\n%d of %d active users inactive since %s\n", flagged, len(rows), since.Format("2006-01-02"))
+        return nil
+    },
+}
+
+// userReportRow is one user's activity in the report period. LastActivity is
+// their latest action within the period only.
+type userReportRow struct {
+    ID            string     `json:"id"`
+    Name          string     `json:"name"`
+    Email         string     `json:"email"`
+    Role          string     `json:"role"`
+    CreatedAt     time.Time  `json:"createdAt"`
+    LastSeen      *time.Time `json:"lastSeen,omitempty"`
+    LastActivity  *time.Time `json:"lastActivity,omitempty"`
+    IssuesCreated int        `json:"issuesCreated"`
+    IssuesUpdated int        `json:"issuesUpdated"`
+    Comments      int        `json:"comments"`
+    Inactive      bool       `json:"inactive"`
+}
+
+// buildUserReport joins users with their activity since the cutoff. Inactive
+// users come first, those last seen longest ago (or never) leading.
+func buildUserReport(users []api.WorkspaceUser, activity map[string]*api.UserActivity, since time.Time) []userReportRow {
+    rows := make([]userReportRow, 0, len(users))
+    for _, u := range users {
+        r := userReportRow{ID: u.ID, Name: u.Name, Email: u.Email, Role: "member", CreatedAt: u.CreatedAt, LastSeen: u.LastSeen}
+        switch {
+        case u.Admin:
+            r.Role = "admin"
+        case u.Guest:
+            r.Role = "guest"
+        }
+        if a := activity[u.ID]; a != nil {
+            r.IssuesCreated, r.IssuesUpdated, r.Comments, r.LastActivity = a.IssuesCreated, a.IssuesUpdated, a.Comments, a.Last
+        }
+        r.Inactive = r.IssuesCreated+r.IssuesUpdated+r.Comments == 0 && u.CreatedAt.Before(since)
+        rows = append(rows, r)
+    }
+    seen := func(r userReportRow) time.Time {
+        if r.LastSeen == nil { return time.Time{} }
+        return *r.LastSeen
+    }
+    sort.SliceStable(rows, func(i, j int) bool {
+        if rows[i].Inactive != rows[j].Inactive { return rows[i].Inactive }
+        if a, b := seen(rows[i]), seen(rows[j]); !a.Equal(b) { return a.Before(b) }
+        return strings.ToLower(rows[i].Name) < strings.ToLower(rows[j].Name)
+    })
+    return rows
+}
+
+func writeUserReportCSV(w io.Writer, rows []userReportRow) error {
+    cw := csv.NewWriter(w)
+    if err := cw.Write([]string{"name", "email", "role", "created_at", "last_seen", "last_activity", "issues_created", "issues_updated", "comments", "inactive"}); err != nil { return err }
+    day := func(t *time.Time) string {
+        if t == nil { return "" }
+        return t.UTC().Format("2006-01-02")
+    }
+    for _, r := range rows {
+        rec := []string{r.Name, r.Email, r.Role, r.CreatedAt.UTC().Format("2006-01-02"), day(r.LastSeen), day(r.LastActivity),
+            strconv.Itoa(r.IssuesCreated), strconv.Itoa(r.IssuesUpdated), strconv.Itoa(r.Comments), strconv.FormatBool(r.Inactive)}
+        if err := cw.Write(rec); err != nil { return err }
+    }
+    cw.Flush()
+    return cw.Error()
+}
+
+// dateOr formats t as a date where times are shown, or returns none when it is unset
+func dateOr(t *time.Time, none string) string {
+    if t == nil { return none }
+    return ui.Date(*t)
+}
+
+func init() {
+    adminCmd.AddCommand(adminUsersCmd)
+    adminUsersCmd.AddCommand(adminUsersReportCmd)
+    adminUsersReportCmd.Flags().String("inactive", "60d", "Period without activity, e.g. 30d, 8w, or a date like 2024-01-01")
+    adminUsersReportCmd.Flags().Bool("all", false, "List every active user with their counts, not only inactive ones")
+    adminUsersReportCmd.Flags().String("csv", "", "Also write the rows as CSV to this file ('-' for stdout instead of the table)")
+}
@@ -7,11 +7,9 @@ import (
 	"os"
 	"strings"
 
-	"linear-cli/internal/api"
 	"linear-cli/internal/config"
 
 	"github.com/spf13/cobra"
-	"golang.org/x/term"
 )
 
 var authCmd = &cobra.Command{
@@ -25,8 +23,20 @@ var authCmd = &cobra.Command{
 var authLoginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login by setting your Linear API key",
+	Long: `Store a Linear API key for the active profile.
+
+With --sso, walk through creating a personal API key instead (for workspaces that
+sign in with SSO): the key settings page is opened, the pasted key is checked for
+the --scopes it needs, and its workspace, scopes and creation date are stored
+alongside it for 'auth status'.`,
+	Example: `  linear-cli auth login --token lin_api_...
+  linear-cli auth login --sso --workspace acme`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		token, _ := cmd.Flags().GetString("token")
+		if sso, _ := cmd.Flags().GetBool("sso"); sso {
+			if token != "" { return errors.New("--sso and --token cannot be used together") }
+			return runSSOLogin(cmd)
+		}
 		if token == "" {
 			env := os.Getenv("LINEAR_API_KEY")
 			if env != "" {
@@ -34,14 +44,15 @@ var authLoginCmd = &cobra.Command{
 			}
 		}
 		if token == "" {
+			if err := ensureInteractive("API key; pass --token or set LINEAR_API_KEY"); err != nil { return err }
 			fmt.Print("Enter Linear API Key: ")
-			b, err := term.ReadPassword(int(os.Stdin.Fd()))
+			b, err := readPassword(int(os.Stdin.Fd()))
 			fmt.Println("")
 			if err != nil {
 				// Fallback to visible input if no TTY
 				fmt.Print("Enter Linear API Key (not hidden): ")
 				reader := bufio.NewReader(os.Stdin)
-				line, rerr := reader.ReadString('\n')
+				line, rerr := readInputLine(reader)
 				if rerr != nil {
 					return rerr
 				}
@@ -55,12 +66,14 @@ var authLoginCmd = &cobra.Command{
 		}
 
 		cfg, _ := config.Load()
-		cfg.APIKey = token
+		overridden := cfg.KeySource() == "command"
+		cfg.APIKey, cfg.KeyInfo = token, nil
 		if err := config.Save(cfg); err != nil {
 			return err
 		}
+		if overridden { ui.Warnf("api_key_cmd is configured and overrides the stored key") }
 
-		client := api.NewClient(cfg.APIKey)
+		client := newAPIClient(cmd, cfg.APIKey)
 		viewer, err := client.Viewer()
 		if err != nil {
 			return fmt.Errorf("saved token, but verification failed: %w", err)
@@ -83,7 +96,7 @@ var authStatusCmd = &cobra.Command{
             }
 			return nil
 		}
-		client := api.NewClient(cfg.APIKey)
+		client := newAPIClient(cmd, cfg.APIKey)
 		viewer, err := client.Viewer()
 		if err != nil {
                 if printer(cmd).JSONEnabled() {
@@ -93,15 +106,73 @@ var authStatusCmd = &cobra.Command{
                 }
 			return nil
 		}
+        // Probe writes afresh unless --read-only forbids it, and remember the answer
+        forced := readOnlyForced(cmd)
+        if !forced { client = client.WithReadOnly("") }
+        caps := client.Capabilities()
+        for _, c := range caps {
+            if c.Name == "write" && c.Error == "" { recordTokenAccess(client.CacheScope(), c.Allowed) }
+        }
+        readOnly := detectedReadOnly(cfg, client.CacheScope())
+        if forced { readOnly = readOnlyForcedReason }
+        info := cfg.CurrentKeyInfo()
         if printer(cmd).JSONEnabled() {
-            _ = printer(cmd).PrintJSON(map[string]any{"authenticated": true, "user": viewer})
-        } else {
-            fmt.Printf("Logged in as %s (%s)\n", viewer.Name, viewer.Email)
+            out := map[string]any{"authenticated": true, "user": viewer, "token": tokenKind(cfg.APIKey), "source": cfg.KeySource(), "capabilities": caps, "readOnly": readOnly != ""}
+            if info != nil { out["key"] = info }
+            _ = printer(cmd).PrintJSON(out)
+            return nil
         }
-		return nil
+        fmt.Printf("Logged in as %s (%s)\n", viewer.Name, viewer.Email)
+        fmt.Printf("Token: %s (%s)\n", tokenKind(cfg.APIKey), keySourceLabel(cfg.KeySource()))
+        if info != nil {
+            if info.Workspace != "" { fmt.Printf("Workspace: %s (%s)\n", info.Workspace, info.WorkspaceKey) }
+            fmt.Printf("Key scopes: %s (added %s)\n", strings.Join(info.Scopes, ", "), ui.Date(info.CreatedAt))
+        }
+        if readOnly != "" { fmt.Printf("Mode: read-only; commands that change data are disabled: %s\n", readOnly) }
+        fmt.Println()
+        rows := make([][]string, 0, len(caps))
+        for _, c := range caps {
+            access := "yes"
+            if !c.Allowed { access = "no" }
+            if c.Error != "" { access = "unknown (" + truncate(c.Error, 40) + ")" }
+            rows = append(rows, []string{c.Name, c.Scope, access, capabilityUsers[c.Name]})
+        }
+        return printer(cmd).Table([]string{"Capability", "Scope", "Allowed", "Needed by"}, rows)
 	},
 }
 
+// capabilityUsers names the commands that need each probed capability, so a
+// denied row says what will not work
+var capabilityUsers = map[string]string{
+    "issues":    "issues list/view/due, standup, export",
+    "projects":  "projects list, export",
+    "templates": "templates sync/diff, issues create --template",
+    "write":     "issues create/take/assign/link, comment, templates push",
+    "admin":     "org (SSO and subscription details)",
+}
+
+// keySourceLabel says where the key in use was read from
+func keySourceLabel(source string) string {
+    switch source {
+    case "env":
+        return "from LINEAR_API_KEY"
+    case "command":
+        return "from api_key_cmd"
+    }
+    return "stored in config"
+}
+
+// tokenKind describes the stored credential from its prefix
+func tokenKind(key string) string {
+    switch {
+    case strings.HasPrefix(key, "lin_api_"):
+        return "personal API key"
+    case strings.HasPrefix(key, "lin_oauth_"):
+        return "OAuth access token"
+    }
+    return "unrecognized token format"
+}
+
 // auth test behaves like status but returns non-zero on failure for CI
 var authTestCmd = &cobra.Command{
     Use:   "test",
@@ -111,7 +182,7 @@ var authTestCmd = &cobra.Command{
         if cfg.APIKey == "" {
             return errors.New("no credentials found: set LINEAR_API_KEY or run 'linear-cli auth login'")
         }
-        client := api.NewClient(cfg.APIKey)
+        client := newAPIClient(cmd, cfg.APIKey)
         viewer, err := client.Viewer()
         if err != nil {
             return err
@@ -124,10 +195,86 @@ var authTestCmd = &cobra.Command{
     },
 }
 
+var authLogoutCmd = &cobra.Command{
+    Use:   "logout",
+    Short: "Remove stored credentials",
+    Long:  "Remove the stored API key for the active profile (default, or the one selected with --profile / LINEAR_PROFILE).",
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, err := config.Load()
+        if err != nil { return err }
+        path, _ := config.Path()
+        updated := []string{}
+        keyCmd := cfg.KeySource() == "command"
+        cfg.APIKey, cfg.KeyInfo = "", nil
+        if err := config.Save(cfg); err != nil { return err }
+        updated = append(updated, fmt.Sprintf("%s (profile %s)", path, profileName(cfg)))
+        if cfg.Profile == "" {
+            // The legacy JSON file would otherwise resurrect the key as a fallback
+            removed, err := config.RemoveLegacy()
+            if err != nil { return err }
+            if removed {
+                legacy, _ := config.LegacyPath()
+                updated = append(updated, legacy+" (removed)")
+            }
+        }
+        envSet := os.Getenv("LINEAR_API_KEY") != ""
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            return p.PrintJSON(map[string]any{"profile": profileName(cfg), "updated": updated, "envKeySet": envSet, "apiKeyCmd": keyCmd})
+        }
+        fmt.Printf("Logged out of profile %s. Updated:\n", profileName(cfg))
+        for _, u := range updated { fmt.Printf("  - %s\n", u) }
+        if envSet { fmt.Println("Note: LINEAR_API_KEY is still set in your environment and will continue to be used.") }
+        if keyCmd { fmt.Println("Note: api_key_cmd is still configured and will continue to supply a key; remove it from the config to log out fully.") }
+        return nil
+    },
+}
+
+var authRotateCmd = &cobra.Command{
+    Use:   "rotate --token <new-key>",
+    Short: "Replace the stored API key after verifying the new one",
+    RunE: func(cmd *cobra.Command, args []string) error {
+        token, _ := cmd.Flags().GetString("token")
+        token = strings.TrimSpace(token)
+        if token == "" { return errors.New("--token is required") }
+        // Validate first so a bad key never replaces a working one
+        viewer, err := newAPIClient(cmd, token).Viewer()
+        if err != nil { return fmt.Errorf("new token verification failed, stored credentials unchanged: %w", err) }
+
+        cfg, err := config.Load()
+        if err != nil { return err }
+        keyCmd := cfg.KeySource() == "command"
+        cfg.APIKey, cfg.KeyInfo = token, nil
+        if err := config.Save(cfg); err != nil { return err }
+        path, _ := config.Path()
+        updated := []string{fmt.Sprintf("%s (profile %s)", path, profileName(cfg))}
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            return p.PrintJSON(map[string]any{"profile": profileName(cfg), "updated": updated, "user": viewer})
+        }
+        fmt.Printf("Rotated token for %s (%s). Updated:\n", viewer.Name, viewer.Email)
+        for _, u := range updated { fmt.Printf("  - %s\n", u) }
+        if os.Getenv("LINEAR_API_KEY") != "" { fmt.Println("Note: LINEAR_API_KEY is set in your environment and overrides the stored key.") }
+        if keyCmd { fmt.Println("Note: api_key_cmd is configured and overrides the stored key.") }
+        return nil
+    },
+}
+
+func profileName(cfg *config.Config) string {
+    if cfg.Profile == "" { return "default" }
+    return cfg.Profile
+}
+
 func init() {
 	rootCmd.AddCommand(authCmd)
 	authCmd.AddCommand(authLoginCmd)
 	authCmd.AddCommand(authStatusCmd)
     authCmd.AddCommand(authTestCmd)
+    authCmd.AddCommand(authLogoutCmd)
+    authCmd.AddCommand(authRotateCmd)
     authLoginCmd.Flags().StringP("token", "t", "", "Linear API key (or set LINEAR_API_KEY)")
+    authLoginCmd.Flags().Bool("sso", false, "Guide creating a scoped personal API key in the browser and validate it")
+    authLoginCmd.Flags().String("workspace", "", "Workspace URL key for --sso (the 'acme' in linear.app/acme)")
+    authLoginCmd.Flags().StringSlice("scopes", []string{"read", "write"}, "Scopes the --sso key must have")
+    authRotateCmd.Flags().StringP("token", "t", "", "New Linear API key")
 }
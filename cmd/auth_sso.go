@@ -0,0 +1,126 @@
+package cmd
+
+import (
+    "bufio"
+    "errors"
+    "fmt"
+    "os"
+    "os/exec"
+    "runtime"
+    "sort"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Guided key creation for 'auth login --sso'. Workspaces that sign in through
+// SSO have no password to give a CLI, so the user creates a personal API key in
+// Linear's settings and pastes it here; the key is checked for the scopes it
+// needs before it is stored, together with what was learned about it.
+
+const ssoKeyAttempts = 3
+
+// keySettingsURL is the settings page where personal API keys are created
+func keySettingsURL(workspace string) string {
+    workspace = strings.Trim(strings.TrimSpace(workspace), "/")
+    if workspace == "" { return "https://linear.app/settings/account/security" }
+    return "https://linear.app/" + workspace + "/settings/account/security"
+}
+
+// grantedScopes lists the scopes of the capabilities the key was allowed
+func grantedScopes(caps []api.Capability) []string {
+    seen := map[string]bool{}
+    var out []string
+    for _, c := range caps {
+        if c.Allowed && !seen[c.Scope] { seen[c.Scope] = true; out = append(out, c.Scope) }
+    }
+    sort.Strings(out)
+    return out
+}
+
+// missingScopes returns the wanted scopes not in granted; write implies read
+func missingScopes(want, granted []string) []string {
+    var out []string
+    for _, w := range want {
+        w = strings.ToLower(strings.TrimSpace(w))
+        if w == "" || containsString(granted, w) || (w == "read" && containsString(granted, "write")) { continue }
+        out = append(out, w)
+    }
+    return out
+}
+
+func openBrowser(url string) error {
+    switch runtime.GOOS {
+    case "darwin":
+        return exec.Command("open", url).Start()
+    case "linux":
+        return exec.Command("xdg-open", url).Start()
+    case "windows":
+        return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+    default:
+        return fmt.Errorf("opening a browser is not supported on %s", runtime.GOOS)
+    }
+}
+
+// promptSecret reads a line without echo, falling back to visible input
+// when stdin is not a terminal
+func promptSecret(prompt string) (string, error) {
+    fmt.Print(prompt)
+    b, err := readPassword(int(os.Stdin.Fd()))
+    fmt.Println("")
+    if err == nil { return strings.TrimSpace(string(b)), nil }
+    line, err := readInputLine(bufio.NewReader(os.Stdin))
+    if err != nil && line == "" { return "", err }
+    return strings.TrimSpace(line), nil
+}
+
+func runSSOLogin(cmd *cobra.Command) error {
+    workspace, _ := cmd.Flags().GetString("workspace")
+    want, _ := cmd.Flags().GetStringSlice("scopes")
+    if err := ensureInteractive("API key; create one in Linear's settings and pass --token"); err != nil { return err }
+
+    url := keySettingsURL(workspace)
+    arrow := ui.Symbol("→", ">")
+    fmt.Printf("Create a personal API key in Linear (Settings %s Account %s Security & access).\n", arrow, arrow)
+    fmt.Printf("Give it the %s scope(s), then paste it below.\n", strings.Join(want, ", "))
+    if err := openBrowser(url); err != nil {
+        fmt.Printf("Open this page in your browser: %s\n", url)
+    } else {
+        fmt.Printf("Opened %s\n", url)
+    }
+
+    for attempt := 1; attempt <= ssoKeyAttempts; attempt++ {
+        token, err := promptSecret("Paste the new API key: ")
+        if err != nil { return err }
+        if token == "" { return errors.New("no token provided") }
+        client := newAPIClient(cmd, token)
+        viewer, err := client.Viewer()
+        if err != nil { ui.Warnf("that key did not work: %v", err); continue }
+        scopes := grantedScopes(client.Capabilities())
+        if missing := missingScopes(want, scopes); len(missing) > 0 {
+            ui.Warnf("that key lacks the %s scope(s); it has: %s. Edit or recreate it at %s", strings.Join(missing, ", "), strings.Join(scopes, ", "), url)
+            continue
+        }
+        info := &config.KeyInfo{Fingerprint: config.KeyFingerprint(token), User: viewer.Email, Scopes: scopes, CreatedAt: time.Now().UTC()}
+        if org, err := client.GetOrganization(); err == nil {
+            info.Workspace, info.WorkspaceKey = org.Name, org.URLKey
+        }
+        if ws := strings.TrimSpace(workspace); ws != "" && info.WorkspaceKey != "" && !strings.EqualFold(ws, info.WorkspaceKey) {
+            ui.Warnf("that key belongs to workspace %s, not %s", info.WorkspaceKey, ws)
+        }
+
+        cfg, err := config.Load()
+        if err != nil { return err }
+        cfg.APIKey, cfg.KeyInfo = token, info
+        if err := config.Save(cfg); err != nil { return err }
+        fmt.Printf("Logged in as %s (%s)", viewer.Name, viewer.Email)
+        if info.Workspace != "" { fmt.Printf(" to %s", info.Workspace) }
+        fmt.Printf(" with scopes %s\n", strings.Join(scopes, ", "))
+        return nil
+    }
+    return fmt.Errorf("no usable key after %d attempts; nothing was stored", ssoKeyAttempts)
+}
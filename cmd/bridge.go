@@ -0,0 +1,690 @@
+package cmd
+
+import (
+    "context"
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "net"
+    "net/http"
+    "os"
+    "regexp"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+    "linear-cli/internal/output"
+
+    "github.com/spf13/cobra"
+)
+
+// The alert bridge turns monitoring webhooks into issues. Each payload is
+// reduced to a bridgeAlert: a fingerprint naming the underlying problem, a
+// status and a flat set of fields ({{title}}, {{environment}}, ...) that fill
+// the sections of a team template. The first alert for a fingerprint creates
+// an issue under an idempotency key derived from it (see idempotency.go), so a
+// restarted bridge, or one on another machine, finds the issue again; later
+// alerts comment on it instead, at most once per --quiet period.
+
+var bridgeSources = []string{"sentry", "pagerduty", "generic"}
+
+// bridgeAlert is one webhook payload, normalized. Status is firing, resolved,
+// or ignored for events that are not about an alert (Ignored says why).
+type bridgeAlert struct {
+    Source      string            `json:"source"`
+    Fingerprint string            `json:"fingerprint"`
+    Status      string            `json:"status"`
+    Title       string            `json:"title"`
+    Severity    string            `json:"severity,omitempty"`
+    URL         string            `json:"url,omitempty"`
+    Fields      map[string]string `json:"fields"`
+    Ignored     string            `json:"ignored,omitempty"`
+}
+
+// bridgeMapping fills the template section Section with Value, in which
+// {{field}} references are replaced by the alert's fields
+type bridgeMapping struct {
+    Section string
+    Value   string
+}
+
+// bridgeResult is what the bridge did with one alert
+type bridgeResult struct {
+    At          time.Time `json:"at"`
+    Source      string    `json:"source"`
+    Fingerprint string    `json:"fingerprint,omitempty"`
+    Title       string    `json:"title,omitempty"`
+    Status      string    `json:"status"` // created, updated, resolved, deduplicated, ignored, dry-run or failed
+    Issue       string    `json:"issue,omitempty"`
+    URL         string    `json:"url,omitempty"`
+    Error       string    `json:"error,omitempty"`
+}
+
+var bridgeCmd = &cobra.Command{
+    Use:   "bridge",
+    Short: "Turn alerts from monitoring tools into issues",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var bridgeServeCmd = &cobra.Command{
+    Use:   "serve --source <sentry|pagerduty|generic> --team <KEY> --template <name>",
+    Short: "Receive alert webhooks and create or update issues from a template",
+    // A server runs until stopped; redo would start a second one
+    Annotations: map[string]string{noHistory: "long-running"},
+    Long: `Listen for alert webhooks and turn them into issues of a team, filled in from
+one of its templates. The first alert for a problem creates an issue; the same
+problem firing again adds a comment to that issue (at most once per --quiet
+period) and a resolved alert says so in a comment. Problems are told apart by
+their fingerprint: the Sentry issue, the PagerDuty incident, or the
+"fingerprint" field of a generic payload.
+
+Sources:
+  sentry     Sentry issue alerts and issue webhooks (internal integrations or the
+             legacy webhook plugin); signed with Sentry-Hook-Signature
+  pagerduty  PagerDuty v3 webhook subscriptions for incident events; signed with
+             X-PagerDuty-Signature
+  generic    any JSON object with at least "title", and optionally "fingerprint",
+             "description", "url", "severity", "status" ("resolved" closes) and
+             "environment"; signed with X-Signature-256: sha256=<hex>
+
+Alert fields fill the template's sections by their headings (a "Description"
+gets the message, "Environment" the environment, "Links" the alert URL, ...);
+--map sets a section explicitly, with {{field}} references, and is repeatable.
+Available fields: title, message, culprit, level, environment, release, url,
+project, service, count, first_seen, plus every top-level value of a generic
+payload. Severity sets the priority: fatal or critical is Urgent, error or
+high is High, warning is Medium, info or low is Low.
+
+The template is the team's synced template ('linear-cli templates sync') or a
+local template file of that name. Set --secret (or LINEAR_BRIDGE_SECRET) to the
+webhook's signing secret so only signed requests are accepted. --dry-run logs
+what would be created without creating it.`,
+    Example: `  linear-cli bridge serve --source sentry --team ENG --template "Bug Template" --secret "$SENTRY_SECRET"
+  linear-cli bridge serve --source pagerduty --team OPS --template Incident --listen :8080 --path /pagerduty
+  linear-cli bridge serve --source generic --team ENG --template "Bug Template" \
+    --map "Steps to Reproduce={{runbook}}" --title "[{{environment}}] {{title}}"`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        source, _ := cmd.Flags().GetString("source")
+        teamKey, _ := cmd.Flags().GetString("team")
+        tplName, _ := cmd.Flags().GetString("template")
+        listen, _ := cmd.Flags().GetString("listen")
+        path, _ := cmd.Flags().GetString("path")
+        secret, _ := cmd.Flags().GetString("secret")
+        title, _ := cmd.Flags().GetString("title")
+        mapFlags, _ := cmd.Flags().GetStringArray("map")
+        quiet, _ := cmd.Flags().GetDuration("quiet")
+        source = strings.ToLower(strings.TrimSpace(source))
+        teamKey, tplName = strings.ToUpper(strings.TrimSpace(teamKey)), strings.TrimSpace(tplName)
+        if !containsString(bridgeSources, source) { return fmt.Errorf("--source must be one of: %s", strings.Join(bridgeSources, ", ")) }
+        if teamKey == "" || tplName == "" { return errors.New("--team and --template are required") }
+        if !strings.HasPrefix(path, "/") { path = "/" + path }
+        if secret == "" { secret = os.Getenv("LINEAR_BRIDGE_SECRET") }
+        mapping, err := parseBridgeMap(mapFlags)
+        if err != nil { return err }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        if reason := client.ReadOnly(); reason != "" { return fmt.Errorf("the bridge creates issues, but %s", reason) }
+        team, err := cachedTeamByKeyOrError(client, teamKey)
+        if err != nil { return err }
+        var templateID, content string
+        if info, body, err := GetLocalTemplate(teamKey, tplName); err == nil {
+            templateID, content = info.ID, body
+        } else if body, lerr := loadTemplateContent(tplName, "", ""); lerr == nil && body != "" {
+            content = body
+        } else {
+            return fmt.Errorf("no template '%s' for team %s: sync the team's templates with 'linear-cli templates sync --team %s' or add a local template file", tplName, teamKey, teamKey)
+        }
+        for _, m := range mapping {
+            if bridgeSectionName(content, m.Section) == "" { return fmt.Errorf("--map: template '%s' has no section '%s'", tplName, m.Section) }
+        }
+
+        srv := &bridgeServer{
+            client: client, p: printer(cmd), source: source, secret: secret,
+            teamID: team.ID, templateID: templateID, template: content, mapping: mapping, title: title,
+            quiet: quiet, notified: map[string]bridgeNotice{},
+        }
+        mux := http.NewServeMux()
+        mux.Handle(path, srv)
+        ln, err := net.Listen("tcp", listen)
+        if err != nil { return err }
+        httpSrv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+        if secret == "" { ui.Warnf("no --secret: anyone who can reach %s can create issues", ln.Addr()) }
+        ui.Infof("Listening on http://%s%s for %s alerts, creating %s issues from '%s' (Ctrl-C to stop)", ln.Addr(), path, source, teamKey, tplName)
+
+        served := make(chan error, 1)
+        go func() { served <- httpSrv.Serve(ln) }()
+        select {
+        case err := <-served:
+            return err
+        case <-cmd.Context().Done():
+        }
+        ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+        defer cancel()
+        return httpSrv.Shutdown(ctx)
+    },
+}
+
+// bridgeNotice is the last alert the bridge acted on for a fingerprint
+type bridgeNotice struct {
+    Issue string
+    URL   string
+    At    time.Time
+}
+
+type bridgeServer struct {
+    client     *api.Client
+    p          output.Printer
+    source     string
+    secret     string
+    teamID     string
+    templateID string
+    template   string
+    mapping    []bridgeMapping
+    title      string
+    quiet      time.Duration
+
+    // mu guards the maps only and is never held across a request to Linear.
+    // Alerts for the same problem are serialized by its entry in keys, so two
+    // deliveries of a new problem create one issue while other problems go on.
+    mu       sync.Mutex
+    notified map[string]bridgeNotice
+    keys     map[string]*bridgeKeyLock
+}
+
+// bridgeKeyLock serializes the alerts of one problem; waiters counts the
+// handlers holding or waiting for it so the entry can be dropped after
+type bridgeKeyLock struct {
+    sync.Mutex
+    waiters int
+}
+
+// lockKey waits for other alerts of the same problem and returns the unlock
+func (s *bridgeServer) lockKey(key string) func() {
+    s.mu.Lock()
+    if s.keys == nil { s.keys = map[string]*bridgeKeyLock{} }
+    l := s.keys[key]
+    if l == nil {
+        l = &bridgeKeyLock{}
+        s.keys[key] = l
+    }
+    l.waiters++
+    s.mu.Unlock()
+    l.Lock()
+    return func() {
+        l.Unlock()
+        s.mu.Lock()
+        if l.waiters--; l.waiters == 0 { delete(s.keys, key) }
+        s.mu.Unlock()
+    }
+}
+
+func (s *bridgeServer) notice(key string) (bridgeNotice, bool) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    n, ok := s.notified[key]
+    return n, ok
+}
+
+func (s *bridgeServer) setNotice(key string, n *bridgeNotice) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if n == nil {
+        delete(s.notified, key)
+    } else {
+        s.notified[key] = *n
+    }
+}
+
+func (s *bridgeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+    if r.Method == http.MethodGet || r.Method == http.MethodHead {
+        fmt.Fprintln(w, "ok")
+        return
+    }
+    if r.Method != http.MethodPost {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    if s.secret != "" && !verifyBridgeSignature(s.source, r.Header, body, s.secret) {
+        ui.Warnf("rejected a request from %s: missing or wrong signature", r.RemoteAddr)
+        http.Error(w, "invalid signature", http.StatusUnauthorized)
+        return
+    }
+    alert, err := parseBridgeAlert(s.source, body)
+    if err != nil {
+        ui.Warnf("rejected a %s payload: %v", s.source, err)
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    res := s.handle(alert, time.Now())
+    s.log(res)
+
+    code := http.StatusOK
+    switch res.Status {
+    case "created":
+        code = http.StatusCreated
+    case "failed":
+        // Let the sender retry
+        code = http.StatusBadGateway
+    }
+    w.Header().Set("Content-Type", "application/json")
+    w.WriteHeader(code)
+    _ = json.NewEncoder(w).Encode(res)
+}
+
+// handle creates, comments on or skips the issue for alert
+func (s *bridgeServer) handle(alert *bridgeAlert, now time.Time) bridgeResult {
+    res := bridgeResult{At: now, Source: alert.Source, Fingerprint: alert.Fingerprint, Title: alert.Title, Status: "ignored"}
+    if alert.Status == "ignored" { return res }
+    fail := func(err error) bridgeResult {
+        res.Status, res.Error = "failed", err.Error()
+        return res
+    }
+    key := bridgeKey(alert)
+    defer s.lockKey(key)()
+    if n, ok := s.notice(key); ok && alert.Status == "firing" && now.Sub(n.At) < s.quiet {
+        res.Status, res.Issue, res.URL = "deduplicated", n.Issue, n.URL
+        return res
+    }
+    existing, err := findIdempotentIssue(s.client, key)
+    if err != nil { return fail(err) }
+
+    if existing == nil {
+        // Nothing to resolve when the problem never made it into an issue
+        if alert.Status == "resolved" { return res }
+        in := api.IssueCreateInput{TeamID: s.teamID, TemplateID: s.templateID, Title: bridgeTitle(s.title, alert), Description: withIdempotencyMarker(renderBridgeDescription(s.template, alert, s.mapping), key), Priority: bridgePriority(alert.Severity)}
+        created, err := s.client.CreateIssueAdvanced(in)
+        if errors.Is(err, api.ErrDryRun) {
+            res.Status = "dry-run"
+            return res
+        }
+        if err != nil { return fail(err) }
+        recordIdempotentIssue(s.client, key, created)
+        s.setNotice(key, &bridgeNotice{Issue: created.Identifier, URL: created.URL, At: now})
+        res.Status, res.Issue, res.URL = "created", created.Identifier, created.URL
+        return res
+    }
+
+    res.Issue, res.URL = existing.Identifier, existing.URL
+    _, err = s.client.CreateComment(existing.ID, bridgeComment(alert))
+    if errors.Is(err, api.ErrDryRun) {
+        res.Status = "dry-run"
+        return res
+    }
+    if err != nil { return fail(err) }
+    res.Status = "updated"
+    if alert.Status == "resolved" {
+        res.Status = "resolved"
+        s.setNotice(key, nil)
+    } else {
+        s.setNotice(key, &bridgeNotice{Issue: existing.Identifier, URL: existing.URL, At: now})
+    }
+    return res
+}
+
+func (s *bridgeServer) log(res bridgeResult) {
+    if s.p.JSONEnabled() {
+        _ = s.p.StreamJSON(res)
+        return
+    }
+    when := ui.In(res.At).Format("15:04:05")
+    switch res.Status {
+    case "failed":
+        ui.Warnf("%s: %s", truncate(res.Title, 60), res.Error)
+    case "ignored":
+        if res.Title != "" { fmt.Printf("%s  %-12s  %s\n", when, res.Status, truncate(res.Title, 60)) }
+    default:
+        fmt.Printf("%s  %-12s  %-9s %s\n", when, res.Status, res.Issue, truncate(res.Title, 60))
+    }
+}
+
+// bridgeKey is the idempotency key of the issue for an alert's problem; the
+// fingerprint is hashed since sources put anything in it
+func bridgeKey(a *bridgeAlert) string {
+    sum := sha256.Sum256([]byte(a.Fingerprint))
+    return "bridge:" + a.Source + ":" + hex.EncodeToString(sum[:16])
+}
+
+// verifyBridgeSignature checks the source's HMAC-SHA256 signature of body
+func verifyBridgeSignature(source string, h http.Header, body []byte, secret string) bool {
+    mac := hmac.New(sha256.New, []byte(secret))
+    mac.Write(body)
+    want := hex.EncodeToString(mac.Sum(nil))
+    var candidates []string
+    switch source {
+    case "sentry":
+        candidates = []string{h.Get("Sentry-Hook-Signature")}
+    case "pagerduty":
+        // Several v1= signatures while a secret is being rotated
+        for _, part := range strings.Split(h.Get("X-PagerDuty-Signature"), ",") {
+            candidates = append(candidates, strings.TrimPrefix(strings.TrimSpace(part), "v1="))
+        }
+    default:
+        for _, name := range []string{"X-Signature-256", "X-Hub-Signature-256"} {
+            candidates = append(candidates, strings.TrimPrefix(strings.TrimSpace(h.Get(name)), "sha256="))
+        }
+    }
+    for _, c := range candidates {
+        if c != "" && hmac.Equal([]byte(strings.ToLower(c)), []byte(want)) { return true }
+    }
+    return false
+}
+
+// parseBridgeAlert reads a webhook payload of source
+func parseBridgeAlert(source string, body []byte) (*bridgeAlert, error) {
+    var m map[string]any
+    if err := json.Unmarshal(body, &m); err != nil { return nil, fmt.Errorf("not a JSON object: %w", err) }
+    a := &bridgeAlert{Source: source, Status: "firing", Fields: map[string]string{}}
+    switch source {
+    case "sentry":
+        parseSentryAlert(m, a)
+    case "pagerduty":
+        parsePagerDutyAlert(m, a)
+    default:
+        if err := parseGenericAlert(m, a); err != nil { return nil, err }
+    }
+    if a.Status != "ignored" && a.Title == "" { a.Status, a.Ignored = "ignored", "no title" }
+    if a.Fingerprint == "" { a.Fingerprint = a.Title + "\n" + a.Fields["culprit"] }
+    a.Fields["title"], a.Fields["url"] = a.Title, a.URL
+    if a.Fields["level"] == "" { a.Fields["level"] = a.Severity }
+    return a, nil
+}
+
+func parseSentryAlert(m map[string]any, a *bridgeAlert) {
+    action := jsonString(m, "action")
+    // Issue alerts carry the event, issue webhooks the issue, and the legacy
+    // webhook plugin sends the issue fields at the top level
+    data := jsonObject(m, "data", "event")
+    if data == nil { data = jsonObject(m, "data", "issue") }
+    if data == nil && jsonString(m, "project") != "" { data = m }
+    if data == nil {
+        a.Status, a.Ignored = "ignored", "not an issue or event payload"
+        return
+    }
+    a.Title = firstNonEmpty(jsonString(data, "title"), jsonString(data, "message"))
+    a.URL = firstNonEmpty(jsonString(data, "web_url"), jsonString(data, "permalink"), jsonString(data, "url"))
+    a.Severity = jsonString(data, "level")
+    id := firstNonEmpty(jsonString(data, "issue_id"), jsonString(data, "groupID"))
+    if jsonObject(m, "data", "event") == nil { id = firstNonEmpty(id, jsonString(data, "id")) }
+    if id != "" {
+        a.Fingerprint = "issue:" + id
+    } else if fp, ok := data["fingerprint"].([]any); ok && len(fp) > 0 {
+        parts := make([]string, len(fp))
+        for i, v := range fp { parts[i] = fmt.Sprint(v) }
+        a.Fingerprint = strings.Join(parts, "|")
+    }
+    switch action {
+    case "resolved":
+        a.Status = "resolved"
+    case "assigned", "ignored", "archived", "unresolved":
+        a.Status, a.Ignored = "ignored", "issue "+action
+    }
+    f := a.Fields
+    f["message"] = firstNonEmpty(jsonString(data, "message"), jsonString(data, "metadata", "value"), a.Title)
+    f["culprit"] = firstNonEmpty(jsonString(data, "culprit"), jsonString(data, "transaction"))
+    f["environment"] = jsonString(data, "environment")
+    f["release"] = jsonString(data, "release")
+    f["project"] = firstNonEmpty(jsonString(data, "project", "slug"), jsonString(data, "project_slug"), jsonString(m, "project_name"), jsonString(data, "project"))
+    f["count"] = jsonString(data, "count")
+    f["first_seen"] = jsonString(data, "firstSeen")
+    f["rule"] = jsonString(m, "data", "triggered_rule")
+    // Tags arrive as [key, value] pairs; environment and release are among them
+    if tags, ok := data["tags"].([]any); ok {
+        for _, t := range tags {
+            if kv, ok := t.([]any); ok && len(kv) == 2 {
+                k := strings.ToLower(fmt.Sprint(kv[0]))
+                if f[k] == "" { f[k] = fmt.Sprint(kv[1]) }
+            }
+        }
+    }
+}
+
+func parsePagerDutyAlert(m map[string]any, a *bridgeAlert) {
+    event := jsonObject(m, "event")
+    data := jsonObject(m, "event", "data")
+    if event == nil || data == nil || jsonString(data, "type") != "incident" {
+        a.Status, a.Ignored = "ignored", "not an incident event"
+        return
+    }
+    switch kind := jsonString(event, "event_type"); kind {
+    case "incident.triggered", "incident.reopened", "incident.escalated":
+    case "incident.resolved":
+        a.Status = "resolved"
+    default:
+        a.Status, a.Ignored = "ignored", kind
+    }
+    a.Title = jsonString(data, "title")
+    a.URL = jsonString(data, "html_url")
+    a.Fingerprint = "incident:" + jsonString(data, "id")
+    a.Severity = firstNonEmpty(jsonString(data, "priority", "summary"), jsonString(data, "urgency"))
+    f := a.Fields
+    f["service"] = jsonString(data, "service", "summary")
+    f["message"] = a.Title
+    if f["service"] != "" { f["message"] = a.Title + " (" + f["service"] + ")" }
+    f["urgency"] = jsonString(data, "urgency")
+    f["number"] = jsonString(data, "number")
+    f["first_seen"] = firstNonEmpty(jsonString(data, "created_at"), jsonString(event, "occurred_at"))
+}
+
+func parseGenericAlert(m map[string]any, a *bridgeAlert) error {
+    for k, v := range m {
+        switch v.(type) {
+        case map[string]any, []any, nil:
+            continue
+        }
+        a.Fields[strings.ToLower(k)] = fmt.Sprint(v)
+    }
+    f := a.Fields
+    a.Title = strings.TrimSpace(firstNonEmpty(f["title"], f["summary"], f["name"]))
+    if a.Title == "" { return errors.New(`generic payloads need a "title"`) }
+    a.URL = firstNonEmpty(f["url"], f["link"])
+    a.Severity = firstNonEmpty(f["severity"], f["level"], f["priority"])
+    a.Fingerprint = f["fingerprint"]
+    if f["message"] == "" { f["message"] = firstNonEmpty(f["description"], a.Title) }
+    switch strings.ToLower(f["status"]) {
+    case "resolved", "ok", "closed", "recovered":
+        a.Status = "resolved"
+    }
+    return nil
+}
+
+// bridgePriority maps an alert severity to a Linear priority, nil when unknown
+func bridgePriority(severity string) *int {
+    var p int
+    switch strings.ToLower(strings.TrimSpace(severity)) {
+    case "fatal", "critical", "p1", "sev1":
+        p = 1
+    case "error", "high", "p2", "sev2":
+        p = 2
+    case "warning", "warn", "medium", "p3", "sev3":
+        p = 3
+    case "info", "low", "debug", "p4", "p5", "sev4":
+        p = 4
+    default:
+        return nil
+    }
+    return &p
+}
+
+var reBridgeField = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// expandBridgeFields replaces {{field}} references with the alert's fields.
+// A line whose references are all empty is dropped, so a missing field does
+// not leave a dangling "- Release:" behind.
+func expandBridgeFields(s string, fields map[string]string) string {
+    var kept []string
+    for _, line := range strings.Split(s, "\n") {
+        refs, empty := 0, 0
+        line = reBridgeField.ReplaceAllStringFunc(line, func(ref string) string {
+            v := fields[strings.ToLower(reBridgeField.FindStringSubmatch(ref)[1])]
+            refs++
+            if strings.TrimSpace(v) == "" { empty++ }
+            return v
+        })
+        if refs > 0 && refs == empty { continue }
+        kept = append(kept, strings.TrimRight(line, " "))
+    }
+    return strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+func bridgeTitle(format string, a *bridgeAlert) string {
+    if strings.TrimSpace(format) == "" { return truncate(a.Title, 250) }
+    if t := expandBridgeFields(format, a.Fields); t != "" { return truncate(t, 250) }
+    return truncate(a.Title, 250)
+}
+
+// bridgeSectionDefaults fill sections by heading when --map does not: the
+// first rule whose words appear in a heading gives the section its value
+var bridgeSectionDefaults = []struct {
+    words []string
+    value string
+}{
+    {[]string{"description", "summary", "overview", "problem", "details", "context"}, "{{message}}"},
+    {[]string{"actual", "observed", "error", "exception", "log", "logs", "trace", "stack"}, "```\n{{title}}\n{{culprit}}\n```"},
+    {[]string{"environment", "env", "platform", "version"}, "- Environment: {{environment}}\n- Release: {{release}}\n- Service: {{service}}"},
+    {[]string{"impact", "severity", "priority", "urgency", "frequency"}, "- Severity: {{level}}\n- Events: {{count}}\n- First seen: {{first_seen}}"},
+    {[]string{"link", "links", "reference", "references", "resources"}, "{{url}}"},
+}
+
+// bridgeSectionName returns the template section named name, ignoring case,
+// or the one most like it
+func bridgeSectionName(content, name string) string {
+    specs := templateSectionSpecs(content)
+    for _, s := range specs {
+        if strings.EqualFold(s.Name, strings.TrimSpace(name)) { return s.Name }
+    }
+    return bestSection(name, specs)
+}
+
+// renderBridgeDescription fills the template's sections from the alert, the
+// --map entries first, and appends the alert's own details
+func renderBridgeDescription(content string, a *bridgeAlert, mapping []bridgeMapping) string {
+    done := map[string]bool{}
+    for _, m := range mapping {
+        name := bridgeSectionName(content, m.Section)
+        v := expandBridgeFields(m.Value, a.Fields)
+        if name == "" || v == "" { continue }
+        content = fillSingleSection(content, name, v)
+        done[name] = true
+    }
+    for _, s := range templateSectionSpecs(content) {
+        if done[s.Name] { continue }
+        words := headingWords(s.Name)
+        for _, d := range bridgeSectionDefaults {
+            matched := false
+            for _, w := range words { if containsString(d.words, w) { matched = true; break } }
+            if !matched { continue }
+            if v := expandBridgeFields(d.value, a.Fields); v != "" { content = fillSingleSection(content, s.Name, v) }
+            break
+        }
+    }
+    return strings.TrimRight(content, "\n") + "\n\n" + bridgeDetails(a)
+}
+
+// bridgeDetails lists the alert's fields under the description
+func bridgeDetails(a *bridgeAlert) string {
+    var b strings.Builder
+    name := a.Source
+    switch a.Source {
+    case "sentry":
+        name = "Sentry"
+    case "pagerduty":
+        name = "PagerDuty"
+    }
+    if a.URL != "" {
+        fmt.Fprintf(&b, "---\nCreated from a [%s alert](%s).\n", name, a.URL)
+    } else {
+        fmt.Fprintf(&b, "---\nCreated from a %s alert.\n", name)
+    }
+    for _, k := range sortedKeys(a.Fields) {
+        v := strings.TrimSpace(a.Fields[k])
+        if v == "" || k == "title" || k == "url" || k == "message" || len(v) > 200 || strings.Contains(v, "\n") { continue }
+        fmt.Fprintf(&b, "\n- %s: %s", k, v)
+    }
+    return strings.TrimRight(b.String(), "\n")
+}
+
+// bridgeComment is the comment for a problem that fired again or resolved
+func bridgeComment(a *bridgeAlert) string {
+    verb := "fired again"
+    if a.Status == "resolved" { verb = "resolved" }
+    msg := fmt.Sprintf("Alert %s: %s", verb, a.Title)
+    if a.URL != "" { msg = fmt.Sprintf("Alert %s: [%s](%s)", verb, a.Title, a.URL) }
+    var extra []string
+    if n := a.Fields["count"]; n != "" { extra = append(extra, n+" events") }
+    if e := a.Fields["environment"]; e != "" { extra = append(extra, e) }
+    if len(extra) > 0 { msg += " (" + strings.Join(extra, ", ") + ")" }
+    return msg
+}
+
+// parseBridgeMap reads --map values like "Environment={{environment}}"
+func parseBridgeMap(values []string) ([]bridgeMapping, error) {
+    out := make([]bridgeMapping, 0, len(values))
+    for _, v := range values {
+        name, value, ok := strings.Cut(v, "=")
+        if !ok || strings.TrimSpace(name) == "" { return nil, fmt.Errorf("invalid --map '%s': use Section={{field}}", v) }
+        out = append(out, bridgeMapping{Section: strings.TrimSpace(name), Value: strings.ReplaceAll(value, `\n`, "\n")})
+    }
+    return out, nil
+}
+
+// jsonObject walks path through nested objects
+func jsonObject(m map[string]any, path ...string) map[string]any {
+    for _, k := range path {
+        next, ok := m[k].(map[string]any)
+        if !ok { return nil }
+        m = next
+    }
+    return m
+}
+
+// jsonString returns the scalar at path as a string, or ""
+func jsonString(m map[string]any, path ...string) string {
+    if o := jsonObject(m, path[:len(path)-1]...); o != nil {
+        switch v := o[path[len(path)-1]].(type) {
+        case string:
+            return strings.TrimSpace(v)
+        case float64:
+            return strconv.FormatFloat(v, 'f', -1, 64)
+        case bool:
+            return strconv.FormatBool(v)
+        }
+    }
+    return ""
+}
+
+func firstNonEmpty(values ...string) string {
+    for _, v := range values {
+        if strings.TrimSpace(v) != "" { return v }
+    }
+    return ""
+}
+
+func init() {
+    rootCmd.AddCommand(bridgeCmd)
+    bridgeCmd.AddCommand(bridgeServeCmd)
+    f := bridgeServeCmd.Flags()
+    f.String("source", "", "Webhook format: "+strings.Join(bridgeSources, ", ")+" (required)")
+    f.String("team", "", "Team key to create issues in (required)")
+    f.String("template", "", "Template to fill from each alert (required)")
+    f.String("listen", "127.0.0.1:8787", "Address to listen on")
+    f.String("path", "/", "URL path the webhooks are sent to")
+    f.String("secret", "", "Webhook signing secret; unsigned requests are rejected (or set LINEAR_BRIDGE_SECRET)")
+    f.String("title", "", "Issue title with {{field}} references (default: the alert title)")
+    f.StringArray("map", nil, "Fill a section from alert fields, e.g. \"Environment={{environment}} {{release}}\" (repeatable)")
+    f.Duration("quiet", time.Hour, "Comment on an issue at most this often while its alert keeps firing")
+}
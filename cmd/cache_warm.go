@@ -0,0 +1,198 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+    "sync"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// 'cache warm' fetches each team's create context (states, labels, members and
+// templates, see api.CreateContext) ahead of time and keeps it in
+// create_context.json, scoped per workspace credentials like the team cache.
+// Commands that resolve a create context use a warm entry until it expires
+// instead of querying; anything missing from it (a label created since, say)
+// still falls back to the individual lookups.
+
+var cacheCmd = &cobra.Command{
+    Use:   "cache",
+    Short: "Manage the local lookup caches",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var cacheWarmCmd = &cobra.Command{
+    Use:   "warm [--team <key>...]",
+    Short: "Pre-fetch teams, states, labels, members and templates into the local cache",
+    Long: `Fetch the teams and, for each team, its workflow states, labels, members and
+issue templates in one parallel pass, and keep them in the local cache so
+later 'issues create', 'drafts submit' and team lookups skip those queries.
+Useful before an agent or script runs many commands in a row.
+
+Without --team every team you can see is warmed. Entries are used for --ttl
+(default 1h); run 'cache warm' again to refresh them. Template content for
+'--template' files still comes from 'templates sync'.`,
+    Example: `  linear-cli cache warm --team ENG
+  linear-cli cache warm --team ENG --team OPS --ttl 8h
+  linear-cli cache warm`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        keys, _ := cmd.Flags().GetStringSlice("team")
+        ttl, _ := cmd.Flags().GetDuration("ttl")
+        if ttl <= 0 { return errors.New("--ttl must be positive") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        start := time.Now()
+
+        teams, err := client.ListAllTeams()
+        if err != nil { return err }
+        byKey := map[string]api.Team{}
+        for _, t := range teams { byKey[strings.ToUpper(t.Key)] = t }
+        if len(keys) == 0 {
+            for _, t := range teams { keys = append(keys, t.Key) }
+        }
+        var missing []string
+        for i, k := range keys {
+            keys[i] = strings.ToUpper(strings.TrimSpace(k))
+            if _, ok := byKey[keys[i]]; !ok { missing = append(missing, keys[i]) }
+        }
+        if len(missing) > 0 { return fmt.Errorf("team(s) not found: %s", strings.Join(missing, ", ")) }
+
+        results := warmCreateContexts(client, keys)
+        expires := time.Now().Add(ttl)
+        cache := loadCreateContextCache()
+        scope := client.CacheScope()
+        if cache[scope] == nil { cache[scope] = map[string]createContextEntry{} }
+        warmed := 0
+        for _, r := range results {
+            if r.ctx == nil { continue }
+            cache[scope][r.Team] = createContextEntry{FetchedAt: start, ExpiresAt: expires, Context: *r.ctx}
+            warmed++
+        }
+        if err := saveCreateContextCache(cache); err != nil { return fmt.Errorf("saving the cache: %w", err) }
+        teamCache := loadTeamCache()
+        teamCache[scope] = byKey
+        saveTeamCache(teamCache)
+
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            return p.PrintJSON(map[string]any{"teams": results, "expiresAt": expires, "durationMs": time.Since(start).Milliseconds()})
+        }
+        rows := make([][]string, 0, len(results))
+        for _, r := range results {
+            if r.Error != "" {
+                rows = append(rows, []string{r.Team, "failed: " + r.Error, "", "", ""})
+                continue
+            }
+            rows = append(rows, []string{r.Team, fmt.Sprint(r.States), fmt.Sprint(r.Labels), fmt.Sprint(r.Members), fmt.Sprint(r.Templates)})
+        }
+        if err := p.Table([]string{"Team", "States", "Labels", "Members", "Templates"}, rows); err != nil { return err }
+        ui.Infof("%s Warmed %d of %d team(s) in %s; cached until %s", p.Symbol("✅", "OK"), warmed, len(results), time.Since(start).Round(time.Millisecond), ui.Time(expires))
+        if warmed == 0 && len(results) > 0 { return errors.New("no team could be warmed") }
+        return nil
+    },
+}
+
+// warmResult is what 'cache warm' fetched for one team
+type warmResult struct {
+    Team      string `json:"team"`
+    States    int    `json:"states"`
+    Labels    int    `json:"labels"`
+    Members   int    `json:"members"`
+    Templates int    `json:"templates"`
+    Error     string `json:"error,omitempty"`
+    ctx       *api.CreateContext
+}
+
+// warmParallelism bounds the create context queries in flight at once
+const warmParallelism = 4
+
+// warmCreateContexts fetches the create context of each team key in parallel;
+// results are in the order of keys
+func warmCreateContexts(client *api.Client, keys []string) []warmResult {
+    results := make([]warmResult, len(keys))
+    sem := make(chan struct{}, warmParallelism)
+    var wg sync.WaitGroup
+    for i, key := range keys {
+        wg.Add(1)
+        go func(i int, key string) {
+            defer wg.Done()
+            sem <- struct{}{}
+            defer func() { <-sem }()
+            r := warmResult{Team: key}
+            ctx, err := client.CreateContextForTeam(key)
+            switch {
+            case err != nil:
+                r.Error = err.Error()
+            case ctx == nil:
+                r.Error = "team not found"
+            default:
+                r.ctx = ctx
+                r.States, r.Labels, r.Members, r.Templates = len(ctx.States), len(ctx.Labels), len(ctx.Members), len(ctx.Templates)
+            }
+            results[i] = r
+        }(i, key)
+    }
+    wg.Wait()
+    return results
+}
+
+// createContextEntry is a warmed create context and how long it may be used
+type createContextEntry struct {
+    FetchedAt time.Time         `json:"fetchedAt"`
+    ExpiresAt time.Time         `json:"expiresAt"`
+    Context   api.CreateContext `json:"context"`
+}
+
+type createContextCacheFile map[string]map[string]createContextEntry
+
+func createContextCachePath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "create_context.json"), nil
+}
+
+func loadCreateContextCache() createContextCacheFile {
+    cache := createContextCacheFile{}
+    p, err := createContextCachePath()
+    if err != nil { return cache }
+    if b, err := os.ReadFile(p); err == nil { _ = json.Unmarshal(b, &cache) }
+    return cache
+}
+
+func saveCreateContextCache(cache createContextCacheFile) error {
+    p, err := createContextCachePath()
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    b, err := json.MarshalIndent(cache, "", "  ")
+    if err != nil { return err }
+    return os.WriteFile(p, b, 0o600)
+}
+
+// teamCreateContext returns the create context for a team key, from a warm
+// cache entry when one has not expired, otherwise from the API
+func teamCreateContext(client *api.Client, key string) (*api.CreateContext, error) {
+    key = strings.ToUpper(strings.TrimSpace(key))
+    if e, ok := loadCreateContextCache()[client.CacheScope()][key]; ok && time.Now().Before(e.ExpiresAt) && e.Context.Team.ID != "" {
+        notePerfCache("create-context", true)
+        return &e.Context, nil
+    }
+    notePerfCache("create-context", false)
+    return client.CreateContextForTeam(key)
+}
+
+func init() {
+    rootCmd.AddCommand(cacheCmd)
+    cacheCmd.AddCommand(cacheWarmCmd)
+    cacheWarmCmd.Flags().StringSlice("team", nil, "Team key to warm (repeatable or comma-separated; default: every team)")
+    cacheWarmCmd.Flags().Duration("ttl", time.Hour, "How long commands may use the warmed entries")
+}
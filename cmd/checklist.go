@@ -0,0 +1,169 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "regexp"
+    "strconv"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesChecklistCmd = &cobra.Command{
+    Use:   "checklist <issue-key>",
+    Short: "List the '- [ ]' checklist items in an issue's description",
+    Example: `  linear-cli issues checklist ENG-7
+  linear-cli --json issues checklist ENG-7`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        det, err := issueForChecklist(client, args[0])
+        if err != nil { return err }
+        items := parseChecklist(det.Description)
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            return p.PrintJSON(map[string]any{"issue": det.Identifier, "done": checklistDone(items), "total": len(items), "items": items})
+        }
+        if len(items) == 0 {
+            fmt.Printf("%s has no checklist items\n", det.Identifier)
+            return nil
+        }
+        rows := make([][]string, 0, len(items))
+        for _, it := range items {
+            box := "[ ]"
+            if it.Checked { box = "[x]" }
+            rows = append(rows, []string{strconv.Itoa(it.Index), box, it.Text})
+        }
+        if err := p.Table([]string{"#", "Done", "Item"}, rows); err != nil { return err }
+        fmt.Printf("\n%d of %d done\n", checklistDone(items), len(items))
+        return nil
+    },
+}
+
+var issuesCheckCmd = &cobra.Command{
+    Use:   "check <issue-key> <item>",
+    Short: "Tick (or with --uncheck, untick) a checklist item in an issue's description",
+    Long: `Tick a '- [ ]' item in the issue's description and save it. The item is its
+number from 'issues checklist' or its text: an exact (case-insensitive) match,
+else a unique substring.`,
+    Example: `  linear-cli issues check ENG-7 "Add tests"
+  linear-cli issues check ENG-7 2
+  linear-cli issues check ENG-7 "Add tests" --uncheck`,
+    Args: cobra.ExactArgs(2),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        uncheck, _ := cmd.Flags().GetBool("uncheck")
+        client := newAPIClient(cmd, cfg.APIKey)
+        det, err := issueForChecklist(client, args[0])
+        if err != nil { return err }
+        items := parseChecklist(det.Description)
+        if len(items) == 0 { return fmt.Errorf("%s has no checklist items", det.Identifier) }
+        it, err := findChecklistItem(items, args[1])
+        if err != nil { return err }
+
+        p := printer(cmd)
+        verb := "Checked"
+        if uncheck { verb = "Unchecked" }
+        if it.Checked != !uncheck {
+            desc := setChecklistItem(det.Description, it.Line, !uncheck)
+            if _, err := client.UpdateIssueAdvanced(det.ID, api.IssueUpdateInput{Description: desc}); err != nil { return err }
+            it.Checked = !uncheck
+            items[it.Index-1] = it
+        } else {
+            verb = "Already " + strings.ToLower(verb)
+        }
+        if p.JSONEnabled() {
+            return p.PrintJSON(map[string]any{"issue": det.Identifier, "item": it, "done": checklistDone(items), "total": len(items)})
+        }
+        fmt.Printf("%s %s: %s (%d of %d done)\n", verb, det.Identifier, it.Text, checklistDone(items), len(items))
+        return nil
+    },
+}
+
+func issueForChecklist(client *api.Client, key string) (*api.IssueDetails, error) {
+    iss, err := resolveIssue(client, key)
+    if err != nil { return nil, err }
+    det, err := client.GetIssueDetails(iss.ID)
+    if err != nil { return nil, err }
+    if det == nil { return nil, fmt.Errorf("issue %s not found", key) }
+    return det, nil
+}
+
+// checklistItem is a task list item of a markdown description. Line is its
+// 0-based line in the description and Index its 1-based position in the list.
+type checklistItem struct {
+    Index   int    `json:"index"`
+    Checked bool   `json:"checked"`
+    Text    string `json:"text"`
+    Line    int    `json:"line"`
+}
+
+var reChecklistItem = regexp.MustCompile(`^(\s*[-*+]\s+\[)([ xX])(\]\s+)(.*)$`)
+
+// parseChecklist finds the "- [ ]" and "- [x]" items of a description, skipping
+// fenced code blocks
+func parseChecklist(desc string) []checklistItem {
+    var out []checklistItem
+    fenced := false
+    for i, line := range strings.Split(desc, "\n") {
+        if t := strings.TrimSpace(line); strings.HasPrefix(t, "```") || strings.HasPrefix(t, "~~~") { fenced = !fenced; continue }
+        if fenced { continue }
+        m := reChecklistItem.FindStringSubmatch(strings.TrimRight(line, "\r"))
+        if m == nil { continue }
+        out = append(out, checklistItem{Index: len(out) + 1, Checked: m[2] != " ", Text: strings.TrimSpace(m[4]), Line: i})
+    }
+    return out
+}
+
+// setChecklistItem rewrites the box on the given line, leaving every other
+// byte of the description as it was
+func setChecklistItem(desc string, line int, checked bool) string {
+    lines := strings.Split(desc, "\n")
+    if line < 0 || line >= len(lines) { return desc }
+    box := " "
+    if checked { box = "x" }
+    lines[line] = reChecklistItem.ReplaceAllString(lines[line], "${1}"+box+"${3}${4}")
+    return strings.Join(lines, "\n")
+}
+
+// findChecklistItem picks an item by number, exact text or unique substring
+func findChecklistItem(items []checklistItem, ref string) (checklistItem, error) {
+    ref = strings.TrimSpace(ref)
+    if n, err := strconv.Atoi(ref); err == nil {
+        if n < 1 || n > len(items) { return checklistItem{}, fmt.Errorf("no checklist item %d (items are 1-%d)", n, len(items)) }
+        return items[n-1], nil
+    }
+    var partial []checklistItem
+    for _, it := range items {
+        if strings.EqualFold(it.Text, ref) { return it, nil }
+        if strings.Contains(strings.ToLower(it.Text), strings.ToLower(ref)) { partial = append(partial, it) }
+    }
+    switch len(partial) {
+    case 1:
+        return partial[0], nil
+    case 0:
+        return checklistItem{}, fmt.Errorf("no checklist item matches %q", ref)
+    }
+    names := make([]string, len(partial))
+    for i, it := range partial { names[i] = fmt.Sprintf("%d. %s", it.Index, it.Text) }
+    return checklistItem{}, fmt.Errorf("%q matches %d items; use the number:\n  %s", ref, len(partial), strings.Join(names, "\n  "))
+}
+
+func checklistDone(items []checklistItem) int {
+    n := 0
+    for _, it := range items { if it.Checked { n++ } }
+    return n
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesChecklistCmd)
+    issuesCmd.AddCommand(issuesCheckCmd)
+    issuesCheckCmd.Flags().Bool("uncheck", false, "Untick the item instead")
+}
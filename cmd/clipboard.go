@@ -0,0 +1,71 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "os/exec"
+    "runtime"
+    "strings"
+)
+
+// readClipboard returns the system clipboard as text using the platform's
+// clipboard tool: pbpaste (macOS), PowerShell Get-Clipboard (Windows), and
+// wl-paste, xclip or xsel (Linux/BSD, first one found).
+func readClipboard() (string, error) {
+    var candidates [][]string
+    switch runtime.GOOS {
+    case "darwin":
+        candidates = [][]string{{"pbpaste"}}
+    case "windows":
+        candidates = [][]string{{"powershell.exe", "-NoProfile", "-Command", "Get-Clipboard -Raw"}}
+    default:
+        if os.Getenv("WAYLAND_DISPLAY") != "" { candidates = append(candidates, []string{"wl-paste", "--no-newline"}) }
+        candidates = append(candidates, []string{"xclip", "-selection", "clipboard", "-o"}, []string{"xsel", "--clipboard", "--output"})
+    }
+    for _, c := range candidates {
+        if _, err := exec.LookPath(c[0]); err != nil { continue }
+        out, err := exec.Command(c[0], c[1:]...).Output()
+        if err != nil { return "", fmt.Errorf("reading clipboard with %s failed: %w", c[0], err) }
+        return strings.ReplaceAll(string(out), "\r\n", "\n"), nil
+    }
+    if runtime.GOOS == "linux" { return "", errors.New("no clipboard tool found (install wl-clipboard, xclip or xsel)") }
+    return "", fmt.Errorf("no clipboard tool available on %s", runtime.GOOS)
+}
+
+// writeClipboard puts text on the system clipboard with pbcopy (macOS), clip
+// (Windows), or wl-copy, xclip or xsel (Linux/BSD, first one found).
+func writeClipboard(text string) error {
+    var candidates [][]string
+    switch runtime.GOOS {
+    case "darwin":
+        candidates = [][]string{{"pbcopy"}}
+    case "windows":
+        candidates = [][]string{{"clip.exe"}}
+    default:
+        if os.Getenv("WAYLAND_DISPLAY") != "" { candidates = append(candidates, []string{"wl-copy"}) }
+        candidates = append(candidates, []string{"xclip", "-selection", "clipboard"}, []string{"xsel", "--clipboard", "--input"})
+    }
+    for _, c := range candidates {
+        if _, err := exec.LookPath(c[0]); err != nil { continue }
+        cmd := exec.Command(c[0], c[1:]...)
+        cmd.Stdin = strings.NewReader(text)
+        if err := cmd.Run(); err != nil { return fmt.Errorf("writing clipboard with %s failed: %w", c[0], err) }
+        return nil
+    }
+    if runtime.GOOS == "linux" { return errors.New("no clipboard tool found (install wl-clipboard, xclip or xsel)") }
+    return fmt.Errorf("no clipboard tool available on %s", runtime.GOOS)
+}
+
+// splitClipboardDraft splits "title\n---\nbody" into its parts. Without a "---"
+// separator line the whole text is returned as the body.
+func splitClipboardDraft(text string) (title, body string) {
+    lines := strings.Split(text, "\n")
+    for i, l := range lines {
+        if strings.TrimSpace(l) != "---" { continue }
+        head := strings.TrimSpace(strings.Join(lines[:i], "\n"))
+        if head == "" || strings.Contains(head, "\n") { break }
+        return strings.TrimPrefix(head, "# "), strings.TrimSpace(strings.Join(lines[i+1:], "\n"))
+    }
+    return "", strings.TrimSpace(text)
+}
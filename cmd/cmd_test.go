@@ -1,13 +1,34 @@
 package cmd
 
 import (
+    "context"
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
     "io"
     "net/http"
     "net/http/httptest"
     "os"
+    "os/exec"
+    "path/filepath"
     "regexp"
+    "strconv"
     "strings"
+    "sync"
     "testing"
+    "time"
+    "unicode/utf8"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+    "linear-cli/internal/output"
+
+    "github.com/spf13/cobra"
+    "github.com/spf13/pflag"
+    bolt "go.etcd.io/bbolt"
 )
 
 // helper to run a command and capture stdout/stderr
@@ -17,6 +38,9 @@ func runCLI(t *testing.T, args ...string) (stdout string, stderr string, exitErr
     oldArgs := os.Args
     os.Args = append([]string{"linear-cli"}, args...)
     t.Cleanup(func(){ os.Args = oldArgs })
+    // The run sets the global printer from its flags and terminal
+    oldUI := ui
+    t.Cleanup(func(){ ui = oldUI })
 
     // Capture stdio
     oldOut, oldErr := os.Stdout, os.Stderr
@@ -25,6 +49,11 @@ func runCLI(t *testing.T, args ...string) (stdout string, stderr string, exitErr
     os.Stdout, os.Stderr = wOut, wErr
     t.Cleanup(func(){ os.Stdout, os.Stderr = oldOut, oldErr })
 
+    // Commands keep the context of the previous Execute, which is canceled by now
+    var resetCtx func(c *cobra.Command)
+    resetCtx = func(c *cobra.Command) { c.SetContext(nil); for _, sub := range c.Commands() { resetCtx(sub) } }
+    resetCtx(rootCmd)
+
     // Run
     var runErr error
     func(){
@@ -72,3 +101,2049 @@ func TestIssuesView_WithKey_JSONOutput(t *testing.T) {
         t.Fatalf("expected JSON to contain identifier POK-28, got: %s", out)
     }
 }
+
+func TestParsePriority_NamesAndNumbers(t *testing.T) {
+    cases := map[string]int{"urgent": 1, "High": 2, "medium": 3, "low": 4, "none": 0, "2": 2}
+    for in, want := range cases {
+        got, err := parsePriority(in)
+        if err != nil { t.Fatalf("parsePriority(%q) error: %v", in, err) }
+        if got != want { t.Fatalf("parsePriority(%q) = %d, want %d", in, got, want) }
+    }
+    if _, err := parsePriority("critical"); err == nil { t.Fatalf("expected error for unknown priority name") }
+    if _, err := parsePriority("7"); err == nil { t.Fatalf("expected error for out-of-range priority") }
+}
+
+func TestParseUntil_RelativeTargets(t *testing.T) {
+    // Wednesday 2025-01-15 14:30 local
+    now := time.Date(2025, 1, 15, 14, 30, 0, 0, time.Local)
+    cases := map[string]time.Time{
+        "tomorrow":   time.Date(2025, 1, 16, 9, 0, 0, 0, time.Local),
+        "monday":     time.Date(2025, 1, 20, 9, 0, 0, 0, time.Local),
+        "wednesday":  time.Date(2025, 1, 22, 9, 0, 0, 0, time.Local),
+        "3d":         now.AddDate(0, 0, 3),
+        "4h":         now.Add(4 * time.Hour),
+        "2025-02-01": time.Date(2025, 2, 1, 9, 0, 0, 0, time.Local),
+    }
+    for in, want := range cases {
+        got, err := parseUntil(in, now)
+        if err != nil { t.Fatalf("parseUntil(%q) error: %v", in, err) }
+        if !got.Equal(want) { t.Fatalf("parseUntil(%q) = %v, want %v", in, got, want) }
+    }
+    if _, err := parseUntil("someday", now); err == nil { t.Fatalf("expected error for unrecognized value") }
+}
+
+func TestBuildAuditGroups_FlagsNearDuplicates(t *testing.T) {
+    groups := buildAuditGroups([]auditEntry{
+        {Team: "ENG", Name: "Bug", Color: "#f00"},
+        {Team: "OPS", Name: "bugs", Color: "#F00"},
+        {Team: "WEB", Name: "Feature", Color: "#0f0"},
+        {Team: "OPS", Name: "Feature", Color: "#00f"},
+        {Team: "ENG", Name: "Docs", Color: "#999"},
+    })
+    byKey := map[string]auditGroup{}
+    for _, g := range groups { byKey[g.Key] = g }
+    if g := byKey["bug"]; len(g.Variants) != 2 || len(g.Colors) != 1 || len(g.Findings) != 1 {
+        t.Fatalf("expected Bug/bugs flagged as name variants only, got %+v", g)
+    }
+    if g := byKey["feature"]; len(g.Findings) != 1 || !strings.Contains(g.Findings[0], "colors") {
+        t.Fatalf("expected Feature flagged for colors, got %+v", g)
+    }
+    if g := byKey["doc"]; len(g.Findings) != 0 {
+        t.Fatalf("expected Docs to be consistent, got %+v", g)
+    }
+}
+
+func TestParseSince_RelativeAndAbsolute(t *testing.T) {
+    now := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+    cases := map[string]time.Time{
+        "yesterday":  time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC),
+        "7d":         time.Date(2025, 1, 8, 14, 30, 0, 0, time.UTC),
+        "2w":         time.Date(2025, 1, 1, 14, 30, 0, 0, time.UTC),
+        "6h":         time.Date(2025, 1, 15, 8, 30, 0, 0, time.UTC),
+        "2024-01-01": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+    }
+    for in, want := range cases {
+        got, err := parseSince(in, now)
+        if err != nil { t.Fatalf("parseSince(%q) error: %v", in, err) }
+        if !got.Equal(want) { t.Fatalf("parseSince(%q) = %v, want %v", in, got, want) }
+    }
+    if _, err := parseSince("last quarter", now); err == nil { t.Fatalf("expected error for unrecognized value") }
+}
+
+func TestProgress_JSONModeEmitsNDJSONOnStderr(t *testing.T) {
+    oldErr := os.Stderr
+    r, w, _ := os.Pipe()
+    os.Stderr = w
+    prog := output.Printer{JSON: true}.StartProgress("Syncing", 2)
+    prog.Step("ENG")
+    prog.Step("OPS")
+    prog.Done("synced")
+    _ = w.Close()
+    os.Stderr = oldErr
+    b, _ := io.ReadAll(r)
+
+    lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+    if len(lines) != 4 { t.Fatalf("expected 4 NDJSON events, got %d: %q", len(lines), b) }
+    var ev output.ProgressEvent
+    if err := json.Unmarshal([]byte(lines[2]), &ev); err != nil { t.Fatalf("invalid NDJSON line %q: %v", lines[2], err) }
+    if ev.Event != "progress" || ev.Current != 2 || ev.Total != 2 || ev.Message != "OPS" { t.Fatalf("unexpected event: %+v", ev) }
+    if !strings.Contains(lines[3], `"event":"done"`) { t.Fatalf("expected done event last, got %s", lines[3]) }
+}
+
+func TestSplitClipboardDraft(t *testing.T) {
+    title, body := splitClipboardDraft("Fix login loop\r\n---\nSteps:\n1. open app\n")
+    if title != "Fix login loop" || body != "Steps:\n1. open app" { t.Fatalf("unexpected split: %q / %q", title, body) }
+    title, body = splitClipboardDraft("just a body\nwith lines\n")
+    if title != "" || body != "just a body\nwith lines" { t.Fatalf("expected body only, got %q / %q", title, body) }
+}
+
+func TestCycleTimeRow_TimeInStateAndPercentiles(t *testing.T) {
+    t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+    at := func(h int) time.Time { return t0.Add(time.Duration(h) * time.Hour) }
+    started, completed := at(24), at(72)
+    h := api.IssueHistory{Identifier: "ENG-1", CreatedAt: t0, StartedAt: &started, CompletedAt: &completed, Transitions: []api.StateTransition{
+        {At: at(24), From: &api.State{Name: "Todo"}, To: &api.State{Name: "In Progress"}},
+        {At: at(60), From: &api.State{Name: "In Progress"}, To: &api.State{Name: "In Review"}},
+        {At: at(72), From: &api.State{Name: "In Review"}, To: &api.State{Name: "Done"}},
+    }}
+    r := newCycleTimeRow(h)
+    if r.LeadHours != 72 || r.CycleHours == nil || *r.CycleHours != 48 { t.Fatalf("unexpected lead/cycle: %+v", r) }
+    want := map[string]float64{"Todo": 24, "In Progress": 36, "In Review": 12}
+    for k, v := range want {
+        if r.StateHours[k] != v { t.Fatalf("time in %s = %v, want %v (all: %v)", k, r.StateHours[k], v, r.StateHours) }
+    }
+    p := percentilesOf([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+    if p.P50 != 5 || p.P90 != 9 { t.Fatalf("unexpected percentiles: %+v", p) }
+}
+
+func TestBuildStandup_GroupsDoneInProgressAndBlocked(t *testing.T) {
+    since := time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)
+    doneAt := since.Add(10 * time.Hour)
+    s := buildStandup([]api.ActivityIssue{
+        {Identifier: "ENG-1", Title: "Shipped", StateType: "completed", CompletedAt: &doneAt},
+        {Identifier: "ENG-2", Title: "Working", StateType: "started"},
+        {Identifier: "ENG-3", Title: "Stuck", StateType: "started", BlockedBy: []api.RelatedIssue{{Identifier: "OPS-9", Title: "Infra", StateName: "Todo"}}},
+    }, since)
+    if len(s.Done) != 1 || len(s.InProgress) != 2 || len(s.Blocked) != 1 { t.Fatalf("unexpected grouping: %+v", s) }
+    var b strings.Builder
+    writeStandup(&b, s)
+    if !strings.Contains(b.String(), "• ENG-3 Stuck — blocked by OPS-9 Infra (Todo)") { t.Fatalf("missing blocker line:\n%s", b.String()) }
+}
+
+func TestDescribeHistoryEntry(t *testing.T) {
+    from, to := 3, 1
+    got := describeHistoryEntry(api.HistoryEntry{FromState: "Todo", ToState: "In Progress", ToAssignee: "Ada", AddedLabels: []string{"bug"}, FromPriority: &from, ToPriority: &to})
+    want := []string{"state Todo → In Progress", "assigned to Ada", "added labels bug", "priority Medium → Urgent"}
+    if strings.Join(got, "|") != strings.Join(want, "|") { t.Fatalf("describeHistoryEntry = %q, want %q", got, want) }
+    if len(describeHistoryEntry(api.HistoryEntry{})) != 0 { t.Fatalf("expected no changes for empty entry") }
+}
+
+func TestLoadTemplate_ExtendsAndPartials(t *testing.T) {
+    dir := t.TempDir()
+    write := func(name, body string) {
+        if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755); err != nil { t.Fatal(err) }
+        if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil { t.Fatal(err) }
+    }
+    write("base.md", "---\nlabels: triage\n---\n## Summary\n{{> content}}\n\n{{> common-footer}}\n")
+    write("partials/common-footer.md", "## Checklist\n- [ ] Tests")
+    write("bug.md", "---\nextends: base.md\npriority: high\n---\n## Steps\n{{STEPS}}\n")
+
+    tpl, err := loadTemplate("bug", dir, "")
+    if err != nil { t.Fatalf("loadTemplate error: %v", err) }
+    want := "## Summary\n## Steps\n{{STEPS}}\n\n## Checklist\n- [ ] Tests\n"
+    if tpl.Body != want { t.Fatalf("body = %q, want %q", tpl.Body, want) }
+    if tpl.Meta["labels"] != "triage" || tpl.Meta["priority"] != "high" || tpl.Meta["extends"] != "" { t.Fatalf("unexpected meta: %v", tpl.Meta) }
+
+    write("loop.md", "{{> loop}}")
+    if _, err := loadTemplate("loop", dir, ""); err == nil || !strings.Contains(err.Error(), "cycle") { t.Fatalf("expected cycle error, got %v", err) }
+}
+
+func TestTemplateFieldsFromMeta(t *testing.T) {
+    meta, body, err := parseFrontMatter("---\nlabels: [bug, \"needs triage\"]\npriority: high\nassignee: ada@example.com\nproject: Website\nestimate: 3\n---\n## Steps\n")
+    if err != nil || body != "## Steps\n" { t.Fatalf("front matter not stripped: %q (%v)", body, err) }
+    f, err := templateFieldsFromMeta(meta)
+    if err != nil { t.Fatalf("templateFieldsFromMeta error: %v", err) }
+    if strings.Join(f.Labels, "|") != "bug|needs triage" || f.Priority == nil || *f.Priority != 2 || f.Estimate == nil || *f.Estimate != 3 || f.Assignee != "ada@example.com" || f.Project != "Website" {
+        t.Fatalf("unexpected fields: %+v", f)
+    }
+    if _, err := templateFieldsFromMeta(map[string]string{"estimate": "lots"}); err == nil { t.Fatalf("expected invalid estimate error") }
+
+    // Block lists, indented or not, read like flow lists
+    for _, src := range []string{"---\nlabels:\n  - bug\n  - 'needs, triage'\n---\nBody\n", "---\nlabels:\n- bug\n- \"needs, triage\"\n---\nBody\n"} {
+        meta, body, err := parseFrontMatter(src)
+        if err != nil || body != "Body\n" { t.Fatalf("block list: body %q (%v)", body, err) }
+        f, err := templateFieldsFromMeta(meta)
+        if err != nil || strings.Join(f.Labels, "|") != "bug|needs, triage" { t.Fatalf("block list labels = %q (%v)", f.Labels, err) }
+    }
+    // A line that does not parse is an error naming it, not silently kept in the body
+    if _, _, err := parseFrontMatter("---\npriority: high\njust text\n---\nBody\n"); err == nil || !strings.Contains(err.Error(), "line 3") { t.Fatalf("expected an error on line 3, got %v", err) }
+    if _, _, err := parseFrontMatter("---\npriority: high\n"); err == nil { t.Fatal("expected an unclosed block error") }
+}
+
+func TestGroupIssues_OrdersGroupsAndCounts(t *testing.T) {
+    items := []api.IssueDetails{
+        {Identifier: "ENG-1", Priority: 0, Assignee: &api.User{Name: "Zoe"}},
+        {Identifier: "ENG-2", Priority: 1},
+        {Identifier: "ENG-3", Priority: 3, Assignee: &api.User{Name: "Ada"}},
+        {Identifier: "ENG-4", Priority: 1, Assignee: &api.User{Name: "Ada"}},
+    }
+    byPrio, err := groupIssues(items, "priority")
+    if err != nil { t.Fatal(err) }
+    if len(byPrio) != 3 || byPrio[0].Count != 2 || byPrio[2].Issues[0].Identifier != "ENG-1" { t.Fatalf("unexpected priority groups: %+v", byPrio) }
+    byAssignee, _ := groupIssues(items, "assignee")
+    var names []string
+    for _, g := range byAssignee { names = append(names, g.Group) }
+    if strings.Join(names, ",") != "Ada,Zoe,Unassigned" { t.Fatalf("unexpected assignee order: %v", names) }
+    if _, err := groupIssues(items, "color"); err == nil { t.Fatalf("expected error for unknown field") }
+}
+
+func TestSummarizeReactions_CountsInFirstSeenOrder(t *testing.T) {
+    got := summarizeReactions([]api.Reaction{{Emoji: "👍"}, {Emoji: "🎉"}, {Emoji: "👍"}})
+    if got != "👍 2  🎉 1" { t.Fatalf("unexpected summary: %q", got) }
+    if summarizeReactions(nil) != "" { t.Fatalf("expected empty summary for no reactions") }
+}
+
+func TestCheckClockSkew(t *testing.T) {
+    server := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+    if c := checkClockSkew(server.Add(5*time.Second), server); c.Status != "ok" { t.Fatalf("small skew should pass: %+v", c) }
+    c := checkClockSkew(server.Add(-3*time.Minute), server)
+    if c.Status != "warn" || !strings.Contains(c.Detail, "3m0s behind") || c.Fix == "" { t.Fatalf("unexpected skew check: %+v", c) }
+}
+
+func TestDraftFormatParseRoundTrip(t *testing.T) {
+    prio, est := 2, 3
+    orig := Draft{Team: "ENG", Title: "Add search", Description: "Body text", Labels: []string{"feature", "ui"}, Priority: &prio, Estimate: &est, Sections: map[string]string{"Summary": "x"}}
+    got, err := parseDraft(formatDraft(orig), orig)
+    if err != nil { t.Fatalf("parseDraft error: %v", err) }
+    if got.Title != "Add search" || got.Description != "Body text" || strings.Join(got.Labels, ",") != "feature,ui" || got.Priority == nil || *got.Priority != 2 || got.Estimate == nil || *got.Estimate != 3 || got.Sections["Summary"] != "x" {
+        t.Fatalf("round trip mismatch: %+v", got)
+    }
+    edited := strings.Replace(formatDraft(orig), "priority: high", "priority:", 1)
+    if got, _ := parseDraft(edited, orig); got.Priority != nil { t.Fatalf("clearing priority should drop it, got %v", *got.Priority) }
+    if _, err := parseDraft("no front matter", orig); err == nil { t.Fatalf("expected error for missing front matter") }
+}
+
+func TestBuildIssueGraphAndMermaid(t *testing.T) {
+    issues := []api.GraphIssue{
+        {Identifier: "PAY-10", Title: "Checkout", StateType: "started", Parent: &api.RelatedIssue{Identifier: "PAY-2", Title: "Epic"}},
+        {Identifier: "PAY-2", Title: "Epic", StateType: "started", Blocks: []api.RelatedIssue{{Identifier: "PAY-10"}, {Identifier: "OPS-1", Title: "Infra \"x\"", StateType: "completed"}}},
+    }
+    g := buildIssueGraph(issues)
+    var ids []string
+    for _, n := range g.Nodes { ids = append(ids, n.ID) }
+    if strings.Join(ids, ",") != "OPS-1,PAY-2,PAY-10" { t.Fatalf("unexpected node order: %v", ids) }
+    if len(g.Edges) != 3 || !g.Nodes[0].External { t.Fatalf("unexpected graph: %+v", g) }
+    var b strings.Builder
+    writeGraphMermaid(&b, g)
+    out := b.String()
+    for _, want := range []string{"PAY_2 -->|blocks| PAY_10", "PAY_2 -.-|parent| PAY_10", "#quot;x#quot;", "class OPS_1 closed", "class OPS_1 external"} {
+        if !strings.Contains(out, want) { t.Fatalf("mermaid output missing %q:\n%s", want, out) }
+    }
+}
+
+func TestConfigSetTeam_DefaultTemplate(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    t.Setenv("HOME", t.TempDir())
+    if _, _, err := runCLI(t, "config", "set-team", "eng", "default_template=Bug Template"); err != nil { t.Fatalf("set-team error: %v", err) }
+    cfg, err := config.Load()
+    if err != nil { t.Fatalf("load config: %v", err) }
+    if got := cfg.TeamPrefs["ENG"].DefaultTemplate; got != "Bug Template" { t.Fatalf("default_template = %q", got) }
+    if err := configSetTeamCmd.RunE(configSetTeamCmd, []string{"ENG", "color=red"}); err == nil { t.Fatalf("expected error for unknown key") }
+}
+
+func TestIssuesView_KeyLookupRoundTrips(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    supportsIdentifier, broken := true, false
+    var queries []string
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string; Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        queries = append(queries, p.Query)
+        issue := `{"id":"iss_1","identifier":"POK-28","title":"T","description":"D","url":"U","state":{"name":"Todo"},"labels":{"nodes":[]}}`
+        switch {
+        case broken && strings.Contains(p.Query, "issue("):
+            w.Write([]byte(`{"errors":[{"message":"Something went wrong"}]}`))
+        case strings.Contains(p.Query, "teams("):
+            w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"team_1","key":"POK","name":"Pokedex"}]}}}`))
+        case strings.Contains(p.Query, "issues("):
+            w.Write([]byte(`{"data":{"issues":{"nodes":[` + issue + `]}}}`))
+        case strings.Contains(p.Query, "issue(") && p.Variables["id"] == "POK-28" && !supportsIdentifier:
+            w.Write([]byte(`{"errors":[{"message":"Entity not found"}]}`))
+        default:
+            w.Write([]byte(`{"data":{"issue":` + issue + `}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+
+    if _, _, err := runCLI(t, "--json", "issues", "view", "POK-28"); err != nil { t.Fatalf("cli error: %v", err) }
+    if len(queries) != 1 { t.Fatalf("identifier lookup should take one request, took %d", len(queries)) }
+
+    // Without identifier support, the team is looked up once and then served from teams.json
+    supportsIdentifier = false
+    client := api.NewClient("test")
+    for run := 0; run < 2; run++ {
+        queries = nil
+        iss, err := resolveIssue(client, "POK-28")
+        if err != nil || iss == nil || iss.ID != "iss_1" { t.Fatalf("run %d: resolve failed: %v %+v", run, err, iss) }
+        teamQueries := 0
+        for _, q := range queries { if strings.Contains(q, "teams(") { teamQueries++ } }
+        if want := 1 - run; teamQueries != want { t.Fatalf("run %d: expected %d teams queries, got %d", run, want, teamQueries) }
+    }
+
+    // Only "not found" falls back to the team lookup; other errors are returned
+    broken, queries = true, nil
+    if _, err := resolveIssue(client, "POK-28"); err == nil || !strings.Contains(err.Error(), "Something went wrong") || len(queries) != 1 { t.Fatalf("expected the fast path error without a fallback: %v, %d queries", err, len(queries)) }
+}
+
+func TestMergeIssues_CopiesLabelsAndMovesOpenSubIssues(t *testing.T) {
+    var inputs []map[string]any
+    var ids []any
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string; Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        if in, ok := p.Variables["input"].(map[string]any); ok { inputs, ids = append(inputs, in), append(ids, p.Variables["id"]) }
+        switch {
+        case strings.Contains(p.Query, "issueRelationCreate"):
+            w.Write([]byte(`{"data":{"issueRelationCreate":{"success":true}}}`))
+        case strings.Contains(p.Query, "children("):
+            w.Write([]byte(`{"data":{"issue":{"children":{"nodes":[{"id":"c1","identifier":"ENG-51","state":{"type":"started"}},{"id":"c2","identifier":"ENG-52","state":{"type":"completed"}}]}}}}`))
+        case strings.Contains(p.Query, "issueUpdate"):
+            w.Write([]byte(`{"data":{"issueUpdate":{"success":true,"issue":{"id":"x","identifier":"ENG-1","state":{"name":"Duplicate"}}}}}`))
+        case strings.Contains(p.Query, "commentCreate"):
+            w.Write([]byte(`{"data":{"commentCreate":{"success":true,"comment":{"id":"cm"}}}}`))
+        case strings.Contains(p.Query, "states("):
+            w.Write([]byte(`{"data":{"team":{"states":{"nodes":[{"id":"s_can","name":"Canceled","type":"canceled"},{"id":"s_dup","name":"Duplicate","type":"canceled"}]}}}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+
+    dup := &api.IssueDetails{ID: "d", Identifier: "ENG-50", Team: &api.Team{ID: "t"}, Labels: []api.Label{{ID: "l_bug", Name: "bug"}, {ID: "l_ui", Name: "ui"}}}
+    canon := &api.IssueDetails{ID: "k", Identifier: "ENG-12", Labels: []api.Label{{ID: "l_bug", Name: "bug"}}}
+    res, err := mergeIssues(api.NewClient("test"), dup, canon, true)
+    if err != nil { t.Fatalf("merge: %v", err) }
+    if strings.Join(res.LabelsCopied, ",") != "ui" || strings.Join(res.SubIssuesMoved, ",") != "ENG-51" || res.ClosedAs != "Duplicate" {
+        t.Fatalf("unexpected result: %+v", res)
+    }
+    if inputs[0]["type"] != "duplicate" || inputs[0]["issueId"] != "d" || inputs[0]["relatedIssueId"] != "k" { t.Fatalf("relation input: %v", inputs[0]) }
+    if ids[2] != "c1" || inputs[2]["parentId"] != "k" { t.Fatalf("sub-issue update: id %v input %v", ids[2], inputs[2]) }
+}
+
+func TestRankMemberLoad_LeastLoadedFirstWithExcludes(t *testing.T) {
+    members := []api.User{{ID: "u1", Name: "Grace", Email: "grace@x.io"}, {ID: "u2", Name: "ada", Email: "ada@x.io"}, {ID: "u3", Name: "Linus", Email: "linus@x.io"}, {ID: "u4", Name: "Bot", Email: "bot@x.io"}}
+    counts := map[string]int{"u1": 3, "u2": 1, "u3": 1}
+    got := rankMemberLoad(members, counts, []string{"BOT@x.io"})
+    var names []string
+    for _, l := range got { names = append(names, fmt.Sprintf("%s:%d", l.User.Name, l.Open)) }
+    if strings.Join(names, ",") != "ada:1,Linus:1,Grace:3" { t.Fatalf("unexpected ranking: %v", names) }
+}
+
+func TestNewCycleReportRow_CompletedAndCarryOver(t *testing.T) {
+    end := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+    done, late := end.Add(-time.Hour), end.Add(time.Hour)
+    closed := end
+    c := api.Cycle{Number: 7, StartsAt: end.AddDate(0, 0, -14), EndsAt: end, CompletedAt: &closed}
+    issues := []api.CycleIssue{
+        {ID: "a", Estimate: 3, StateType: "completed", CompletedAt: &done},
+        {ID: "b", Estimate: 2, StateType: "completed", CompletedAt: &late},
+        {ID: "c", Estimate: 5, StateType: "started", Carried: true},
+        {ID: "d", Estimate: 8, StateType: "canceled"},
+    }
+    r := newCycleReportRow(c, issues, end.AddDate(0, 0, 7))
+    if r.Active || r.ScopeIssues != 3 || r.ScopePoints != 10 || r.CompletedPoints != 3 || r.CarryOverIssues != 2 || r.CarryOverPoints != 7 || r.CompletionPct != 30 {
+        t.Fatalf("unexpected row: %+v", r)
+    }
+    active := newCycleReportRow(api.Cycle{Number: 8, StartsAt: end, EndsAt: end.AddDate(0, 0, 14)}, issues[2:3], end.AddDate(0, 0, 1))
+    if !active.Active || active.CarryOverIssues != 0 { t.Fatalf("active cycle should not report carry-over: %+v", active) }
+    if tr := summarizeCycleTrend([]cycleReportRow{r, active}); tr.Cycles != 1 || tr.AvgCompletedPoints != 3 { t.Fatalf("unexpected trend: %+v", tr) }
+}
+
+func TestResolveLinkPR_URLAndGhDetection(t *testing.T) {
+    if u, err := resolveLinkPR("https://github.com/acme/api/pull/42"); err != nil || u != "https://github.com/acme/api/pull/42" { t.Fatalf("explicit URL: %q %v", u, err) }
+    if _, err := resolveLinkPR("https://github.com/acme/api/issues/42"); err == nil { t.Fatal("expected non-PR URL to be rejected") }
+
+    dir := t.TempDir()
+    gh := filepath.Join(dir, "gh")
+    state := filepath.Join(dir, "state")
+    _ = os.WriteFile(state, []byte("OPEN"), 0o644)
+    script := "#!/bin/sh\necho '{\"url\":\"https://github.com/acme/api/pull/7\",\"number\":7,\"state\":\"'$(cat " + state + ")'\"}'\n"
+    if err := os.WriteFile(gh, []byte(script), 0o755); err != nil { t.Fatal(err) }
+    t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+    if u, err := resolveLinkPR("auto"); err != nil || u != "https://github.com/acme/api/pull/7" { t.Fatalf("auto: %q %v", u, err) }
+    _ = os.WriteFile(state, []byte("MERGED"), 0o644)
+    if _, err := resolveLinkPR("auto"); err == nil || !strings.Contains(err.Error(), "merged") { t.Fatalf("expected merged PR to be rejected, got %v", err) }
+}
+
+func TestIssuesView_OutputTemplate(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte(`{"data":{"issue":{"id":"iss_1","identifier":"POK-28","title":"Fix login","description":"D","url":"U","state":{"name":"Todo"},"labels":{"nodes":[{"id":"l1","name":"bug"}]}}}}`))
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+    t.Cleanup(func() { _ = issuesViewCmd.Flags().Set("template", "") })
+
+    out, _, err := runCLI(t, "issues", "view", "POK-28", "--template", `{{.Identifier}} {{.Title}} ({{.StateName}}){{range .Labels}} #{{.Name}}{{end}}`)
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    if out != "POK-28 Fix login (Todo) #bug\n" { t.Fatalf("unexpected template output: %q", out) }
+    if p := (output.Printer{Template: "{{.Nope"}); p.PrintJSON(1) == nil { t.Fatal("expected a parse error for a malformed template") }
+}
+
+func TestNewAPIClient_EndpointPrecedence(t *testing.T) {
+    dir := t.TempDir()
+    t.Setenv("XDG_CONFIG_HOME", dir)
+    t.Setenv("LINEAR_API_ENDPOINT", "")
+    t.Setenv("LINEAR_TIMEOUT", "")
+    t.Setenv("LINEAR_PROFILE", "")
+    toml := "api_endpoint = \"https://top.example/graphql\"\n[profiles.work]\napi_key = \"k\"\napi_endpoint = \"https://work.example/graphql\"\ntimeout = \"2m\"\n"
+    if err := os.MkdirAll(filepath.Join(dir, "linear"), 0o755); err != nil { t.Fatal(err) }
+    if err := os.WriteFile(filepath.Join(dir, "linear", "config.toml"), []byte(toml), 0o600); err != nil { t.Fatal(err) }
+
+    if got := newAPIClient(rootCmd, "k").Endpoint(); got != "https://top.example/graphql" { t.Fatalf("top-level endpoint: %s", got) }
+    t.Setenv("LINEAR_PROFILE", "work")
+    if got := newAPIClient(rootCmd, "k").Endpoint(); got != "https://work.example/graphql" { t.Fatalf("profile endpoint: %s", got) }
+    cfg, _ := config.Load()
+    if d, err := cfg.RequestTimeout(); err != nil || d != 2*time.Minute { t.Fatalf("profile timeout: %v %v", d, err) }
+    t.Setenv("LINEAR_API_ENDPOINT", "https://env.example/graphql")
+    if got := newAPIClient(rootCmd, "k").Endpoint(); got != "https://env.example/graphql" { t.Fatalf("env endpoint: %s", got) }
+
+    if d, err := config.ParseTimeout("45"); err != nil || d != 45*time.Second { t.Fatalf("bare seconds: %v %v", d, err) }
+    if _, err := config.ParseTimeout("soon"); err == nil { t.Fatal("expected invalid timeout error") }
+}
+
+func TestIdempotentIssue_LocalRecordThenMarker(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    var searched string
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string; Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        issue := `{"id":"iss_1","identifier":"ENG-7","title":"T","description":"Body\n\n<!-- linear-cli:idempotency-key=run-42 -->","url":"U","state":{"name":"Todo"},"labels":{"nodes":[]}}`
+        switch {
+        case strings.Contains(p.Query, "issueUpdate"):
+            t.Errorf("recording a key must not update the issue")
+        case strings.Contains(p.Query, "issues("):
+            searched, _ = p.Variables["text"].(string)
+            w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"iss_1"}]}}}`))
+        default:
+            w.Write([]byte(`{"data":{"issue":` + issue + `}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+    client := api.NewClient("test")
+
+    // The marker is part of the description sent with the create, once
+    desc := withIdempotencyMarker("Body\n", "run-42")
+    if desc != "Body\n\n"+idempotencyMarker("run-42") || withIdempotencyMarker(desc, "run-42") != desc || withIdempotencyMarker("Body", "") != "Body" { t.Fatalf("marked description = %q", desc) }
+    recordIdempotentIssue(client, "run-42", &api.IssueDetails{ID: "iss_1", Identifier: "ENG-7", Description: desc})
+    if got, err := findIdempotentIssue(client, "run-42"); err != nil || got == nil || got.Identifier != "ENG-7" || searched != "" {
+        t.Fatalf("local record lookup: %+v %v (searched %q)", got, err, searched)
+    }
+
+    // Without the local record (another machine), the description marker finds it
+    p, _ := idempotencyPath()
+    if err := os.Remove(p); err != nil { t.Fatal(err) }
+    if got, err := findIdempotentIssue(client, "run-42"); err != nil || got == nil || searched != idempotencyMarker("run-42") {
+        t.Fatalf("marker lookup: %+v %v (searched %q)", got, err, searched)
+    }
+    if !reIdempotencyKey.MatchString("ci:build.17_a-b") || reIdempotencyKey.MatchString("bad key-->") { t.Fatal("unexpected key validation") }
+}
+
+func TestUnifiedDiff_HunksWithContext(t *testing.T) {
+    a := "# Bug\n\n## Steps\n1.\n\n## Expected\n\n## Actual\n"
+    b := "# Bug\n\n## Steps to reproduce\n1.\n\n## Expected\n\n## Actual\n\n## Logs\n"
+    // With one line of context the two changes are separate hunks
+    want := "--- local/bug.md\n+++ server/Bug\n" +
+        "@@ -2,3 +2,3 @@\n \n-## Steps\n+## Steps to reproduce\n 1.\n" +
+        "@@ -8 +8,3 @@\n ## Actual\n+\n+## Logs\n"
+    if got := unifiedDiff("local/bug.md", "server/Bug", a, b, 1); got != want { t.Fatalf("context 1:\n%s", got) }
+    // With three they are close enough to merge
+    want = "--- local/bug.md\n+++ server/Bug\n" +
+        "@@ -1,8 +1,10 @@\n # Bug\n \n-## Steps\n+## Steps to reproduce\n 1.\n \n ## Expected\n \n ## Actual\n+\n+## Logs\n"
+    if got := unifiedDiff("local/bug.md", "server/Bug", a, b, 3); got != want { t.Fatalf("context 3:\n%s", got) }
+    if unifiedDiff("a", "b", "same\n", "same", 3) != "" { t.Fatal("a trailing newline alone should not count as a change") }
+}
+
+func TestDebugLog_GoesToStderrOnly(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte(`{"data":{"issue":{"id":"iss_1","identifier":"POK-28","title":"T","description":"D","url":"U","state":{"name":"Todo"},"labels":{"nodes":[]}}}}`))
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+    t.Setenv("LINEAR_CLI_LOG", "debug")
+
+    out, errOut, err := runCLI(t, "--json", "issues", "view", "POK-28")
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    var issue map[string]any
+    if err := json.Unmarshal([]byte(out), &issue); err != nil { t.Fatalf("stdout should hold only the result: %v\n%s", err, out) }
+    var ev output.LogEvent
+    if err := json.Unmarshal([]byte(strings.SplitN(errOut, "\n", 2)[0]), &ev); err != nil || ev.Event != "log" || ev.Level != "debug" || !strings.Contains(ev.Message, "query issue: 200 OK") {
+        t.Fatalf("expected a debug log event on stderr, got %q (%v)", errOut, err)
+    }
+
+    if l, err := output.ParseLevel("WARNING"); err != nil || l != output.LevelWarn { t.Fatalf("parse warning: %v %v", l, err) }
+    if _, err := output.ParseLevel("loud"); err == nil { t.Fatal("expected an invalid level error") }
+    if (output.Printer{Quiet: true}).Enabled(output.LevelInfo) { t.Fatal("quiet should hide info messages") }
+}
+
+func TestBuildDueCalendar_OverdueAndDays(t *testing.T) {
+    today := time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC)
+    issues := []api.DueIssue{{Identifier: "ENG-1", DueDate: "2025-03-03"}, {Identifier: "ENG-2", DueDate: "2025-03-11"}, {Identifier: "ENG-3", DueDate: "2025-03-13"}, {Identifier: "ENG-4", DueDate: "2025-03-13"}}
+    cal := buildDueCalendar(issues, today, 3)
+    if len(cal.Overdue) != 1 || cal.Overdue[0].Identifier != "ENG-1" { t.Fatalf("overdue: %+v", cal.Overdue) }
+    if len(cal.Days) != 3 || cal.Days[0].Date != "2025-03-11" || len(cal.Days[0].Issues) != 1 || len(cal.Days[1].Issues) != 0 || len(cal.Days[2].Issues) != 2 {
+        t.Fatalf("days: %+v", cal.Days)
+    }
+    for in, want := range map[string]int{"14d": 14, "2w": 14, "10": 10, "36h": 2} {
+        if got, err := parseDayCount(in); err != nil || got != want { t.Fatalf("parseDayCount(%q) = %d, %v", in, got, err) }
+    }
+    if _, err := parseDayCount("soon"); err == nil { t.Fatal("expected an invalid window error") }
+}
+
+func TestExportWorkspace_WritesJSONLAndManifest(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string; Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        switch {
+        case strings.Contains(p.Query, "organization{ id"):
+            w.Write([]byte(`{"data":{"organization":{"id":"o1","name":"Acme","urlKey":"acme"}}}`))
+        case strings.Contains(p.Query, "organization"), strings.Contains(p.Query, "integrations("):
+            w.Write([]byte(`{"errors":[{"message":"forbidden"}]}`))
+        case strings.Contains(p.Query, "issues(") && p.Variables["after"] == nil:
+            w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`))
+        case strings.Contains(p.Query, "issues("):
+            w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"i2","identifier":"ENG-2"}],"pageInfo":{"hasNextPage":false}}}}`))
+        case strings.Contains(p.Query, "teams("):
+            w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"t1","key":"ENG"}],"pageInfo":{"hasNextPage":false}}}}`))
+        default:
+            w.Write([]byte(`{"data":{"workflowStates":{"nodes":[],"pageInfo":{"hasNextPage":false}},"issueLabels":{"nodes":[],"pageInfo":{"hasNextPage":false}},"projects":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+    dir := t.TempDir()
+    t.Cleanup(func() {
+        _ = exportWorkspaceCmd.Flags().Lookup("skip").Value.(interface{ Replace([]string) error }).Replace(nil)
+        _ = exportWorkspaceCmd.Flags().Set("out", "")
+    })
+
+    if _, _, err := runCLI(t, "--json", "export", "workspace", "--out", dir, "--skip", "users,comments"); err != nil { t.Fatalf("cli error: %v", err) }
+    b, err := os.ReadFile(filepath.Join(dir, "issues.jsonl"))
+    if err != nil || string(b) != "{\"id\":\"i1\",\"identifier\":\"ENG-1\"}\n{\"id\":\"i2\",\"identifier\":\"ENG-2\"}\n" { t.Fatalf("issues.jsonl: %q %v", b, err) }
+    var m exportManifest
+    mb, _ := os.ReadFile(filepath.Join(dir, "manifest.json"))
+    if err := json.Unmarshal(mb, &m); err != nil || m.Workspace == nil || m.Workspace.Name != "Acme" || len(m.Files) != 5 { t.Fatalf("manifest: %s %v", mb, err) }
+    if _, err := os.Stat(filepath.Join(dir, "comments.jsonl")); !os.IsNotExist(err) { t.Fatal("skipped resources should not be written") }
+    if err := exportWorkspaceCmd.RunE(exportWorkspaceCmd, nil); err == nil || !strings.Contains(err.Error(), "--force") { t.Fatalf("expected overwrite protection, got %v", err) }
+}
+
+func TestReadIssueKeys_FirstKeyPerLine(t *testing.T) {
+    in := "ENG-3\n  eng-7  Fix login  In Progress\nno key here\nENG-3 again\nOPS-12\tblocked by ENG-9\n"
+    keys, err := readIssueKeys(strings.NewReader(in))
+    if err != nil { t.Fatal(err) }
+    if strings.Join(keys, ",") != "ENG-3,ENG-7,OPS-12" { t.Fatalf("keys = %v", keys) }
+    if err := issuesPriorityCmd.Args(issuesPriorityCmd, []string{"urgent"}); err == nil { t.Fatal("expected a key to be required without --from-stdin") }
+}
+
+func TestGroupProjectIssues_MilestoneOrderAndProgress(t *testing.T) {
+    beta := &api.ProjectMilestone{ID: "m2", Name: "Beta", SortOrder: 2}
+    alpha := &api.ProjectMilestone{ID: "m1", Name: "Alpha", SortOrder: 1, TargetDate: "2025-04-01"}
+    issues := []api.ProjectIssue{
+        {Identifier: "APP-1", StateType: "completed", Milestone: beta},
+        {Identifier: "APP-2", StateType: "started"},
+        {Identifier: "APP-3", StateType: "completed", Milestone: alpha},
+        {Identifier: "APP-4", StateType: "canceled", Milestone: alpha},
+        {Identifier: "APP-5", StateType: "unstarted", Milestone: beta},
+    }
+    groups := groupProjectIssues(issues, "milestone")
+    var got []string
+    for _, g := range groups { got = append(got, fmt.Sprintf("%s %d/%d", g.Group, g.Done, g.Total)) }
+    want := "Alpha (due 2025-04-01) 1/1|Beta 1/2|No milestone 0/1"
+    if strings.Join(got, "|") != want { t.Fatalf("groups = %q, want %q", strings.Join(got, "|"), want) }
+    if bar := progressBar(1, 2, 10); bar != "[#####-----] 50% (1/2)" { t.Fatalf("bar = %q", bar) }
+}
+
+func TestCommitWebURLAndDescription(t *testing.T) {
+    sha := "0123456789abcdef0123456789abcdef01234567"
+    for remote, want := range map[string]string{
+        "git@github.com:acme/app.git":           "https://github.com/acme/app/commit/" + sha,
+        "https://gitlab.com/acme/group/app.git": "https://gitlab.com/acme/group/app/commit/" + sha,
+        "ssh://git@bitbucket.org:22/acme/app":   "https://bitbucket.org/acme/app/commits/" + sha,
+        "/srv/git/app.git":                      "",
+    } {
+        if got := commitWebURL(remote, sha); got != want { t.Fatalf("commitWebURL(%q) = %q, want %q", remote, got, want) }
+    }
+    desc := commitIssueDescription(&gitCommit{SHA: sha, Body: "Crash on empty cart.", URL: "https://github.com/acme/app/commit/" + sha})
+    if desc != "Crash on empty cart.\n\nCommit: [0123456789ab](https://github.com/acme/app/commit/"+sha+")" { t.Fatalf("desc = %q", desc) }
+    if desc := commitIssueDescription(&gitCommit{SHA: sha}); desc != "Commit: `0123456789ab`" { t.Fatalf("desc = %q", desc) }
+}
+
+func TestTriageSLABreaches_MeasuresFromLastResponse(t *testing.T) {
+    now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+    responded := now.Add(-10 * time.Hour)
+    issues := []api.TriageActivity{
+        {TriageIssue: api.TriageIssue{Identifier: "SUP-1", CreatedAt: now.Add(-72 * time.Hour)}},
+        {TriageIssue: api.TriageIssue{Identifier: "SUP-2", CreatedAt: now.Add(-96 * time.Hour)}, LastResponseAt: &responded},
+        {TriageIssue: api.TriageIssue{Identifier: "SUP-3", CreatedAt: now.Add(-50 * time.Hour)}},
+        {TriageIssue: api.TriageIssue{Identifier: "SUP-4", CreatedAt: now.Add(-2 * time.Hour)}},
+    }
+    window, err := parseSLAWindow("2d")
+    if err != nil || window != 48*time.Hour { t.Fatalf("parseSLAWindow = %v, %v", window, err) }
+    got := triageSLABreaches(issues, window, now)
+    if len(got) != 2 || got[0].Identifier != "SUP-1" || got[1].Identifier != "SUP-3" || got[0].OverdueHours != 24 { t.Fatalf("breaches = %+v", got) }
+    if _, err := parseSLAWindow("soon"); err == nil { t.Fatal("expected an invalid window error") }
+}
+
+func TestStatePositionFlags_AfterAndLastOfType(t *testing.T) {
+    states := []api.State{{ID: "s1", Name: "Todo", Type: "unstarted", Position: 0}, {ID: "s2", Name: "In Progress", Type: "started", Position: 1}, {ID: "s3", Name: "QA", Type: "started", Position: 2}}
+    t.Cleanup(func() { _ = statesCreateCmd.Flags().Set("after", "") })
+    pos, err := statePositionFlags(statesCreateCmd, states, "started")
+    if err != nil || pos == nil || *pos != 3 { t.Fatalf("last of type: %v, %v", pos, err) }
+    _ = statesCreateCmd.Flags().Set("after", "in progress")
+    pos, err = statePositionFlags(statesCreateCmd, states, "started")
+    if err != nil || pos == nil || *pos != 1.5 { t.Fatalf("after: %v, %v", pos, err) }
+    if _, err := statePositionFlags(statesCreateCmd, states, "canceled"); err != nil { t.Fatal(err) }
+    _ = statesCreateCmd.Flags().Set("after", "")
+    if pos, err := statePositionFlags(statesCreateCmd, states, "completed"); err != nil || pos != nil { t.Fatalf("no states of type: %v, %v", pos, err) }
+}
+
+func TestNoInput_PromptsReturnInputRequired(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    t.Setenv("LINEAR_API_KEY", "")
+    rootCmd.SetArgs([]string{"auth", "login", "--no-input"})
+    oldUI := ui
+    t.Cleanup(func() {
+        ui = oldUI
+        rootCmd.SetArgs(nil)
+        _ = rootCmd.PersistentFlags().Set("no-input", "false")
+        noInput = false
+    })
+    // The command returns the error, so Execute sets the exit code after its cleanup
+    err := rootCmd.Execute()
+    if !errors.Is(err, errInputRequired) || !strings.Contains(err.Error(), "--token") { t.Fatalf("auth login --no-input: %v", err) }
+    if _, err := promptYesNo("Apply? [y/N] ", true); !errors.Is(err, errInputRequired) { t.Fatalf("promptYesNo: %v", err) }
+    if ok, err := confirmed(true, "Apply? [y/N] "); !ok || err != nil { t.Fatalf("--yes should not prompt: %v, %v", ok, err) }
+}
+
+func TestDryRun_NoCommandShadowsGlobalFlag(t *testing.T) {
+    var walk func(c *cobra.Command)
+    walk = func(c *cobra.Command) {
+        if c.LocalNonPersistentFlags().Lookup("dry-run") != nil { t.Errorf("%s defines its own --dry-run", c.CommandPath()) }
+        for _, sub := range c.Commands() { walk(sub) }
+    }
+    walk(rootCmd)
+}
+
+func TestCommandHistory_RecordsMutatingRunsForRedo(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        switch {
+        case strings.Contains(string(b), "issueUpdate"):
+            w.Write([]byte(`{"data":{"issueUpdate":{"success":true,"issue":{"id":"i1","identifier":"ENG-3","title":"t","url":"u","state":{"name":"Todo"},"priority":2}}}}`))
+        default:
+            w.Write([]byte(`{"data":{"issue":{"id":"i1","identifier":"ENG-3","title":"t","url":"u","state":{"name":"Todo"}}}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "k")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+    if _, stderr, err := runCLI(t, "issues", "priority", "ENG-3", "high"); err != nil { t.Fatalf("priority: %v\n%s", err, stderr) }
+    if out, _, err := runCLI(t, "history"); err != nil || !strings.Contains(out, "ENG-3") { t.Fatalf("history: %v\n%s", err, out) }
+    recs := loadCommandHistory()
+    if len(recs) != 1 || strings.Join(recs[0].Args, " ") != "issues priority ENG-3 high" || recs[0].Mutations[0] != "issueUpdate" { t.Fatalf("history = %+v", recs) }
+
+    cmd := &cobra.Command{Use: "create"}
+    (&cobra.Command{Use: "linear-cli"}).AddCommand(cmd)
+    cmd.Flags().StringSlice("label", nil, "")
+    cmd.Flags().StringToString("sections", nil, "")
+    cmd.Flags().String("title", "", "")
+    _ = cmd.ParseFlags([]string{"--label", "a,b", "--sections", "Summary=x y", "--title", "Fix it"})
+    got, redacted := commandReplayArgs(cmd, []string{"-1"})
+    if shellJoin(got) != `create --label=a --label=b "--sections=Summary=x y" "--title=Fix it" -- -1` || redacted != nil { t.Fatalf("replay args = %s %v", shellJoin(got), redacted) }
+
+    // Secrets never reach history.json, and redo refuses to replay the placeholder
+    cmd.Flags().String("secret", "", "")
+    cmd.Flags().String("idempotency-key", "", "")
+    _ = cmd.ParseFlags([]string{"--secret", "hunter2", "--idempotency-key", "k1"})
+    got, redacted = commandReplayArgs(cmd, nil)
+    if strings.Contains(shellJoin(got), "hunter2") || !strings.Contains(shellJoin(got), "--idempotency-key=k1") || strings.Join(redacted, ",") != "secret" { t.Fatalf("redaction: %s %v", shellJoin(got), redacted) }
+    if err := appendCommandHistory(historyRecord{Command: "create", Args: got, Mutations: []string{"issueCreate"}, Redacted: redacted}); err != nil { t.Fatal(err) }
+    if err := redoCmd.RunE(redoCmd, nil); err == nil || !strings.Contains(err.Error(), "--secret") { t.Fatalf("redo of a redacted record: %v", err) }
+
+    // Runs that read stdin and long-running commands are not recorded
+    before := len(loadCommandHistory())
+    resetSentMutations()
+    noteMutation("mutation issueUpdate")
+    noteStdinRead()
+    recordCommandHistory(issuesPriorityCmd, []string{"ENG-3", "high"})
+    resetSentMutations()
+    noteMutation("mutation issueCreate")
+    recordCommandHistory(bridgeServeCmd, nil)
+    resetSentMutations()
+    if n := len(loadCommandHistory()); n != before { t.Fatalf("unreplayable runs were recorded: %d -> %d", before, n) }
+
+    // Parallel runs append under the lock without losing records
+    var wg sync.WaitGroup
+    for i := 0; i < 8; i++ {
+        wg.Add(1)
+        go func() { defer wg.Done(); _ = appendCommandHistory(historyRecord{Command: "x", Args: []string{"x"}}) }()
+    }
+    wg.Wait()
+    if n := len(loadCommandHistory()); n != before+8 { t.Fatalf("parallel appends kept %d of %d records", n-before, 8) }
+}
+
+func TestParsePlanSelection_RangesKeysAndCapacity(t *testing.T) {
+    backlog := []api.BacklogIssue{
+        {ID: "a", Identifier: "ENG-1", Priority: 0, Estimate: 3, Estimated: true},
+        {ID: "b", Identifier: "ENG-2", Priority: 1, Estimate: 5, Estimated: true},
+        {ID: "c", Identifier: "ENG-3", Priority: 3},
+        {ID: "d", Identifier: "ENG-4", Priority: 2, Estimate: 2, Estimated: true},
+    }
+    sortBacklogByPriority(backlog)
+    if got := backlog[0].Identifier + backlog[3].Identifier; got != "ENG-2ENG-1" { t.Fatalf("urgent first, no priority last; got %v", backlog) }
+    picked, err := parsePlanSelection("1-2, eng-3,2", backlog)
+    if err != nil { t.Fatal(err) }
+    if len(picked) != 3 || picked[2].Identifier != "ENG-3" { t.Fatalf("unexpected selection %v", picked) }
+    sum := summarizeCyclePlan(4, 10, picked)
+    if sum.SelectedPoints != 7 || sum.TotalPoints != 11 || sum.OverBy != 1 || sum.Unestimated != 1 { t.Fatalf("unexpected summary %+v", sum) }
+    if _, err := parsePlanSelection("5", backlog); err == nil { t.Fatal("expected out-of-range row to fail") }
+    if _, err := parsePlanSelection("ENG-9", backlog); err == nil { t.Fatal("expected unknown key to fail") }
+}
+
+func TestRenderBoard_WorkflowOrderAndHiddenColumns(t *testing.T) {
+    items := []api.IssueDetails{
+        {Identifier: "ENG-1", Title: "Ship the thing with a very long title indeed", StateName: "Done", StateType: "completed"},
+        {Identifier: "ENG-2", Title: "Write docs", StateName: "Todo", StateType: "unstarted"},
+        {Identifier: "ENG-3", Title: "Fix bug", StateName: "In Progress", StateType: "started"},
+        {Identifier: "ENG-4", Title: "Plan", StateName: "Todo", StateType: "unstarted"},
+    }
+    cols := boardColumns(items)
+    if len(cols) != 3 || cols[0].Group != "Todo" || cols[0].Count != 2 || cols[2].Group != "Done" { t.Fatalf("unexpected columns %+v", cols) }
+    out := renderBoard(cols, 80)
+    lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+    if len(lines) != 4 || !strings.HasPrefix(lines[0], "Todo (2)") || !strings.Contains(lines[0], "In Progress (1)") { t.Fatalf("unexpected board:\n%s", out) }
+    for _, l := range lines {
+        if n := utf8.RuneCountInString(l); n > 80 { t.Fatalf("line exceeds width (%d): %q", n, l) }
+    }
+    if !strings.Contains(out, "…") { t.Fatalf("expected long titles to be truncated:\n%s", out) }
+    narrow := renderBoard(cols, 40)
+    if !strings.Contains(narrow, "+1 more column(s) not shown: Done (1)") { t.Fatalf("expected hidden column footer:\n%s", narrow) }
+}
+
+func TestTemplateJSONSchema_SectionsAndPlaceholders(t *testing.T) {
+    tpl := "## Summary\nOne or two sentences.\n\n## Steps\n{{STEPS|How do we reproduce it?}}\n\n### Notes (optional)\n\nAnything else. {{OWNER}} {{STEPS}}\n"
+    s := templateJSONSchema("Bug", "Bug report", tpl)
+    b, err := json.Marshal(s)
+    if err != nil { t.Fatal(err) }
+    var got struct {
+        Required   []string `json:"required"`
+        Properties struct {
+            Sections struct {
+                Properties           map[string]struct{ Description string `json:"description"`; MinLength int `json:"minLength"` } `json:"properties"`
+                Required             []string `json:"required"`
+                AdditionalProperties *bool    `json:"additionalProperties"`
+            } `json:"sections"`
+            Vars struct {
+                Properties map[string]struct{ Description string `json:"description"` } `json:"properties"`
+                Required   []string `json:"required"`
+            } `json:"vars"`
+        } `json:"properties"`
+    }
+    if err := json.Unmarshal(b, &got); err != nil { t.Fatal(err) }
+    sec := got.Properties.Sections
+    if strings.Join(sec.Required, ",") != "Summary,Steps" || len(sec.Properties) != 3 { t.Fatalf("unexpected sections: %s", b) }
+    if sec.Properties["Summary"].Description != "One or two sentences." || sec.Properties["Notes (optional)"].MinLength != 0 { t.Fatalf("unexpected section properties: %s", b) }
+    if sec.AdditionalProperties == nil || *sec.AdditionalProperties { t.Fatalf("unknown sections should be rejected: %s", b) }
+    vars := got.Properties.Vars
+    if strings.Join(vars.Required, ",") != "STEPS,OWNER" || vars.Properties["STEPS"].Description != "How do we reproduce it?" { t.Fatalf("unexpected vars: %s", b) }
+    if strings.Join(got.Required, ",") != "sections,vars" { t.Fatalf("unexpected top-level required: %v", got.Required) }
+}
+
+func TestReadSectionsFile_YAMLBlocksAndTemplateMatch(t *testing.T) {
+    src := "# sections for ENG bugs\nSummary: Login times out  # short\nSteps: |\n  1. Open the app\n\n  2. Wait 30s\nContext: >\n  Reported by two\n  customers.\n\n  Started after 2.3.\n\"Notes (optional)\": 'it''s flaky'\n"
+    got, err := readSectionsFile("-", strings.NewReader(src))
+    if err != nil { t.Fatal(err) }
+    want := map[string]string{
+        "Summary":          "Login times out",
+        "Steps":            "1. Open the app\n\n2. Wait 30s",
+        "Context":          "Reported by two customers.\nStarted after 2.3.",
+        "Notes (optional)": "it's flaky",
+    }
+    for k, v := range want {
+        if got[k] != v { t.Fatalf("%s = %q, want %q", k, got[k], v) }
+    }
+    if _, err := parseSectionsYAML("Summary:\n  nested: value\n"); err == nil { t.Fatal("expected nested mapping to be rejected") }
+    if _, err := parseSectionsYAML("Summary: x\nSteps:\n  - one\n"); err == nil || !strings.Contains(err.Error(), "line 2: section 'Steps': lists") { t.Fatalf("expected lists to be rejected with their line, got %v", err) }
+    js, err := readSectionsFile("-", strings.NewReader(`{"summary": "From JSON"}`))
+    if err != nil || js["summary"] != "From JSON" { t.Fatalf("json sections: %v %v", js, err) }
+
+    tpl := "## Summary\nx\n## Steps\ny\n## Expected\nz\n### Notes (optional)\n"
+    matched, missing, err := matchTemplateSections(tpl, js)
+    if err != nil || matched["Summary"] != "From JSON" { t.Fatalf("expected case-insensitive match: %v %v", matched, err) }
+    if strings.Join(missing, ",") != "Steps,Expected" { t.Fatalf("unexpected missing sections %v", missing) }
+    if _, _, err := matchTemplateSections(tpl, map[string]string{"Impact": "x"}); err == nil || !strings.Contains(err.Error(), "Summary, Steps, Expected") { t.Fatalf("expected unknown section error listing the template's sections, got %v", err) }
+}
+
+func TestPreflightIssueFields_ReportsAllProblems(t *testing.T) {
+    rc := &api.CreateContext{
+        Team:    api.Team{ID: "t1", Key: "ENG"},
+        States:  []api.State{{ID: "s1", Name: "Todo"}, {ID: "s2", Name: "In Progress"}},
+        Labels:  []api.Label{{ID: "l1", Name: "bug"}},
+        Members: []api.User{{ID: "u1", Name: "Ada Lovelace", Email: "ada@example.com"}},
+    }
+    now := time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC) // a Wednesday
+    ok, err := preflightIssueFields(nil, rc, "t1", issueFieldsInput{Priority: "high", PrioritySet: true, Labels: []string{"Bug", "bug"}, Assignee: "ada@example.com", State: "in progress", Due: "friday"}, now)
+    if err != nil { t.Fatal(err) }
+    if *ok.Priority != 2 || len(ok.LabelIDs) != 1 || ok.AssigneeID != "u1" || ok.StateID != "s2" || ok.DueDate != "2025-03-07" { t.Fatalf("unexpected fields %+v", ok) }
+
+    _, err = preflightIssueFields(nil, rc, "t1", issueFieldsInput{Priority: "7", PrioritySet: true, Labels: []string{"nope"}, Assignee: "grace", State: "Doing", Due: "2025-02-30"}, now)
+    if err == nil { t.Fatal("expected validation errors") }
+    for _, want := range []string{"5 invalid field(s)", "priority '7'", "label 'nope'", "assignee 'grace' is not a member of team ENG", "state 'Doing' does not belong to team ENG (have: Todo, In Progress)", "due date '2025-02-30'"} {
+        if !strings.Contains(err.Error(), want) { t.Fatalf("error missing %q:\n%v", want, err) }
+    }
+    if _, err := parseDueDate("4h", now); err == nil { t.Fatal("expected a duration to be rejected as a due date") }
+}
+
+func TestSSOLoginHelpers_ScopesAndKeyInfo(t *testing.T) {
+    if got := keySettingsURL(" acme/ "); got != "https://linear.app/acme/settings/account/security" { t.Fatalf("unexpected url %s", got) }
+    if got := keySettingsURL(""); got != "https://linear.app/settings/account/security" { t.Fatalf("unexpected default url %s", got) }
+    caps := []api.Capability{{Name: "issues", Scope: "read", Allowed: true}, {Name: "projects", Scope: "read", Allowed: true}, {Name: "write", Scope: "write", Allowed: true}, {Name: "admin", Scope: "admin"}}
+    scopes := grantedScopes(caps)
+    if strings.Join(scopes, ",") != "read,write" { t.Fatalf("unexpected scopes %v", scopes) }
+    if m := missingScopes([]string{"read", "write", "Admin"}, scopes); strings.Join(m, ",") != "admin" { t.Fatalf("unexpected missing %v", m) }
+    if m := missingScopes([]string{"read"}, []string{"write"}); len(m) != 0 { t.Fatalf("write should imply read, got %v", m) }
+
+    cfg := &config.Config{APIKey: "lin_api_abcdef123456", KeyInfo: &config.KeyInfo{Fingerprint: config.KeyFingerprint("lin_api_abcdef123456"), Workspace: "Acme"}}
+    if cfg.CurrentKeyInfo() == nil { t.Fatal("expected key info for the stored key") }
+    cfg.APIKey = "lin_api_other999999"
+    if cfg.CurrentKeyInfo() != nil { t.Fatal("expected stale key info to be ignored for a different key") }
+}
+
+func TestPreflightIssueFields_RepeatedAndMissingLabels(t *testing.T) {
+    labels := splitListFlags([]string{"bug", "customer, Bug", " p1 "})
+    if strings.Join(labels, "|") != "bug|customer|p1" { t.Fatalf("unexpected labels %v", labels) }
+    rc := &api.CreateContext{Team: api.Team{ID: "t1", Key: "ENG"}, Labels: []api.Label{{ID: "l1", Name: "bug"}}}
+    now := time.Now()
+    if _, err := preflightIssueFields(nil, rc, "t1", issueFieldsInput{Labels: labels}, now); err == nil || !strings.Contains(err.Error(), "2 invalid field(s)") || !strings.Contains(err.Error(), "--create-missing-labels") {
+        t.Fatalf("expected both unknown labels to be reported, got %v", err)
+    }
+    f, err := preflightIssueFields(nil, rc, "t1", issueFieldsInput{Labels: append(labels, "P1"), CreateMissingLabels: true}, now)
+    if err != nil { t.Fatal(err) }
+    if strings.Join(f.LabelIDs, ",") != "l1" || strings.Join(f.MissingLabels, "|") != "customer|p1" { t.Fatalf("unexpected fields %+v", f) }
+}
+
+func TestCommentThreads_GroupRepliesAndShowResolved(t *testing.T) {
+    at := time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC)
+    comments := []api.Comment{
+        {ID: "c1", Body: "Root", CreatedAt: at, User: &api.User{Name: "Ada"}, ResolvedAt: &at, ResolvingUser: &api.User{Name: "Grace"}},
+        {ID: "c2", Body: "Other", CreatedAt: at.Add(time.Minute)},
+        {ID: "c3", Body: "Reply", CreatedAt: at.Add(2 * time.Minute), Parent: &api.CommentRef{ID: "c1"}},
+        {ID: "c4", Body: "Orphan", CreatedAt: at.Add(3 * time.Minute), Parent: &api.CommentRef{ID: "gone"}},
+    }
+    threads := commentThreads(comments)
+    if len(threads) != 3 || threads[0].Root.ID != "c1" || len(threads[0].Replies) != 1 || threads[0].Replies[0].ID != "c3" || threads[2].Root.ID != "c4" {
+        t.Fatalf("unexpected threads %+v", threads)
+    }
+    oldOut := os.Stdout
+    r, w, _ := os.Pipe()
+    os.Stdout = w
+    printComments(comments)
+    _ = w.Close()
+    os.Stdout = oldOut
+    b, _ := io.ReadAll(r)
+    out := string(b)
+    if !strings.Contains(out, "c1 · resolved by Grace") || !strings.Contains(out, "  ↳ unknown") || !strings.Contains(out, "      Reply") { t.Fatalf("unexpected listing:\n%s", out) }
+}
+
+func TestNewWatchEvents_OnlyUnseenOldestFirst(t *testing.T) {
+    at := time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC)
+    seen := map[string]bool{}
+    history := []api.HistoryEntry{{At: at, Actor: &api.User{Name: "Ada"}, FromState: "Todo", ToState: "In Progress"}}
+    comments := []api.Comment{{ID: "c1", Body: "On it", CreatedAt: at.Add(-time.Minute)}}
+    first := newWatchEvents("ENG-5", history, comments, seen)
+    if len(first) != 2 || first[0].Kind != "comment" || first[1].Text != "state Todo → In Progress" || first[1].Actor != "Ada" { t.Fatalf("unexpected events %+v", first) }
+
+    history = append(history, api.HistoryEntry{At: at.Add(time.Hour), FromAssignee: "Ada", ToAssignee: "Grace"})
+    comments = append(comments, api.Comment{ID: "c2", Body: "Handing over", CreatedAt: at.Add(2 * time.Hour), User: &api.User{Name: "Ada"}})
+    next := newWatchEvents("ENG-5", history, comments, seen)
+    if len(next) != 2 || next[0].Text != "reassigned Ada → Grace" || next[0].Actor != "system" || next[1].CommentID != "c2" { t.Fatalf("unexpected new events %+v", next) }
+    if again := newWatchEvents("ENG-5", history, comments, seen); len(again) != 0 { t.Fatalf("expected nothing new, got %+v", again) }
+}
+
+func TestConfigAPIKeyCmd_UsedButNeverSaved(t *testing.T) {
+    dir := t.TempDir()
+    t.Setenv("XDG_CONFIG_HOME", dir)
+    t.Setenv("LINEAR_API_KEY", "")
+    t.Setenv("LINEAR_PROFILE", "")
+    path := filepath.Join(dir, "linear", "config.toml")
+    if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { t.Fatal(err) }
+    if err := os.WriteFile(path, []byte("api_key = \"lin_api_stored\"\napi_key_cmd = \"echo lin_api_fromcmd\"\n"), 0o600); err != nil { t.Fatal(err) }
+    cfg, err := config.Load()
+    if err != nil { t.Fatal(err) }
+    if cfg.APIKey != "lin_api_fromcmd" || cfg.KeySource() != "command" { t.Fatalf("key = %q from %q", cfg.APIKey, cfg.KeySource()) }
+    cfg.NotifyReminders = true
+    if err := config.Save(cfg); err != nil { t.Fatal(err) }
+    b, _ := os.ReadFile(path)
+    if strings.Contains(string(b), `"lin_api_fromcmd"`) || !strings.Contains(string(b), `api_key = "lin_api_stored"`) { t.Fatalf("fetched key written to disk:\n%s", b) }
+
+    if err := os.WriteFile(path, []byte("api_key_cmd = \"exit 3\"\n"), 0o600); err != nil { t.Fatal(err) }
+    cfg, err = config.Load()
+    if err != nil || cfg.APIKey != "" || cfg.KeyCommandError() == nil { t.Fatalf("expected a failed key command to leave no key: %q %v %v", cfg.APIKey, err, cfg.KeyCommandError()) }
+}
+
+func TestAuthLogout_NotesAPIKeyCmdStillSuppliesAKey(t *testing.T) {
+    dir := t.TempDir()
+    t.Setenv("XDG_CONFIG_HOME", dir)
+    t.Setenv("LINEAR_API_KEY", "")
+    t.Setenv("LINEAR_PROFILE", "")
+    path := filepath.Join(dir, "linear", "config.toml")
+    if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { t.Fatal(err) }
+    if err := os.WriteFile(path, []byte("api_key = \"lin_api_stored\"\napi_key_cmd = \"echo lin_api_fromcmd\"\n"), 0o600); err != nil { t.Fatal(err) }
+    out, _, err := runCLI(t, "--json", "auth", "logout")
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    if !regexp.MustCompile(`"apiKeyCmd":\s*true`).MatchString(out) { t.Fatalf("expected apiKeyCmd in: %s", out) }
+    if b, _ := os.ReadFile(path); strings.Contains(string(b), "lin_api_stored") { t.Fatalf("stored key kept:\n%s", b) }
+}
+
+func TestConfigEncrypt_TransparentLoadAndSave(t *testing.T) {
+    dir := t.TempDir()
+    t.Setenv("XDG_CONFIG_HOME", dir)
+    t.Setenv("LINEAR_API_KEY", "")
+    t.Setenv("LINEAR_PROFILE", "")
+    t.Setenv("LINEAR_CONFIG_PASSPHRASE", "correct horse battery")
+    path := filepath.Join(dir, "linear", "config.toml")
+    if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { t.Fatal(err) }
+    if err := os.WriteFile(path, []byte("api_key = \"lin_api_secret\"\n"), 0o600); err != nil { t.Fatal(err) }
+    if _, _, err := runCLI(t, "config", "encrypt"); err != nil { t.Fatalf("encrypt: %v", err) }
+    b, _ := os.ReadFile(path)
+    if strings.Contains(string(b), "lin_api_secret") { t.Fatalf("key left in plain text:\n%s", b) }
+    if err := config.Encrypt("correct horse battery"); err == nil { t.Fatal("expected an error encrypting twice") }
+
+    cfg, err := config.Load()
+    if err != nil { t.Fatalf("load encrypted: %v", err) }
+    if cfg.APIKey != "lin_api_secret" { t.Fatalf("api key = %q", cfg.APIKey) }
+    params := func(b []byte) []string { return strings.Split(strings.SplitN(string(b), "\n", 3)[1], "$") }
+    before := params(b)
+    cfg.NotifyReminders = true
+    if err := config.Save(cfg); err != nil { t.Fatal(err) }
+    if b, _ = os.ReadFile(path); strings.Contains(string(b), "lin_api_secret") || strings.Contains(string(b), "notify_reminders") { t.Fatalf("save wrote plain text:\n%s", b) }
+    // Save keeps the salt and work factor, so the key opened with is reused; the nonce is new
+    after := params(b)
+    if strings.Join(after[:6], "$") != strings.Join(before[:6], "$") || after[6] == before[6] { t.Fatalf("save should only change the nonce: %v -> %v", before, after) }
+
+    if _, _, err := runCLI(t, "config", "decrypt"); err != nil { t.Fatalf("decrypt: %v", err) }
+    if b, _ = os.ReadFile(path); !strings.Contains(string(b), `api_key = "lin_api_secret"`) || !strings.Contains(string(b), "notify_reminders = true") { t.Fatalf("unexpected decrypted config:\n%s", b) }
+}
+
+func TestChecklist_ParseFindAndToggle(t *testing.T) {
+    desc := "## Acceptance\n- [ ] Add tests\n* [x] Update docs\n```\n- [ ] not an item\n```\n  - [ ] Add tests for edge cases\n"
+    items := parseChecklist(desc)
+    if len(items) != 3 || items[1].Text != "Update docs" || !items[1].Checked || items[2].Line != 6 { t.Fatalf("unexpected items %+v", items) }
+    it, err := findChecklistItem(items, "add TESTS")
+    if err != nil || it.Index != 1 { t.Fatalf("exact match: %+v %v", it, err) }
+    if _, err := findChecklistItem(items, "tests"); err == nil || !strings.Contains(err.Error(), "matches 2 items") { t.Fatalf("expected ambiguity error, got %v", err) }
+    if it, _ := findChecklistItem(items, "edge"); it.Index != 3 { t.Fatalf("substring match: %+v", it) }
+    out := setChecklistItem(desc, 6, true)
+    if !strings.Contains(out, "  - [x] Add tests for edge cases") || strings.Count(out, "[x]") != 2 { t.Fatalf("unexpected description:\n%s", out) }
+    if setChecklistItem(out, 2, false) != strings.Replace(out, "* [x] Update docs", "* [ ] Update docs", 1) { t.Fatal("uncheck should only touch its line") }
+}
+
+func TestProjectMembers_NarrowAndApplyChanges(t *testing.T) {
+    alice := api.User{ID: "u1", Name: "Alice Ng", Email: "alice@example.com"}
+    alicia := api.User{ID: "u2", Name: "Alicia Ray", Email: "alicia@example.com"}
+    bob := api.User{ID: "u3", Name: "Bob", Email: "bob@example.com"}
+    if u, _ := narrowUsers([]api.User{alice, alicia}, "ALICE@example.com"); u == nil || u.ID != "u1" { t.Fatalf("expected email to pick alice, got %+v", u) }
+    if u, c := narrowUsers([]api.User{alice, alicia}, "ali"); u != nil || len(c) != 2 { t.Fatalf("expected both candidates, got %+v %+v", u, c) }
+
+    ids, added, removed := applyMemberChanges([]api.User{alice, bob}, []api.User{alicia, alice}, []api.User{bob})
+    if strings.Join(ids, ",") != "u1,u2" || strings.Join(added, ",") != "Alicia Ray" || strings.Join(removed, ",") != "Bob" { t.Fatalf("ids=%v added=%v removed=%v", ids, added, removed) }
+}
+
+func TestReleaseIssues_SkipsPostedCommentsAndReportsFailures(t *testing.T) {
+    var created []string
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string; Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        switch {
+        case strings.Contains(p.Query, "comments("):
+            body := "Earlier note"
+            if p.Variables["id"] == "i1" { body = "Shipped in v2.0" }
+            w.Write([]byte(`{"data":{"issue":{"comments":{"nodes":[{"id":"c0","body":"` + body + `"}],"pageInfo":{"hasNextPage":false}}}}}`))
+        case strings.Contains(p.Query, "commentCreate"):
+            in, _ := p.Variables["input"].(map[string]any)
+            created = append(created, fmt.Sprint(in["issueId"]))
+            w.Write([]byte(`{"data":{"commentCreate":{"success":true,"comment":{"id":"c1","body":"x","issue":{"id":"i2","url":"u","identifier":"ENG-2"}}}}}`))
+        case strings.Contains(p.Query, "issueUpdate") && p.Variables["id"] == "i2" && fmt.Sprint(p.Variables["input"]) == fmt.Sprint(map[string]any{"stateId": "s-done"}):
+            w.Write([]byte(`{"errors":[{"message":"state transition not allowed"}]}`))
+        case strings.Contains(p.Query, "issueUpdate"):
+            w.Write([]byte(`{"data":{"issueUpdate":{"success":true,"issue":{"id":"i1","identifier":"ENG-1","title":"T","url":"u","state":{"name":"Done"}}}}}`))
+        default:
+            t.Fatalf("unexpected query %s", p.Query)
+        }
+    }))
+    defer srv.Close()
+    client := api.NewClient("k").WithEndpoint(srv.URL)
+    issues := []api.ProjectIssue{{ID: "i1", Identifier: "ENG-1", TeamID: "t1"}, {ID: "i2", Identifier: "ENG-2", TeamID: "t1"}}
+    res := releaseIssues(context.Background(), client, issues, map[string]*api.State{"t1": {ID: "s-done", Name: "Done"}}, "Shipped in v2.0\n")
+    if !res[0].Moved || !res[0].CommentExisted || res[0].Commented { t.Fatalf("ENG-1: %+v", res[0]) }
+    if res[1].Moved || !res[1].Commented || !strings.Contains(res[1].Error, "not allowed") { t.Fatalf("ENG-2: %+v", res[1]) }
+    if strings.Join(created, ",") != "i2" { t.Fatalf("comments created on %v", created) }
+}
+
+func TestReleaseIssues_StopsOnceCancelled(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+    updates := 0
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        updates++
+        cancel()
+        w.Write([]byte(`{"data":{"issueUpdate":{"success":true,"issue":{"id":"i1","identifier":"ENG-1","title":"T","url":"u","state":{"name":"Done"}}}}}`))
+    }))
+    defer srv.Close()
+    client := api.NewClient("k").WithEndpoint(srv.URL)
+    issues := []api.ProjectIssue{{ID: "i1", Identifier: "ENG-1", TeamID: "t1"}, {ID: "i2", Identifier: "ENG-2", TeamID: "t1"}, {ID: "i3", Identifier: "ENG-3", TeamID: "t1"}}
+    res := releaseIssues(ctx, client.WithContext(ctx), issues, map[string]*api.State{"t1": {ID: "s-done", Name: "Done"}}, "")
+    if updates != 1 || len(res) > 1 { t.Fatalf("updates = %d, results = %+v", updates, res) }
+}
+
+func TestHandleInterrupt_RunsCleanupsOnlyWhenSignalled(t *testing.T) {
+    var order []string
+    remove := addCleanup(func() { order = append(order, "first") })
+    addCleanup(func() { order = append(order, "second") })
+    gone := addCleanup(func() { order = append(order, "removed") })
+    gone()
+
+    // Finishing normally cancels the context too, but must not clean up
+    ctx, cancel := context.WithCancel(context.Background())
+    finished := make(chan struct{})
+    close(finished)
+    cancel()
+    handleInterrupt(ctx, func() {}, finished)
+    if len(order) != 0 { t.Fatalf("cleanups ran after a normal finish: %v", order) }
+
+    ctx, cancel = context.WithCancel(context.Background())
+    cancel()
+    stopped := false
+    handleInterrupt(ctx, func() { stopped = true }, make(chan struct{}))
+    if !stopped || strings.Join(order, ",") != "second,first" { t.Fatalf("stopped=%v order=%v", stopped, order) }
+    remove()
+    runCleanups()
+    if len(order) != 2 { t.Fatalf("cleanups ran twice: %v", order) }
+}
+
+func TestPlainOutput_DropsEmojisAndBoxDrawing(t *testing.T) {
+    old := ui
+    t.Cleanup(func() { ui = old })
+    if got := output.StripEmoji("📋 Using template: Bug ✅ done ⚠️ careful ⏰ soon"); got != "Using template: Bug done careful soon" { t.Fatalf("unexpected strip: %q", got) }
+
+    ui = output.Printer{Plain: true}
+    if got := priorityLabel(1); got != "Urgent" { t.Fatalf("plain priority label: %q", got) }
+    board := renderBoard([]issueGroup{{Group: "Todo", Count: 1, Issues: []api.IssueDetails{{Identifier: "ENG-1", Title: "A"}}}, {Group: "Done"}}, 60)
+    for _, r := range board {
+        if r > 127 { t.Fatalf("plain board has non-ASCII %q:\n%s", r, board) }
+    }
+    if !strings.Contains(board, " | ") { t.Fatalf("expected ASCII separators:\n%s", board) }
+    from, to := 3, 1
+    if got := strings.Join(describeHistoryEntry(api.HistoryEntry{FromState: "Todo", ToState: "Done", FromPriority: &from, ToPriority: &to}), "|"); got != "state Todo -> Done|priority Medium -> Urgent" { t.Fatalf("plain history: %q", got) }
+
+    ui = output.Printer{}
+    if got := priorityLabel(1); !strings.HasPrefix(got, "🔴") { t.Fatalf("decorated priority label: %q", got) }
+}
+
+func TestTeamUpdateFromFlags_OnlyChangedSettings(t *testing.T) {
+    backlog := api.State{ID: "s1", Name: "Backlog", Type: "backlog"}
+    states := []api.State{backlog, {ID: "s2", Name: "Done", Type: "completed"}}
+    ts := &api.TeamSettings{Team: api.Team{ID: "t1", Key: "ENG", Name: "Engineering"}, CyclesEnabled: true, CycleDuration: 2, IssueEstimationType: "notUsed", DefaultIssueState: &backlog}
+    parse := func(args ...string) *cobra.Command {
+        c := &cobra.Command{}
+        c.Flags().String("name", "", "")
+        c.Flags().String("description", "", "")
+        c.Flags().String("cycles", "", "")
+        c.Flags().Int("cycle-duration", 0, "")
+        c.Flags().String("estimation", "", "")
+        c.Flags().String("default-state", "", "")
+        if err := c.Flags().Parse(args); err != nil { t.Fatal(err) }
+        return c
+    }
+
+    in, changes, err := teamUpdateFromFlags(parse("--cycles", "on", "--cycle-duration", "1", "--estimation", "tshirt", "--default-state", "backlog"), ts, states)
+    if err != nil { t.Fatal(err) }
+    if in.CyclesEnabled != nil || in.CycleDuration == nil || *in.CycleDuration != 1 || in.IssueEstimationType != "tShirt" || in.DefaultIssueStateID != "" {
+        t.Fatalf("unexpected input %+v", in)
+    }
+    if strings.Join(changes, "; ") != "cycle duration: 2 week(s) → 1 week(s); estimation: notUsed → tShirt" { t.Fatalf("unexpected changes %q", changes) }
+
+    if _, _, err := teamUpdateFromFlags(parse("--default-state", "Done"), ts, states); err == nil || !strings.Contains(err.Error(), "completed state") { t.Fatalf("expected a completed default state to be refused, got %v", err) }
+    if _, _, err := teamUpdateFromFlags(parse("--cycle-duration", "9"), ts, states); err == nil { t.Fatal("expected a 9-week cycle to be refused") }
+    if _, changes, _ := teamUpdateFromFlags(parse("--name", "Engineering", "--cycles", "yes"), ts, states); len(changes) != 0 { t.Fatalf("expected no changes, got %q", changes) }
+}
+
+func TestFillTemplateFromDescription_HeadingsOrderAndMap(t *testing.T) {
+    tpl := "## Summary\nOne or two sentences.\n\n## Context\nBackground.\n\n## Steps to Reproduce\n1.\n\n### Notes (optional)\nAnything else.\n"
+    desc := "Login times out after 5 minutes.\n\nIt started with the new session store.\n\nSteps:\n1. Log in\n2. Wait\n\nMore background from support."
+
+    got, err := fillTemplateFromDescription(tpl, desc, nil)
+    if err != nil { t.Fatal(err) }
+    summary := got[strings.Index(got, "## Summary"):strings.Index(got, "## Context")]
+    context := got[strings.Index(got, "## Context"):strings.Index(got, "## Steps")]
+    steps := got[strings.Index(got, "## Steps"):strings.Index(got, "### Notes")]
+    if !strings.Contains(summary, "Login times out") || !strings.Contains(context, "session store") || !strings.Contains(context, "from support") || !strings.Contains(steps, "1. Log in\n2. Wait") {
+        t.Fatalf("unexpected fill:\n%s", got)
+    }
+    if !strings.Contains(got, "Anything else.") { t.Fatalf("unrouted section should keep its text:\n%s", got) }
+
+    routes, err := parseParagraphMap([]string{"summary=2,notes=1,4"})
+    if err != nil { t.Fatal(err) }
+    got, err = fillTemplateFromDescription(tpl, desc, routes)
+    if err != nil { t.Fatal(err) }
+    notes := got[strings.Index(got, "### Notes"):]
+    if !strings.Contains(got[:strings.Index(got, "## Context")], "session store") || !strings.Contains(notes, "Login times out") || !strings.Contains(notes, "from support") {
+        t.Fatalf("unexpected mapped fill:\n%s", got)
+    }
+    if _, err := parseParagraphMap([]string{"Summary=3-1"}); err == nil { t.Fatal("expected a reversed range to fail") }
+    if _, err := fillTemplateFromDescription(tpl, desc, map[string][]int{"Summary": {9}}); err == nil { t.Fatal("expected an out-of-range paragraph to fail") }
+    if _, err := fillTemplateFromDescription(tpl, desc, map[string][]int{"Budget": {1}}); err == nil { t.Fatal("expected an unknown section to fail") }
+}
+
+func TestIssuesList_OutputURLAndCopy(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1","title":"A","url":"https://linear.app/acme/issue/ENG-1"},{"id":"i2","identifier":"ENG-2","title":"B","url":"https://linear.app/acme/issue/ENG-2"}],"pageInfo":{"hasNextPage":false}}}}`))
+    }))
+    defer srv.Close()
+    dir := t.TempDir()
+    copied := filepath.Join(dir, "copied")
+    if err := os.WriteFile(filepath.Join(dir, "xclip"), []byte("#!/bin/sh\n/bin/cat > "+copied+"\n"), 0o755); err != nil { t.Fatal(err) }
+    t.Setenv("PATH", dir)
+    t.Setenv("WAYLAND_DISPLAY", "")
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+    // Earlier runs leave --json set, which excludes --output
+    reset := func() {
+        for _, f := range []*pflag.Flag{rootCmd.PersistentFlags().Lookup("json"), rootCmd.PersistentFlags().Lookup("json-lines"), rootCmd.PersistentFlags().Lookup("output"), issuesListAdvCmd.Flags().Lookup("copy")} {
+            _ = f.Value.Set(f.DefValue)
+            f.Changed = false
+        }
+    }
+    reset()
+    t.Cleanup(reset)
+
+    out, _, err := runCLI(t, "-o", "url", "issues", "list", "--copy=2")
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    if out != "https://linear.app/acme/issue/ENG-1\nhttps://linear.app/acme/issue/ENG-2\n" { t.Fatalf("unexpected url output: %q", out) }
+    if b, _ := os.ReadFile(copied); string(b) != "https://linear.app/acme/issue/ENG-2" { t.Fatalf("clipboard got %q", b) }
+    if err := copyIssueURL([]api.IssueDetails{{URL: "u"}}, 3); err == nil { t.Fatal("expected an out-of-range row to fail") }
+}
+
+func TestRecurring_CronOccurrencesAndDue(t *testing.T) {
+    spec, err := parseCron("0 9 * * MON")
+    if err != nil { t.Fatal(err) }
+    wed := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC) // a Wednesday
+    if got := spec.prev(wed); !got.Equal(time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)) { t.Fatalf("prev = %v", got) }
+    if got := spec.next(wed); !got.Equal(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)) { t.Fatalf("next = %v", got) }
+    if got := spec.prev(time.Date(2026, 10, 12, 9, 0, 30, 0, time.UTC)); got.Hour() != 9 || got.Day() != 12 { t.Fatalf("prev at the minute = %v", got) }
+
+    if spec, err := parseCron("*/15 8-17 1,15 * *"); err != nil {
+        t.Fatal(err)
+    } else if got := spec.next(time.Date(2026, 10, 15, 17, 50, 0, 0, time.UTC)); !got.Equal(time.Date(2026, 11, 1, 8, 0, 0, 0, time.UTC)) {
+        t.Fatalf("next with steps = %v", got)
+    }
+    if spec, _ := parseCron("0 0 30 FEB *"); !spec.next(wed).IsZero() { t.Fatal("30 February should never fire") }
+    for _, bad := range []string{"0 9 * *", "60 * * * *", "0 9 * * FUNDAY", "0 9 5-1 * *"} {
+        if _, err := parseCron(bad); err == nil { t.Fatalf("expected %q to be rejected", bad) }
+    }
+
+    s := RecurringSchedule{ID: "eng-ops-checklist", Template: "Ops Checklist", Cron: "0 9 * * MON", CreatedAt: time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)}
+    if _, ok := dueOccurrence(s, spec, wed); ok { t.Fatal("an occurrence before the schedule existed is not due") }
+    later := time.Date(2026, 10, 21, 8, 0, 0, 0, time.UTC)
+    occ, ok := dueOccurrence(s, spec, later)
+    if !ok || occ.Day() != 19 { t.Fatalf("expected the 19th to be due, got %v %v", occ, ok) }
+    if recurringTitle(s, occ) != "Ops Checklist (2026-10-19)" || recurringKey(s, occ) != "recurring:eng-ops-checklist:20261019T0900Z" { t.Fatalf("title %q key %q", recurringTitle(s, occ), recurringKey(s, occ)) }
+    if !reIdempotencyKey.MatchString(recurringKey(s, occ)) { t.Fatal("recurring keys must be valid idempotency keys") }
+    s.LastRun = occ
+    if _, ok := dueOccurrence(s, spec, later); ok { t.Fatal("an occurrence with an issue is not due again") }
+    if id := recurringID([]RecurringSchedule{s}, "ENG", "Ops Checklist"); id != "eng-ops-checklist-2" { t.Fatalf("id = %q", id) }
+}
+
+func TestResolveCycleRef_RelativeNumberAndName(t *testing.T) {
+    day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+    cycles := []api.Cycle{
+        {ID: "c11", Number: 11, Name: "Sprint 11", StartsAt: day(1), EndsAt: day(8)},
+        {ID: "c12", Number: 12, Name: "Sprint 12", StartsAt: day(8), EndsAt: day(15)},
+        {ID: "c13", Number: 13, Name: "Hardening", StartsAt: day(15), EndsAt: day(22)},
+        {ID: "c14", Number: 14, StartsAt: day(22), EndsAt: day(29)},
+    }
+    now := day(10)
+    for ref, want := range map[string]string{"current": "c12", "next": "c13", "previous": "c11", "#14": "c14", "11": "c11", "sprint 12": "c12", "hard": "c13"} {
+        c, err := resolveCycleRef(cycles, ref, now)
+        if err != nil || c.ID != want { t.Fatalf("%s: got %+v, %v; want %s", ref, c, err, want) }
+    }
+    if _, err := resolveCycleRef(cycles, "sprint", now); err == nil || !strings.Contains(err.Error(), "several cycles") { t.Fatalf("ambiguous prefix: %v", err) }
+    if _, err := resolveCycleRef(cycles, "#40", now); err == nil { t.Fatal("expected a missing number to fail") }
+    if _, err := resolveCycleRef(cycles, "next", day(28)); err == nil { t.Fatal("expected no upcoming cycle") }
+    if cycleLabel(&cycles[3]) != "cycle #14" || cycleLabel(nil) != "no cycle" { t.Fatalf("labels %q %q", cycleLabel(&cycles[3]), cycleLabel(nil)) }
+}
+
+func TestBuildUserReport_FlagsInactiveAndWritesCSV(t *testing.T) {
+    since := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+    old := since.AddDate(-1, 0, 0)
+    seen := since.AddDate(0, -2, 0)
+    users := []api.WorkspaceUser{
+        {User: api.User{ID: "u1", Name: "Ada", Email: "ada@example.com"}, CreatedAt: old},
+        {User: api.User{ID: "u2", Name: "Grace", Email: "grace@example.com"}, Admin: true, CreatedAt: old, LastSeen: &seen},
+        {User: api.User{ID: "u3", Name: "Linus", Email: "linus@example.com"}, Guest: true, CreatedAt: old},
+        {User: api.User{ID: "u4", Name: "New Hire", Email: "new@example.com"}, CreatedAt: since.AddDate(0, 0, 10)},
+    }
+    last := since.AddDate(0, 0, 3)
+    activity := map[string]*api.UserActivity{"u1": {IssuesUpdated: 2, Comments: 1, Last: &last}}
+    rows := buildUserReport(users, activity, since)
+    var order []string
+    for _, r := range rows { order = append(order, fmt.Sprintf("%s:%s:%v", r.Name, r.Role, r.Inactive)) }
+    // Inactive first, never seen before seen; new users are not flagged
+    want := "Linus:guest:true Grace:admin:true Ada:member:false New Hire:member:false"
+    if got := strings.Join(order, " "); got != want { t.Fatalf("rows = %s\nwant   %s", got, want) }
+
+    var buf strings.Builder
+    if err := writeUserReportCSV(&buf, rows[:2]); err != nil { t.Fatal(err) }
+    lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+    if len(lines) != 3 || lines[2] != "Grace,grace@example.com,admin,2025-08-01,2026-06-01,,0,0,0,true" { t.Fatalf("csv = %q", buf.String()) }
+}
+
+func TestSampleTemplate_TypeAwareAndDeterministic(t *testing.T) {
+    now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+    raw := "Reported by {{REPORTER}} on {{dueDate}} for {{SERVICE}}.\n\n## Steps to Reproduce\n1.\n\n## Environment\nBrowser: {{BROWSER}}\n\n## Acceptance Criteria\n"
+    content, vars, sampled := sampleTemplate(raw, map[string]string{"SERVICE": "checkout"}, now)
+    out, err := fillTemplate(content, vars, false, true)
+    if err != nil { t.Fatalf("every placeholder should have a value: %v", err) }
+    if !strings.Contains(out, "for checkout.") { t.Fatalf("--var values must win:\n%s", out) }
+    if reporter := vars["REPORTER"]; !strings.Contains(reporter, " ") || strings.Contains(strings.ToLower(reporter), "lorem") { t.Fatalf("REPORTER = %q, want a person", reporter) }
+    if d, err := time.Parse("2006-01-02", vars["dueDate"]); err != nil || !d.After(now) { t.Fatalf("dueDate = %q, want a future date", vars["dueDate"]) }
+    if !strings.Contains(out, "## Steps to Reproduce\n\n1. ") || !strings.Contains(out, "- [ ] ") { t.Fatalf("sections not sampled by heading:\n%s", out) }
+    if !strings.Contains(out, "Browser: "+vars["BROWSER"]) { t.Fatalf("a section with placeholders keeps its text:\n%s", out) }
+    want := []string{`section "Steps to Reproduce"`, `section "Acceptance Criteria"`, "{{REPORTER}}", "{{dueDate}}", "{{BROWSER}}"}
+    if strings.Join(sampled, "|") != strings.Join(want, "|") { t.Fatalf("sampled = %v", sampled) }
+    again, _, _ := sampleTemplate(raw, map[string]string{"SERVICE": "checkout"}, now)
+    if again != content { t.Fatal("samples should be the same on every run") }
+}
+
+func TestBridge_ParsesAlertsFillsTemplateAndChecksSignatures(t *testing.T) {
+    sentry := `{"action":"triggered","data":{"triggered_rule":"Errors","event":{"issue_id":"4711","title":"TypeError: x is undefined","culprit":"checkout/cart.js","level":"error","web_url":"https://sentry.example.com/issues/4711/","environment":"production","tags":[["release","2.4.1"],["browser","Firefox"]]}}}`
+    a, err := parseBridgeAlert("sentry", []byte(sentry))
+    if err != nil { t.Fatal(err) }
+    if a.Status != "firing" || a.Fingerprint != "issue:4711" || a.Fields["release"] != "2.4.1" || *bridgePriority(a.Severity) != 2 { t.Fatalf("sentry alert = %+v", a) }
+
+    tpl := "## Description\n\n## Environment\n\n## Steps to Reproduce\n1.\n"
+    desc := renderBridgeDescription(tpl, a, []bridgeMapping{{Section: "steps to reproduce", Value: "Open {{url}}\n{{runbook}}"}})
+    for _, want := range []string{"## Description\n\nTypeError: x is undefined\n", "- Environment: production\n- Release: 2.4.1\n\n", "## Steps to Reproduce\n\nOpen https://sentry.example.com/issues/4711/\n", "- browser: Firefox"} {
+        if !strings.Contains(desc, want) { t.Fatalf("description lacks %q:\n%s", want, desc) }
+    }
+    if strings.Contains(desc, "Service:") { t.Fatalf("lines of missing fields should be dropped:\n%s", desc) }
+
+    pd := `{"event":{"event_type":"incident.resolved","data":{"id":"Q1","type":"incident","title":"DB down","html_url":"https://pd.example.com/incidents/Q1","urgency":"high","service":{"summary":"db"}}}}`
+    b, err := parseBridgeAlert("pagerduty", []byte(pd))
+    if err != nil || b.Status != "resolved" || b.Fingerprint != "incident:Q1" || b.Fields["message"] != "DB down (db)" { t.Fatalf("pagerduty alert = %+v, %v", b, err) }
+    if bridgeKey(a) == bridgeKey(b) || bridgeKey(a) != bridgeKey(&bridgeAlert{Source: "sentry", Fingerprint: "issue:4711"}) { t.Fatal("keys must follow source and fingerprint") }
+    if !reIdempotencyKey.MatchString(bridgeKey(a)) { t.Fatalf("key %q is not a valid idempotency key", bridgeKey(a)) }
+    if c, _ := parseBridgeAlert("pagerduty", []byte(`{"event":{"event_type":"incident.acknowledged","data":{"id":"Q1","type":"incident","title":"DB down"}}}`)); c.Status != "ignored" { t.Fatalf("acknowledgements should be ignored: %+v", c) }
+    if _, err := parseBridgeAlert("generic", []byte(`{"severity":"warning"}`)); err == nil { t.Fatal("generic payloads without a title should be rejected") }
+
+    mac := hmac.New(sha256.New, []byte("s3cret"))
+    mac.Write([]byte(sentry))
+    sig := hex.EncodeToString(mac.Sum(nil))
+    h := http.Header{}
+    h.Set("Sentry-Hook-Signature", sig)
+    if !verifyBridgeSignature("sentry", h, []byte(sentry), "s3cret") || verifyBridgeSignature("sentry", h, []byte(sentry+" "), "s3cret") { t.Fatal("sentry signature check") }
+    h = http.Header{}
+    h.Set("X-PagerDuty-Signature", "v1=deadbeef, v1="+sig)
+    if !verifyBridgeSignature("pagerduty", h, []byte(sentry), "s3cret") { t.Fatal("any rotated PagerDuty signature should do") }
+}
+
+func TestBridgeServer_DeduplicatesAndChoosesCreateOrComment(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    var mu sync.Mutex
+    issues := map[string]string{} // description -> identifier
+    var creates, comments int
+    slowSeen, releaseSlow := make(chan struct{}), make(chan struct{})
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        var p struct {
+            Query     string         `json:"query"`
+            Variables map[string]any `json:"variables"`
+        }
+        _ = json.NewDecoder(r.Body).Decode(&p)
+        issue := func(id string) string { return `{"id":"` + id + `","identifier":"` + id + `","title":"t","url":"https://linear.app/` + id + `","state":{"name":"Todo"}}` }
+        switch {
+        case strings.Contains(p.Query, "issueCreate"):
+            in := p.Variables["input"].(map[string]any)
+            if in["title"] == "Slow" {
+                close(slowSeen)
+                <-releaseSlow
+            }
+            mu.Lock()
+            creates++
+            id := fmt.Sprintf("ENG-%d", creates)
+            issues[in["description"].(string)] = id
+            mu.Unlock()
+            w.Write([]byte(`{"data":{"issueCreate":{"success":true,"issue":` + issue(id) + `}}}`))
+        case strings.Contains(p.Query, "commentCreate"):
+            mu.Lock()
+            comments++
+            mu.Unlock()
+            w.Write([]byte(`{"data":{"commentCreate":{"success":true,"comment":{"id":"c1","body":"b","issue":{"id":"x","url":"u","identifier":"x"}}}}}`))
+        case strings.Contains(p.Query, "description:{ contains"):
+            mu.Lock()
+            defer mu.Unlock()
+            for desc, id := range issues {
+                if strings.Contains(desc, p.Variables["text"].(string)) {
+                    w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"` + id + `"}]}}}`))
+                    return
+                }
+            }
+            w.Write([]byte(`{"data":{"issues":{"nodes":[]}}}`))
+        default:
+            w.Write([]byte(`{"data":{"issue":` + issue(p.Variables["id"].(string)) + `}}`))
+        }
+    }))
+    defer srv.Close()
+    ui = output.Printer{Quiet: true}
+    t.Cleanup(func() { ui = output.Printer{} })
+    bs := &bridgeServer{client: api.NewClient("k").WithEndpoint(srv.URL), p: output.Printer{Quiet: true}, source: "generic", teamID: "t1", template: "## Summary\n", quiet: time.Hour, notified: map[string]bridgeNotice{}}
+    post := func(body string) (int, bridgeResult) {
+        rec := httptest.NewRecorder()
+        bs.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+        var res bridgeResult
+        _ = json.Unmarshal(rec.Body.Bytes(), &res)
+        return rec.Code, res
+    }
+
+    // Two deliveries of a new problem at once create one issue
+    firing := `{"title":"DB down","fingerprint":"db"}`
+    codes := make([]int, 2)
+    var wg sync.WaitGroup
+    for i := range codes {
+        wg.Add(1)
+        go func(i int) { defer wg.Done(); codes[i], _ = post(firing) }(i)
+    }
+    wg.Wait()
+    if creates != 1 || codes[0]+codes[1] != http.StatusCreated+http.StatusOK { t.Fatalf("duplicate delivery: %d creates, codes %v", creates, codes) }
+
+    // Within the quiet period a repeat is only deduplicated; after it, it comments
+    if _, res := post(firing); res.Status != "deduplicated" || res.Issue != "ENG-1" || comments != 0 { t.Fatalf("quiet period: %+v, %d comments", res, comments) }
+    bs.quiet = 0
+    if _, res := post(firing); res.Status != "updated" || comments != 1 { t.Fatalf("after the quiet period: %+v, %d comments", res, comments) }
+    if _, res := post(`{"title":"DB down","fingerprint":"db","status":"resolved"}`); res.Status != "resolved" || comments != 2 { t.Fatalf("resolved: %+v", res) }
+    if _, res := post(`{"title":"Cache down","fingerprint":"cache","status":"resolved"}`); res.Status != "ignored" || creates != 1 { t.Fatalf("resolving an unknown problem: %+v", res) }
+
+    // A slow create for one problem does not hold up another
+    slow := make(chan bridgeResult)
+    go func() { _, res := post(`{"title":"Slow","fingerprint":"slow"}`); slow <- res }()
+    <-slowSeen
+    fast := make(chan bridgeResult)
+    go func() { _, res := post(`{"title":"Fast","fingerprint":"fast"}`); fast <- res }()
+    select {
+    case res := <-fast:
+        if res.Status != "created" { t.Fatalf("fast alert: %+v", res) }
+    case <-time.After(5 * time.Second):
+        t.Fatal("an alert waited for another problem's request to Linear")
+    }
+    close(releaseSlow)
+    if res := <-slow; res.Status != "created" || creates != 3 { t.Fatalf("slow alert: %+v, %d creates", res, creates) }
+}
+
+func TestCodeowners_LastMatchWinsAndRanksOwners(t *testing.T) {
+    file := `# Default owners
+*       @acme/core
+*.js    @frontend-dev   # web
+/docs/  docs@example.com
+apps/   @app-owner
+/build/logs/*  @ops
+**/billing/**  @payments @octocat
+/vendor/
+`
+    rules, err := parseCodeowners(strings.NewReader(file))
+    if err != nil { t.Fatal(err) }
+    owner := func(path string) string {
+        r := codeownersFor(rules, path)
+        if r == nil { return "<none>" }
+        return strings.Join(r.Owners, " ")
+    }
+    for path, want := range map[string]string{
+        "main.go":                      "@acme/core",
+        "web/app.js":                   "@frontend-dev",
+        "docs/guide/intro.md":          "docs@example.com",
+        "src/docs/notes.md":            "@acme/core",
+        "services/apps/x/y.go":         "@app-owner",
+        "build/logs/today.log":         "@ops",
+        "build/logs/old/2025.log":      "@acme/core",
+        "internal/billing/invoice.js":  "@payments @octocat",
+        "./vendor/lib/a.go":            "",
+    } {
+        if got := owner(path); got != want { t.Errorf("%s: owners %q, want %q", path, got, want) }
+    }
+
+    ranked, unowned := rankCodeowners(rules, []string{"internal/billing/a.go", "internal/billing/b.go", "main.go", "web/x.js", "vendor/z.go"})
+    if len(ranked) != 4 || ranked[0] != (codeownersCount{"@payments", 2}) || ranked[1] != (codeownersCount{"@octocat", 2}) || ranked[2].Owner != "@acme/core" {
+        t.Fatalf("ranked = %+v", ranked)
+    }
+    if len(unowned) != 1 || unowned[0] != "vendor/z.go" { t.Fatalf("unowned = %v", unowned) }
+    if _, err := parseCodeowners(strings.NewReader("/ @root\n")); err == nil || !strings.Contains(err.Error(), "line 1") { t.Fatalf("expected a line error, got %v", err) }
+}
+
+func TestListWatermark_ReturnsEachChangeOnce(t *testing.T) {
+    at := func(min int) *time.Time { v := time.Date(2026, 10, 1, 12, min, 0, 0, time.UTC); return &v }
+    now := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+    first := []api.IssueDetails{{ID: "a", UpdatedAt: at(1)}, {ID: "b", UpdatedAt: at(5)}, {ID: "c", UpdatedAt: at(5)}}
+    w := advanceWatermark(listWatermark{}, first, now)
+    if !w.UpdatedAt.Equal(*at(5)) || strings.Join(w.IDs, ",") != "b,c" || !w.LastRun.Equal(now) { t.Fatalf("watermark = %+v", w) }
+
+    // The next query asks for updatedAt >= 12:05, so b and c come back; d was
+    // updated in the same minute after the last run and must not be lost
+    second := []api.IssueDetails{{ID: "b", UpdatedAt: at(5)}, {ID: "c", UpdatedAt: at(5)}, {ID: "d", UpdatedAt: at(5)}, {ID: "a", UpdatedAt: at(9)}}
+    fresh := sinceWatermark(second, w)
+    if len(fresh) != 2 || fresh[0].ID != "d" || fresh[1].ID != "a" { t.Fatalf("fresh = %+v", fresh) }
+    w = advanceWatermark(w, fresh, now)
+    if !w.UpdatedAt.Equal(*at(9)) || strings.Join(w.IDs, ",") != "a" { t.Fatalf("watermark = %+v", w) }
+    if again := advanceWatermark(w, nil, now.Add(time.Hour)); !again.UpdatedAt.Equal(w.UpdatedAt) { t.Fatal("an empty run must keep the watermark") }
+
+    f := api.IssueListFilter{StateName: "Todo", Limit: 10}
+    g := f
+    g.Limit, g.UpdatedSince = 0, *at(9)
+    if f.FilterKey() != g.FilterKey() { t.Fatal("limit and watermark must not change the filter key") }
+    g.StateName = "Done"
+    if f.FilterKey() == g.FilterKey() { t.Fatal("different filters need different keys") }
+}
+
+func TestTemplatesLint_ReportsTemplateMistakes(t *testing.T) {
+    dir := t.TempDir()
+    raw := "---\nlabels: bug\ncolour: red\n---\nTitle-Prefix:\n## Summary\n{{SUMMARY|What happened}} {{ bad key }}\n## summary\n{{OPEN\n"
+    file := filepath.Join(dir, "bug.md")
+    if err := os.WriteFile(file, []byte(raw), 0o600); err != nil { t.Fatal(err) }
+    got := map[string]int{}
+    for _, f := range lintTemplate(file, raw, false, false) { got[f.Rule+"/"+f.Severity+"@"+strconv.Itoa(f.Line)]++ }
+    for _, want := range []string{"front-matter/warning@3", "title-prefix/error@5", "placeholder/error@7", "duplicate-section/error@8", "placeholder/error@9"} {
+        if got[want] != 1 { t.Fatalf("missing %s in %v", want, got) }
+    }
+    if len(got) != 5 { t.Fatalf("unexpected findings: %v", got) }
+
+    if fs := lintTemplate(file, "TITLE-PREFIX: [Bug]\n## Summary\n{{SUMMARY}}\n", false, true); len(fs) != 0 { t.Fatalf("clean template flagged: %v", fs) }
+    if fs := lintTemplate(file, "## Summary\n", false, true); len(fs) != 1 || fs[0].Rule != "title-prefix" { t.Fatalf("missing prefix not flagged: %v", fs) }
+    if p, _ := parseTitlePrefixAndStrip("TITLE-PREFIX: [Bug]\nbody"); p != "[Bug]" { t.Fatalf("prefix = %q", p) }
+
+    vars := filepath.Join(dir, "vars.json")
+    if err := os.WriteFile(vars, []byte(`{"SUMMARY":"x","UNUSED":"y"}`), 0o600); err != nil { t.Fatal(err) }
+    fs, err := lintVarsFile(vars, map[string]bool{"SUMMARY": true})
+    if err != nil || len(fs) != 1 || !strings.Contains(fs[0].Message, "UNUSED") { t.Fatalf("vars findings = %v, %v", fs, err) }
+}
+
+func TestPerfReport_SummarizesRequestsRetriesAndCache(t *testing.T) {
+    resetPerfStats()
+    notePerfRequest(api.RequestStat{Operation: "query teams", Duration: 120 * time.Millisecond, Attempts: 1, Status: 200})
+    notePerfRequest(api.RequestStat{Operation: "query issues", Duration: 300 * time.Millisecond, Attempts: 3, Status: 200})
+    notePerfRequest(api.RequestStat{Operation: "query issues", Duration: 100 * time.Millisecond, Attempts: 1, Status: 502, Error: "linear api error: 502 Bad Gateway"})
+    notePerfCache("teams", true)
+    notePerfCache("teams", false)
+    notePerfCache("teams", true)
+
+    r := buildPerfReport(time.Now())
+    if len(r.Requests) != 3 || r.Retries != 2 || r.RequestMs != 520 { t.Fatalf("unexpected totals %+v", r) }
+    if len(r.Operations) != 2 || r.Operations[0].Operation != "query issues" || r.Operations[0].Count != 2 || r.Operations[0].MaxMs != 300 {
+        t.Fatalf("operations should be slowest first: %+v", r.Operations)
+    }
+    if r.Cache["teams"] != (perfCacheCount{Hits: 2, Misses: 1}) { t.Fatalf("cache = %+v", r.Cache) }
+
+    var text strings.Builder
+    writePerfReport(&text, r, false)
+    for _, want := range []string{"3 request(s) in 520ms, 2 retry(ies)", "query issues", "502 (linear api error", "teams 2 hit(s), 1 miss(es)"} {
+        if !strings.Contains(text.String(), want) { t.Fatalf("report missing %q:\n%s", want, text.String()) }
+    }
+    var js strings.Builder
+    writePerfReport(&js, r, true)
+    var out struct{ Perf perfReport `json:"perf"` }
+    if err := json.Unmarshal([]byte(js.String()), &out); err != nil || len(out.Perf.Requests) != 3 { t.Fatalf("json report = %s (%v)", js.String(), err) }
+}
+
+func TestProjectBadge_ShieldsEndpointJSON(t *testing.T) {
+    issues := []api.ProjectIssue{{ID: "1", StateType: "completed"}, {ID: "2", StateType: "completed"}, {ID: "3", StateType: "started"}, {ID: "4", StateType: "canceled"}, {ID: "5", StateType: "backlog"}}
+    b := newBadgeProgress("Website", "project", issues)
+    if b.Done != 2 || b.Total != 4 || b.Canceled != 1 || b.Percent != 50 { t.Fatalf("progress = %+v", b) }
+    got := shieldsBadge("Website", b)
+    if got["schemaVersion"] != 1 || got["label"] != "Website" || got["message"] != "50% (2/4)" || got["color"] != "yellowgreen" { t.Fatalf("badge = %v", got) }
+    if got := shieldsBadge("Empty", newBadgeProgress("Empty", "project", nil)); got["message"] != "no issues" || got["color"] != "lightgrey" { t.Fatalf("empty badge = %v", got) }
+    if got := shieldsBadge("Done", newBadgeProgress("Done", "project", issues[:2])); got["color"] != "brightgreen" { t.Fatalf("finished badge = %v", got) }
+
+    path := filepath.Join(t.TempDir(), "public", "badge.json")
+    if err := writeBadgeFile(path, []byte(`{"old":true}`)); err != nil { t.Fatal(err) }
+    if err := writeBadgeFile(path, []byte(`{"schemaVersion":1}`)); err != nil { t.Fatal(err) }
+    data, err := os.ReadFile(path)
+    if err != nil || string(data) != `{"schemaVersion":1}` { t.Fatalf("badge file = %q, %v", data, err) }
+    entries, _ := os.ReadDir(filepath.Dir(path))
+    if len(entries) != 1 { t.Fatalf("temporary files left behind: %v", entries) }
+}
+
+func TestPlanSplit_MovesChecklistItemsToMatchingTitles(t *testing.T) {
+    desc := "Ship search.\n\n## Backend\n- [ ] Add index endpoint\n- [x] Pick a search engine\n\n## Frontend\n- [ ] Search box\n  - [ ] Results page for backend errors\n\n## Docs\n- [ ] Write the docs\n```\n- [ ] not an item\n```"
+    parts, kept := planSplit(desc, []string{"Backend work", "Frontend work"})
+    if len(parts) != 2 || len(parts[0].Items) != 1 || parts[0].Items[0].Text != "Add index endpoint" { t.Fatalf("backend part = %+v", parts) }
+    // Under the Frontend heading but mentioning backend: equally relevant to both, so it stays
+    if len(parts[1].Items) != 1 || parts[1].Items[0].Text != "Search box" { t.Fatalf("frontend part = %+v", parts[1]) }
+    if len(kept) != 2 || kept[0].Text != "Results page for backend errors" || kept[1].Text != "Write the docs" { t.Fatalf("kept = %+v", kept) }
+
+    lines := strings.Split(desc, "\n")
+    if got := splitChildDescription(lines, parts[0], "ENG-40"); got != "- [ ] Add index endpoint\n\nSplit from ENG-40." { t.Fatalf("child description = %q", got) }
+    left := removeChecklistLines(desc, append(parts[0].Items, parts[1].Items...))
+    if strings.Contains(left, "Add index endpoint") || strings.Contains(left, "Search box") || !strings.Contains(left, "- [x] Pick a search engine") || !strings.Contains(left, "Write the docs") {
+        t.Fatalf("remaining description = %q", left)
+    }
+
+    parts, kept = planSplit(desc, nil)
+    if len(parts) != 4 || parts[3].Title != "Write the docs" || len(kept) != 0 { t.Fatalf("checklist split = %+v, kept %+v", parts, kept) }
+}
+
+func TestResolveMentions_LinksKnownUsersAndSkipsCode(t *testing.T) {
+    users := []api.MentionUser{
+        {ID: "u1", Name: "Alice Smith", DisplayName: "alice", Email: "alice@x.io", URL: "https://linear.app/x/profiles/alice"},
+        {ID: "u2", Name: "Bob Jones", DisplayName: "bobby", Email: "bob@x.io", URL: "https://linear.app/x/profiles/bobby"},
+        {ID: "u3", Name: "Sam Lee", DisplayName: "sam", Email: "sam.lee@x.io", URL: "https://linear.app/x/profiles/sam"},
+        {ID: "u4", Name: "Sam Park", DisplayName: "sam", Email: "sam.park@x.io", URL: "https://linear.app/x/profiles/sam2"},
+    }
+    body := "@Alice and @bob, please review. Ping @sam or @nobody.\nMail alice@x.io, `@alice` stays\n```\n@bobby in code\n```\n(@alice)."
+    out, results := resolveMentions(body, users)
+    want := "https://linear.app/x/profiles/alice and https://linear.app/x/profiles/bobby, please review. Ping @sam or @nobody.\nMail alice@x.io, `@alice` stays\n```\n@bobby in code\n```\n(https://linear.app/x/profiles/alice)."
+    if out != want { t.Fatalf("body =\n%s\nwant\n%s", out, want) }
+    if len(results) != 4 { t.Fatalf("results = %+v", results) }
+    if results[1].Handle != "bob" || results[1].User == nil || results[1].User.ID != "u2" { t.Fatalf("email handle = %+v", results[1]) }
+    if results[2].User != nil || !strings.Contains(results[2].Problem, "Sam Park") { t.Fatalf("ambiguous handle = %+v", results[2]) }
+    if results[3].User != nil || results[3].Problem == "" { t.Fatalf("unknown handle = %+v", results[3]) }
+    if hasMentionHandles("mail alice@x.io or `@alice`") { t.Fatal("emails and code spans are not mentions") }
+}
+
+func TestSuggestLabels_ByNameAndKeyword(t *testing.T) {
+    labels := []api.Label{{ID: "l1", Name: "Performance"}, {ID: "l2", Name: "Bug"}, {ID: "l3", Name: "Flaky Test"}, {ID: "l4", Name: "Mobile"}, {ID: "l5", Name: "Billing"}}
+    cfg := &config.Config{LabelKeywords: map[string][]string{"Billing": {"invoice"}}}
+    text := "Checkout timeout on the invoice page\nThe flaky test for checkout also fails. Not debugging mobiles."
+    got := suggestLabels(labels, text, []string{"l2"}, labelKeywords(cfg))
+    var names []string
+    for _, s := range got { names = append(names, describeLabelSuggestion(s)) }
+    if strings.Join(names, ", ") != `Performance ("timeout"), Flaky Test, Billing ("invoice")` { t.Fatalf("suggestions = %v", names) }
+    if got := suggestLabels(labels, "Timeouts everywhere", []string{"l1"}, labelKeywords(nil)); len(got) != 0 { t.Fatalf("a label already set is not suggested: %+v", got) }
+}
+
+func TestOutputTimes_RelativeAndUTC(t *testing.T) {
+    now := time.Date(2026, 3, 2, 14, 5, 0, 0, time.UTC)
+    cases := map[time.Duration]string{
+        20 * time.Second:      "just now",
+        5 * time.Minute:       "5m ago",
+        2 * time.Hour:         "2h ago",
+        3 * 24 * time.Hour:    "3d ago",
+        20 * 24 * time.Hour:   "2w ago",
+        120 * 24 * time.Hour:  "4mo ago",
+        400 * 24 * time.Hour:  "1y ago",
+        -3 * 24 * time.Hour:   "in 3d",
+    }
+    for d, want := range cases {
+        if got := output.Relative(now.Add(-d), now); got != want { t.Fatalf("Relative(-%v) = %q, want %q", d, got, want) }
+    }
+    p := output.Printer{UTC: true}
+    at := time.Date(2026, 3, 2, 16, 5, 0, 0, time.FixedZone("CET", 2*3600))
+    if got := p.When(at, now); got != "2026-03-02 14:05 UTC (just now)" { t.Fatalf("When = %q", got) }
+    if got := p.Time(time.Time{}); got != "-" { t.Fatalf("zero Time = %q", got) }
+    if got := p.When(time.Time{}, now); got != "never" { t.Fatalf("zero When = %q", got) }
+}
+
+func TestCacheWarm_ServesCreateContextUntilExpiry(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    var queries []string
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string }
+        _ = json.Unmarshal(b, &p)
+        queries = append(queries, p.Query)
+        switch {
+        case strings.Contains(p.Query, "__type("):
+            w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"team_1","key":"ENG","name":"Engineering","states":{"nodes":[{"id":"s1","name":"Todo","type":"unstarted"}]},"labels":{"nodes":[{"id":"l1","name":"Bug"}]},"members":{"nodes":[{"id":"u1","name":"Ada","email":"ada@x.io"}]},"templates":{"nodes":[]}}]},"__type":{"inputFields":[{"name":"templateId"}]}}}`))
+        default:
+            w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"team_1","key":"ENG","name":"Engineering"},{"id":"team_2","key":"OPS","name":"Ops"}],"pageInfo":{"hasNextPage":false}}}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+
+    out, _, err := runCLI(t, "--json", "cache", "warm", "--team", "eng")
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    if !strings.Contains(out, `"labels": 1`) || !strings.Contains(out, `"team": "ENG"`) { t.Fatalf("output = %s", out) }
+
+    client := api.NewClient("test")
+    queries = nil
+    rc, err := teamCreateContext(client, "eng")
+    if err != nil || rc == nil || rc.LabelByName("bug") == nil || !rc.SupportsTemplateID { t.Fatalf("warm context = %+v, %v", rc, err) }
+    if team, cached, _ := cachedTeamByKey(client, "OPS"); !cached || team.ID != "team_2" { t.Fatalf("teams cache = %+v cached=%v", team, cached) }
+    if len(queries) != 0 { t.Fatalf("warm entries should answer without requests, sent %d", len(queries)) }
+
+    cache := loadCreateContextCache()
+    e := cache[client.CacheScope()]["ENG"]
+    e.ExpiresAt = time.Now().Add(-time.Minute)
+    cache[client.CacheScope()]["ENG"] = e
+    if err := saveCreateContextCache(cache); err != nil { t.Fatal(err) }
+    if _, err := teamCreateContext(client, "ENG"); err != nil || len(queries) != 1 { t.Fatalf("an expired entry should be refetched: %v, %d requests", err, len(queries)) }
+}
+
+func TestIssueRollup_WalksPagedSubIssueTree(t *testing.T) {
+    // p is the parent; c1 has children over two pages, c2 has none
+    node := func(id, state string, est string, hasChildren bool) string {
+        kids := `[]`
+        if hasChildren { kids = `[{"id":"x"}]` }
+        return `{"id":"` + id + `","identifier":"ENG-` + id + `","title":"` + id + `","estimate":` + est + `,"state":{"name":"` + state + `","type":"` + state + `"},"children":{"nodes":` + kids + `}}`
+    }
+    pages := map[string][]string{
+        "p|":  {`[` + node("c1", "started", "3", true) + `,` + node("c2", "completed", "2", false) + `]`, ""},
+        "c1|": {`[` + node("g1", "completed", "5", false) + `]`, "cur"},
+        "c1|cur": {`[` + node("g2", "canceled", "8", false) + `,` + node("g3", "unstarted", "null", false) + `]`, ""},
+    }
+    var requests int
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        requests++
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        after, _ := p.Variables["after"].(string)
+        page, ok := pages[p.Variables["id"].(string)+"|"+after]
+        if !ok { t.Fatalf("unexpected request for %v", p.Variables) }
+        next := fmt.Sprintf(`{"hasNextPage":%v,"endCursor":%q}`, page[1] != "", page[1])
+        w.Write([]byte(`{"data":{"issue":{"children":{"nodes":` + page[0] + `,"pageInfo":` + next + `}}}}`))
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+
+    below, err := api.NewClient("test").ListIssueDescendants("p")
+    if err != nil { t.Fatal(err) }
+    if len(below) != 5 || requests != 3 { t.Fatalf("got %d issues in %d requests: %+v", len(below), requests, below) }
+    if below[2].ParentID != "c1" || below[2].Depth != 2 { t.Fatalf("grandchild = %+v", below[2]) }
+
+    r := rollupIssues(below)
+    if r != (issueRollup{Issues: 4, Completed: 2, Canceled: 1, Estimate: 10, CompletedEstimate: 7, Unestimated: 1}) { t.Fatalf("rollup = %+v", r) }
+    if got := r.issuesText() + "; " + r.estimateText(); got != "2 of 4 done, 1 canceled; 7 of 10 points done, 1 unestimated" { t.Fatalf("text = %q", got) }
+    if sub := rollupIssues(subtreeOf("c1", below)); sub.Issues != 3 || sub.Completed != 1 || sub.Estimate != 8 { t.Fatalf("c1 subtree = %+v", sub) }
+}
+
+func TestOrgRateLimits_BudgetAndLocalUsage(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    reset := time.Now().Add(20 * time.Minute).Truncate(time.Second)
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("X-RateLimit-Requests-Limit", "1500")
+        w.Header().Set("X-RateLimit-Requests-Remaining", "1400")
+        w.Header().Set("X-RateLimit-Requests-Reset", strconv.FormatInt(reset.UnixMilli(), 10))
+        w.Header().Set("X-RateLimit-Complexity-Limit", "3000000")
+        w.Header().Set("X-RateLimit-Complexity-Remaining", "2000000")
+        w.Header().Set("X-Complexity", "50")
+        w.Write([]byte(`{"data":{"__typename":"Query"}}`))
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+
+    // Two earlier runs this hour: one request retried after a 429, one plain
+    scope := api.NewClient("test").WithEndpoint(srv.URL).CacheScope()
+    now := time.Now()
+    noteUsage(scope, api.RequestStat{Attempts: 2, Status: 429}, now)
+    flushUsage()
+    noteUsage(scope, api.RequestStat{Attempts: 1, Status: 200, Complexity: 150}, now)
+    flushUsage()
+
+    out, _, err := runCLI(t, "--json", "org", "rate-limits")
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    var got struct {
+        RateLimit         api.RateLimit `json:"rateLimit"`
+        Usage             []usageHour   `json:"usage"`
+        AverageComplexity float64       `json:"averageComplexity"`
+    }
+    if err := json.Unmarshal([]byte(out), &got); err != nil { t.Fatalf("bad json: %v\n%s", err, out) }
+    if got.RateLimit.RequestsRemaining != 1400 || got.RateLimit.ComplexityLimit != 3000000 || !got.RateLimit.RequestsReset.Equal(reset) { t.Fatalf("rate limit = %+v", got.RateLimit) }
+    // The check itself is the fourth request of the hour
+    if len(got.Usage) != 1 || got.Usage[0].Requests != 4 || got.Usage[0].Retries != 1 || got.Usage[0].RateLimited != 1 || got.Usage[0].Complexity != 200 { t.Fatalf("usage = %+v", got.Usage) }
+    if got.AverageComplexity != 100 { t.Fatalf("average complexity = %v", got.AverageComplexity) }
+}
+
+func TestTemplatesWhich_ReportsOrderAndShadowing(t *testing.T) {
+    home := t.TempDir()
+    t.Setenv("HOME", home)
+    t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg"))
+    t.Setenv("LINEAR_TEMPLATES_BASE_URL", "")
+    override, envDir := t.TempDir(), t.TempDir()
+    t.Setenv("LINEAR_TEMPLATES_DIR", envDir)
+    for _, d := range []string{override, envDir} {
+        if err := os.WriteFile(filepath.Join(d, "bug.md"), []byte("## Steps\n"), 0o644); err != nil { t.Fatal(err) }
+    }
+
+    res := resolveTemplateSources(nil, "bug", "", "auto", override, "")
+    if res.Used != 0 || res.Candidates[0].Location != filepath.Join(override, "bug.md") { t.Fatalf("resolution = %+v", res) }
+    if len(res.Candidates) != 4 || !res.Candidates[1].Found || res.Candidates[2].Found { t.Fatalf("candidates = %+v", res.Candidates) }
+
+    // Without the override the env dir wins; an unknown name resolves nowhere
+    if res := resolveTemplateSources(nil, "bug", "", "local", "", ""); res.Used != 0 || res.Candidates[0].Location != filepath.Join(envDir, "bug.md") { t.Fatalf("env dir resolution = %+v", res) }
+    if res := resolveTemplateSources(nil, "./missing.md", "", "auto", "", ""); res.Used != -1 || len(res.Candidates) != 1 || res.Candidates[0].Source != "path" { t.Fatalf("path resolution = %+v", res) }
+
+    t.Setenv("LINEAR_API_KEY", "")
+    out, _, err := runCLI(t, "--json", "templates", "which", "bug", "--templates-dir", override)
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    var got templateResolution
+    if err := json.Unmarshal([]byte(out), &got); err != nil || got.Used != 0 || len(got.Candidates) != 4 { t.Fatalf("output (%v):\n%s", err, out) }
+    if res := resolveTemplateSources(nil, "nope", "", "auto", override, ""); res.Used != -1 { t.Fatalf("unknown name resolution = %+v", res) }
+}
+
+func TestActivityFilters_MatchHistoryActors(t *testing.T) {
+    alice, bob := &api.User{ID: "u-alice"}, &api.User{ID: "u-bob"}
+    at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+    done := api.IssueDetails{ID: "i1", StateName: "Done", StateType: "completed"}
+    history := []api.HistoryEntry{
+        {At: at(1), Actor: bob, ToState: "In Progress"},
+        {At: at(2), Actor: alice, ToState: "Done"},
+        {At: at(3), Actor: bob, ToTitle: "Renamed"},
+    }
+
+    if !(actorFilter{CompletedBy: alice}).matches(done, history) { t.Fatal("alice completed the issue") }
+    if (actorFilter{CompletedBy: bob}).matches(done, history) { t.Fatal("bob did not complete the issue") }
+    if !(actorFilter{UpdatedBy: bob}).matches(done, history) || (actorFilter{UpdatedBy: alice}).matches(done, history) { t.Fatal("bob changed the issue last") }
+    if (actorFilter{CompletedBy: alice, UpdatedBy: alice}).matches(done, history) { t.Fatal("both checks must pass") }
+
+    // A completion that was reopened and completed again belongs to the last mover
+    reopened := append(history, api.HistoryEntry{At: at(4), Actor: bob, ToState: "Todo"}, api.HistoryEntry{At: at(5), Actor: bob, ToState: "Done"})
+    if (actorFilter{CompletedBy: alice}).matches(done, reopened) || !(actorFilter{CompletedBy: bob}).matches(done, reopened) { t.Fatal("the last move into Done decides the completer") }
+    if (actorFilter{UpdatedBy: bob}).matches(done, nil) || (actorFilter{}).active() { t.Fatal("no history matches no actor") }
+
+    // A filter that matches nothing stops at the scan cap instead of walking every issue
+    var mu sync.Mutex
+    historyRequests := 0
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        if strings.Contains(string(b), "history(") {
+            mu.Lock()
+            historyRequests++
+            mu.Unlock()
+            w.Write([]byte(`{"data":{"issue":{"history":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
+            return
+        }
+        nodes := make([]string, 100)
+        for i := range nodes { nodes[i] = fmt.Sprintf(`{"id":"i%d","identifier":"ENG-%d","title":"t","state":{"name":"Todo"}}`, i, i) }
+        w.Write([]byte(`{"data":{"issues":{"nodes":[` + strings.Join(nodes, ",") + `],"pageInfo":{"hasNextPage":true,"endCursor":"c"}}}}`))
+    }))
+    defer srv.Close()
+    ui = output.Printer{Quiet: true}
+    t.Cleanup(func() { ui = output.Printer{} })
+    list, err := listIssuesByActor(api.NewClient("k").WithEndpoint(srv.URL), api.IssueListFilter{Limit: 10}, actorFilter{UpdatedBy: bob, MaxScan: 150})
+    if err != nil || len(list.Issues) != 0 || !list.HasMore || historyRequests != 150 { t.Fatalf("scan cap: %+v, %v, %d history requests", list, err, historyRequests) }
+}
+
+func TestTemplateNameFromFile_TitleCasesRunes(t *testing.T) {
+    for in, want := range map[string]string{"bug-report.md": "Bug Report", "éclair_tart.md": "Éclair Tart", "ünit test.md": "Ünit Test"} {
+        if got := templateNameFromFile(in); got != want { t.Fatalf("templateNameFromFile(%q) = %q, want %q", in, got, want) }
+    }
+}
+
+func TestTemplateStore_MigratesAndKeepsParallelCommits(t *testing.T) {
+    dir := t.TempDir()
+    legacy := `{"templates":{"ENG":{"team_id":"t-eng","templates":{"Bug":{"id":"tpl-1","name":"Bug","filename":"bug.md"}}}},"last_sync":"2026-03-01T10:00:00Z"}`
+    if err := os.WriteFile(filepath.Join(dir, templateMetadataFile), []byte(legacy), 0o644); err != nil { t.Fatal(err) }
+
+    // Until the first write, reads come from the legacy file
+    m, err := loadTemplateMetadata(dir)
+    if err != nil || m.SchemaVersion != templateMetadataSchema || m.Templates["ENG"].TeamKey != "ENG" { t.Fatalf("migrated = %+v (%v)", m, err) }
+
+    // Parallel commits of different teams all land on top of the imported file
+    var wg sync.WaitGroup
+    keys := []string{"OPS", "WEB", "API", "DOC", "QA", "SEC"}
+    for _, key := range keys {
+        wg.Add(1)
+        go func(key string) {
+            defer wg.Done()
+            local := &TemplateMetadata{Templates: map[string]TeamTemplates{key: {TeamKey: key, Templates: map[string]TemplateInfo{}}}}
+            if err := saveTemplateTeams(dir, local, []string{key}, false); err != nil { t.Error(err) }
+        }(key)
+    }
+    wg.Wait()
+    m, err = loadTemplateMetadata(dir)
+    if err != nil || len(m.Templates) != len(keys)+1 || m.Templates["ENG"].Templates["Bug"].ID != "tpl-1" || !m.LastSync.Equal(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)) { t.Fatalf("after parallel commits = %+v (%v)", m, err) }
+    if b, err := os.ReadFile(filepath.Join(dir, ".metadata.v0.json")); err != nil || string(b) != legacy { t.Fatalf("legacy backup = %q (%v)", b, err) }
+    if fileExists(filepath.Join(dir, templateMetadataFile)) { t.Fatal("legacy file left in place after the import") }
+
+    // Committing a team it no longer has removes it
+    if err := saveTemplateTeams(dir, &TemplateMetadata{Templates: map[string]TeamTemplates{}}, []string{"OPS"}, false); err != nil { t.Fatal(err) }
+    if m, _ := loadTemplateMetadata(dir); len(m.Templates) != len(keys) { t.Fatalf("OPS not removed: %+v", m.Templates) }
+
+    // A writer holds the store until it closes it
+    db, err := openTemplateStore(dir, false)
+    if err != nil { t.Fatalf("open: %v", err) }
+    old := templateLockWait
+    templateLockWait = 100 * time.Millisecond
+    t.Cleanup(func() { templateLockWait = old })
+    if err := saveTemplateTeams(dir, m, []string{"ENG"}, false); err == nil { t.Fatal("a held store was written twice") }
+    db.Close()
+    if err := saveTemplateTeams(dir, m, []string{"ENG"}, false); err != nil { t.Fatalf("released store not written: %v", err) }
+
+    // A newer schema is readable but never written
+    db, err = openTemplateStore(dir, false)
+    if err != nil { t.Fatal(err) }
+    if err := db.Update(func(tx *bolt.Tx) error { return tx.Bucket(templateMetaBucket).Put([]byte("schema_version"), []byte("99")) }); err != nil { t.Fatal(err) }
+    db.Close()
+    if _, err := loadTemplateMetadata(dir); err != nil { t.Fatalf("newer schema unreadable: %v", err) }
+    if err := saveTemplateTeams(dir, m, []string{"ENG"}, true); err == nil || !strings.Contains(err.Error(), "newer") { t.Fatalf("newer schema written: %v", err) }
+}
+
+func TestTemplateStore_CorruptLegacyFileIsNotReplaced(t *testing.T) {
+    dir := t.TempDir()
+    corrupt := `{"templates":{"ENG":`
+    path := filepath.Join(dir, templateMetadataFile)
+    if err := os.WriteFile(path, []byte(corrupt), 0o644); err != nil { t.Fatal(err) }
+    local := &TemplateMetadata{Templates: map[string]TeamTemplates{"OPS": {TeamKey: "OPS", Templates: map[string]TemplateInfo{}}}}
+    if err := saveTemplateTeams(dir, local, []string{"OPS"}, false); err == nil || !strings.Contains(err.Error(), "not valid") { t.Fatalf("corrupt metadata replaced: %v", err) }
+    if b, _ := os.ReadFile(path); string(b) != corrupt { t.Fatalf("corrupt file changed: %s", b) }
+    if m, err := loadTemplateMetadata(dir); err == nil { t.Fatalf("corrupt metadata read as %+v", m) }
+}
+
+func TestIssuesCreate_DefaultPathAppliesTemplateFrontMatter(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    dir := t.TempDir()
+    tpl := "---\nlabels:\n  - Bug\npriority: high\nestimate: 3\n---\n## Steps\n"
+    if err := os.WriteFile(filepath.Join(dir, "bug.md"), []byte(tpl), 0o644); err != nil { t.Fatal(err) }
+    var input map[string]any
+    var queries []string
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string; Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        queries = append(queries, p.Query)
+        switch {
+        case strings.Contains(p.Query, "issueCreate"):
+            input, _ = p.Variables["input"].(map[string]any)
+            w.Write([]byte(`{"data":{"issueCreate":{"success":true,"issue":{"id":"i1","identifier":"ENG-1","title":"Crash","url":"https://linear.app/x/ENG-1"}}}}`))
+        case strings.Contains(p.Query, "__type("):
+            w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"team_1","key":"ENG","name":"Engineering","states":{"nodes":[{"id":"s1","name":"Todo","type":"unstarted"}]},"labels":{"nodes":[{"id":"l-bug","name":"Bug"}]},"members":{"nodes":[]},"templates":{"nodes":[]}}]},"__type":{"inputFields":[{"name":"templateId"}]}}}`))
+        default:
+            w.Write([]byte(`{"data":{}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+    // Prompts read an empty stdin and take their defaults
+    stdin, err := os.Open(os.DevNull)
+    if err != nil { t.Fatal(err) }
+    oldIn := os.Stdin
+    os.Stdin = stdin
+    t.Cleanup(func() { os.Stdin = oldIn; stdin.Close() })
+
+    // Neither --interactive nor --no-interactive: the default, interactive path
+    out, _, err := runCLI(t, "--json", "issues", "create", "--team", "ENG", "--template", "bug", "--templates-dir", dir, "--title", "Crash")
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    if input == nil { t.Fatalf("no issue created; queries:\n%s\noutput: %s", strings.Join(queries, "\n---\n"), out) }
+    labels, _ := input["labelIds"].([]any)
+    if len(labels) != 1 || labels[0] != "l-bug" || input["priority"] != float64(2) || input["estimate"] != float64(3) { t.Fatalf("create input = %v", input) }
+}
+
+func TestIssuesCreate_IdempotencyMarkerSentWithCreate(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    var creates, updates int
+    var sent string
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        var p struct{ Query string; Variables map[string]any }
+        _ = json.Unmarshal(b, &p)
+        issue := `{"id":"i1","identifier":"ENG-1","title":"Crash","url":"U","description":` + strconv.Quote(sent) + `,"state":{"name":"Todo"},"labels":{"nodes":[]}}`
+        switch {
+        case strings.Contains(p.Query, "issueCreate"):
+            creates++
+            in, _ := p.Variables["input"].(map[string]any)
+            sent, _ = in["description"].(string)
+            w.Write([]byte(`{"data":{"issueCreate":{"success":true,"issue":{"id":"i1","identifier":"ENG-1","title":"Crash","url":"U"}}}}`))
+        case strings.Contains(p.Query, "issueUpdate"):
+            updates++
+            w.Write([]byte(`{"data":{"issueUpdate":{"success":true,"issue":` + issue + `}}}`))
+        case strings.Contains(p.Query, "__type("):
+            w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"team_1","key":"ENG","name":"Engineering","states":{"nodes":[{"id":"s1","name":"Todo","type":"unstarted"}]},"labels":{"nodes":[]},"members":{"nodes":[]},"templates":{"nodes":[]}}]},"__type":{"inputFields":[{"name":"templateId"}]}}}`))
+        case strings.Contains(p.Query, "issues("):
+            // The search finds the issue only when the create carried the marker
+            text, _ := p.Variables["text"].(string)
+            if sent != "" && strings.Contains(sent, text) {
+                w.Write([]byte(`{"data":{"issues":{"nodes":[{"id":"i1"}]}}}`))
+                return
+            }
+            w.Write([]byte(`{"data":{"issues":{"nodes":[]}}}`))
+        default:
+            w.Write([]byte(`{"data":{"issue":` + issue + `}}`))
+        }
+    }))
+    defer srv.Close()
+    t.Setenv("LINEAR_API_KEY", "test")
+    t.Setenv("LINEAR_API_ENDPOINT", srv.URL)
+
+    args := []string{"--json", "issues", "create", "--no-interactive", "--team", "ENG", "--title", "Crash", "--description", "Boom", "--idempotency-key", "job-9"}
+    if _, _, err := runCLI(t, args...); err != nil { t.Fatalf("cli error: %v", err) }
+    if creates != 1 || updates != 0 || sent != "Boom\n\n"+idempotencyMarker("job-9") { t.Fatalf("creates=%d updates=%d description=%q", creates, updates, sent) }
+
+    // As if the response had been lost: no local record, only the issue on the server
+    p, _ := idempotencyPath()
+    if err := os.Remove(p); err != nil { t.Fatal(err) }
+    out, _, err := runCLI(t, args...)
+    if err != nil { t.Fatalf("cli error: %v", err) }
+    if creates != 1 || !strings.Contains(out, "ENG-1") { t.Fatalf("retry created another issue (%d creates): %s", creates, out) }
+}
+
+func TestResolveCreateTargets_ProjectAndTeamContextInFlightTogether(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    // Each request waits for the other, so a sequential resolver times out
+    var arrived sync.WaitGroup
+    arrived.Add(2)
+    both := make(chan struct{})
+    go func() { arrived.Wait(); close(both) }()
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        b, _ := io.ReadAll(r.Body)
+        q := string(b)
+        if strings.Contains(q, "project(") || strings.Contains(q, "teams(") { arrived.Done() }
+        select {
+        case <-both:
+        case <-time.After(2 * time.Second):
+            w.Write([]byte(`{"errors":[{"message":"requests were not in flight together"}]}`))
+            return
+        }
+        switch {
+        case strings.Contains(q, "project("):
+            w.Write([]byte(`{"data":{"project":{"id":"p1","name":"Apollo","state":"started","team":{"id":"team_1"}}}}`))
+        default:
+            w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"team_1","key":"ENG","name":"Engineering","states":{"nodes":[]},"labels":{"nodes":[]},"members":{"nodes":[]},"templates":{"nodes":[]}}]}}}`))
+        }
+    }))
+    defer srv.Close()
+    client := api.NewClient("k").WithEndpoint(srv.URL)
+    pr, rc, err := resolveCreateTargets(context.Background(), client, "Apollo", "ENG")
+    if err != nil { t.Fatal(err) }
+    if pr == nil || pr.ID != "p1" || rc == nil || rc.Team.ID != "team_1" { t.Fatalf("project %+v context %+v", pr, rc) }
+}
+
+func TestAmendCommitWithKey_RefusesPushedCommitUnlessForced(t *testing.T) {
+    git := gitTestRunner(t)
+    root := t.TempDir()
+    remote, repo := filepath.Join(root, "remote.git"), filepath.Join(root, "repo")
+    git(root, "init", "--quiet", "--bare", remote)
+    git(root, "clone", "--quiet", remote, repo)
+    git(repo, "commit", "--quiet", "--allow-empty", "-m", "Fix crash")
+    git(repo, "push", "--quiet", "origin", "HEAD")
+    sha := git(repo, "rev-parse", "HEAD")
+    t.Chdir(repo)
+
+    cmd := &cobra.Command{}
+    cmd.Flags().Bool("amend-commit", true, "")
+    cmd.Flags().Bool("force-amend", false, "")
+    cmd.Flags().String("from-commit", sha, "")
+    created := &api.IssueDetails{ID: "i1", Identifier: "ENG-7"}
+    err := amendCommitWithKey(cmd, nil, created)
+    if err == nil || !strings.Contains(err.Error(), "Refs: ENG-7") { t.Fatalf("pushed commit amended: %v", err) }
+    if head := git(repo, "rev-parse", "HEAD"); head != sha { t.Fatalf("HEAD rewritten to %s", head) }
+
+    _ = cmd.Flags().Set("force-amend", "true")
+    if err := amendCommitWithKey(cmd, nil, created); err != nil { t.Fatal(err) }
+    if msg := git(repo, "log", "-1", "--format=%B"); !strings.HasSuffix(msg, "Refs: ENG-7") { t.Fatalf("message = %q", msg) }
+}
+
+// gitTestRunner skips t without git, and otherwise returns a function that
+// runs git in dir with a fixed identity and returns its trimmed output
+func gitTestRunner(t *testing.T) func(dir string, args ...string) string {
+    t.Helper()
+    if _, err := exec.LookPath("git"); err != nil { t.Skip("git not installed") }
+    for k, v := range map[string]string{"GIT_AUTHOR_NAME": "t", "GIT_AUTHOR_EMAIL": "t@example.com", "GIT_COMMITTER_NAME": "t", "GIT_COMMITTER_EMAIL": "t@example.com"} { t.Setenv(k, v) }
+    return func(dir string, args ...string) string {
+        t.Helper()
+        c := exec.Command("git", args...)
+        c.Dir = dir
+        out, err := c.CombinedOutput()
+        if err != nil { t.Fatalf("git %v: %v\n%s", args, err, out) }
+        return strings.TrimSpace(string(out))
+    }
+}
+
+func TestGitChangedFiles_KeepsPathsWithSpaces(t *testing.T) {
+    git := gitTestRunner(t)
+    repo := t.TempDir()
+    git(repo, "init", "--quiet")
+    git(repo, "commit", "--quiet", "--allow-empty", "-m", "base")
+    base := git(repo, "rev-parse", "HEAD")
+    for _, name := range []string{"docs/User Guide.md", "src/main.go"} {
+        if err := os.MkdirAll(filepath.Join(repo, filepath.Dir(name)), 0o755); err != nil { t.Fatal(err) }
+        if err := os.WriteFile(filepath.Join(repo, name), []byte("x"), 0o644); err != nil { t.Fatal(err) }
+    }
+    git(repo, "add", "-A")
+    git(repo, "commit", "--quiet", "-m", "change")
+    t.Chdir(repo)
+    got, err := gitChangedFiles(base)
+    if err != nil { t.Fatal(err) }
+    if strings.Join(got, "|") != "docs/User Guide.md|src/main.go" { t.Fatalf("changed files = %q", got) }
+}
+
+func TestUpdateRecurring_RunKeepsConcurrentAddsAndRemoves(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    add := func(id string) {
+        if err := updateRecurring(func(items []RecurringSchedule) ([]RecurringSchedule, error) { return append(items, RecurringSchedule{ID: id}), nil }); err != nil { t.Fatal(err) }
+    }
+    add("a")
+    add("b")
+    // A run read both, then "b" was removed and "c" added before it saved
+    ran, _ := loadRecurring()
+    ran[0].LastRun, ran[0].LastIssue = time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC), "ENG-1"
+    ran[1].LastRun = ran[0].LastRun
+    if err := updateRecurring(func(items []RecurringSchedule) ([]RecurringSchedule, error) { return items[:1], nil }); err != nil { t.Fatal(err) }
+    add("c")
+    var wg sync.WaitGroup
+    for _, r := range ran {
+        wg.Add(1)
+        go func(r RecurringSchedule) {
+            defer wg.Done()
+            if err := updateRecurring(func(items []RecurringSchedule) ([]RecurringSchedule, error) { settleRecurring(items, r); return items, nil }); err != nil { t.Error(err) }
+        }(r)
+    }
+    wg.Wait()
+    items, err := loadRecurring()
+    if err != nil { t.Fatal(err) }
+    if len(items) != 2 || items[0].ID != "a" || items[0].LastIssue != "ENG-1" || items[1].ID != "c" || !items[1].LastRun.IsZero() { t.Fatalf("schedules = %+v", items) }
+}
+
+func TestUpdateReminders_ParallelWritesKeepEachOther(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    past := time.Now().Add(-time.Hour)
+    var wg sync.WaitGroup
+    for i := 0; i < 8; i++ {
+        wg.Add(1)
+        go func(i int) {
+            defer wg.Done()
+            err := updateReminders(func(items []Reminder) ([]Reminder, error) {
+                return append(items, Reminder{IssueID: fmt.Sprintf("i%d", i), IssueKey: fmt.Sprintf("ENG-%d", i), Until: past}), nil
+            })
+            if err != nil { t.Error(err) }
+        }(i)
+    }
+    wg.Wait()
+    items, err := loadReminders()
+    if err != nil || len(items) != 8 || !hasUnnotifiedDue(items, time.Now()) { t.Fatalf("reminders = %+v (%v)", items, err) }
+}
+
+func TestRecordTokenAccess_ParallelRunsKeepEachOther(t *testing.T) {
+    t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+    var wg sync.WaitGroup
+    for i := 0; i < 8; i++ {
+        wg.Add(1)
+        go func(i int) {
+            defer wg.Done()
+            recordTokenAccess(fmt.Sprintf("scope-%d", i), i%2 == 0)
+        }(i)
+    }
+    wg.Wait()
+    all := loadTokenAccess()
+    if len(all) != 8 || !all["scope-0"].Write || all["scope-1"].Write { t.Fatalf("token access = %+v", all) }
+}
@@ -0,0 +1,315 @@
+package cmd
+
+import (
+    "bufio"
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "os/exec"
+    "path/filepath"
+    "regexp"
+    "sort"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Assigning issues from CODEOWNERS. The files an issue is about are matched
+// against the repository's CODEOWNERS the way GitHub does (gitignore-style
+// patterns, the last matching rule wins), the owner of most of them is picked,
+// and its GitHub handle is turned into a Linear user through [github_users] in
+// config.toml or --map. Emails in CODEOWNERS can be looked up directly.
+
+var issuesAssignFromCodeownersCmd = &cobra.Command{
+    Use:   "assign-from-codeowners <issue-key> [path]...",
+    Short: "Assign an issue to the code owner of the files it is about",
+    Long: `Assign an issue to whoever owns the given files according to the repository's
+CODEOWNERS (.github/CODEOWNERS, CODEOWNERS or docs/CODEOWNERS at the top of the
+git repository, or --codeowners). Files come from the arguments, --files-from
+(one path per line, '-' for stdin) or --changed <base> (the files changed since
+base, as in a pull request). The owner of the most files wins; ties go to the
+owner listed first.
+
+GitHub handles become Linear users through the [github_users] table of
+config.toml, or --map for a single run:
+
+  [github_users]
+  "@octocat" = "ada@example.com"
+  "@acme/payments" = "Grace Hopper"
+
+Owners given as an email are looked up in Linear directly. An issue that
+already has an assignee is left alone unless --force is given.`,
+    Example: `  linear-cli issues assign-from-codeowners ENG-12 internal/billing/invoice.go
+  git diff --name-only main | linear-cli issues assign-from-codeowners ENG-12 --files-from -
+  linear-cli issues assign-from-codeowners ENG-12 --changed origin/main --map @octocat=ada@example.com`,
+    Args: cobra.MinimumNArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        ownersPath, _ := cmd.Flags().GetString("codeowners")
+        filesFrom, _ := cmd.Flags().GetString("files-from")
+        changed, _ := cmd.Flags().GetString("changed")
+        mapFlags, _ := cmd.Flags().GetStringArray("map")
+        force, _ := cmd.Flags().GetBool("force")
+
+        paths := append([]string{}, args[1:]...)
+        if filesFrom != "" {
+            more, err := readPathList(filesFrom)
+            if err != nil { return err }
+            paths = append(paths, more...)
+        }
+        if changed != "" {
+            more, err := gitChangedFiles(changed)
+            if err != nil { return err }
+            paths = append(paths, more...)
+        }
+        if len(paths) == 0 { return errors.New("no files: pass paths, --files-from or --changed <base>") }
+
+        handles := map[string]string{}
+        for k, v := range cfg.GitHubUsers { handles[strings.ToLower(k)] = v }
+        for _, m := range mapFlags {
+            handle, user, ok := strings.Cut(m, "=")
+            if !ok || strings.TrimSpace(handle) == "" || strings.TrimSpace(user) == "" { return fmt.Errorf("invalid --map '%s': use @handle=user", m) }
+            handles[strings.ToLower(strings.TrimSpace(handle))] = strings.TrimSpace(user)
+        }
+
+        if ownersPath == "" {
+            found, err := findCodeownersFile()
+            if err != nil { return err }
+            ownersPath = found
+        }
+        f, err := os.Open(expandUserPath(ownersPath))
+        if err != nil { return err }
+        defer f.Close()
+        rules, err := parseCodeowners(f)
+        if err != nil { return fmt.Errorf("%s: %w", ownersPath, err) }
+
+        ranked, unowned := rankCodeowners(rules, paths)
+        if len(ranked) == 0 { return fmt.Errorf("no owner in %s for any of the %d file(s)", ownersPath, len(paths)) }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+        det, err := client.GetIssueDetails(iss.ID)
+        if err != nil { return err }
+        if det == nil { return fmt.Errorf("issue %s not found", args[0]) }
+
+        // The first owner, by files owned, that maps to a Linear user
+        var owner *codeownersCount
+        var user *api.User
+        var unmapped []string
+        for i := range ranked {
+            u, err := codeownerUser(client, handles, ranked[i].Owner)
+            if err != nil { return err }
+            if u == nil { unmapped = append(unmapped, ranked[i].Owner); continue }
+            owner, user = &ranked[i], u
+            break
+        }
+        if len(unmapped) > 0 { ui.Warnf("no Linear user for %s; add them to [github_users] in config.toml or pass --map", strings.Join(unmapped, ", ")) }
+        if user == nil { return fmt.Errorf("none of the owners (%s) maps to a Linear user", strings.Join(unmapped, ", ")) }
+
+        res := codeownersResult{Issue: det.Identifier, Owner: owner.Owner, Files: owner.Files, Total: len(paths), Owners: ranked, Unowned: unowned, Assignee: user}
+        switch {
+        case det.Assignee != nil && det.Assignee.ID == user.ID:
+            res.Status = "unchanged"
+        case det.Assignee != nil && !force:
+            res.Status, res.Assignee = "kept", det.Assignee
+        default:
+            _, err = client.UpdateIssueAdvanced(det.ID, api.IssueUpdateInput{AssigneeID: user.ID})
+            if errors.Is(err, api.ErrDryRun) { return nil }
+            if err != nil { return err }
+            res.Status = "assigned"
+        }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(res) }
+        why := fmt.Sprintf("%s owns %d of %d file(s)", owner.Owner, owner.Files, len(paths))
+        switch res.Status {
+        case "assigned":
+            fmt.Printf("Assigned %s to %s (%s)\n", det.Identifier, user.Name, why)
+        case "unchanged":
+            fmt.Printf("%s is already assigned to %s (%s)\n", det.Identifier, user.Name, why)
+        case "kept":
+            fmt.Printf("%s is assigned to %s; not reassigning to %s without --force (%s)\n", det.Identifier, det.Assignee.Name, user.Name, why)
+        }
+        if len(unowned) > 0 { ui.Infof("No owner for: %s", strings.Join(unowned, ", ")) }
+        return nil
+    },
+}
+
+// codeownersRule is one CODEOWNERS line; no owners means the files are unowned
+type codeownersRule struct {
+    Pattern string
+    Owners  []string
+    Line    int
+    re      *regexp.Regexp
+}
+
+// codeownersCount is how many of the files an owner owns
+type codeownersCount struct {
+    Owner string `json:"owner"`
+    Files int    `json:"files"`
+}
+
+type codeownersResult struct {
+    Issue    string            `json:"issue"`
+    Status   string            `json:"status"` // assigned, unchanged or kept
+    Assignee *api.User         `json:"assignee"`
+    Owner    string            `json:"owner"`
+    Files    int               `json:"files"`
+    Total    int               `json:"total"`
+    Owners   []codeownersCount `json:"owners"`
+    Unowned  []string          `json:"unowned,omitempty"`
+}
+
+// parseCodeowners reads a CODEOWNERS file
+func parseCodeowners(r io.Reader) ([]codeownersRule, error) {
+    var rules []codeownersRule
+    sc := bufio.NewScanner(r)
+    n := 0
+    for sc.Scan() {
+        n++
+        line := sc.Text()
+        if i := strings.Index(line, " #"); i >= 0 { line = line[:i] }
+        fields := strings.Fields(line)
+        if len(fields) == 0 || strings.HasPrefix(fields[0], "#") { continue }
+        re, err := codeownersPattern(fields[0])
+        if err != nil { return nil, fmt.Errorf("line %d: %w", n, err) }
+        rules = append(rules, codeownersRule{Pattern: fields[0], Owners: fields[1:], Line: n, re: re})
+    }
+    return rules, sc.Err()
+}
+
+// codeownersPattern compiles a gitignore-style pattern. A pattern with a slash
+// other than a trailing one is relative to the repository root, others match
+// at any depth; a match also covers everything below it, except for a final
+// "/*", which only matches a directory's direct entries.
+func codeownersPattern(pattern string) (*regexp.Regexp, error) {
+    p := strings.TrimSuffix(pattern, "/")
+    anchored := strings.Contains(p, "/")
+    p = strings.TrimPrefix(p, "/")
+    if p == "" { return nil, fmt.Errorf("invalid pattern '%s'", pattern) }
+    var b strings.Builder
+    if anchored { b.WriteString("^") } else { b.WriteString("^(?:.*/)?") }
+    for i := 0; i < len(p); i++ {
+        switch {
+        case strings.HasPrefix(p[i:], "**/"):
+            b.WriteString("(?:.*/)?")
+            i += 2
+        case strings.HasPrefix(p[i:], "**"):
+            b.WriteString(".*")
+            i++
+        case p[i] == '*':
+            b.WriteString("[^/]*")
+        case p[i] == '?':
+            b.WriteString("[^/]")
+        default:
+            b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+        }
+    }
+    if !strings.HasSuffix(p, "/*") || strings.HasSuffix(p, "**/*") { b.WriteString("(?:/.*)?") }
+    b.WriteString("$")
+    return regexp.Compile(b.String())
+}
+
+// codeownersFor returns the rule that decides who owns path: the last match
+func codeownersFor(rules []codeownersRule, path string) *codeownersRule {
+    path = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "./")
+    for i := len(rules) - 1; i >= 0; i-- {
+        if rules[i].re.MatchString(path) { return &rules[i] }
+    }
+    return nil
+}
+
+// rankCodeowners counts the files each owner owns, most first and ties in
+// the order owners first appear, and lists the files nobody owns
+func rankCodeowners(rules []codeownersRule, paths []string) ([]codeownersCount, []string) {
+    var ranked []codeownersCount
+    index := map[string]int{}
+    var unowned []string
+    for _, p := range paths {
+        r := codeownersFor(rules, p)
+        if r == nil || len(r.Owners) == 0 { unowned = append(unowned, p); continue }
+        for _, o := range r.Owners {
+            k := strings.ToLower(o)
+            i, ok := index[k]
+            if !ok {
+                i = len(ranked)
+                index[k] = i
+                ranked = append(ranked, codeownersCount{Owner: o})
+            }
+            ranked[i].Files++
+        }
+    }
+    sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Files > ranked[j].Files })
+    return ranked, unowned
+}
+
+// codeownerUser finds the Linear user for a CODEOWNERS owner: the mapping
+// first, then an email owner as is; nil when neither applies
+func codeownerUser(client *api.Client, handles map[string]string, owner string) (*api.User, error) {
+    who := handles[strings.ToLower(owner)]
+    if who == "" { who = handles[strings.ToLower(strings.TrimPrefix(owner, "@"))] }
+    if who == "" && !strings.HasPrefix(owner, "@") && strings.Contains(owner, "@") { who = owner }
+    if who == "" { return nil, nil }
+    u, err := resolveUserOrMe(client, who)
+    if err != nil { return nil, fmt.Errorf("%s: %w", owner, err) }
+    return u, nil
+}
+
+// findCodeownersFile looks where GitHub does, from the top of the git
+// repository (or the working directory outside one)
+func findCodeownersFile() (string, error) {
+    root := "."
+    if out, err := exec.Command("git", "rev-parse", "--show-toplevel").Output(); err == nil { root = strings.TrimSpace(string(out)) }
+    for _, rel := range []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"} {
+        p := filepath.Join(root, rel)
+        if fileExists(p) { return p, nil }
+    }
+    return "", errors.New("no CODEOWNERS file in .github/, the repository root or docs/ (use --codeowners)")
+}
+
+// gitChangedFiles lists the files changed between base and HEAD. Paths are
+// NUL-separated, so names with spaces or newlines come through whole.
+func gitChangedFiles(base string) ([]string, error) {
+    out, err := exec.Command("git", "diff", "-z", "--name-only", base+"...HEAD", "--").Output()
+    if err != nil { return nil, fmt.Errorf("cannot list files changed since '%s' (not a git repository, or unknown revision)", base) }
+    var paths []string
+    for _, p := range strings.Split(string(out), "\x00") {
+        if p != "" { paths = append(paths, p) }
+    }
+    return paths, nil
+}
+
+// readPathList reads one path per line from file, or stdin for "-"
+func readPathList(file string) ([]string, error) {
+    var r io.Reader = os.Stdin
+    if file == "-" {
+        noteStdinRead()
+    } else {
+        f, err := os.Open(expandUserPath(file))
+        if err != nil { return nil, err }
+        defer f.Close()
+        r = f
+    }
+    var out []string
+    sc := bufio.NewScanner(r)
+    for sc.Scan() {
+        if line := strings.TrimSpace(sc.Text()); line != "" { out = append(out, line) }
+    }
+    return out, sc.Err()
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesAssignFromCodeownersCmd)
+    f := issuesAssignFromCodeownersCmd.Flags()
+    f.String("codeowners", "", "CODEOWNERS file (default: .github/CODEOWNERS, CODEOWNERS or docs/CODEOWNERS in the repository)")
+    f.String("files-from", "", "Read paths from this file, one per line ('-' for stdin)")
+    f.String("changed", "", "Use the files changed between this base revision and HEAD, e.g. origin/main")
+    f.StringArray("map", nil, "Map a GitHub handle to a Linear user for this run, e.g. @octocat=ada@example.com (repeatable)")
+    f.Bool("force", false, "Reassign an issue that already has an assignee")
+}
@@ -3,9 +3,12 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"linear-cli/internal/api"
 	"linear-cli/internal/config"
@@ -15,17 +18,22 @@ import (
 
 var commentCmd = &cobra.Command{
 	Use:   "comment",
-	Short: "Write a comment on an issue",
+	Short: "Write, edit, react to or resolve comments on an issue",
 	RunE: func(cmd *cobra.Command, args []string) error { return cmd.Help() },
 }
 
 var commentCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a comment on an issue",
+	Long: `Create a comment on an issue. @handles in the body (display name, email
+name or full name without spaces) become mentions, so the people named are
+notified; handles that match nobody or several people are left as typed with a
+warning. --no-mentions sends the body as typed.`,
+	Example: `  linear-cli comment create --key ENG-12 --body "@ada can you take a look?"`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, _ := config.Load()
 		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
-		client := api.NewClient(cfg.APIKey)
+		client := newAPIClient(cmd, cfg.APIKey)
 
 		issueID, _ := cmd.Flags().GetString("id")
 		issueKey, _ := cmd.Flags().GetString("key")
@@ -50,6 +58,9 @@ var commentCreateCmd = &cobra.Command{
 			issueID = iss.ID
 		}
 
+		noMentions, _ := cmd.Flags().GetBool("no-mentions")
+		body, err := mentionBody(client, body, noMentions)
+		if err != nil { return err }
 		res, err := client.CreateComment(issueID, body)
 		if err != nil { return err }
 		p := printer(cmd)
@@ -61,10 +72,266 @@ var commentCreateCmd = &cobra.Command{
 	},
 }
 
+var commentReactCmd = &cobra.Command{
+	Use:   "react <comment-id>",
+	Short: "Add an emoji reaction to a comment",
+	Example: `  linear-cli comment react 3f1c2d4e-... --emoji 👍
+  linear-cli comment react 3f1c2d4e-... --emoji :tada:`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, _ := config.Load()
+		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+		emoji, _ := cmd.Flags().GetString("emoji")
+		// Shortcodes are sent without their surrounding colons
+		emoji = strings.Trim(strings.TrimSpace(emoji), ":")
+		if emoji == "" { return errors.New("--emoji is required") }
+		client := newAPIClient(cmd, cfg.APIKey)
+		r, err := client.ReactToComment(strings.TrimSpace(args[0]), emoji)
+		if err != nil { return err }
+		p := printer(cmd)
+		if p.JSONEnabled() { return p.PrintJSON(r) }
+		fmt.Printf("Reacted %s to comment %s\n", r.Emoji, args[0])
+		return nil
+	},
+}
+
+var issuesCommentsCmd = &cobra.Command{
+	Use:   "comments <issue-key>",
+	Short: "List comments on an issue with author and timestamp",
+	Example: `  linear-cli issues comments ENG-42
+  linear-cli issues comments ENG-42 --since 2024-01-01
+  linear-cli issues comments ENG-42 --since 7d --limit 0`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, _ := config.Load()
+		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+		sinceFlag, _ := cmd.Flags().GetString("since")
+		limit, _ := cmd.Flags().GetInt("limit")
+		var since time.Time
+		if strings.TrimSpace(sinceFlag) != "" {
+			t, err := parseSince(sinceFlag, time.Now())
+			if err != nil { return err }
+			since = t
+		}
+		client := newAPIClient(cmd, cfg.APIKey)
+		iss, err := resolveIssue(client, args[0])
+		if err != nil { return err }
+		comments, err := client.ListIssueComments(iss.ID, since, limit)
+		if err != nil { return err }
+		p := printer(cmd)
+		if p.JSONEnabled() { return p.PrintJSON(map[string]any{"issue": iss.Identifier, "comments": comments}) }
+		if len(comments) == 0 {
+			fmt.Printf("No comments on %s\n", iss.Identifier)
+			return nil
+		}
+		printComments(comments)
+		return nil
+	},
+}
+
+var commentResolveCmd = &cobra.Command{
+	Use:   "resolve <comment-id>",
+	Short: "Resolve the comment thread started by a comment",
+	Long: `Mark a comment thread as resolved, as in Linear's UI. Threads are resolved
+through their first comment; the ids are shown by 'issues comments'.`,
+	Example: `  linear-cli comment resolve 3f1c2d4e-...`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error { return setCommentResolved(cmd, args[0], true) },
+}
+
+var commentUnresolveCmd = &cobra.Command{
+	Use:   "unresolve <comment-id>",
+	Short: "Reopen a resolved comment thread",
+	Args:  cobra.ExactArgs(1),
+	RunE:  func(cmd *cobra.Command, args []string) error { return setCommentResolved(cmd, args[0], false) },
+}
+
+func setCommentResolved(cmd *cobra.Command, id string, resolve bool) error {
+	cfg, _ := config.Load()
+	if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+	client := newAPIClient(cmd, cfg.APIKey)
+	c, err := client.ResolveComment(strings.TrimSpace(id), resolve)
+	if err != nil { return err }
+	p := printer(cmd)
+	if p.JSONEnabled() { return p.PrintJSON(c) }
+	if resolve {
+		fmt.Printf("Resolved thread %s\n", c.ID)
+	} else {
+		fmt.Printf("Reopened thread %s\n", c.ID)
+	}
+	return nil
+}
+
+var commentEditCmd = &cobra.Command{
+	Use:   "edit <comment-id>",
+	Short: "Replace the body of one of your comments",
+	Long: `Replace the body of a comment you wrote, from --body or a markdown file
+(--body-file, '-' for stdin). Comment ids are shown by 'issues comments'.
+@handles become mentions as in 'comment create'.`,
+	Example: `  linear-cli comment edit 3f1c2d4e-... --body-file reply.md
+  linear-cli comment edit 3f1c2d4e-... --body "Fixed in ENG-12"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, _ := config.Load()
+		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+		body, err := commentBodyFlags(cmd)
+		if err != nil { return err }
+		client := newAPIClient(cmd, cfg.APIKey)
+		id := strings.TrimSpace(args[0])
+		cm, err := client.GetComment(id)
+		if err != nil { return err }
+		if cm == nil { return fmt.Errorf("comment %s not found", id) }
+		if !cm.Mine { return fmt.Errorf("comment %s on %s was not written by you; only your own comments can be edited", id, cm.IssueKey) }
+		noMentions, _ := cmd.Flags().GetBool("no-mentions")
+		body, err = mentionBody(client, body, noMentions)
+		if err != nil { return err }
+		updated, err := client.UpdateComment(id, body)
+		if errors.Is(err, api.ErrDryRun) { return nil }
+		if err != nil { return err }
+		p := printer(cmd)
+		if p.JSONEnabled() { return p.PrintJSON(updated) }
+		fmt.Printf("Updated comment %s on %s\n", updated.ID, cm.IssueKey)
+		return nil
+	},
+}
+
+var commentDeleteCmd = &cobra.Command{
+	Use:   "delete <comment-id> --confirm",
+	Short: "Delete one of your comments",
+	Long: `Delete a comment you wrote. This cannot be undone, so --confirm is required;
+without it the comment is shown and nothing is deleted. Comments by anyone
+else are refused before anything is sent. This is the only delete linear-cli
+performs.`,
+	Example: `  linear-cli comment delete 3f1c2d4e-... --confirm`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, _ := config.Load()
+		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+		confirm, _ := cmd.Flags().GetBool("confirm")
+		client := newAPIClient(cmd, cfg.APIKey)
+		id := strings.TrimSpace(args[0])
+		cm, err := client.GetComment(id)
+		if err != nil { return err }
+		if cm == nil { return fmt.Errorf("comment %s not found", id) }
+		if !cm.Mine { return fmt.Errorf("comment %s on %s was not written by you; only your own comments can be deleted", id, cm.IssueKey) }
+		if !confirm {
+			printComments([]api.Comment{cm.Comment})
+			return fmt.Errorf("not deleting this comment on %s: pass --confirm to delete it", cm.IssueKey)
+		}
+		err = client.DeleteOwnComment(id)
+		if errors.Is(err, api.ErrDryRun) { return nil }
+		if err != nil { return err }
+		p := printer(cmd)
+		if p.JSONEnabled() { return p.PrintJSON(map[string]any{"id": id, "issue": cm.IssueKey, "deleted": true}) }
+		fmt.Printf("Deleted comment %s from %s\n", id, cm.IssueKey)
+		return nil
+	},
+}
+
+// commentBodyFlags returns the body from --body or --body-file ('-' is stdin)
+func commentBodyFlags(cmd *cobra.Command) (string, error) {
+	body, _ := cmd.Flags().GetString("body")
+	file, _ := cmd.Flags().GetString("body-file")
+	if body != "" && file != "" { return "", errors.New("use --body or --body-file, not both") }
+	if file != "" {
+		var b []byte
+		var err error
+		if file == "-" { noteStdinRead(); b, err = io.ReadAll(os.Stdin) } else { b, err = os.ReadFile(expandUserPath(file)) }
+		if err != nil { return "", err }
+		body = string(b)
+	}
+	if strings.TrimSpace(body) == "" { return "", errors.New("--body or --body-file is required and must not be empty") }
+	return body, nil
+}
+
+// commentThread is a top-level comment and its replies
+type commentThread struct {
+	Root    api.Comment
+	Replies []api.Comment
+}
+
+// commentThreads groups replies under their thread's first comment, keeping
+// order. Replies whose parent is not in comments (e.g. cut off by --since)
+// stand as threads of their own.
+func commentThreads(comments []api.Comment) []commentThread {
+	var out []commentThread
+	index := map[string]int{}
+	for _, c := range comments {
+		if c.Parent != nil {
+			if i, ok := index[c.Parent.ID]; ok {
+				out[i].Replies = append(out[i].Replies, c)
+				continue
+			}
+		}
+		index[c.ID] = len(out)
+		out = append(out, commentThread{Root: c})
+	}
+	return out
+}
+
+// printComments renders comment threads oldest first as "author · timestamp · id"
+// headers followed by the body, with replies indented under the thread and
+// resolved threads marked
+func printComments(comments []api.Comment) {
+	header := func(c api.Comment) string {
+		author := "unknown"
+		if c.User != nil && c.User.Name != "" { author = c.User.Name }
+		when := ""
+		if !c.CreatedAt.IsZero() { when = " · " + ui.Time(c.CreatedAt) }
+		return author + when + " · " + c.ID
+	}
+	body := func(c api.Comment, indent string) {
+		for _, line := range strings.Split(strings.TrimSpace(c.Body), "\n") { fmt.Printf("%s%s\n", indent, line) }
+		if r := summarizeReactions(c.Reactions); r != "" { fmt.Printf("%s[%s]\n", indent, r) }
+	}
+	for i, t := range commentThreads(comments) {
+		if i > 0 { fmt.Println() }
+		status := ""
+		if t.Root.ResolvedAt != nil {
+			status = " · resolved"
+			if t.Root.ResolvingUser != nil && t.Root.ResolvingUser.Name != "" { status += " by " + t.Root.ResolvingUser.Name }
+		}
+		fmt.Printf("%s%s\n", header(t.Root), status)
+		body(t.Root, "  ")
+		for _, r := range t.Replies {
+			fmt.Printf("  %s %s\n", ui.Symbol("↳", "->"), header(r))
+			body(r, "      ")
+		}
+	}
+}
+
+// summarizeReactions counts reactions per emoji in order of first appearance, e.g. "👍 2  🎉 1"
+func summarizeReactions(reactions []api.Reaction) string {
+	var order []string
+	counts := map[string]int{}
+	for _, r := range reactions {
+		if counts[r.Emoji] == 0 { order = append(order, r.Emoji) }
+		counts[r.Emoji]++
+	}
+	parts := make([]string, 0, len(order))
+	for _, e := range order { parts = append(parts, fmt.Sprintf("%s %d", e, counts[e])) }
+	return strings.Join(parts, "  ")
+}
+
 func init() {
+	issuesCmd.AddCommand(issuesCommentsCmd)
+	issuesCommentsCmd.Flags().String("since", "", "Only comments created at or after this time (yesterday, 7d, 2024-01-01)")
+	issuesCommentsCmd.Flags().Int("limit", 0, "Maximum comments to fetch (0 = all, paginated)")
+
 	rootCmd.AddCommand(commentCmd)
 	commentCmd.AddCommand(commentCreateCmd)
+	commentCmd.AddCommand(commentReactCmd)
+	commentCmd.AddCommand(commentResolveCmd)
+	commentCmd.AddCommand(commentUnresolveCmd)
+	commentCmd.AddCommand(commentEditCmd)
+	commentCmd.AddCommand(commentDeleteCmd)
+	commentEditCmd.Flags().StringP("body", "b", "", "New comment body (markdown supported)")
+	commentEditCmd.Flags().String("body-file", "", "Read the new body from a markdown file ('-' for stdin)")
+	commentEditCmd.Flags().Bool("no-mentions", false, "Send @handles as typed instead of turning them into mentions")
+	commentDeleteCmd.Flags().Bool("confirm", false, "Really delete the comment")
+	commentReactCmd.Flags().StringP("emoji", "e", "", "Emoji or shortcode name (👍, +1, :tada:)")
     commentCreateCmd.Flags().StringP("id", "i", "", "Issue ID")
     commentCreateCmd.Flags().StringP("key", "k", "", "Issue key like TEAM-123")
     commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (markdown supported)")
+    commentCreateCmd.Flags().Bool("no-mentions", false, "Send @handles as typed instead of turning them into mentions")
 }
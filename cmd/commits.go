@@ -0,0 +1,113 @@
+package cmd
+
+import (
+    "fmt"
+    "net/url"
+    "os"
+    "os/exec"
+    "regexp"
+    "strings"
+
+    "linear-cli/internal/api"
+
+    "github.com/spf13/cobra"
+)
+
+// gitCommit is the commit 'issues create --from-commit' turns into an issue
+type gitCommit struct {
+    SHA     string
+    Subject string
+    Body    string
+    URL     string
+    IsHead  bool
+}
+
+// readGitCommit reads rev from the repository in the working directory; URL is
+// set when the origin remote is on a known forge
+func readGitCommit(rev string) (*gitCommit, error) {
+    out, err := exec.Command("git", "log", "-1", "--format=%H%x00%s%x00%b", rev, "--").Output()
+    if err != nil { return nil, fmt.Errorf("cannot read commit '%s' (not a git repository, or unknown revision)", rev) }
+    parts := strings.SplitN(string(out), "\x00", 3)
+    if len(parts) != 3 { return nil, fmt.Errorf("unexpected git log output for '%s'", rev) }
+    c := &gitCommit{SHA: strings.TrimSpace(parts[0]), Subject: strings.TrimSpace(parts[1]), Body: strings.TrimSpace(parts[2])}
+    if head, err := exec.Command("git", "rev-parse", "HEAD").Output(); err == nil { c.IsHead = strings.TrimSpace(string(head)) == c.SHA }
+    if remote, err := exec.Command("git", "remote", "get-url", "origin").Output(); err == nil { c.URL = commitWebURL(strings.TrimSpace(string(remote)), c.SHA) }
+    return c, nil
+}
+
+var reSCPRemote = regexp.MustCompile(`^[\w.-]+@([^:/]+):(.+)$`)
+
+// commitWebURL builds the web URL of sha from a git remote (https, ssh:// or
+// scp-style git@host:owner/repo); unrecognized remotes give ""
+func commitWebURL(remote, sha string) string {
+    var host, path string
+    if m := reSCPRemote.FindStringSubmatch(remote); m != nil {
+        host, path = m[1], m[2]
+    } else if u, err := url.Parse(remote); err == nil && u.Host != "" {
+        host, path = u.Hostname(), u.Path
+    } else {
+        return ""
+    }
+    path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+    if strings.Count(path, "/") < 1 { return "" }
+    segment := "commit"
+    if host == "bitbucket.org" { segment = "commits" }
+    return fmt.Sprintf("https://%s/%s/%s/%s", host, path, segment, sha)
+}
+
+// commitIssueDescription is the commit body followed by a reference to the commit
+func commitIssueDescription(c *gitCommit) string {
+    ref := "`" + shortSHA(c.SHA) + "`"
+    if c.URL != "" { ref = fmt.Sprintf("[%s](%s)", shortSHA(c.SHA), c.URL) }
+    if c.Body == "" { return "Commit: " + ref }
+    return c.Body + "\n\nCommit: " + ref
+}
+
+func shortSHA(sha string) string {
+    if len(sha) > 12 { return sha[:12] }
+    return sha
+}
+
+// amendCommitWithKey adds "Refs: KEY" to the commit 'issues create --from-commit
+// --amend-commit' was created from, then points the issue at the rewritten commit.
+// Only the message changes: staged changes are left out of the amend. A commit
+// already on a remote branch is not rewritten unless --force-amend is given.
+func amendCommitWithKey(cmd *cobra.Command, client *api.Client, created *api.IssueDetails) error {
+    if amend, _ := cmd.Flags().GetBool("amend-commit"); !amend { return nil }
+    oldSHA, _ := cmd.Flags().GetString("from-commit")
+    head, err := exec.Command("git", "rev-parse", "HEAD").Output()
+    if err != nil || strings.TrimSpace(string(head)) != oldSHA {
+        return fmt.Errorf("created %s, but HEAD moved since it was read; not amending the commit", created.Identifier)
+    }
+    msg, err := exec.Command("git", "log", "-1", "--format=%B", oldSHA).Output()
+    if err != nil { return fmt.Errorf("created %s, but reading the commit message failed: %w", created.Identifier, err) }
+    if strings.Contains(string(msg), created.Identifier) { return nil }
+    if force, _ := cmd.Flags().GetBool("force-amend"); !force && commitPushed(oldSHA) {
+        return fmt.Errorf("created %s, but commit %s is already on a remote branch; not rewriting it. Add \"Refs: %s\" to a later commit by hand, or pass --force-amend", created.Identifier, shortSHA(oldSHA), created.Identifier)
+    }
+    amend := exec.Command("git", "commit", "--amend", "--only", "--allow-empty", "--quiet", "-F", "-")
+    amend.Stdin = strings.NewReader(strings.TrimRight(string(msg), "\n") + "\n\nRefs: " + created.Identifier + "\n")
+    amend.Stdout, amend.Stderr = os.Stderr, os.Stderr
+    if err := amend.Run(); err != nil { return fmt.Errorf("created %s, but amending the commit failed: %w", created.Identifier, err) }
+    out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+    if err != nil { return err }
+    newSHA := strings.TrimSpace(string(out))
+    ui.Infof("Amended commit %s -> %s with Refs: %s", shortSHA(oldSHA), shortSHA(newSHA), created.Identifier)
+    if commitPushed(oldSHA) {
+        ui.Warnf("the original commit was already pushed; pushing the amended one needs --force-with-lease")
+    }
+
+    desc := strings.ReplaceAll(created.Description, oldSHA, newSHA)
+    desc = strings.ReplaceAll(desc, shortSHA(oldSHA), shortSHA(newSHA))
+    if desc == created.Description { return nil }
+    updated, err := client.UpdateIssue(created.ID, "", desc)
+    if err != nil { return fmt.Errorf("amended the commit, but updating %s's commit reference failed: %w", created.Identifier, err) }
+    created.Description = updated.Description
+    return nil
+}
+
+// commitPushed reports whether a remote-tracking branch contains sha
+func commitPushed(sha string) bool {
+    remotes, err := exec.Command("git", "branch", "-r", "--contains", sha).Output()
+    return err == nil && strings.TrimSpace(string(remotes)) != ""
+}
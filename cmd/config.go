@@ -0,0 +1,147 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "strings"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+    "golang.org/x/term"
+)
+
+// teamSettingKeys are the per-team preferences users may set explicitly; the
+// last_* preferences are recorded automatically by 'issues create'.
+var teamSettingKeys = map[string]func(*config.TeamPrefs, string){
+    "default_template": func(tp *config.TeamPrefs, v string) { tp.DefaultTemplate = v },
+}
+
+var configCmd = &cobra.Command{
+    Use:   "config",
+    Short: "View or change local preferences in config.toml",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var configSetTeamCmd = &cobra.Command{
+    Use:   "set-team <team-key> key=value...",
+    Short: "Set per-team preferences (default_template)",
+    Long: `Set per-team preferences stored under [team_prefs.<KEY>] in config.toml.
+An empty value clears the setting.
+
+Keys:
+  default_template   Template pre-selected by 'issues create --team KEY'`,
+    Example: `  linear-cli config set-team ENG default_template="Bug Template"
+  linear-cli config set-team ENG default_template=`,
+    Args: cobra.MinimumNArgs(2),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        teamKey := strings.ToUpper(strings.TrimSpace(args[0]))
+        cfg, err := config.Load()
+        if err != nil { return err }
+        tp := cfg.TeamPrefs[teamKey]
+        for _, kv := range args[1:] {
+            k, v, ok := strings.Cut(kv, "=")
+            if !ok { return fmt.Errorf("expected key=value, got %q", kv) }
+            set, known := teamSettingKeys[strings.ToLower(strings.TrimSpace(k))]
+            if !known { return fmt.Errorf("unknown team setting %q (supported: %s)", k, strings.Join(sortedKeys(teamSettingKeys), ", ")) }
+            set(&tp, strings.TrimSpace(v))
+        }
+        if cfg.TeamPrefs == nil { cfg.TeamPrefs = map[string]config.TeamPrefs{} }
+        cfg.TeamPrefs[teamKey] = tp
+        if err := config.Save(cfg); err != nil { return err }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"team": teamKey, "defaultTemplate": tp.DefaultTemplate}) }
+        if tp.DefaultTemplate == "" {
+            fmt.Printf("%s: default_template cleared\n", teamKey)
+        } else {
+            fmt.Printf("%s: default_template = %q\n", teamKey, tp.DefaultTemplate)
+        }
+        return nil
+    },
+}
+
+var configEncryptCmd = &cobra.Command{
+    Use:   "encrypt",
+    Short: "Encrypt config.toml with a passphrase",
+    Long: `Encrypt config.toml (AES-256-GCM, key derived from a passphrase with
+PBKDF2-SHA256), for shared machines where an OS keyring is not available.
+Every command then decrypts it on load and re-encrypts it on save.
+
+The passphrase is read from LINEAR_CONFIG_PASSPHRASE, or from the output of the
+command in LINEAR_CONFIG_KEY_CMD (e.g. a keychain or password manager lookup),
+or asked for once per command in a terminal. Without any of these, commands
+fail instead of reading the config. Run 'config decrypt' to go back.`,
+    Example: `  linear-cli config encrypt
+  LINEAR_CONFIG_KEY_CMD='security find-generic-password -w -s linear-cli' linear-cli config encrypt`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        if enc, err := config.IsEncrypted(); err != nil {
+            return err
+        } else if enc {
+            return errors.New("config.toml is already encrypted")
+        }
+        pass, err := config.PassphraseFromEnv()
+        if err != nil { return err }
+        if pass == "" {
+            if err := ensureInteractive("config passphrase; set LINEAR_CONFIG_PASSPHRASE or LINEAR_CONFIG_KEY_CMD"); err != nil { return err }
+            if !term.IsTerminal(int(os.Stdin.Fd())) { return errors.New("no terminal to read a passphrase from; set LINEAR_CONFIG_PASSPHRASE or LINEAR_CONFIG_KEY_CMD") }
+            if pass, err = readConfigPassphrase("New config passphrase: "); err != nil { return err }
+            again, err := readConfigPassphrase("Repeat passphrase: ")
+            if err != nil { return err }
+            if again != pass { return errors.New("the passphrases do not match") }
+        }
+        if len(pass) < minConfigPassphrase { return fmt.Errorf("the passphrase must be at least %d characters", minConfigPassphrase) }
+        if err := config.Encrypt(pass); err != nil { return err }
+
+        path, _ := config.Path()
+        p := printer(cmd)
+        if legacy, _ := config.LegacyPath(); legacy != "" {
+            if _, err := os.Stat(legacy); err == nil { ui.Warnf("%s is not encrypted and may still hold an API key; 'linear-cli auth logout' removes it", legacy) }
+        }
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"path": path, "encrypted": true}) }
+        fmt.Printf("Encrypted %s\n", path)
+        fmt.Println("Set LINEAR_CONFIG_PASSPHRASE or LINEAR_CONFIG_KEY_CMD to use it without a prompt.")
+        return nil
+    },
+}
+
+var configDecryptCmd = &cobra.Command{
+    Use:   "decrypt",
+    Short: "Turn an encrypted config.toml back into plain TOML",
+    Args:  cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        if err := config.Decrypt(); err != nil { return err }
+        path, _ := config.Path()
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"path": path, "encrypted": false}) }
+        fmt.Printf("Decrypted %s\n", path)
+        return nil
+    },
+}
+
+// minConfigPassphrase is the shortest passphrase 'config encrypt' accepts
+const minConfigPassphrase = 8
+
+// readConfigPassphrase reads a passphrase without echo. The prompt goes to
+// stderr so it does not mix with --json output.
+func readConfigPassphrase(prompt string) (string, error) {
+    fmt.Fprint(os.Stderr, prompt)
+    b, err := readPassword(int(os.Stdin.Fd()))
+    fmt.Fprintln(os.Stderr)
+    if err != nil { return "", err }
+    return string(b), nil
+}
+
+// promptConfigPassphrase unlocks an encrypted config.toml from a terminal
+func promptConfigPassphrase() (string, error) {
+    if noInput || !term.IsTerminal(int(os.Stdin.Fd())) { return "", config.ErrLocked }
+    return readConfigPassphrase("Config passphrase: ")
+}
+
+func init() {
+    rootCmd.AddCommand(configCmd)
+    configCmd.AddCommand(configSetTeamCmd, configEncryptCmd, configDecryptCmd)
+    config.PassphrasePrompt = promptConfigPassphrase
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// cronSpec is a parsed five-field cron expression (minute hour day-of-month
+// month day-of-week), evaluated in local time like crontab. As in cron, when
+// both day fields are restricted a day matching either one is scheduled.
+type cronSpec struct {
+    minute, hour, dom, month, dow uint64
+    domAny, dowAny                bool
+}
+
+var cronMacros = map[string]string{
+    "@hourly":   "0 * * * *",
+    "@daily":    "0 0 * * *",
+    "@midnight": "0 0 * * *",
+    "@weekly":   "0 0 * * 0",
+    "@monthly":  "0 0 1 * *",
+    "@yearly":   "0 0 1 1 *",
+    "@annually": "0 0 1 1 *",
+}
+
+var (
+    cronMonthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+    cronDayNames   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// cronSearchLimit bounds how far next/prev look for a matching minute, so an
+// expression that never fires (30 February) gives up instead of looping
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// parseCron parses "0 9 * * MON", lists, ranges, steps (*/15, 1-5/2), month and
+// weekday names, and the @daily style macros
+func parseCron(expr string) (*cronSpec, error) {
+    s := strings.TrimSpace(expr)
+    if m, ok := cronMacros[strings.ToLower(s)]; ok { s = m }
+    f := strings.Fields(s)
+    if len(f) != 5 { return nil, fmt.Errorf("invalid cron expression '%s': expected 5 fields (minute hour day-of-month month day-of-week)", expr) }
+    var c cronSpec
+    var err error
+    if c.minute, err = parseCronField(f[0], 0, 59, nil); err != nil { return nil, fmt.Errorf("cron minute: %w", err) }
+    if c.hour, err = parseCronField(f[1], 0, 23, nil); err != nil { return nil, fmt.Errorf("cron hour: %w", err) }
+    if c.dom, err = parseCronField(f[2], 1, 31, nil); err != nil { return nil, fmt.Errorf("cron day of month: %w", err) }
+    if c.month, err = parseCronField(f[3], 1, 12, cronMonthNames); err != nil { return nil, fmt.Errorf("cron month: %w", err) }
+    if c.dow, err = parseCronField(f[4], 0, 7, cronDayNames); err != nil { return nil, fmt.Errorf("cron day of week: %w", err) }
+    // 7 is Sunday too
+    if c.dow&(1<<7) != 0 { c.dow = c.dow&^(1<<7) | 1 }
+    c.domAny, c.dowAny = strings.HasPrefix(f[2], "*"), strings.HasPrefix(f[4], "*")
+    return &c, nil
+}
+
+// parseCronField returns the values a field allows as a bit set; names, when
+// given, are accepted for lo, lo+1, ...
+func parseCronField(field string, lo, hi int, names []string) (uint64, error) {
+    value := func(s string) (int, error) {
+        for i, n := range names {
+            if strings.EqualFold(s, n) { return lo + i, nil }
+        }
+        v, err := strconv.Atoi(s)
+        if err != nil || v < lo || v > hi { return 0, fmt.Errorf("'%s' is not between %d and %d", s, lo, hi) }
+        return v, nil
+    }
+    var bits uint64
+    for _, part := range strings.Split(field, ",") {
+        rng, stepStr, hasStep := strings.Cut(part, "/")
+        step := 1
+        if hasStep {
+            n, err := strconv.Atoi(stepStr)
+            if err != nil || n < 1 { return 0, fmt.Errorf("invalid step '%s'", stepStr) }
+            step = n
+        }
+        from, to := lo, hi
+        if rng != "*" {
+            a, b, isRange := strings.Cut(rng, "-")
+            var err error
+            if from, err = value(a); err != nil { return 0, err }
+            to = from
+            if isRange {
+                if to, err = value(b); err != nil { return 0, err }
+            } else if hasStep {
+                to = hi
+            }
+            if to < from { return 0, fmt.Errorf("invalid range '%s'", rng) }
+        }
+        for v := from; v <= to; v += step { bits |= 1 << uint(v) }
+    }
+    return bits, nil
+}
+
+func (c *cronSpec) dayMatches(t time.Time) bool {
+    dom := c.dom&(1<<uint(t.Day())) != 0
+    dow := c.dow&(1<<uint(t.Weekday())) != 0
+    switch {
+    case c.domAny && c.dowAny:
+        return true
+    case c.domAny:
+        return dow
+    case c.dowAny:
+        return dom
+    }
+    return dom || dow
+}
+
+// next returns the first scheduled minute after t, or the zero time if none
+// falls within the search limit
+func (c *cronSpec) next(t time.Time) time.Time {
+    t = t.Truncate(time.Minute).Add(time.Minute)
+    for end := t.Add(cronSearchLimit); t.Before(end); {
+        switch {
+        case c.month&(1<<uint(t.Month())) == 0:
+            t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+        case !c.dayMatches(t):
+            t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+        case c.hour&(1<<uint(t.Hour())) == 0:
+            t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+        case c.minute&(1<<uint(t.Minute())) == 0:
+            t = t.Add(time.Minute)
+        default:
+            return t
+        }
+    }
+    return time.Time{}
+}
+
+// prev returns the last scheduled minute at or before t, or the zero time if
+// none falls within the search limit
+func (c *cronSpec) prev(t time.Time) time.Time {
+    t = t.Truncate(time.Minute)
+    for end := t.Add(-cronSearchLimit); t.After(end); {
+        switch {
+        case c.month&(1<<uint(t.Month())) == 0:
+            t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+        case !c.dayMatches(t):
+            t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+        case c.hour&(1<<uint(t.Hour())) == 0:
+            t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(-time.Minute)
+        case c.minute&(1<<uint(t.Minute())) == 0:
+            t = t.Add(-time.Minute)
+        default:
+            return t
+        }
+    }
+    return time.Time{}
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "sort"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var cyclesPlanCmd = &cobra.Command{
+    Use:   "plan --team <key> --next",
+    Short: "Move backlog issues into the team's next cycle",
+    Long: `List the team's unscheduled backlog issues, most urgent first, and move the
+ones you pick into the next cycle. Pick by row number or key ("1,3-5,ENG-42"),
+interactively or with --select. The estimates already in the cycle plus the
+picked ones are summed and compared with the capacity: --capacity in points, or
+else the average completed points of the last 3 closed cycles. Going over
+capacity or picking unestimated issues only warns.`,
+    Example: `  linear-cli cycles plan --team ENG --next
+  linear-cli cycles plan --team ENG --next --select 1-4 --yes
+  linear-cli cycles plan --team ENG --next --capacity 20 --select ENG-12,ENG-15`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        next, _ := cmd.Flags().GetBool("next")
+        selectFlag, _ := cmd.Flags().GetString("select")
+        yes, _ := cmd.Flags().GetBool("yes")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        if !next { return errors.New("pass --next to plan the team's upcoming cycle") }
+        p := printer(cmd)
+        if p.JSONEnabled() && (strings.TrimSpace(selectFlag) == "" || !yes) { return errors.New("--json needs --select and --yes") }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := cachedTeamByKeyOrError(client, teamKey)
+        if err != nil { return err }
+        now := time.Now()
+        cycle, err := client.NextCycle(team.ID, now)
+        if err != nil { return err }
+        if cycle == nil { return fmt.Errorf("%s has no upcoming cycle", team.Key) }
+        backlog, err := client.ListBacklogIssues(team.ID)
+        if err != nil { return err }
+        sortBacklogByPriority(backlog)
+        planned, err := client.ListCycleIssues(cycle.ID)
+        if err != nil { return err }
+        capacity, err := planCapacity(cmd, client, team.ID, now)
+        if err != nil { return err }
+        committed := newCycleReportRow(*cycle, planned, now).ScopePoints
+
+        if !p.JSONEnabled() {
+            fmt.Printf("Cycle #%d (%s – %s): %s pts planned", cycle.Number, ui.In(cycle.StartsAt).Format("Jan 02"), ui.In(cycle.EndsAt).Format("Jan 02"), formatPoints(committed))
+            if capacity > 0 { fmt.Printf(", capacity %s pts", formatPoints(capacity)) }
+            fmt.Println()
+        }
+        if len(backlog) == 0 {
+            if p.JSONEnabled() { return errors.New("no unscheduled backlog issues to plan") }
+            fmt.Printf("No unscheduled backlog issues in %s\n", team.Key)
+            return nil
+        }
+        if strings.TrimSpace(selectFlag) == "" {
+            rows := make([][]string, 0, len(backlog))
+            for i, b := range backlog {
+                est := "-"
+                if b.Estimated { est = formatPoints(b.Estimate) }
+                rows = append(rows, []string{strconv.Itoa(i + 1), b.Identifier, priorityLabel(b.Priority), est, truncate(b.Title, 60)})
+            }
+            if err := p.Table([]string{"#", "Key", "Priority", "Estimate", "Title"}, rows); err != nil { return err }
+            selectFlag, err = promptLine("Issues to move (e.g. 1,3-5 or ENG-42; empty to cancel): ")
+            if err != nil || selectFlag == "" { return err }
+        }
+        picked, err := parsePlanSelection(selectFlag, backlog)
+        if err != nil { return err }
+
+        sum := summarizeCyclePlan(committed, capacity, picked)
+        if sum.Unestimated > 0 { ui.Warnf("%d selected issue(s) have no estimate and count as 0 pts", sum.Unestimated) }
+        if sum.OverBy > 0 { ui.Warnf("cycle #%d would be %s pts over its capacity of %s pts", cycle.Number, formatPoints(sum.OverBy), formatPoints(capacity)) }
+        if !p.JSONEnabled() {
+            fmt.Printf("Moving %d issue(s), %s pts: cycle total %s pts\n", len(picked), formatPoints(sum.SelectedPoints), formatPoints(sum.TotalPoints))
+        }
+        if ok, err := confirmed(yes, fmt.Sprintf("Move %d issue(s) into cycle #%d? [y/N] ", len(picked), cycle.Number)); err != nil || !ok { return err }
+
+        prog := ui.StartProgress(fmt.Sprintf("Moving into cycle #%d", cycle.Number), len(picked))
+        var moved []string
+        failed := 0
+        for _, b := range picked {
+            _, err := client.UpdateIssueAdvanced(b.ID, api.IssueUpdateInput{CycleID: cycle.ID})
+            switch {
+            case errors.Is(err, api.ErrDryRun):
+            case err != nil:
+                failed++
+                ui.Warnf("moving %s failed: %v", b.Identifier, err)
+            default:
+                moved = append(moved, b.Identifier)
+            }
+            prog.Step("%s", b.Identifier)
+        }
+        prog.Done("Moved %d issue(s) into cycle #%d", len(moved), cycle.Number)
+
+        if p.JSONEnabled() {
+            if moved == nil { moved = []string{} }
+            if err := p.PrintJSON(map[string]any{"team": team.Key, "cycle": cycle.Number, "moved": moved, "plan": sum}); err != nil { return err }
+        }
+        if failed > 0 { return fmt.Errorf("%d of %d issue(s) could not be moved", failed, len(picked)) }
+        return nil
+    },
+}
+
+// cyclePlanSummary is the points picture of a cycle once the picked issues are in
+type cyclePlanSummary struct {
+    CommittedPoints float64 `json:"committedPoints"`
+    SelectedPoints  float64 `json:"selectedPoints"`
+    TotalPoints     float64 `json:"totalPoints"`
+    Capacity        float64 `json:"capacity,omitempty"`
+    OverBy          float64 `json:"overBy,omitempty"`
+    Unestimated     int     `json:"unestimated,omitempty"`
+}
+
+// summarizeCyclePlan adds the picked estimates to what the cycle already holds;
+// a zero capacity means unknown and never warns
+func summarizeCyclePlan(committed, capacity float64, picked []api.BacklogIssue) cyclePlanSummary {
+    s := cyclePlanSummary{CommittedPoints: committed, Capacity: capacity}
+    for _, b := range picked {
+        if !b.Estimated { s.Unestimated++; continue }
+        s.SelectedPoints += b.Estimate
+    }
+    s.TotalPoints = committed + s.SelectedPoints
+    if capacity > 0 && s.TotalPoints > capacity { s.OverBy = s.TotalPoints - capacity }
+    return s
+}
+
+// planCapacity is --capacity when set, otherwise the team's recent velocity
+func planCapacity(cmd *cobra.Command, client *api.Client, teamID string, now time.Time) (float64, error) {
+    if cmd.Flags().Changed("capacity") {
+        capacity, _ := cmd.Flags().GetFloat64("capacity")
+        if capacity <= 0 { return 0, errors.New("--capacity must be greater than 0") }
+        return capacity, nil
+    }
+    cycles, err := client.ListTeamCycles(teamID, now)
+    if err != nil { return 0, err }
+    var rows []cycleReportRow
+    for _, c := range cycles {
+        if c.CompletedAt == nil && now.Before(c.EndsAt) { continue }
+        issues, err := client.ListCycleIssues(c.ID)
+        if err != nil { return 0, err }
+        rows = append(rows, newCycleReportRow(c, issues, now))
+        if len(rows) == 3 { break }
+    }
+    return summarizeCycleTrend(rows).AvgCompletedPoints, nil
+}
+
+// sortBacklogByPriority puts urgent first and no priority last, keeping the
+// API order within a priority
+func sortBacklogByPriority(issues []api.BacklogIssue) {
+    rank := func(p int) int {
+        if p == 0 { return 5 }
+        return p
+    }
+    sort.SliceStable(issues, func(i, j int) bool { return rank(issues[i].Priority) < rank(issues[j].Priority) })
+}
+
+// parsePlanSelection picks issues by 1-based row number, range ("3-5") or key,
+// in the order given and without duplicates
+func parsePlanSelection(sel string, issues []api.BacklogIssue) ([]api.BacklogIssue, error) {
+    var out []api.BacklogIssue
+    seen := map[int]bool{}
+    add := func(i int) {
+        if !seen[i] { seen[i] = true; out = append(out, issues[i]) }
+    }
+    for _, part := range strings.FieldsFunc(sel, func(r rune) bool { return r == ',' || r == ' ' }) {
+        if lo, hi, ok := strings.Cut(part, "-"); ok {
+            a, errA := strconv.Atoi(lo)
+            b, errB := strconv.Atoi(hi)
+            if errA == nil && errB == nil {
+                if a < 1 || b > len(issues) || a > b { return nil, fmt.Errorf("invalid range '%s' (rows are 1-%d)", part, len(issues)) }
+                for i := a; i <= b; i++ { add(i - 1) }
+                continue
+            }
+        }
+        if n, err := strconv.Atoi(part); err == nil {
+            if n < 1 || n > len(issues) { return nil, fmt.Errorf("no row %d (rows are 1-%d)", n, len(issues)) }
+            add(n - 1)
+            continue
+        }
+        found := false
+        for i, b := range issues {
+            if strings.EqualFold(b.Identifier, part) { add(i); found = true; break }
+        }
+        if !found { return nil, fmt.Errorf("%s is not an unscheduled backlog issue", part) }
+    }
+    if len(out) == 0 { return nil, errors.New("nothing selected") }
+    return out, nil
+}
+
+func init() {
+    cyclesCmd.AddCommand(cyclesPlanCmd)
+    cyclesPlanCmd.Flags().String("team", "", "Team key (required)")
+    cyclesPlanCmd.Flags().Bool("next", false, "Plan the team's next upcoming cycle")
+    cyclesPlanCmd.Flags().Float64("capacity", 0, "Cycle capacity in points (default: average velocity of the last 3 closed cycles)")
+    cyclesPlanCmd.Flags().String("select", "", "Issues to move by row number, range or key, e.g. 1,3-5,ENG-42 (skips the prompt)")
+    cyclesPlanCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+    "encoding/csv"
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var cyclesCmd = &cobra.Command{
+    Use:   "cycles",
+    Short: "Cycle reports and planning",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var cyclesReportCmd = &cobra.Command{
+    Use:   "report",
+    Short: "Scope, completed points and carry-over for a team's recent cycles",
+    Long: `Summarize a team's most recent cycles (the active one included): scope in issues
+and points, what was completed, and what carried over when the cycle closed.
+Canceled issues are left out of scope. The footer averages velocity over the
+closed cycles shown. Use --csv to export the rows for a spreadsheet.`,
+    Example: `  linear-cli cycles report --team ENG
+  linear-cli cycles report --team ENG --last 6 --csv cycles.csv
+  linear-cli --json cycles report --team ENG --last 3`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        last, _ := cmd.Flags().GetInt("last")
+        csvPath, _ := cmd.Flags().GetString("csv")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        if last < 1 { return errors.New("--last must be at least 1") }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
+        if err != nil { return err }
+        if team == nil { return fmt.Errorf("team with key %s not found", teamKey) }
+        now := time.Now()
+        cycles, err := client.ListTeamCycles(team.ID, now)
+        if err != nil { return err }
+        if len(cycles) > last { cycles = cycles[:last] }
+
+        prog := ui.StartProgress(fmt.Sprintf("Fetching %s cycles", team.Key), len(cycles))
+        rows := make([]cycleReportRow, len(cycles))
+        // Oldest first so the table reads as a trend
+        for i, c := range cycles {
+            issues, err := client.ListCycleIssues(c.ID)
+            if err != nil { prog.Done("Fetching cycles failed"); return err }
+            rows[len(cycles)-1-i] = newCycleReportRow(c, issues, now)
+            prog.Step("cycle %d", c.Number)
+        }
+        prog.Done("Fetched %d cycle(s)", len(cycles))
+        trend := summarizeCycleTrend(rows)
+
+        if csvPath != "" {
+            var w io.Writer = os.Stdout
+            if csvPath != "-" {
+                f, err := os.Create(expandUserPath(csvPath))
+                if err != nil { return err }
+                defer f.Close()
+                w = f
+            }
+            if err := writeCycleReportCSV(w, rows); err != nil { return err }
+            if csvPath == "-" { return nil }
+            ui.Infof("Wrote %d row(s) to %s", len(rows), csvPath)
+        }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"team": team.Key, "cycles": rows, "trend": trend}) }
+        if len(rows) == 0 {
+            fmt.Printf("No cycles found for %s\n", team.Key)
+            return nil
+        }
+        table := make([][]string, 0, len(rows))
+        for _, r := range rows {
+            name := "#" + strconv.Itoa(r.Number)
+            if r.Active { name += " (active)" }
+            carry := "-"
+            if !r.Active { carry = fmt.Sprintf("%d / %s", r.CarryOverIssues, formatPoints(r.CarryOverPoints)) }
+            table = append(table, []string{name, ui.In(r.StartsAt).Format("Jan 02") + " – " + ui.In(r.EndsAt).Format("Jan 02"),
+                fmt.Sprintf("%d / %s", r.ScopeIssues, formatPoints(r.ScopePoints)), fmt.Sprintf("%d / %s", r.CompletedIssues, formatPoints(r.CompletedPoints)), carry, fmt.Sprintf("%.0f%%", r.CompletionPct)})
+        }
+        if err := p.Table([]string{"Cycle", "Dates", "Scope (issues/pts)", "Done", "Carry-over", "Done %"}, table); err != nil { return err }
+        if trend.Cycles > 0 {
+            fmt.Printf("\nVelocity over %d closed cycle(s): %.1f pts, %.1f issues per cycle; %.0f%% of scope completed on average\n", trend.Cycles, trend.AvgCompletedPoints, trend.AvgCompletedIssues, trend.AvgCompletionPct)
+        }
+        return nil
+    },
+}
+
+// cycleReportRow is one cycle's scope and outcome; points are summed estimates
+type cycleReportRow struct {
+    Number          int       `json:"number"`
+    Name            string    `json:"name,omitempty"`
+    StartsAt        time.Time `json:"startsAt"`
+    EndsAt          time.Time `json:"endsAt"`
+    Active          bool      `json:"active"`
+    ScopeIssues     int       `json:"scopeIssues"`
+    ScopePoints     float64   `json:"scopePoints"`
+    CompletedIssues int       `json:"completedIssues"`
+    CompletedPoints float64   `json:"completedPoints"`
+    CarryOverIssues int       `json:"carryOverIssues"`
+    CarryOverPoints float64   `json:"carryOverPoints"`
+    CompletionPct   float64   `json:"completionPct"`
+}
+
+type cycleTrend struct {
+    Cycles             int     `json:"cycles"`
+    AvgCompletedPoints float64 `json:"avgCompletedPoints"`
+    AvgCompletedIssues float64 `json:"avgCompletedIssues"`
+    AvgCompletionPct   float64 `json:"avgCompletionPct"`
+}
+
+// newCycleReportRow tallies a cycle's issues. An issue counts as completed if it
+// was done by the cycle's end; in a closed cycle everything else carried over.
+func newCycleReportRow(c api.Cycle, issues []api.CycleIssue, now time.Time) cycleReportRow {
+    r := cycleReportRow{Number: c.Number, Name: c.Name, StartsAt: c.StartsAt, EndsAt: c.EndsAt}
+    r.Active = c.CompletedAt == nil && now.Before(c.EndsAt)
+    for _, iss := range issues {
+        if iss.StateType == "canceled" { continue }
+        r.ScopeIssues++
+        r.ScopePoints += iss.Estimate
+        done := !iss.Carried && iss.StateType == "completed" && iss.CompletedAt != nil && !iss.CompletedAt.After(c.EndsAt)
+        if done {
+            r.CompletedIssues++
+            r.CompletedPoints += iss.Estimate
+        } else if !r.Active {
+            r.CarryOverIssues++
+            r.CarryOverPoints += iss.Estimate
+        }
+    }
+    // Points when the team estimates, issue counts otherwise
+    if r.ScopePoints > 0 {
+        r.CompletionPct = 100 * r.CompletedPoints / r.ScopePoints
+    } else if r.ScopeIssues > 0 {
+        r.CompletionPct = 100 * float64(r.CompletedIssues) / float64(r.ScopeIssues)
+    }
+    return r
+}
+
+// summarizeCycleTrend averages the closed cycles; the active one is still moving
+func summarizeCycleTrend(rows []cycleReportRow) cycleTrend {
+    var t cycleTrend
+    for _, r := range rows {
+        if r.Active { continue }
+        t.Cycles++
+        t.AvgCompletedPoints += r.CompletedPoints
+        t.AvgCompletedIssues += float64(r.CompletedIssues)
+        t.AvgCompletionPct += r.CompletionPct
+    }
+    if t.Cycles > 0 {
+        n := float64(t.Cycles)
+        t.AvgCompletedPoints, t.AvgCompletedIssues, t.AvgCompletionPct = t.AvgCompletedPoints/n, t.AvgCompletedIssues/n, t.AvgCompletionPct/n
+    }
+    return t
+}
+
+func writeCycleReportCSV(w io.Writer, rows []cycleReportRow) error {
+    cw := csv.NewWriter(w)
+    if err := cw.Write([]string{"cycle", "name", "starts_at", "ends_at", "active", "scope_issues", "scope_points", "completed_issues", "completed_points", "carry_over_issues", "carry_over_points", "completion_pct"}); err != nil { return err }
+    for _, r := range rows {
+        rec := []string{strconv.Itoa(r.Number), r.Name, r.StartsAt.UTC().Format("2006-01-02"), r.EndsAt.UTC().Format("2006-01-02"), strconv.FormatBool(r.Active),
+            strconv.Itoa(r.ScopeIssues), formatPoints(r.ScopePoints), strconv.Itoa(r.CompletedIssues), formatPoints(r.CompletedPoints), strconv.Itoa(r.CarryOverIssues), formatPoints(r.CarryOverPoints), fmt.Sprintf("%.1f", r.CompletionPct)}
+        if err := cw.Write(rec); err != nil { return err }
+    }
+    cw.Flush()
+    return cw.Error()
+}
+
+// formatPoints drops the decimals from whole-point estimates
+func formatPoints(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+
+func init() {
+    rootCmd.AddCommand(cyclesCmd)
+    cyclesCmd.AddCommand(cyclesReportCmd)
+    cyclesReportCmd.Flags().String("team", "", "Team key (required)")
+    cyclesReportCmd.Flags().Int("last", 6, "Number of most recent cycles to include")
+    cyclesReportCmd.Flags().String("csv", "", "Write per-cycle rows as CSV to this file ('-' for stdout)")
+}
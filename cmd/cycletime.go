@@ -0,0 +1,231 @@
+package cmd
+
+import (
+    "encoding/csv"
+    "errors"
+    "fmt"
+    "io"
+    "math"
+    "os"
+    "sort"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Engineering metrics computed from Linear's issue history
+
+var issuesCycleTimeCmd = &cobra.Command{
+    Use:   "cycle-time",
+    Short: "Lead time, cycle time and time-in-state for completed issues",
+    Long: `Compute per-issue lead time (created → completed), cycle time (started → completed)
+and time spent in each workflow state for a team's issues completed in the window,
+with p50/p90 summaries. Use --csv to export the per-issue rows.`,
+    Example: `  linear-cli issues cycle-time --team ENG --since 90d
+  linear-cli issues cycle-time --team ENG --since 2024-01-01 --csv metrics.csv
+  linear-cli --json issues cycle-time --team ENG`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        sinceFlag, _ := cmd.Flags().GetString("since")
+        csvPath, _ := cmd.Flags().GetString("csv")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        since, err := parseSince(sinceFlag, time.Now())
+        if err != nil { return err }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
+        if err != nil { return err }
+        if team == nil { return fmt.Errorf("team with key %s not found", teamKey) }
+        prog := ui.StartProgress(fmt.Sprintf("Fetching issue history for %s", team.Key), 0)
+        history, err := client.ListCompletedIssueHistory(team.ID, since)
+        if err != nil { prog.Done("Fetching issue history failed"); return err }
+        prog.Done("Fetched %d completed issue(s)", len(history))
+
+        rows := make([]cycleTimeRow, 0, len(history))
+        for _, h := range history { rows = append(rows, newCycleTimeRow(h)) }
+        sort.Slice(rows, func(i, j int) bool { return rows[i].CompletedAt.Before(rows[j].CompletedAt) })
+        summary := summarizeCycleTimes(rows)
+
+        if csvPath != "" {
+            var w io.Writer = os.Stdout
+            if csvPath != "-" {
+                f, err := os.Create(expandUserPath(csvPath))
+                if err != nil { return err }
+                defer f.Close()
+                w = f
+            }
+            if err := writeCycleTimeCSV(w, rows); err != nil { return err }
+            if csvPath == "-" { return nil }
+            ui.Infof("Wrote %d row(s) to %s", len(rows), csvPath)
+        }
+
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            return p.PrintJSON(map[string]any{"team": team.Key, "since": since, "issues": rows, "summary": summary})
+        }
+        if len(rows) == 0 {
+            fmt.Printf("No issues completed in %s since %s\n", team.Key, since.Format("2006-01-02"))
+            return nil
+        }
+        table := make([][]string, 0, len(rows))
+        for _, r := range rows {
+            cycle := "-"
+            if r.CycleHours != nil { cycle = formatHours(*r.CycleHours) }
+            table = append(table, []string{r.Key, formatHours(r.LeadHours), cycle, truncate(r.Title, 60)})
+        }
+        if err := p.Table([]string{"Key", "Lead", "Cycle", "Title"}, table); err != nil { return err }
+        fmt.Printf("\n%d issue(s) completed since %s\n", len(rows), since.Format("2006-01-02"))
+        fmt.Printf("Lead time:  p50 %s  p90 %s\n", formatHours(summary.Lead.P50), formatHours(summary.Lead.P90))
+        if summary.Cycle.Count > 0 {
+            fmt.Printf("Cycle time: p50 %s  p90 %s\n", formatHours(summary.Cycle.P50), formatHours(summary.Cycle.P90))
+        }
+        if len(summary.States) > 0 {
+            fmt.Println("\nTime in state (p50 / p90):")
+            for _, name := range sortedKeys(summary.States) {
+                s := summary.States[name]
+                fmt.Printf("  %-20s %s / %s\n", name, formatHours(s.P50), formatHours(s.P90))
+            }
+        }
+        return nil
+    },
+}
+
+// cycleTimeRow is one completed issue's metrics; durations are in hours
+type cycleTimeRow struct {
+    Key         string             `json:"key"`
+    Title       string             `json:"title"`
+    CreatedAt   time.Time          `json:"createdAt"`
+    StartedAt   *time.Time         `json:"startedAt,omitempty"`
+    CompletedAt time.Time          `json:"completedAt"`
+    LeadHours   float64            `json:"leadHours"`
+    CycleHours  *float64           `json:"cycleHours,omitempty"`
+    StateHours  map[string]float64 `json:"stateHours"`
+}
+
+type percentiles struct {
+    Count int     `json:"count"`
+    P50   float64 `json:"p50Hours"`
+    P90   float64 `json:"p90Hours"`
+}
+
+type cycleTimeSummary struct {
+    Lead   percentiles            `json:"lead"`
+    Cycle  percentiles            `json:"cycle"`
+    States map[string]percentiles `json:"states"`
+}
+
+func newCycleTimeRow(h api.IssueHistory) cycleTimeRow {
+    completed := h.CreatedAt
+    if h.CompletedAt != nil { completed = *h.CompletedAt }
+    r := cycleTimeRow{Key: h.Identifier, Title: h.Title, CreatedAt: h.CreatedAt, StartedAt: h.StartedAt, CompletedAt: completed, StateHours: map[string]float64{}}
+    r.LeadHours = completed.Sub(h.CreatedAt).Hours()
+    if h.StartedAt != nil {
+        v := completed.Sub(*h.StartedAt).Hours()
+        r.CycleHours = &v
+    }
+    for name, d := range timeInStates(h, completed) { r.StateHours[name] = d.Hours() }
+    return r
+}
+
+// timeInStates replays state transitions from creation until end and sums the
+// time spent in each state. The initial state is the first transition's source.
+func timeInStates(h api.IssueHistory, end time.Time) map[string]time.Duration {
+    out := map[string]time.Duration{}
+    cur, entered := "", h.CreatedAt
+    for _, t := range h.Transitions {
+        if cur == "" && t.From != nil { cur = t.From.Name }
+        if cur != "" && t.At.After(entered) { out[cur] += t.At.Sub(entered) }
+        cur, entered = t.To.Name, t.At
+    }
+    if cur != "" && end.After(entered) { out[cur] += end.Sub(entered) }
+    return out
+}
+
+func summarizeCycleTimes(rows []cycleTimeRow) cycleTimeSummary {
+    var lead, cycle []float64
+    states := map[string][]float64{}
+    for _, r := range rows {
+        lead = append(lead, r.LeadHours)
+        if r.CycleHours != nil { cycle = append(cycle, *r.CycleHours) }
+        for name, h := range r.StateHours { states[name] = append(states[name], h) }
+    }
+    s := cycleTimeSummary{Lead: percentilesOf(lead), Cycle: percentilesOf(cycle), States: map[string]percentiles{}}
+    for name, v := range states { s.States[name] = percentilesOf(v) }
+    return s
+}
+
+// percentilesOf returns nearest-rank p50/p90 of values
+func percentilesOf(values []float64) percentiles {
+    if len(values) == 0 { return percentiles{} }
+    v := append([]float64(nil), values...)
+    sort.Float64s(v)
+    rank := func(p float64) float64 {
+        i := int(math.Ceil(p*float64(len(v)))) - 1
+        if i < 0 { i = 0 }
+        return v[i]
+    }
+    return percentiles{Count: len(v), P50: rank(0.5), P90: rank(0.9)}
+}
+
+func writeCycleTimeCSV(w io.Writer, rows []cycleTimeRow) error {
+    stateSet := map[string]float64{}
+    for _, r := range rows { for name := range r.StateHours { stateSet[name] = 0 } }
+    states := sortedKeys(stateSet)
+    cw := csv.NewWriter(w)
+    head := []string{"key", "title", "created_at", "started_at", "completed_at", "lead_hours", "cycle_hours"}
+    for _, s := range states { head = append(head, "hours_in_"+s) }
+    if err := cw.Write(head); err != nil { return err }
+    num := func(f float64) string { return fmt.Sprintf("%.2f", f) }
+    for _, r := range rows {
+        started, cycle := "", ""
+        if r.StartedAt != nil { started = r.StartedAt.UTC().Format(time.RFC3339) }
+        if r.CycleHours != nil { cycle = num(*r.CycleHours) }
+        rec := []string{r.Key, r.Title, r.CreatedAt.UTC().Format(time.RFC3339), started, r.CompletedAt.UTC().Format(time.RFC3339), num(r.LeadHours), cycle}
+        for _, s := range states { rec = append(rec, num(r.StateHours[s])) }
+        if err := cw.Write(rec); err != nil { return err }
+    }
+    cw.Flush()
+    return cw.Error()
+}
+
+// formatHours renders hours compactly: "45m", "5h", "3d 4h"
+func formatHours(h float64) string {
+    d := time.Duration(h * float64(time.Hour))
+    switch {
+    case d < time.Hour:
+        return fmt.Sprintf("%dm", int(d.Minutes()))
+    case d < 24*time.Hour:
+        return fmt.Sprintf("%dh", int(d.Hours()))
+    default:
+        days := int(d.Hours()) / 24
+        if rem := int(d.Hours()) % 24; rem > 0 { return fmt.Sprintf("%dd %dh", days, rem) }
+        return fmt.Sprintf("%dd", days)
+    }
+}
+
+// truncate shortens s to at most n runes, marking the cut with "…"
+func truncate(s string, n int) string {
+    r := []rune(s)
+    if len(r) <= n { return s }
+    return string(r[:n-1]) + "…"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+    out := make([]string, 0, len(m))
+    for k := range m { out = append(out, k) }
+    sort.Strings(out)
+    return out
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesCycleTimeCmd)
+    issuesCycleTimeCmd.Flags().String("team", "", "Team key (required)")
+    issuesCycleTimeCmd.Flags().String("since", "90d", "Only issues completed since (90d, 2w, 2024-01-01)")
+    issuesCycleTimeCmd.Flags().String("csv", "", "Write per-issue rows as CSV to this file ('-' for stdout)")
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// parseSpan reads the relative spans every date flag accepts: "7d" and "2w"
+// as whole days, or a Go duration such as "36h". Days come back separately so
+// callers can step by calendar day.
+func parseSpan(s string) (days int, d time.Duration, ok bool) {
+    v := strings.ToLower(strings.TrimSpace(s))
+    if strings.HasSuffix(v, "d") || strings.HasSuffix(v, "w") {
+        if n, err := strconv.Atoi(strings.TrimRight(v, "dw")); err == nil && n > 0 {
+            if strings.HasSuffix(v, "w") { n *= 7 }
+            return n, 0, true
+        }
+    }
+    if d, err := time.ParseDuration(v); err == nil && d > 0 { return 0, d, true }
+    return 0, 0, false
+}
+
+// parseSince converts a lower time bound such as "yesterday", "90d", "2w", "36h"
+// or "2024-01-01" into an absolute time relative to now.
+func parseSince(s string, now time.Time) (time.Time, error) {
+    v := strings.ToLower(strings.TrimSpace(s))
+    midnight := func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()) }
+    switch v {
+    case "today":
+        return midnight(now), nil
+    case "yesterday":
+        return midnight(now.AddDate(0, 0, -1)), nil
+    }
+    if days, d, ok := parseSpan(v); ok { return now.AddDate(0, 0, -days).Add(-d), nil }
+    if t, err := time.ParseInLocation("2006-01-02", v, now.Location()); err == nil { return t, nil }
+    if t, err := time.Parse(time.RFC3339, strings.TrimSpace(s)); err == nil { return t, nil }
+    return time.Time{}, fmt.Errorf("unrecognized --since value '%s' (try yesterday, 7d, 2w, 36h, 2024-01-01)", s)
+}
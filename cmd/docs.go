@@ -0,0 +1,160 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var docsCmd = &cobra.Command{
+    Use:   "docs",
+    Short: "Work with Linear documents (specs, RFCs) in projects",
+    Long: `List, read, create and update the markdown documents attached to Linear projects,
+so specs can be kept next to the issues they describe. Documents can be named by id,
+slug id or URL. Deleting documents is not supported (see SECURITY.md).`,
+    RunE: func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var docsListCmd = &cobra.Command{
+    Use:     "list --project <project>",
+    Short:   "List a project's documents, most recently updated first",
+    Example: `  linear-cli docs list --project "Mobile App"`,
+    Args:    cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        project, err := docsProjectFlag(cmd, client)
+        if err != nil { return err }
+        docs, err := client.ListDocuments(project.ID)
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(docs) }
+        if len(docs) == 0 {
+            fmt.Printf("No documents in project '%s'\n", project.Name)
+            return nil
+        }
+        rows := make([][]string, 0, len(docs))
+        for _, d := range docs {
+            by := ""
+            if d.Creator != nil { by = d.Creator.Name }
+            rows = append(rows, []string{d.SlugID, truncate(d.Title, 50), by, ui.Time(d.UpdatedAt)})
+        }
+        return p.Table([]string{"ID", "Title", "Author", "Updated"}, rows)
+    },
+}
+
+var docsViewCmd = &cobra.Command{
+    Use:   "view <id|url>",
+    Short: "Print a document's markdown",
+    Example: `  linear-cli docs view 3f9c2a1b7d4e
+  linear-cli docs view https://linear.app/acme/document/checkout-spec-3f9c2a1b7d4e > spec.md`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        doc, err := client.GetDocument(documentRef(args[0]))
+        if err != nil { return err }
+        if doc == nil { return fmt.Errorf("document %s not found", args[0]) }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(doc) }
+        // Metadata goes to stderr so 'docs view X > spec.md' saves just the markdown
+        project := ""
+        if doc.Project != nil { project = " · " + doc.Project.Name }
+        ui.Infof("%s%s\n%s", doc.Title, project, doc.URL)
+        fmt.Println(strings.TrimRight(doc.Content, "\n"))
+        return nil
+    },
+}
+
+var docsCreateCmd = &cobra.Command{
+    Use:     "create --project <project> --title <title> --file <path>",
+    Short:   "Create a project document from a markdown file",
+    Example: `  linear-cli docs create --project "Mobile App" --title "Offline sync spec" --file spec.md`,
+    Args:    cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        title, _ := cmd.Flags().GetString("title")
+        file, _ := cmd.Flags().GetString("file")
+        if strings.TrimSpace(title) == "" || strings.TrimSpace(file) == "" { return errors.New("--project, --title and --file are required") }
+        content, err := os.ReadFile(expandUserPath(file))
+        if err != nil { return err }
+        client := newAPIClient(cmd, cfg.APIKey)
+        project, err := docsProjectFlag(cmd, client)
+        if err != nil { return err }
+        doc, err := client.CreateDocument(project.ID, strings.TrimSpace(title), string(content))
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(doc) }
+        fmt.Printf("Created document '%s' in %s: %s\n", doc.Title, project.Name, doc.URL)
+        return nil
+    },
+}
+
+var docsUpdateCmd = &cobra.Command{
+    Use:   "update <id|url> [--title <title>] [--file <path>]",
+    Short: "Replace a document's title and/or content",
+    Example: `  linear-cli docs update 3f9c2a1b7d4e --file spec.md
+  linear-cli docs update 3f9c2a1b7d4e --title "Offline sync spec (v2)"`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        title, _ := cmd.Flags().GetString("title")
+        file, _ := cmd.Flags().GetString("file")
+        if strings.TrimSpace(title) == "" && strings.TrimSpace(file) == "" { return errors.New("nothing to update: pass --title and/or --file") }
+        var content string
+        if strings.TrimSpace(file) != "" {
+            b, err := os.ReadFile(expandUserPath(file))
+            if err != nil { return err }
+            if strings.TrimSpace(string(b)) == "" { return fmt.Errorf("%s is empty", file) }
+            content = string(b)
+        }
+        client := newAPIClient(cmd, cfg.APIKey)
+        doc, err := client.UpdateDocument(documentRef(args[0]), strings.TrimSpace(title), content)
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(doc) }
+        fmt.Printf("Updated document '%s': %s\n", doc.Title, doc.URL)
+        return nil
+    },
+}
+
+// docsProjectFlag resolves the required --project flag by id or name
+func docsProjectFlag(cmd *cobra.Command, client *api.Client) (*api.Project, error) {
+    name, _ := cmd.Flags().GetString("project")
+    if strings.TrimSpace(name) == "" { return nil, errors.New("--project is required") }
+    project, err := client.ResolveProject(strings.TrimSpace(name))
+    if err != nil { return nil, err }
+    if project == nil { return nil, fmt.Errorf("project '%s' not found", name) }
+    return project, nil
+}
+
+// documentRef turns a document URL (.../document/<title>-<slugId>) into its slug
+// id; ids and slug ids are returned unchanged
+func documentRef(s string) string {
+    s = strings.TrimRight(strings.TrimSpace(s), "/")
+    if !strings.Contains(s, "/") { return s }
+    s = s[strings.LastIndex(s, "/")+1:]
+    if i := strings.LastIndex(s, "-"); i >= 0 { s = s[i+1:] }
+    return s
+}
+
+func init() {
+    rootCmd.AddCommand(docsCmd)
+    docsCmd.AddCommand(docsListCmd, docsViewCmd, docsCreateCmd, docsUpdateCmd)
+    docsListCmd.Flags().String("project", "", "Project name or id (required)")
+    docsCreateCmd.Flags().String("project", "", "Project name or id (required)")
+    docsCreateCmd.Flags().String("title", "", "Document title (required)")
+    docsCreateCmd.Flags().String("file", "", "Markdown file with the document content (required)")
+    docsUpdateCmd.Flags().String("title", "", "New title")
+    docsUpdateCmd.Flags().String("file", "", "Markdown file with the new content")
+}
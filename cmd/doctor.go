@@ -0,0 +1,190 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "os"
+    "path/filepath"
+    "runtime"
+    "strings"
+    "time"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// doctorCheck is one diagnostic result. Fix is an actionable hint for warn/fail.
+type doctorCheck struct {
+    Name   string `json:"name"`
+    Status string `json:"status"` // ok, warn, fail or skip
+    Detail string `json:"detail"`
+    Fix    string `json:"fix,omitempty"`
+}
+
+// maxClockSkew is how far the local clock may drift from the API's before warning
+const maxClockSkew = time.Minute
+
+var doctorCmd = &cobra.Command{
+    Use:   "doctor",
+    Short: "Diagnose configuration, credentials, connectivity and local setup",
+    Long: `Run a series of environment checks and print actionable fixes:
+config file validity and permissions, API key and token scope (viewer and teams
+queries), schema capabilities, template cache writability, clock skew against the
+API, and proxy settings. Exits non-zero when any check fails.`,
+    Example: `  linear-cli doctor
+  linear-cli --json doctor`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        checks := runDoctorChecks(cmd)
+        failed := 0
+        for _, c := range checks { if c.Status == "fail" { failed++ } }
+
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            if err := p.PrintJSON(map[string]any{"checks": checks, "failed": failed}); err != nil { return err }
+        } else {
+            for _, c := range checks {
+                fmt.Printf("%s %-16s %s\n", doctorStatusIcon(c.Status, p.Quiet || p.Plain), c.Name, c.Detail)
+                if c.Fix != "" { fmt.Printf("  %-16s %s %s\n", "", p.Symbol("→", "->"), c.Fix) }
+            }
+        }
+        if failed > 0 { return fmt.Errorf("%d check(s) failed", failed) }
+        return nil
+    },
+}
+
+func runDoctorChecks(cmd *cobra.Command) []doctorCheck {
+    var checks []doctorCheck
+    add := func(c doctorCheck) { checks = append(checks, c) }
+
+    cfg, cfgErr := config.Load()
+    add(checkConfigFile(cfgErr))
+    if cfg == nil { cfg = &config.Config{} }
+    if cfg.Profile != "" {
+        if _, ok := cfg.Profiles[cfg.Profile]; !ok && os.Getenv("LINEAR_API_KEY") == "" {
+            add(doctorCheck{Name: "profile", Status: "fail", Detail: fmt.Sprintf("profile %q is not defined", cfg.Profile), Fix: fmt.Sprintf("run 'linear-cli --profile %s auth login' or unset LINEAR_PROFILE", cfg.Profile)})
+        } else {
+            add(doctorCheck{Name: "profile", Status: "ok", Detail: fmt.Sprintf("using profile %q", cfg.Profile)})
+        }
+    }
+
+    client := newAPIClient(cmd, cfg.APIKey)
+    add(checkProxy(client.Endpoint()))
+    add(checkTemplateCache())
+
+    if cfg.APIKey == "" {
+        if err := cfg.KeyCommandError(); err != nil {
+            add(doctorCheck{Name: "api key", Status: "fail", Detail: err.Error(), Fix: "check the api_key_cmd command in config.toml runs on its own and prints only the key"})
+        } else {
+            add(doctorCheck{Name: "api key", Status: "fail", Detail: "no API key configured", Fix: "run 'linear-cli auth login' or set LINEAR_API_KEY"})
+        }
+        for _, name := range []string{"teams", "schema", "clock"} {
+            add(doctorCheck{Name: name, Status: "skip", Detail: "requires an API key"})
+        }
+        return checks
+    }
+    viewer, err := client.Viewer()
+    if err != nil || viewer == nil || viewer.ID == "" {
+        detail := "viewer query returned no user"
+        if err != nil { detail = err.Error() }
+        add(doctorCheck{Name: "api key", Status: "fail", Detail: detail, Fix: "check connectivity, then replace the key with 'linear-cli auth rotate --token <new key>'"})
+        return checks
+    }
+    add(doctorCheck{Name: "api key", Status: "ok", Detail: fmt.Sprintf("authenticated as %s <%s>", viewer.Name, viewer.Email)})
+
+    if teams, err := client.ListTeams(); err != nil {
+        add(doctorCheck{Name: "teams", Status: "fail", Detail: err.Error(), Fix: "the key may lack read scope; create a personal API key with read access"})
+    } else if len(teams) == 0 {
+        add(doctorCheck{Name: "teams", Status: "warn", Detail: "no teams visible to this key", Fix: "ask a workspace admin to add you to a team"})
+    } else {
+        add(doctorCheck{Name: "teams", Status: "ok", Detail: fmt.Sprintf("%d team(s) visible", len(teams))})
+    }
+
+    if client.SupportsIssueTemplates() {
+        detail := "issue templates supported"
+        if client.SupportsIssueCreateTemplateId() { detail += "; server-side --template-id supported" }
+        add(doctorCheck{Name: "schema", Status: "ok", Detail: detail})
+    } else {
+        add(doctorCheck{Name: "schema", Status: "warn", Detail: "issue templates not exposed by the API", Fix: "use local templates (--templates-source local)"})
+    }
+
+    if serverTime, err := client.ServerTime(); err != nil {
+        add(doctorCheck{Name: "clock", Status: "skip", Detail: "could not read server time: " + err.Error()})
+    } else {
+        add(checkClockSkew(time.Now(), serverTime))
+    }
+    return checks
+}
+
+func checkConfigFile(loadErr error) doctorCheck {
+    p, err := config.Path()
+    if err != nil { return doctorCheck{Name: "config", Status: "fail", Detail: err.Error(), Fix: "set HOME (or XDG_CONFIG_HOME) so a config directory can be resolved"} }
+    if loadErr != nil {
+        return doctorCheck{Name: "config", Status: "fail", Detail: fmt.Sprintf("%s: %v", p, loadErr), Fix: "fix the TOML syntax or remove the file and run 'linear-cli auth login'"}
+    }
+    fi, err := os.Stat(p)
+    if errors.Is(err, os.ErrNotExist) {
+        if os.Getenv("LINEAR_API_KEY") != "" { return doctorCheck{Name: "config", Status: "ok", Detail: "no config file; using LINEAR_API_KEY"} }
+        return doctorCheck{Name: "config", Status: "warn", Detail: p + " does not exist", Fix: "run 'linear-cli auth login' (or rely on LINEAR_API_KEY)"}
+    }
+    if err != nil { return doctorCheck{Name: "config", Status: "fail", Detail: err.Error()} }
+    if runtime.GOOS != "windows" && fi.Mode().Perm()&0o077 != 0 {
+        return doctorCheck{Name: "config", Status: "warn", Detail: fmt.Sprintf("%s is readable by others (%#o)", p, fi.Mode().Perm()), Fix: "chmod 600 " + p}
+    }
+    if enc, _ := config.IsEncrypted(); enc { return doctorCheck{Name: "config", Status: "ok", Detail: p + " (encrypted)"} }
+    return doctorCheck{Name: "config", Status: "ok", Detail: p}
+}
+
+func checkProxy(endpoint string) doctorCheck {
+    req, err := http.NewRequest(http.MethodPost, endpoint, nil)
+    if err != nil { return doctorCheck{Name: "proxy", Status: "fail", Detail: fmt.Sprintf("invalid endpoint %q: %v", endpoint, err), Fix: "correct LINEAR_API_ENDPOINT"} }
+    proxyURL, err := http.ProxyFromEnvironment(req)
+    if err != nil {
+        return doctorCheck{Name: "proxy", Status: "fail", Detail: "invalid proxy setting: " + err.Error(), Fix: "set HTTPS_PROXY to a URL like http://proxy.example.com:3128"}
+    }
+    if proxyURL == nil { return doctorCheck{Name: "proxy", Status: "ok", Detail: "direct connection to " + req.URL.Host} }
+    return doctorCheck{Name: "proxy", Status: "ok", Detail: fmt.Sprintf("%s via %s", req.URL.Host, proxyURL.Redacted())}
+}
+
+func checkTemplateCache() doctorCheck {
+    dir, err := getTemplatesDir()
+    if err != nil { return doctorCheck{Name: "cache", Status: "fail", Detail: err.Error(), Fix: "make the config directory writable"} }
+    f, err := os.CreateTemp(dir, ".doctor-*")
+    if err != nil { return doctorCheck{Name: "cache", Status: "fail", Detail: fmt.Sprintf("%s is not writable: %v", dir, err), Fix: "chmod u+w " + dir} }
+    name := f.Name()
+    f.Close()
+    _ = os.Remove(name)
+    return doctorCheck{Name: "cache", Status: "ok", Detail: filepath.Clean(dir) + " is writable"}
+}
+
+// checkClockSkew compares the local clock with the API's; the Date header has
+// one-second resolution, so small differences are ignored.
+func checkClockSkew(local, server time.Time) doctorCheck {
+    skew := local.Sub(server)
+    if skew < 0 { skew = -skew }
+    if skew > maxClockSkew {
+        dir := "ahead of"
+        if local.Before(server) { dir = "behind" }
+        return doctorCheck{Name: "clock", Status: "warn", Detail: fmt.Sprintf("local clock is %s %s the API", skew.Round(time.Second), dir), Fix: "enable NTP time sync; relative dates (--since 7d) and reminders depend on it"}
+    }
+    return doctorCheck{Name: "clock", Status: "ok", Detail: fmt.Sprintf("within %s of the API", maxClockSkew)}
+}
+
+func doctorStatusIcon(status string, quiet bool) string {
+    if quiet { return fmt.Sprintf("[%s]", strings.ToUpper(status)) }
+    switch status {
+    case "ok":
+        return "✅"
+    case "warn":
+        return "⚠️ "
+    case "fail":
+        return "❌"
+    default:
+        return "➖"
+    }
+}
+
+func init() {
+    rootCmd.AddCommand(doctorCmd)
+}
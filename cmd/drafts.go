@@ -0,0 +1,283 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Draft is a fully specified issue saved locally by 'issues create --draft'. Team,
+// project, assignee and labels are stored as typed so drafts can be written
+// offline; they are resolved against Linear on 'drafts submit'.
+type Draft struct {
+    Team        string            `json:"team"`
+    Title       string            `json:"title"`
+    Description string            `json:"description,omitempty"`
+    Template    string            `json:"template,omitempty"`
+    Sections    map[string]string `json:"sections,omitempty"`
+    Project     string            `json:"project,omitempty"`
+    Assignee    string            `json:"assignee,omitempty"`
+    Labels      []string          `json:"labels,omitempty"`
+    Priority    *int              `json:"priority,omitempty"`
+    Estimate    *int              `json:"estimate,omitempty"`
+    CreatedAt   time.Time         `json:"created_at"`
+    UpdatedAt   time.Time         `json:"updated_at,omitempty"`
+}
+
+var draftsCmd = &cobra.Command{
+    Use:   "drafts",
+    Short: "Manage local issue drafts created by 'issues create --draft'",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var draftsListCmd = &cobra.Command{
+    Use:   "list",
+    Short: "List saved drafts",
+    RunE: func(cmd *cobra.Command, args []string) error {
+        items, err := loadDrafts()
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(items) }
+        if len(items) == 0 {
+            fmt.Println("No drafts")
+            return nil
+        }
+        rows := make([][]string, 0, len(items))
+        for i, d := range items {
+            rows = append(rows, []string{strconv.Itoa(i + 1), strings.ToUpper(d.Team), truncate(d.Title, 60), draftSummary(d), ui.Time(d.CreatedAt)})
+        }
+        return p.Table([]string{"#", "Team", "Title", "Fields", "Saved"}, rows)
+    },
+}
+
+var draftsEditCmd = &cobra.Command{
+    Use:   "edit <n>",
+    Short: "Edit a draft's fields and description in $EDITOR",
+    Long: `Open draft <n> (as numbered by 'drafts list') in $EDITOR. Fields are edited as
+front matter above the description:
+
+  ---
+  team: ENG
+  title: Add search
+  labels: [feature]
+  priority: high
+  ---
+  Description...
+
+Template sections saved with --sections are kept unchanged.`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        items, idx, err := loadDraftArg(args[0])
+        if err != nil { return err }
+        edited, err := openInEditor(formatDraft(items[idx]))
+        if err != nil { return err }
+        d, err := parseDraft(edited, items[idx])
+        if err != nil { return err }
+        d.UpdatedAt = time.Now()
+        items[idx] = d
+        if err := saveDrafts(items); err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(d) }
+        fmt.Printf("Updated draft %d: %s\n", idx+1, d.Title)
+        return nil
+    },
+}
+
+var draftsSubmitCmd = &cobra.Command{
+    Use:   "submit <n>",
+    Short: "Create the issue from a draft and remove the draft",
+    Args:  cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        items, idx, err := loadDraftArg(args[0])
+        if err != nil { return err }
+        d := items[idx]
+        client := newAPIClient(cmd, cfg.APIKey)
+        if strings.TrimSpace(d.Template) != "" && len(d.Sections) > 0 {
+            // Section drafts go through the same server-side template flow as create
+            if err := createIssueAIFriendly(client, d.Team, d.Template, d.Title, d.Sections, templateFields{Labels: d.Labels, Priority: d.Priority, Assignee: d.Assignee, Project: d.Project, Estimate: d.Estimate}, cmd); err != nil { return err }
+            return removeDraft(items, idx)
+        }
+        created, err := submitDraft(client, d)
+        if err != nil { return err }
+        if err := removeDraft(items, idx); err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(created) }
+        fmt.Printf("Created %s: %s\n", created.Identifier, created.URL)
+        return nil
+    },
+}
+
+var draftsRemoveCmd = &cobra.Command{
+    Use:     "remove <n>",
+    Aliases: []string{"rm"},
+    Short:   "Delete a draft without creating it",
+    Args:    cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        items, idx, err := loadDraftArg(args[0])
+        if err != nil { return err }
+        title := items[idx].Title
+        if err := removeDraft(items, idx); err != nil { return err }
+        fmt.Printf("Removed draft %d: %s\n", idx+1, title)
+        return nil
+    },
+}
+
+// saveDraftFromCreate appends d to the drafts file on behalf of 'issues create --draft'
+func saveDraftFromCreate(cmd *cobra.Command, d Draft) error {
+    d.Team = strings.ToUpper(strings.TrimSpace(d.Team))
+    d.CreatedAt = time.Now()
+    items, err := loadDrafts()
+    if err != nil { return err }
+    items = append(items, d)
+    if err := saveDrafts(items); err != nil { return err }
+    p := printer(cmd)
+    if p.JSONEnabled() { return p.PrintJSON(map[string]any{"draft": len(items), "saved": d}) }
+    fmt.Printf("Saved draft %d: %s (submit with 'linear-cli drafts submit %d')\n", len(items), d.Title, len(items))
+    return nil
+}
+
+// submitDraft resolves a draft's team, project, assignee and labels and creates the issue
+func submitDraft(client *api.Client, d Draft) (*api.IssueDetails, error) {
+    teamKey := strings.ToUpper(strings.TrimSpace(d.Team))
+    in := api.IssueCreateInput{Title: d.Title, Description: d.Description, Priority: d.Priority, Estimate: d.Estimate}
+    rc, _ := teamCreateContext(client, teamKey)
+    var states []api.State
+    if rc != nil {
+        in.TeamID, states = rc.Team.ID, rc.States
+    } else {
+        t, err := client.TeamByKey(teamKey)
+        if err != nil { return nil, err }
+        if t == nil { return nil, fmt.Errorf("team with key %s not found", teamKey) }
+        in.TeamID = t.ID
+        if states, err = client.TeamStates(t.ID); err != nil { return nil, err }
+    }
+    in.StateID = defaultStateID(states)
+    if d.Project != "" {
+        pr, err := client.ResolveProject(d.Project)
+        if err != nil { return nil, err }
+        if pr == nil { return nil, fmt.Errorf("project '%s' not found", d.Project) }
+        in.ProjectID = pr.ID
+    }
+    if d.Assignee != "" {
+        var u *api.User
+        var err error
+        if rc != nil { u, err = rc.MemberByName(d.Assignee) }
+        if u == nil && err == nil { u, err = client.ResolveUser(d.Assignee) }
+        if err != nil { return nil, err }
+        if u == nil { return nil, fmt.Errorf("assignee '%s' not found", d.Assignee) }
+        in.AssigneeID = u.ID
+    }
+    for _, name := range d.Labels {
+        var l *api.Label
+        if rc != nil { l = rc.LabelByName(name) }
+        if l == nil {
+            var err error
+            if l, err = client.ResolveLabelForTeam(name, in.TeamID); err != nil { return nil, err }
+        }
+        if l == nil { return nil, fmt.Errorf("label '%s' not found", name) }
+        if !containsString(in.LabelIDs, l.ID) { in.LabelIDs = append(in.LabelIDs, l.ID) }
+    }
+    return client.CreateIssueAdvanced(in)
+}
+
+// draftSummary lists the optional fields a draft sets, for the list table
+func draftSummary(d Draft) string {
+    var parts []string
+    if d.Template != "" { parts = append(parts, "template "+d.Template) }
+    if d.Project != "" { parts = append(parts, "project "+d.Project) }
+    if d.Assignee != "" { parts = append(parts, "@"+d.Assignee) }
+    if len(d.Labels) > 0 { parts = append(parts, strings.Join(d.Labels, ",")) }
+    if d.Priority != nil { parts = append(parts, priorityName(*d.Priority)) }
+    if d.Estimate != nil { parts = append(parts, fmt.Sprintf("%dpt", *d.Estimate)) }
+    if len(parts) == 0 { return "-" }
+    return strings.Join(parts, " · ")
+}
+
+// formatDraft renders a draft as front matter plus description for editing
+func formatDraft(d Draft) string {
+    var b strings.Builder
+    b.WriteString("---\n")
+    fmt.Fprintf(&b, "team: %s\n", d.Team)
+    fmt.Fprintf(&b, "title: %s\n", d.Title)
+    fmt.Fprintf(&b, "project: %s\n", d.Project)
+    fmt.Fprintf(&b, "assignee: %s\n", d.Assignee)
+    fmt.Fprintf(&b, "labels: %s\n", yamlFlowListString(d.Labels))
+    if d.Priority != nil { fmt.Fprintf(&b, "priority: %s\n", strings.ToLower(priorityName(*d.Priority))) } else { b.WriteString("priority:\n") }
+    if d.Estimate != nil { fmt.Fprintf(&b, "estimate: %d\n", *d.Estimate) } else { b.WriteString("estimate:\n") }
+    b.WriteString("---\n")
+    b.WriteString(d.Description)
+    return b.String()
+}
+
+// parseDraft reads an edited draft back; fields not present in the text are taken from orig
+func parseDraft(text string, orig Draft) (Draft, error) {
+    meta, body, err := parseFrontMatter(text)
+    if err != nil { return orig, fmt.Errorf("draft front matter: %w; nothing was changed", err) }
+    if len(meta) == 0 { return orig, errors.New("draft front matter is missing or malformed; nothing was changed") }
+    f, err := templateFieldsFromMeta(meta)
+    if err != nil { return orig, err }
+    d := orig
+    if v, ok := meta["team"]; ok { d.Team = strings.ToUpper(strings.TrimSpace(v)) }
+    if v, ok := meta["title"]; ok { d.Title = strings.TrimSpace(v) }
+    d.Project, d.Assignee, d.Labels, d.Priority, d.Estimate = f.Project, f.Assignee, f.Labels, f.Priority, f.Estimate
+    d.Description = strings.TrimSpace(body)
+    if d.Team == "" { return orig, errors.New("draft needs a team") }
+    if d.Title == "" { return orig, errors.New("draft needs a title") }
+    return d, nil
+}
+
+func loadDraftArg(arg string) ([]Draft, int, error) {
+    items, err := loadDrafts()
+    if err != nil { return nil, 0, err }
+    n, err := strconv.Atoi(strings.TrimSpace(arg))
+    if err != nil || n < 1 || n > len(items) { return nil, 0, fmt.Errorf("no draft %s (see 'linear-cli drafts list')", arg) }
+    return items, n - 1, nil
+}
+
+func removeDraft(items []Draft, idx int) error {
+    return saveDrafts(append(items[:idx:idx], items[idx+1:]...))
+}
+
+func draftsPath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "drafts.json"), nil
+}
+
+func loadDrafts() ([]Draft, error) {
+    p, err := draftsPath()
+    if err != nil { return nil, err }
+    b, err := os.ReadFile(p)
+    if errors.Is(err, os.ErrNotExist) { return []Draft{}, nil }
+    if err != nil { return nil, err }
+    var items []Draft
+    if err := json.Unmarshal(b, &items); err != nil { return nil, fmt.Errorf("failed to parse %s: %w", p, err) }
+    return items, nil
+}
+
+func saveDrafts(items []Draft) error {
+    p, err := draftsPath()
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    b, err := json.MarshalIndent(items, "", "  ")
+    if err != nil { return err }
+    return os.WriteFile(p, b, 0o600)
+}
+
+func init() {
+    rootCmd.AddCommand(draftsCmd)
+    addOutputTemplateFlags(draftsListCmd)
+    draftsCmd.AddCommand(draftsListCmd, draftsEditCmd, draftsSubmitCmd, draftsRemoveCmd)
+}
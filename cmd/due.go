@@ -0,0 +1,130 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesDueCmd = &cobra.Command{
+    Use:   "due --team <key> [--next 14d]",
+    Short: "Calendar of upcoming and overdue issues",
+    Long: `List a team's open issues by due date: overdue issues first, then one section
+per day from today through --next (7d by default), so the week can be planned
+from the terminal. Days without due issues are shown as empty.`,
+    Example: `  linear-cli issues due --team ENG
+  linear-cli issues due --team ENG --next 14d --mine
+  linear-cli --json issues due --team ENG --next 2w`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        next, _ := cmd.Flags().GetString("next")
+        mine, _ := cmd.Flags().GetBool("mine")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        days, err := parseDayCount(next)
+        if err != nil { return err }
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := cachedTeamByKeyOrError(client, teamKey)
+        if err != nil { return err }
+        var assigneeID string
+        if mine {
+            v, err := client.Viewer()
+            if err != nil { return err }
+            assigneeID = v.ID
+        }
+
+        now := time.Now()
+        today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+        issues, err := client.ListDueIssues(team.ID, assigneeID, today.AddDate(0, 0, days-1))
+        if err != nil { return err }
+        cal := buildDueCalendar(issues, today, days)
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"team": team.Key, "overdue": cal.Overdue, "days": cal.Days}) }
+        if len(cal.Overdue) > 0 {
+            fmt.Printf("Overdue (%d)\n", len(cal.Overdue))
+            for _, iss := range cal.Overdue {
+                due, _ := time.ParseInLocation("2006-01-02", iss.DueDate, today.Location())
+                late := int(today.Sub(due).Hours() / 24)
+                printDueIssue(iss, fmt.Sprintf("%dd late", late))
+            }
+            fmt.Println()
+        }
+        for i, d := range cal.Days {
+            heading := d.date.Format("Mon Jan 02")
+            if i == 0 { heading += " (today)" }
+            if len(d.Issues) == 0 {
+                fmt.Printf("%s  -\n", heading)
+                continue
+            }
+            fmt.Println(heading)
+            for _, iss := range d.Issues { printDueIssue(iss, "") }
+        }
+        return nil
+    },
+}
+
+// dueCalendar buckets due issues into overdue ones and one entry per day
+type dueCalendar struct {
+    Overdue []api.DueIssue
+    Days    []dueDay
+}
+
+type dueDay struct {
+    Date   string         `json:"date"`
+    Issues []api.DueIssue `json:"issues"`
+    date   time.Time
+}
+
+// buildDueCalendar places issues (sorted by due date) into the days from today
+// for the given number of days; anything due earlier is overdue
+func buildDueCalendar(issues []api.DueIssue, today time.Time, days int) dueCalendar {
+    cal := dueCalendar{Overdue: []api.DueIssue{}, Days: make([]dueDay, days)}
+    index := map[string]int{}
+    for i := range cal.Days {
+        d := today.AddDate(0, 0, i)
+        cal.Days[i] = dueDay{Date: d.Format("2006-01-02"), Issues: []api.DueIssue{}, date: d}
+        index[cal.Days[i].Date] = i
+    }
+    for _, iss := range issues {
+        if i, ok := index[iss.DueDate]; ok {
+            cal.Days[i].Issues = append(cal.Days[i].Issues, iss)
+        } else if iss.DueDate < cal.Days[0].Date {
+            cal.Overdue = append(cal.Overdue, iss)
+        }
+    }
+    return cal
+}
+
+func printDueIssue(iss api.DueIssue, note string) {
+    who := "unassigned"
+    if iss.Assignee != nil { who = iss.Assignee.Name }
+    line := fmt.Sprintf("  %-9s %-48s %-12s %s", iss.Identifier, truncate(iss.Title, 48), iss.StateName, who)
+    if iss.Priority > 0 && iss.Priority <= 2 { line += "  " + priorityName(iss.Priority) }
+    if note != "" { line += "  (" + note + ")" }
+    fmt.Println(line)
+}
+
+// parseDayCount reads a window in the syntax of parseSpan ("14d", "2w", "36h"),
+// or a bare number of days, as whole days; part of a day counts as one
+func parseDayCount(s string) (int, error) {
+    if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && n > 0 { return n, nil }
+    days, d, ok := parseSpan(s)
+    if !ok { return 0, fmt.Errorf("invalid --next '%s' (try 7d, 14d, 2w or 36h)", s) }
+    return days + int((d+24*time.Hour-1)/(24*time.Hour)), nil
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesDueCmd)
+    issuesDueCmd.Flags().String("team", "", "Team key (required)")
+    issuesDueCmd.Flags().String("next", "7d", "How far ahead to look, from today: 14d, 2w, ...")
+    issuesDueCmd.Flags().Bool("mine", false, "Only issues assigned to you")
+}
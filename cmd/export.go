@@ -0,0 +1,140 @@
+package cmd
+
+import (
+    "bufio"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+    Use:   "export",
+    Short: "Export workspace data",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var exportWorkspaceCmd = &cobra.Command{
+    Use:   "workspace --out <dir>",
+    Short: "Snapshot teams, projects, labels, states, issues and comments to JSONL files",
+    Long: `Write a snapshot of the workspace into a directory: one JSONL file per resource
+(teams, users, states, labels, projects, issues, comments; one object per line as
+returned by the API) plus manifest.json with the export time, workspace and
+per-file counts. Archived issues are included. Use --skip to leave resources out.
+An existing export in the directory is only overwritten with --force.`,
+    Example: `  linear-cli export workspace --out backup/
+  linear-cli export workspace --out snapshot --skip comments,users`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        out, _ := cmd.Flags().GetString("out")
+        skip, _ := cmd.Flags().GetStringSlice("skip")
+        force, _ := cmd.Flags().GetBool("force")
+        if strings.TrimSpace(out) == "" { return errors.New("--out is required") }
+        resources, err := exportSelection(skip)
+        if err != nil { return err }
+        dir := expandUserPath(out)
+        if _, err := os.Stat(filepath.Join(dir, "manifest.json")); err == nil && !force {
+            return fmt.Errorf("%s already holds an export; pass --force to overwrite it", out)
+        }
+        if err := os.MkdirAll(dir, 0o755); err != nil { return err }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        m := exportManifest{Format: "linear-cli-export", Version: 1, ExportedAt: time.Now().UTC(), Files: []exportFile{}}
+        if org, err := client.GetOrganization(); err == nil { m.Workspace = &exportWorkspace{ID: org.ID, Name: org.Name, URLKey: org.URLKey} }
+
+        prog := ui.StartProgress("Exporting workspace", len(resources))
+        for _, r := range resources {
+            n, err := exportResource(client, r, dir, func(count int) { prog.Update("%s: %d", r.Name, count) })
+            if err != nil { prog.Done("Export failed"); return err }
+            m.Files = append(m.Files, exportFile{Resource: r.Name, File: r.Name + ".jsonl", Count: n})
+            prog.Step("%s: %d", r.Name, n)
+        }
+        b, err := json.MarshalIndent(m, "", "  ")
+        if err != nil { return err }
+        if err := os.WriteFile(filepath.Join(dir, "manifest.json"), append(b, '\n'), 0o644); err != nil { return err }
+        prog.Done("Exported %d resource(s) to %s", len(m.Files), out)
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(m) }
+        rows := make([][]string, 0, len(m.Files))
+        for _, f := range m.Files { rows = append(rows, []string{f.File, fmt.Sprint(f.Count)}) }
+        return p.Table([]string{"File", "Records"}, rows)
+    },
+}
+
+// exportManifest describes an export directory; Version changes only when the
+// layout does
+type exportManifest struct {
+    Format     string           `json:"format"`
+    Version    int              `json:"version"`
+    ExportedAt time.Time        `json:"exportedAt"`
+    Workspace  *exportWorkspace `json:"workspace,omitempty"`
+    Files      []exportFile     `json:"files"`
+}
+
+type exportWorkspace struct {
+    ID     string `json:"id"`
+    Name   string `json:"name"`
+    URLKey string `json:"urlKey"`
+}
+
+type exportFile struct {
+    Resource string `json:"resource"`
+    File     string `json:"file"`
+    Count    int    `json:"count"`
+}
+
+// exportSelection returns the export resources minus the skipped ones
+func exportSelection(skip []string) ([]api.ExportResource, error) {
+    known := map[string]bool{}
+    for _, r := range api.ExportResources { known[r.Name] = true }
+    skipped := map[string]bool{}
+    for _, s := range skip {
+        s = strings.ToLower(strings.TrimSpace(s))
+        if s == "" { continue }
+        if !known[s] { return nil, fmt.Errorf("unknown resource '%s' in --skip (have %s)", s, strings.Join(sortedKeys(known), ", ")) }
+        skipped[s] = true
+    }
+    var out []api.ExportResource
+    for _, r := range api.ExportResources {
+        if !skipped[r.Name] { out = append(out, r) }
+    }
+    if len(out) == 0 { return nil, errors.New("--skip leaves nothing to export") }
+    return out, nil
+}
+
+// exportResource streams one resource into <dir>/<name>.jsonl via a temporary
+// file, so an interrupted export never leaves a truncated file behind
+func exportResource(client *api.Client, r api.ExportResource, dir string, page func(int)) (int, error) {
+    path := filepath.Join(dir, r.Name+".jsonl")
+    f, err := os.CreateTemp(dir, "."+r.Name+"-*.jsonl")
+    if err != nil { return 0, err }
+    defer os.Remove(f.Name())
+    w := bufio.NewWriter(f)
+    n, err := client.EachExportNode(r, func(node json.RawMessage) error {
+        if _, err := w.Write(node); err != nil { return err }
+        return w.WriteByte('\n')
+    }, page)
+    if err == nil { err = w.Flush() }
+    if cerr := f.Close(); err == nil { err = cerr }
+    if err != nil { return n, err }
+    return n, os.Rename(f.Name(), path)
+}
+
+func init() {
+    rootCmd.AddCommand(exportCmd)
+    exportCmd.AddCommand(exportWorkspaceCmd)
+    exportWorkspaceCmd.Flags().String("out", "", "Directory to write the export to (required)")
+    exportWorkspaceCmd.Flags().StringSlice("skip", nil, "Resources to leave out: teams, users, states, labels, projects, issues, comments")
+    exportWorkspaceCmd.Flags().Bool("force", false, "Overwrite an existing export in --out")
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var favoritesCmd = &cobra.Command{
+    Use:   "favorites",
+    Short: "List and add Linear sidebar favorites",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var favoritesListCmd = &cobra.Command{
+    Use:   "list",
+    Short: "List your favorites in sidebar order",
+    Example: `  linear-cli favorites list
+  linear-cli --json favorites list`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        favs, err := client.ListFavorites()
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(favs) }
+        if len(favs) == 0 {
+            fmt.Println("No favorites")
+            return nil
+        }
+        rows := make([][]string, 0, len(favs))
+        for _, f := range favs { rows = append(rows, []string{f.Type, truncate(f.Name, 60), f.URL}) }
+        return p.Table([]string{"Type", "Name", "URL"}, rows)
+    },
+}
+
+var favoritesAddCmd = &cobra.Command{
+    Use:   "add issue|project <key-or-name>",
+    Short: "Add an issue or a project to your favorites",
+    Long: `Add an issue (by key) or a project (by name or id) to your Linear sidebar
+favorites. Adding something already there is a no-op. Favorites are removed in
+Linear itself; the CLI does not send delete mutations.`,
+    Example: `  linear-cli favorites add issue ENG-10
+  linear-cli favorites add project "Website"`,
+    Args: cobra.ExactArgs(2),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        kind := strings.ToLower(strings.TrimSpace(args[0]))
+        if kind != "issue" && kind != "project" { return fmt.Errorf("unknown favorite type '%s' (use issue|project)", args[0]) }
+        client := newAPIClient(cmd, cfg.APIKey)
+        var issueID, projectID, name string
+        if kind == "issue" {
+            iss, err := resolveIssue(client, args[1])
+            if err != nil { return err }
+            issueID, name = iss.ID, iss.Identifier
+        } else {
+            pr, err := client.ResolveProject(args[1])
+            if err != nil { return err }
+            if pr == nil { return fmt.Errorf("project '%s' not found", args[1]) }
+            projectID, name = pr.ID, pr.Name
+        }
+
+        p := printer(cmd)
+        favs, err := client.ListFavorites()
+        if err != nil { return err }
+        if f := findFavorite(favs, issueID, projectID); f != nil {
+            if p.JSONEnabled() { return p.PrintJSON(map[string]any{"favorite": f, "added": false}) }
+            fmt.Printf("%s is already a favorite\n", name)
+            return nil
+        }
+        f, err := client.AddFavorite(issueID, projectID)
+        if err != nil { return err }
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"favorite": f, "added": true}) }
+        fmt.Printf("Added %s to favorites\n", name)
+        return nil
+    },
+}
+
+// findFavorite returns the favorite of the given issue or project, if any
+func findFavorite(favs []api.Favorite, issueID, projectID string) *api.Favorite {
+    for i, f := range favs {
+        if (issueID != "" && f.IssueID == issueID) || (projectID != "" && f.ProjectID == projectID) { return &favs[i] }
+    }
+    return nil
+}
+
+// favoriteIssueIDs lists the ids of favorited issues
+func favoriteIssueIDs(favs []api.Favorite) []string {
+    ids := []string{}
+    for _, f := range favs { if f.IssueID != "" { ids = append(ids, f.IssueID) } }
+    return ids
+}
+
+func init() {
+    rootCmd.AddCommand(favoritesCmd)
+    favoritesCmd.AddCommand(favoritesListCmd, favoritesAddCmd)
+    addOutputTemplateFlags(favoritesListCmd)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+    "errors"
+    "os"
+    "path/filepath"
+    "time"
+)
+
+// errLockHeld is what tryLockFile reports while another process holds the lock
+var errLockHeld = errors.New("lock is held")
+
+// lockFile takes the OS lock on path (creating the file if needed), polling
+// for up to wait while another process holds it. The file itself stays in
+// place; only the lock on it matters, and the OS releases that when its holder
+// exits, so a crashed run never leaves it held.
+func lockFile(path string, wait time.Duration) (func(), error) {
+    f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+    if err != nil { return nil, err }
+    deadline := time.Now().Add(wait)
+    for {
+        err := tryLockFile(f)
+        if err == nil { return func() { _ = unlockFile(f); f.Close() }, nil }
+        if !errors.Is(err, errLockHeld) || time.Now().After(deadline) {
+            f.Close()
+            return nil, err
+        }
+        time.Sleep(50 * time.Millisecond)
+    }
+}
+
+// writeFileAtomic replaces path with data by writing a temp file next to it
+// and renaming it over the old one, so readers never see a partial file
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+    tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+    if err != nil { return err }
+    defer os.Remove(tmp.Name())
+    if _, err := tmp.Write(data); err != nil {
+        tmp.Close()
+        return err
+    }
+    if err := tmp.Close(); err != nil { return err }
+    if err := os.Chmod(tmp.Name(), perm); err != nil { return err }
+    return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,21 @@
+//go:build unix
+
+package cmd
+
+import (
+    "errors"
+    "os"
+
+    "golang.org/x/sys/unix"
+)
+
+// tryLockFile takes an exclusive advisory lock on f without waiting; it
+// reports errLockHeld when another open file holds it. The kernel drops the
+// lock when f is closed or the process exits.
+func tryLockFile(f *os.File) error {
+    err := unix.Flock(int(f.Fd()), unix.LOCK_EX|unix.LOCK_NB)
+    if errors.Is(err, unix.EWOULDBLOCK) { return errLockHeld }
+    return err
+}
+
+func unlockFile(f *os.File) error { return unix.Flock(int(f.Fd()), unix.LOCK_UN) }
@@ -0,0 +1,23 @@
+//go:build windows
+
+package cmd
+
+import (
+    "errors"
+    "os"
+
+    "golang.org/x/sys/windows"
+)
+
+// tryLockFile takes an exclusive lock on f without waiting; it reports
+// errLockHeld when another handle holds it. Windows drops the lock when f is
+// closed or the process exits.
+func tryLockFile(f *os.File) error {
+    err := windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, new(windows.Overlapped))
+    if errors.Is(err, windows.ERROR_LOCK_VIOLATION) { return errLockHeld }
+    return err
+}
+
+func unlockFile(f *os.File) error {
+    return windows.UnlockFileEx(windows.Handle(f.Fd()), 0, 1, 0, new(windows.Overlapped))
+}
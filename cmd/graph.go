@@ -0,0 +1,182 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "regexp"
+    "sort"
+    "strconv"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesGraphCmd = &cobra.Command{
+    Use:   "graph",
+    Short: "Emit a project's dependency graph as Graphviz DOT or Mermaid",
+    Long: `Draw a project's blocking relations (A blocks B) and parent/child links.
+Issues outside the project that are linked from it are drawn dashed; completed
+and canceled issues are greyed out. Render DOT with Graphviz
+(dot -Tsvg graph.dot > graph.svg) or paste Mermaid into Markdown docs.`,
+    Example: `  linear-cli issues graph --project "Payments" --format dot | dot -Tsvg > payments.svg
+  linear-cli issues graph --project "Payments" --format mermaid`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        project, _ := cmd.Flags().GetString("project")
+        format, _ := cmd.Flags().GetString("format")
+        if strings.TrimSpace(project) == "" { return errors.New("--project is required") }
+        format = strings.ToLower(strings.TrimSpace(format))
+        if format != "dot" && format != "mermaid" { return fmt.Errorf("invalid --format %q (use dot|mermaid)", format) }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        pr, err := client.ResolveProject(project)
+        if err != nil { return err }
+        if pr == nil { return fmt.Errorf("project '%s' not found", project) }
+        issues, err := client.ListProjectIssueGraph(pr.ID)
+        if err != nil { return err }
+        g := buildIssueGraph(issues)
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(g) }
+        if format == "dot" {
+            writeGraphDOT(os.Stdout, pr.Name, g)
+        } else {
+            writeGraphMermaid(os.Stdout, g)
+        }
+        return nil
+    },
+}
+
+type graphNode struct {
+    ID        string `json:"id"`
+    Title     string `json:"title"`
+    State     string `json:"state"`
+    StateType string `json:"stateType"`
+    // External nodes are linked from the project but belong to another one
+    External bool `json:"external,omitempty"`
+}
+
+type graphEdge struct {
+    From string `json:"from"`
+    To   string `json:"to"`
+    Kind string `json:"kind"` // blocks (from blocks to) or parent (from is the parent of to)
+}
+
+type issueGraph struct {
+    Nodes []graphNode `json:"nodes"`
+    Edges []graphEdge `json:"edges"`
+}
+
+// buildIssueGraph collects nodes and de-duplicated edges in a stable order
+func buildIssueGraph(issues []api.GraphIssue) issueGraph {
+    nodes := map[string]graphNode{}
+    for _, iss := range issues {
+        nodes[iss.Identifier] = graphNode{ID: iss.Identifier, Title: iss.Title, State: iss.StateName, StateType: iss.StateType}
+    }
+    addExternal := func(r api.RelatedIssue) {
+        if _, ok := nodes[r.Identifier]; !ok {
+            nodes[r.Identifier] = graphNode{ID: r.Identifier, Title: r.Title, State: r.StateName, StateType: r.StateType, External: true}
+        }
+    }
+    seen := map[graphEdge]bool{}
+    g := issueGraph{Nodes: []graphNode{}, Edges: []graphEdge{}}
+    addEdge := func(e graphEdge) {
+        if !seen[e] { seen[e] = true; g.Edges = append(g.Edges, e) }
+    }
+    for _, iss := range issues {
+        if iss.Parent != nil {
+            addExternal(*iss.Parent)
+            addEdge(graphEdge{From: iss.Parent.Identifier, To: iss.Identifier, Kind: "parent"})
+        }
+        for _, b := range iss.Blocks {
+            addExternal(b)
+            addEdge(graphEdge{From: iss.Identifier, To: b.Identifier, Kind: "blocks"})
+        }
+    }
+    for _, n := range nodes { g.Nodes = append(g.Nodes, n) }
+    sort.Slice(g.Nodes, func(i, j int) bool { return identifierLess(g.Nodes[i].ID, g.Nodes[j].ID) })
+    sort.SliceStable(g.Edges, func(i, j int) bool {
+        a, b := g.Edges[i], g.Edges[j]
+        if a.From != b.From { return identifierLess(a.From, b.From) }
+        return identifierLess(a.To, b.To)
+    })
+    return g
+}
+
+// identifierLess orders TEAM-9 before TEAM-10
+func identifierLess(a, b string) bool {
+    ta, na, _ := strings.Cut(a, "-")
+    tb, nb, _ := strings.Cut(b, "-")
+    if ta != tb { return ta < tb }
+    ia, errA := strconv.Atoi(na)
+    ib, errB := strconv.Atoi(nb)
+    if errA != nil || errB != nil { return a < b }
+    return ia < ib
+}
+
+func graphNodeClosed(n graphNode) bool { return n.StateType == "completed" || n.StateType == "canceled" }
+
+func writeGraphDOT(w io.Writer, name string, g issueGraph) {
+    q := func(s string) string { return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"` }
+    fmt.Fprintf(w, "digraph %s {\n", q(name))
+    fmt.Fprintln(w, "  rankdir=LR;")
+    fmt.Fprintln(w, `  node [shape=box, style=rounded, fontname="Helvetica"];`)
+    for _, n := range g.Nodes {
+        var attrs []string
+        attrs = append(attrs, "label="+q(n.ID+"\n"+truncate(n.Title, 40)+"\n["+n.State+"]"))
+        style := []string{"rounded"}
+        if n.External { style = append(style, "dashed") }
+        if graphNodeClosed(n) { style = append(style, "filled"); attrs = append(attrs, `fillcolor="#eeeeee"`, `fontcolor="#888888"`) }
+        attrs = append(attrs, "style="+q(strings.Join(style, ",")))
+        fmt.Fprintf(w, "  %s [%s];\n", q(n.ID), strings.Join(attrs, ", "))
+    }
+    for _, e := range g.Edges {
+        if e.Kind == "parent" {
+            fmt.Fprintf(w, "  %s -> %s [style=dotted, arrowhead=none, label=\"parent\"];\n", q(e.From), q(e.To))
+        } else {
+            fmt.Fprintf(w, "  %s -> %s [label=\"blocks\", color=\"#d9480f\"];\n", q(e.From), q(e.To))
+        }
+    }
+    fmt.Fprintln(w, "}")
+}
+
+var reMermaidID = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func writeGraphMermaid(w io.Writer, g issueGraph) {
+    id := func(s string) string { return reMermaidID.ReplaceAllString(s, "_") }
+    label := func(s string) string { return strings.NewReplacer(`"`, "#quot;", "\n", " ").Replace(s) }
+    fmt.Fprintln(w, "flowchart LR")
+    var closed, external []string
+    for _, n := range g.Nodes {
+        fmt.Fprintf(w, "  %s[\"%s: %s\"]\n", id(n.ID), n.ID, label(truncate(n.Title, 40)))
+        if graphNodeClosed(n) { closed = append(closed, id(n.ID)) }
+        if n.External { external = append(external, id(n.ID)) }
+    }
+    for _, e := range g.Edges {
+        if e.Kind == "parent" {
+            fmt.Fprintf(w, "  %s -.-|parent| %s\n", id(e.From), id(e.To))
+        } else {
+            fmt.Fprintf(w, "  %s -->|blocks| %s\n", id(e.From), id(e.To))
+        }
+    }
+    if len(closed) > 0 {
+        fmt.Fprintln(w, "  classDef closed fill:#eee,color:#888")
+        fmt.Fprintf(w, "  class %s closed\n", strings.Join(closed, ","))
+    }
+    if len(external) > 0 {
+        fmt.Fprintln(w, "  classDef external stroke-dasharray:4 3")
+        fmt.Fprintf(w, "  class %s external\n", strings.Join(external, ","))
+    }
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesGraphCmd)
+    issuesGraphCmd.Flags().String("project", "", "Project name or id (required)")
+    issuesGraphCmd.Flags().String("format", "mermaid", "Output format: dot|mermaid")
+}
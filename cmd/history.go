@@ -0,0 +1,79 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesHistoryCmd = &cobra.Command{
+    Use:   "history <issue-key>",
+    Short: "Show an issue's audit trail (state, assignee, label changes)",
+    Example: `  linear-cli issues history ENG-3
+  linear-cli --json issues history ENG-3`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+        entries, err := client.IssueHistoryEntries(iss.ID)
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"issue": iss.Identifier, "history": entries}) }
+        rows := historyRows(entries)
+        if len(rows) == 0 {
+            fmt.Printf("No recorded changes for %s\n", iss.Identifier)
+            return nil
+        }
+        return p.Table([]string{"When", "Who", "Change"}, rows)
+    },
+}
+
+// historyRows flattens entries into one table row per described change
+func historyRows(entries []api.HistoryEntry) [][]string {
+    var rows [][]string
+    for _, e := range entries {
+        who := "system"
+        if e.Actor != nil && e.Actor.Name != "" { who = e.Actor.Name }
+        for _, change := range describeHistoryEntry(e) {
+            rows = append(rows, []string{ui.Time(e.At), who, change})
+        }
+    }
+    return rows
+}
+
+// describeHistoryEntry renders the state, assignee, label, priority and title
+// changes of one entry; entries without any of those yield nothing.
+func describeHistoryEntry(e api.HistoryEntry) []string {
+    var out []string
+    arrow := ui.Symbol("→", "->")
+    if e.ToState != "" && e.FromState != e.ToState {
+        if e.FromState == "" { out = append(out, "state "+arrow+" "+e.ToState) } else { out = append(out, fmt.Sprintf("state %s %s %s", e.FromState, arrow, e.ToState)) }
+    }
+    switch {
+    case e.FromAssignee == "" && e.ToAssignee != "":
+        out = append(out, "assigned to "+e.ToAssignee)
+    case e.FromAssignee != "" && e.ToAssignee == "":
+        out = append(out, "unassigned "+e.FromAssignee)
+    case e.FromAssignee != e.ToAssignee:
+        out = append(out, fmt.Sprintf("reassigned %s %s %s", e.FromAssignee, arrow, e.ToAssignee))
+    }
+    if len(e.AddedLabels) > 0 { out = append(out, "added labels "+strings.Join(e.AddedLabels, ", ")) }
+    if len(e.RemovedLabels) > 0 { out = append(out, "removed labels "+strings.Join(e.RemovedLabels, ", ")) }
+    if e.FromPriority != nil && e.ToPriority != nil {
+        out = append(out, fmt.Sprintf("priority %s %s %s", priorityName(*e.FromPriority), arrow, priorityName(*e.ToPriority)))
+    }
+    if e.ToTitle != "" && e.FromTitle != e.ToTitle { out = append(out, fmt.Sprintf("title %q %s %q", e.FromTitle, arrow, e.ToTitle)) }
+    return out
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesHistoryCmd)
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+    "path/filepath"
+    "regexp"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Idempotency keys let automation retry 'issues create' safely. The create
+// mutation itself carries a hidden marker in the description, so a rerun finds
+// the issue even when the first run never saw the response (a timeout or a
+// crash), from another machine, or after the local record is gone. Created
+// issues are also recorded locally under their key (per workspace, see
+// api.Client.CacheScope) to skip the search.
+type idempotencyRecord struct {
+    IssueID    string    `json:"issue_id"`
+    Identifier string    `json:"identifier"`
+    URL        string    `json:"url"`
+    CreatedAt  time.Time `json:"created_at"`
+}
+
+type idempotencyFile map[string]map[string]idempotencyRecord
+
+var reIdempotencyKey = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// idempotencyMarker is appended to the description; HTML comments do not render in Linear
+func idempotencyMarker(key string) string { return "<!-- linear-cli:idempotency-key=" + key + " -->" }
+
+// withIdempotencyMarker appends the marker for key to a description that does
+// not carry it yet; an empty key leaves the description as is
+func withIdempotencyMarker(desc, key string) string {
+    if key == "" || strings.Contains(desc, idempotencyMarker(key)) { return desc }
+    desc = strings.TrimRight(desc, "\n")
+    if desc != "" { desc += "\n\n" }
+    return desc + idempotencyMarker(key)
+}
+
+func idempotencyPath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "idempotency.json"), nil
+}
+
+func loadIdempotency() idempotencyFile {
+    recs := idempotencyFile{}
+    p, err := idempotencyPath()
+    if err != nil { return recs }
+    if b, err := os.ReadFile(p); err == nil { _ = json.Unmarshal(b, &recs) }
+    return recs
+}
+
+// idempotencyLockWait is how long a write waits for another run's write
+var idempotencyLockWait = 5 * time.Second
+
+// updateIdempotency applies fn to the records under the file lock, re-reading
+// them first so parallel runs (and bridge alerts) keep each other's records
+func updateIdempotency(fn func(idempotencyFile)) error {
+    p, err := idempotencyPath()
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    unlock, err := lockFile(p+".lock", idempotencyLockWait)
+    if err != nil { return err }
+    defer unlock()
+    recs := loadIdempotency()
+    fn(recs)
+    b, err := json.MarshalIndent(recs, "", "  ")
+    if err != nil { return err }
+    return writeFileAtomic(p, b, 0o600)
+}
+
+// idempotencyKeyFlag returns the validated --idempotency-key, or "" when unset
+func idempotencyKeyFlag(cmd *cobra.Command) (string, error) {
+    f := cmd.Flags().Lookup("idempotency-key")
+    if f == nil { return "", nil }
+    key := strings.TrimSpace(f.Value.String())
+    if key == "" { return "", nil }
+    if !reIdempotencyKey.MatchString(key) { return "", fmt.Errorf("invalid --idempotency-key '%s': use up to 128 letters, digits, '.', '_', ':' or '-'", key) }
+    return key, nil
+}
+
+// findIdempotentIssue returns the issue already created under key: the local
+// record first, then the description marker. Records of deleted issues are dropped.
+func findIdempotentIssue(client *api.Client, key string) (*api.IssueDetails, error) {
+    scope := client.CacheScope()
+    recs := loadIdempotency()
+    if rec, ok := recs[scope][key]; ok {
+        det, err := client.GetIssueDetails(rec.IssueID)
+        if err != nil { return nil, err }
+        if det != nil { return det, nil }
+        _ = updateIdempotency(func(recs idempotencyFile) { delete(recs[scope], key) })
+    }
+    return client.FindIssueByDescription(idempotencyMarker(key))
+}
+
+// recordIdempotentIssue remembers created under key. It is best effort: the
+// marker sent with the create still finds the issue without the record.
+func recordIdempotentIssue(client *api.Client, key string, created *api.IssueDetails) {
+    scope := client.CacheScope()
+    err := updateIdempotency(func(recs idempotencyFile) {
+        if recs[scope] == nil { recs[scope] = map[string]idempotencyRecord{} }
+        recs[scope][key] = idempotencyRecord{IssueID: created.ID, Identifier: created.Identifier, URL: created.URL, CreatedAt: time.Now().UTC()}
+    })
+    if err != nil { ui.Warnf("could not record idempotency key: %v", err) }
+}
+
+// finishCreatedIssue runs the follow-up steps 'issues create' flags ask for on a
+// freshly created issue
+func finishCreatedIssue(cmd *cobra.Command, client *api.Client, created *api.IssueDetails) error {
+    key, err := idempotencyKeyFlag(cmd)
+    if err != nil { return err }
+    if key != "" { recordIdempotentIssue(client, key, created) }
+    if err := amendCommitWithKey(cmd, client, created); err != nil { return err }
+    return linkCreatedPR(cmd, client, created)
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+    "bufio"
+    "context"
+    "fmt"
+    "os"
+    "sort"
+    "sync"
+    "sync/atomic"
+
+    "golang.org/x/term"
+)
+
+// Ctrl-C handling. The root context is cancelled on SIGINT/SIGTERM, which aborts
+// in-flight API requests and lets the command return. Two things cannot see the
+// context: a prompt blocked reading stdin, and state left behind if the process
+// exits early (editor temp files, a terminal with echo turned off). Cleanups are
+// registered while such state exists and run on interrupt; an interrupt during a
+// prompt exits right away, since nothing has been sent yet.
+
+var (
+    cleanupMu     sync.Mutex
+    cleanupSeq    int
+    cleanups      = map[int]func(){}
+    awaitingInput atomic.Bool
+)
+
+// addCleanup registers fn to run if the command is interrupted; the returned
+// func unregisters it once the state it undoes is gone
+func addCleanup(fn func()) (remove func()) {
+    cleanupMu.Lock()
+    defer cleanupMu.Unlock()
+    cleanupSeq++
+    id := cleanupSeq
+    cleanups[id] = fn
+    return func() {
+        cleanupMu.Lock()
+        delete(cleanups, id)
+        cleanupMu.Unlock()
+    }
+}
+
+// runCleanups runs and clears the registered cleanups, newest first
+func runCleanups() {
+    cleanupMu.Lock()
+    ids := make([]int, 0, len(cleanups))
+    for id := range cleanups { ids = append(ids, id) }
+    sort.Sort(sort.Reverse(sort.IntSlice(ids)))
+    fns := make([]func(), 0, len(ids))
+    for _, id := range ids { fns = append(fns, cleanups[id]); delete(cleanups, id) }
+    cleanupMu.Unlock()
+    for _, fn := range fns { fn() }
+}
+
+// readInputLine reads a line for a prompt, marking the process as waiting on
+// the user so an interrupt exits instead of leaving the read blocked
+func readInputLine(rdr *bufio.Reader) (string, error) {
+    awaitingInput.Store(true)
+    defer awaitingInput.Store(false)
+    return rdr.ReadString('\n')
+}
+
+// readPassword reads a line without echo; an interrupt restores the terminal
+// before exiting, which term.ReadPassword would only do on return
+func readPassword(fd int) ([]byte, error) {
+    if st, err := term.GetState(fd); err == nil {
+        defer addCleanup(func() { _ = term.Restore(fd, st) })()
+    }
+    awaitingInput.Store(true)
+    defer awaitingInput.Store(false)
+    return term.ReadPassword(fd)
+}
+
+// handleInterrupt waits for ctx to be cancelled by a signal (not for finished
+// to close), runs the cleanups and, when a prompt is waiting, exits with 130.
+// stop restores the default signal behaviour, so a second Ctrl-C always kills.
+func handleInterrupt(ctx context.Context, stop func(), finished <-chan struct{}) {
+    select {
+    case <-finished:
+        return
+    case <-ctx.Done():
+    }
+    select {
+    case <-finished:
+        return
+    default:
+    }
+    stop()
+    runCleanups()
+    if awaitingInput.Load() {
+        fmt.Fprintln(os.Stderr, "\ninterrupted")
+        os.Exit(130)
+    }
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+    "fmt"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/output"
+
+    "github.com/spf13/cobra"
+)
+
+// Sections of 'issues view' beyond the issue itself: --children, --relations,
+// --attachments, or --full for all of them plus recent comments and history.
+// Whatever is asked for is fetched with the issue in a single request.
+
+// viewFullComments and viewFullHistory are how many comments and history
+// entries --full shows unless --comments / --history ask for more
+const (
+    viewFullComments = 10
+    viewFullHistory  = 50
+)
+
+// issueViewParts reads the section flags; full reports --full
+func issueViewParts(cmd *cobra.Command) (api.IssueParts, bool) {
+    var parts api.IssueParts
+    full, _ := cmd.Flags().GetBool("full")
+    parts.Children, _ = cmd.Flags().GetBool("children")
+    parts.Relations, _ = cmd.Flags().GetBool("relations")
+    parts.Attachments, _ = cmd.Flags().GetBool("attachments")
+    if full {
+        parts.Children, parts.Relations, parts.Attachments = true, true, true
+        parts.History = viewFullHistory
+    }
+    return parts, full
+}
+
+// viewSection prints a delimited section heading with its item count
+func viewSection(p output.Printer, title string, n int) {
+    rule := p.Symbol("──", "--")
+    fmt.Printf("\n%s %s (%d) %s\n", rule, title, n, rule)
+}
+
+// printIssueSections renders the requested sections after the issue body;
+// empty ones are shown too, so "none" is distinguishable from "not asked for"
+func printIssueSections(p output.Printer, det *api.IssueDetails, ex *api.IssueExtras, parts api.IssueParts, withComments, withHistory bool, history []api.HistoryEntry) error {
+    if parts.Children {
+        viewSection(p, "Sub-issues", len(ex.Children))
+        for _, c := range ex.Children { fmt.Printf("  %s  %s [%s]\n", c.Identifier, c.Title, c.StateName) }
+    }
+    if parts.Relations {
+        viewSection(p, "Relations", len(ex.Relations))
+        for _, r := range ex.Relations { fmt.Printf("  %-13s %s  %s [%s]\n", r.Type, r.Issue.Identifier, r.Issue.Title, r.Issue.StateName) }
+    }
+    if parts.Attachments {
+        viewSection(p, "Attachments", len(ex.Attachments))
+        for _, a := range ex.Attachments {
+            title := strings.TrimSpace(a.Title)
+            if a.Subtitle != "" { title += " · " + a.Subtitle }
+            fmt.Printf("  %s\n    %s\n", title, a.URL)
+        }
+    }
+    if withComments {
+        viewSection(p, "Comments", len(det.Comments))
+        printComments(det.Comments)
+    }
+    if withHistory {
+        title := "History"
+        if ex.HistoryTruncated { title = "History, partial (--history shows all)" }
+        viewSection(p, title, len(history))
+        if rows := historyRows(history); len(rows) > 0 {
+            if err := p.Table([]string{"When", "Who", "Change"}, rows); err != nil { return err }
+        }
+    }
+    return nil
+}
+
+// nonNil turns a nil slice into an empty one so JSON shows [] rather than null
+func nonNil[T any](s []T) []T {
+    if s == nil { return []T{} }
+    return s
+}
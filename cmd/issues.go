@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 
 	"linear-cli/internal/api"
 	"linear-cli/internal/config"
@@ -30,7 +33,7 @@ var issuesListCmd = &cobra.Command{
 		if apiKey == "" {
 			return errors.New("not authenticated. run 'linear-cli auth login'")
 		}
-		client := api.NewClient(apiKey)
+		client := newAPIClient(cmd, apiKey)
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		teamKey, _ := cmd.Flags().GetString("team")
@@ -69,7 +72,7 @@ var issuesGetCmd = &cobra.Command{
 		if apiKey == "" {
 			return errors.New("not authenticated. run 'linear-cli auth login'")
 		}
-		client := api.NewClient(apiKey)
+		client := newAPIClient(cmd, apiKey)
 
 		id, _ := cmd.Flags().GetString("id")
 		key, _ := cmd.Flags().GetString("key")
@@ -120,7 +123,7 @@ var issuesCreateCmd = &cobra.Command{
 		if apiKey == "" {
 			return errors.New("not authenticated. run 'linear-cli auth login'")
 		}
-		client := api.NewClient(apiKey)
+		client := newAPIClient(cmd, apiKey)
 
 		teamKey, _ := cmd.Flags().GetString("team")
 		title, _ := cmd.Flags().GetString("title")
@@ -145,6 +148,81 @@ var issuesCreateCmd = &cobra.Command{
 	},
 }
 
+// resolveIssue looks up an issue by id or by a key like TEAM-123.
+func resolveIssue(client *api.Client, raw string) (*api.Issue, error) {
+	raw = strings.TrimSpace(raw)
+	m := regexp.MustCompile(`^([A-Z]+)-(\d+)$`).FindStringSubmatch(strings.ToUpper(raw))
+	if len(m) != 3 {
+		iss, err := client.IssueByID(raw)
+		if err != nil { return nil, err }
+		if iss == nil { return nil, fmt.Errorf("issue %s not found", raw) }
+		return iss, nil
+	}
+	// Fast path: issue(id:) accepts TEAM-123 identifiers on current schemas.
+	// Older ones answer "not found" and fall back to the team lookup; any
+	// other error is returned.
+	iss, err := client.IssueByID(m[0])
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "not found") { return nil, err }
+	if err == nil && iss != nil && strings.EqualFold(iss.Identifier, m[0]) { return iss, nil }
+	num, _ := strconv.Atoi(m[2])
+	return resolveIssueByTeam(client, m[1], num)
+}
+
+// resolveIssueByTeam looks an issue up by team key and number, using the local
+// team cache and retrying once with a fresh team lookup if the cached ID misses.
+func resolveIssueByTeam(client *api.Client, teamKey string, num int) (*api.Issue, error) {
+	key := fmt.Sprintf("%s-%d", teamKey, num)
+	team, cached, err := cachedTeamByKey(client, teamKey)
+	if err != nil { return nil, err }
+	if team == nil { return nil, fmt.Errorf("team with key %s not found", teamKey) }
+	iss, err := client.IssueByKey(team.ID, num)
+	if err != nil { return nil, err }
+	if iss == nil && cached {
+		forgetCachedTeam(client, teamKey)
+		return resolveIssueByTeam(client, teamKey, num)
+	}
+	if iss == nil { return nil, fmt.Errorf("issue %s not found", key) }
+	return iss, nil
+}
+
+// reIssueKeyInText finds a TEAM-123 key anywhere in a line of text
+var reIssueKeyInText = regexp.MustCompile(`\b[A-Za-z]+-\d+\b`)
+
+// readIssueKeys collects issue keys from r, taking the first key on each line so
+// piped table output works as well as a plain list. Duplicates are dropped.
+func readIssueKeys(r io.Reader) ([]string, error) {
+	var keys []string
+	seen := map[string]bool{}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		k := strings.ToUpper(reIssueKeyInText.FindString(sc.Text()))
+		if k == "" || seen[k] { continue }
+		seen[k] = true
+		keys = append(keys, k)
+	}
+	return keys, sc.Err()
+}
+
+// resolveIssueKeys resolves each key with resolveIssue, a few at a time. The
+// results keep the input order; a key that fails has a nil issue and its error.
+func resolveIssueKeys(client *api.Client, keys []string) ([]*api.Issue, []error) {
+	issues := make([]*api.Issue, len(keys))
+	errs := make([]error, len(keys))
+	sem := make(chan struct{}, 4)
+	var wg sync.WaitGroup
+	for i, k := range keys {
+		wg.Add(1)
+		go func(i int, k string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			issues[i], errs[i] = resolveIssue(client, k)
+		}(i, k)
+	}
+	wg.Wait()
+	return issues, errs
+}
+
 func init() {
 	rootCmd.AddCommand(issuesCmd)
 	issuesCmd.AddCommand(issuesListCmd)
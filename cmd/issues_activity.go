@@ -0,0 +1,153 @@
+package cmd
+
+import (
+    "errors"
+    "strings"
+    "sync"
+
+    "linear-cli/internal/api"
+
+    "github.com/spf13/cobra"
+)
+
+// Activity filters narrow a listing by who acted on the issues: --created-by
+// is a server-side filter, while the completer (--completed-by) and the last
+// actor (--updated-by) are read from each candidate issue's history, which
+// costs a request per issue checked. Completed-by candidates are narrowed to
+// completed issues on the server first, and at most --max-scan candidates are
+// checked so a filter that matches nothing does not walk the whole workspace.
+
+func addActivityFilterFlags(c *cobra.Command) {
+    c.Flags().String("created-by", "", "Only issues created by this user (name, email, id or me)")
+    c.Flags().String("completed-by", "", "Only completed issues this user moved to their completed state (name, email, id or me)")
+    c.Flags().String("updated-by", "", "Only issues this user changed last (name, email, id or me)")
+    c.Flags().Int("max-scan", defaultActorScan, "With --completed-by or --updated-by, check the history of at most this many issues (0 for no limit)")
+}
+
+// defaultActorScan is how many candidate issues --completed-by and --updated-by
+// check by default: a history request each
+const defaultActorScan = 500
+
+// actorFilter is what activity filters check against issue history
+type actorFilter struct {
+    CompletedBy *api.User
+    UpdatedBy   *api.User
+    // MaxScan caps the candidates checked; 0 checks them all
+    MaxScan int
+}
+
+func (a actorFilter) active() bool { return a.CompletedBy != nil || a.UpdatedBy != nil }
+
+// applyActivityFilters adds --created-by to f and returns the history checks
+// of --completed-by and --updated-by
+func applyActivityFilters(cmd *cobra.Command, client *api.Client, f *api.IssueListFilter) (actorFilter, error) {
+    var af actorFilter
+    if cmd.Flags().Lookup("created-by") == nil { return af, nil }
+    af.MaxScan, _ = cmd.Flags().GetInt("max-scan")
+    if af.MaxScan < 0 { return af, errors.New("--max-scan cannot be negative") }
+    createdBy, _ := cmd.Flags().GetString("created-by")
+    completedBy, _ := cmd.Flags().GetString("completed-by")
+    updatedBy, _ := cmd.Flags().GetString("updated-by")
+    if strings.TrimSpace(createdBy) != "" {
+        u, err := resolveUserOrMe(client, createdBy)
+        if err != nil { return af, err }
+        f.CreatorID = u.ID
+    }
+    if strings.TrimSpace(completedBy) != "" {
+        u, err := resolveUserOrMe(client, completedBy)
+        if err != nil { return af, err }
+        af.CompletedBy = u
+        f.StateType = "completed"
+    }
+    if strings.TrimSpace(updatedBy) != "" {
+        u, err := resolveUserOrMe(client, updatedBy)
+        if err != nil { return af, err }
+        af.UpdatedBy = u
+    }
+    return af, nil
+}
+
+// matches reports whether an issue's history (oldest first) passes the checks
+func (a actorFilter) matches(it api.IssueDetails, history []api.HistoryEntry) bool {
+    actorIs := func(e api.HistoryEntry, u *api.User) bool { return e.Actor != nil && e.Actor.ID == u.ID }
+    if a.UpdatedBy != nil {
+        if len(history) == 0 || !actorIs(history[len(history)-1], a.UpdatedBy) { return false }
+    }
+    if a.CompletedBy != nil {
+        // The last state change is the one into the current, completed state
+        var last *api.HistoryEntry
+        for i := range history {
+            if history[i].ToState != "" { last = &history[i] }
+        }
+        if last == nil || !strings.EqualFold(last.ToState, it.StateName) || !actorIs(*last, a.CompletedBy) { return false }
+    }
+    return true
+}
+
+// historyParallelism bounds the history requests in flight for one page
+const historyParallelism = 4
+
+// errListingFull stops paging once a listing has enough matches
+var errListingFull = errors.New("listing full")
+
+// listIssuesByActor lists like ListIssuesCounted, keeping the issues whose
+// history passes af. Paging stops once the limit is reached or af.MaxScan
+// candidates were checked, so HasMore is then set without a total count of
+// the rest; hitting the scan cap is warned about.
+func listIssuesByActor(client *api.Client, f api.IssueListFilter, af actorFilter) (*api.IssueList, error) {
+    limit := f.Limit
+    f.Limit = 0
+    out := &api.IssueList{Issues: []api.IssueDetails{}}
+    scanned := 0
+    err := client.EachIssueFiltered(f, func(page []api.IssueDetails) error {
+        capped := af.MaxScan > 0 && scanned+len(page) > af.MaxScan
+        if capped { page = page[:af.MaxScan-scanned] }
+        scanned += len(page)
+        histories, err := issueHistories(client, page)
+        if err != nil { return err }
+        for i, it := range page {
+            if !af.matches(it, histories[i]) { continue }
+            if limit > 0 && len(out.Issues) == limit {
+                out.HasMore = true
+                return errListingFull
+            }
+            out.Issues = append(out.Issues, it)
+        }
+        if capped {
+            out.HasMore = true
+            ui.Warnf("stopped after checking the history of %d issues; narrow the listing or raise --max-scan", scanned)
+            return errListingFull
+        }
+        return nil
+    })
+    if errors.Is(err, api.ErrPageCap) {
+        out.HasMore, out.Truncated = true, true
+        ui.Warnf("stopped after %d issues at the page cap; narrow the listing to check the rest", scanned)
+        err = nil
+    }
+    if err != nil && !errors.Is(err, errListingFull) { return nil, err }
+    out.TotalCount = len(out.Issues)
+    return out, nil
+}
+
+// issueHistories fetches the history of each issue, a few at a time
+func issueHistories(client *api.Client, issues []api.IssueDetails) ([][]api.HistoryEntry, error) {
+    out := make([][]api.HistoryEntry, len(issues))
+    errs := make([]error, len(issues))
+    sem := make(chan struct{}, historyParallelism)
+    var wg sync.WaitGroup
+    for i, it := range issues {
+        wg.Add(1)
+        go func(i int, id string) {
+            defer wg.Done()
+            sem <- struct{}{}
+            defer func() { <-sem }()
+            out[i], errs[i] = client.IssueHistoryEntries(id)
+        }(i, it.ID)
+    }
+    wg.Wait()
+    for _, err := range errs {
+        if err != nil { return nil, err }
+    }
+    return out, nil
+}
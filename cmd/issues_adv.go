@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"linear-cli/internal/config"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 // Enhanced issues commands per requirements (filters, view, create with resolution)
@@ -30,39 +32,124 @@ var issuesViewCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, _ := config.Load()
 		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
-		client := api.NewClient(cfg.APIKey)
+		client := newAPIClient(cmd, cfg.APIKey)
         raw := strings.TrimSpace(args[0])
         comments, _ := cmd.Flags().GetInt("comments")
+        commentsSince, _ := cmd.Flags().GetString("comments-since")
+        allComments, _ := cmd.Flags().GetBool("all-comments")
+        var since time.Time
+        if strings.TrimSpace(commentsSince) != "" {
+            t, errS := parseSince(commentsSince, time.Now())
+            if errS != nil { return errS }
+            since = t
+        }
+        withComments := comments > 0 || allComments || !since.IsZero()
+        withHistory, _ := cmd.Flags().GetBool("history")
+        parts, full := issueViewParts(cmd)
+        composite := parts != (api.IssueParts{})
+        if full {
+            if !cmd.Flags().Changed("comments") { parts.Comments = viewFullComments }
+            withComments = true
+        } else if comments > 0 && !allComments && since.IsZero() {
+            parts.Comments = comments
+        }
         var det *api.IssueDetails
+        var extras *api.IssueExtras
         var err error
+        // fetch loads details with the first page of comments and any --children,
+        // --relations, --attachments or --full sections in one request
+        fetch := func(id string) (*api.IssueDetails, error) {
+            if composite {
+                d, ex, err := client.GetIssueFull(id, parts)
+                extras = ex
+                return d, err
+            }
+            if comments > 0 && !allComments && since.IsZero() { return client.GetIssueDetailsWithComments(id, comments) }
+            return client.GetIssueDetails(id)
+        }
         // Accept either an issue ID or a key like TEAM-123
         id := raw
         if m := regexp.MustCompile(`^([A-Z]+)-(\d+)$`).FindStringSubmatch(strings.ToUpper(raw)); len(m) == 3 {
-            // Resolve by team+number
-            teamKey := m[1]
-            num, _ := strconv.Atoi(m[2])
-            team, errT := client.TeamByKey(teamKey)
-            if errT != nil { return errT }
-            if team == nil { return fmt.Errorf("team with key %s not found", teamKey) }
-            iss, errK := client.IssueByKey(team.ID, num)
-            if errK != nil { return errK }
-            if iss == nil { return fmt.Errorf("issue %s not found", raw) }
-            id = iss.ID
+            // One round trip when issue(id:) accepts identifiers; otherwise resolve via the team
+            if d, errD := fetch(m[0]); errD == nil && d != nil && strings.EqualFold(d.Identifier, m[0]) {
+                det = d
+            } else {
+                num, _ := strconv.Atoi(m[2])
+                iss, errK := resolveIssueByTeam(client, m[1], num)
+                if errK != nil { return errK }
+                id = iss.ID
+            }
+        }
+        if det == nil {
+            if det, err = fetch(id); err != nil { return err }
+            if det == nil { return fmt.Errorf("issue %s not found", id) }
+        }
+        id = det.ID
+        if allComments || !since.IsZero() {
+            limit := comments
+            if allComments { limit = 0 }
+            det.Comments, err = client.ListIssueComments(id, since, limit)
         }
-        if comments > 0 { det, err = client.GetIssueDetailsWithComments(id, comments) } else { det, err = client.GetIssueDetails(id) }
 		if err != nil { return err }
-		if det == nil { return fmt.Errorf("issue %s not found", id) }
+        var history []api.HistoryEntry
+        if extras != nil && parts.History > 0 {
+            history = extras.History
+            // --history asks for the whole trail, not the latest page --full shows
+            if withHistory && extras.HistoryTruncated {
+                if history, err = client.IssueHistoryEntries(id); err != nil { return err }
+                extras.History, extras.HistoryTruncated = history, false
+            }
+            withHistory = true
+        } else if withHistory {
+            if history, err = client.IssueHistoryEntries(id); err != nil { return err }
+        }
+        var rollup *issueRollup
+        if on, _ := cmd.Flags().GetBool("rollup"); on {
+            below, err := client.ListIssueDescendants(id)
+            if err != nil { return err }
+            r := rollupIssues(below)
+            rollup = &r
+        }
 		p := printer(cmd)
-		if p.JSONEnabled() { return p.PrintJSON(det) }
+		if p.JSONEnabled() {
+            if composite {
+                out := map[string]any{"issue": det}
+                if parts.Children { out["children"] = nonNil(extras.Children) }
+                if parts.Relations { out["relations"] = nonNil(extras.Relations) }
+                if parts.Attachments { out["attachments"] = nonNil(extras.Attachments) }
+                if withHistory { out["history"] = nonNil(history) }
+                if extras.HistoryTruncated { out["historyTruncated"] = true }
+                if rollup != nil { out["rollup"] = rollup }
+                return p.PrintJSON(out)
+            }
+            if withHistory || rollup != nil {
+                out := map[string]any{"issue": det}
+                if withHistory { out["history"] = history }
+                if rollup != nil { out["rollup"] = rollup }
+                return p.PrintJSON(out)
+            }
+            return p.PrintJSON(det)
+        }
 		assignee := ""
 		if det.Assignee != nil { assignee = det.Assignee.Name }
 		project := ""
 		if det.Project != nil { project = det.Project.Name }
-        fmt.Printf("%s %s\nState: %s\nAssignee: %s\nProject: %s\nURL: %s\n\n%s\n", det.Identifier, det.Title, det.StateName, assignee, project, det.URL, strings.TrimSpace(det.Description))
-        if comments > 0 && len(det.Comments) > 0 {
-            fmt.Println("\nComments:")
-            for _, c := range det.Comments { fmt.Printf("- %s\n", strings.TrimSpace(c.Body)) }
+        fmt.Printf("%s %s\nState: %s\nPriority: %s\nAssignee: %s\nProject: %s\nURL: %s\n\n%s\n", det.Identifier, det.Title, det.StateName, priorityLabel(det.Priority), assignee, project, det.URL, strings.TrimSpace(det.Description))
+        if composite {
+            if err := printIssueSections(p, det, extras, parts, withComments, withHistory, history); err != nil { return err }
+        } else {
+            if withComments && len(det.Comments) > 0 {
+                fmt.Println("\nComments:")
+                printComments(det.Comments)
+            }
+            if withHistory {
+                if rows := historyRows(history); len(rows) > 0 {
+                    fmt.Println("\nHistory:")
+                    if err := p.Table([]string{"When", "Who", "Change"}, rows); err != nil { return err }
+                }
+            }
         }
+        if rollup != nil { printRollup(p, *rollup) }
 		return nil
 	},
 }
@@ -81,6 +168,9 @@ Commands:
 Template format:
   - Optional first line: 'Title-Prefix: <prefix>' to auto-prefix issue titles
   - Placeholders: {{KEY}} or {{KEY|Prompt text...}} used with 'issues create --template'
+  - Optional front matter ('---' block); 'extends: base.md' inherits a base template
+  - Front matter labels/priority/assignee/project/estimate preset issue fields on create
+  - Partials: {{> name}} includes name.md from the template's dir, its partials/ dir, or the search dirs
 
 Sources:
   - Local directories (search order): --templates-dir, $LINEAR_TEMPLATES_DIR, UserConfigDir/linear/templates, ~/.config/linear/templates
@@ -111,16 +201,35 @@ Example output:
   - Requirements
   - Definition of Done
 
-AI agents can then use: --template "Feature Template" --sections Summary="Brief desc"`,
+AI agents can then use: --template "Feature Template" --sections Summary="Brief desc"
+
+With --format json-schema: Prints a JSON Schema (draft 2020-12) per cached
+template describing its --sections (required unless the heading says
+"(optional)") and its {{KEY}} placeholder variables, so payloads can be
+validated before 'issues create' is called.`,
+    Example: `  linear-cli issues template structure --team ENG
+  linear-cli issues template structure --team ENG --format json-schema
+  linear-cli issues template structure --team ENG --template "Feature Template" --format json-schema > feature.schema.json`,
     RunE: func(cmd *cobra.Command, args []string) error {
         cfg, _ := config.Load()
         if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
-        client := api.NewClient(cfg.APIKey)
+        client := newAPIClient(cmd, cfg.APIKey)
         
         teamKey, _ := cmd.Flags().GetString("team")
         templateName, _ := cmd.Flags().GetString("template")
         
         if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        format, _ := cmd.Flags().GetString("format")
+        switch strings.ToLower(strings.TrimSpace(format)) {
+        case "", "text":
+        case "json-schema":
+            // Read from the local template cache only
+            schema, err := teamTemplatesJSONSchema(teamKey, templateName)
+            if err != nil { return err }
+            return printer(cmd).PrintJSON(schema)
+        default:
+            return fmt.Errorf("invalid --format '%s' (use text|json-schema)", format)
+        }
         
         // Get team
         team, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
@@ -195,7 +304,7 @@ var issuesTemplateShowCmd = &cobra.Command{
         if strings.TrimSpace(teamKey) == "" || strings.TrimSpace(name) == "" {
             return errors.New("--team and --name are required")
         }
-        client := api.NewClient(cfg.APIKey)
+        client := newAPIClient(cmd, cfg.APIKey)
         t, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
         if err != nil { return err }
         if t == nil { return fmt.Errorf("team with key %s not found", teamKey) }
@@ -242,7 +351,7 @@ var issuesTemplateListCmd = &cobra.Command{
             // Auto-prefer API when available
             cfg, _ := config.Load()
             if cfg.APIKey != "" {
-                client := api.NewClient(cfg.APIKey)
+                client := newAPIClient(cmd, cfg.APIKey)
                 if client.SupportsIssueTemplates() { source = "api" }
             }
         }
@@ -250,7 +359,7 @@ var issuesTemplateListCmd = &cobra.Command{
             cfg, _ := config.Load()
             if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
             if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required with --templates-source=api") }
-            client := api.NewClient(cfg.APIKey)
+            client := newAPIClient(cmd, cfg.APIKey)
             // Resolve team key to ID
             t, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
             if err != nil { return err }
@@ -313,6 +422,14 @@ var issuesTemplateListCmd = &cobra.Command{
 var issuesTemplatePreviewCmd = &cobra.Command{
     Use:   "preview <name-or-path>",
     Short: "Preview a template after optional variable substitution",
+    Long: `Render a template by name or path with --var/--vars-file substitutions.
+With --sample, placeholders without a value and sections without placeholders
+are filled with realistic sample data chosen from their names (a date for
+{{DUE_DATE}}, numbered steps under "Steps to Reproduce"), so template authors
+can see the rendered issue without supplying every value.`,
+    Example: `  linear-cli issues template preview bug --sample
+  linear-cli issues template preview ./templates/incident.md --sample --var SERVICE=checkout
+  linear-cli issues template preview "Bug Template" --team ENG --sample --json`,
     Args:  cobra.ExactArgs(1),
     RunE: func(cmd *cobra.Command, args []string) error {
         override, _ := cmd.Flags().GetString("templates-dir")
@@ -321,16 +438,17 @@ var issuesTemplatePreviewCmd = &cobra.Command{
         teamKey, _ := cmd.Flags().GetString("team")
         debug, _ := cmd.Flags().GetBool("debug")
         
-        // If team is provided and source is auto, prefer API
+        // If team is provided and source is auto, prefer API; without one,
+        // names can only resolve locally
         if source == "auto" && strings.TrimSpace(teamKey) != "" {
             cfg, _ := config.Load()
             if cfg.APIKey != "" {
                 source = "api"
             }
-        } else if source == "api" || (source == "auto") {
+        } else if source == "api" {
             cfg, _ := config.Load()
             if cfg.APIKey != "" {
-                client := api.NewClient(cfg.APIKey)
+                client := newAPIClient(cmd, cfg.APIKey)
                 if client.SupportsIssueTemplates() { source = "api" }
             }
         }
@@ -340,7 +458,7 @@ var issuesTemplatePreviewCmd = &cobra.Command{
         if source == "api" {
             cfg, _ := config.Load()
             if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
-            client := api.NewClient(cfg.APIKey)
+            client := newAPIClient(cmd, cfg.APIKey)
             // Resolve team id
             if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required to resolve template by name with --templates-source=api") }
             t, errT := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
@@ -419,29 +537,53 @@ var issuesTemplatePreviewCmd = &cobra.Command{
         varsFile, _ := cmd.Flags().GetString("vars-file")
         vars, err := gatherVars(varsKVs, varsFile)
         if err != nil { return err }
+        var sampled []string
+        if sample, _ := cmd.Flags().GetBool("sample"); sample {
+            raw, vars, sampled = sampleTemplate(raw, vars, time.Now())
+        }
         // Non-interactive preview; do not fail on missing by default
         rendered, err := fillTemplate(raw, vars, false, false)
         if err != nil { return err }
         p := printer(cmd)
         if p.JSONEnabled() {
+            out := map[string]any{"description": rendered}
             // When using API source, include template title for clarity
-            if tplTitle != "" { return p.PrintJSON(map[string]any{"title": tplTitle, "description": rendered}) }
-            return p.PrintJSON(map[string]any{"description": rendered})
+            if tplTitle != "" { out["title"] = tplTitle }
+            if sampled != nil { out["sampled"] = sampled }
+            return p.PrintJSON(out)
         }
+        if len(sampled) > 0 { ui.Infof("Sample values for: %s", strings.Join(sampled, ", ")) }
         if tplTitle != "" { fmt.Printf("%s\n\n", tplTitle) }
         fmt.Println(rendered)
         return nil
     },
 }
 
-func runIssuesListWithArgs(cmd *cobra.Command, statePreset string) error {
+func runIssuesListWithArgs(cmd *cobra.Command, statePreset string) (err error) {
     cfg, _ := config.Load()
     if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
-    client := api.NewClient(cfg.APIKey)
+    client := newAPIClient(cmd, cfg.APIKey)
     limit, _ := cmd.Flags().GetInt("limit")
+    if all, _ := cmd.Flags().GetBool("all"); all { limit = 0 }
+    if limit < 0 { return errors.New("--limit must be 0 (all) or more") }
     project, _ := cmd.Flags().GetString("project")
     assignee, _ := cmd.Flags().GetString("assignee")
     stateFlag, _ := cmd.Flags().GetString("state")
+    priorityFlag, _ := cmd.Flags().GetString("priority")
+    groupBy, _ := cmd.Flags().GetString("group-by")
+    groupBy = strings.ToLower(strings.TrimSpace(groupBy))
+    if groupBy != "" && !containsString(issueGroupFields, groupBy) {
+        return fmt.Errorf("invalid --group-by '%s' (use %s)", groupBy, strings.Join(issueGroupFields, "|"))
+    }
+    board, _ := cmd.Flags().GetBool("board")
+    if board && groupBy != "" { return errors.New("--board cannot be combined with --group-by") }
+    urlsOnly := outputFormat(cmd) == "url"
+    if urlsOnly && (board || groupBy != "") { return errors.New("--output url cannot be combined with --board or --group-by") }
+    copyRow := 0
+    if cmd.Flags().Changed("copy") {
+        copyRow, _ = cmd.Flags().GetInt("copy")
+        if copyRow < 1 { return errors.New("--copy takes a row number from 1") }
+    }
     // Convenience boolean flags
     todo, _ := cmd.Flags().GetBool("todo")
     doing, _ := cmd.Flags().GetBool("doing")
@@ -471,16 +613,230 @@ func runIssuesListWithArgs(cmd *cobra.Command, statePreset string) error {
         if u == nil { return fmt.Errorf("assignee '%s' not found", assignee) }
         assigneeID = u.ID
     }
-    items, err := client.ListIssuesFiltered(api.IssueListFilter{ProjectID: projectID, AssigneeID: assigneeID, StateName: state, Limit: limit})
+    var prioPtr *int
+    if strings.TrimSpace(priorityFlag) != "" {
+        v, err := parsePriority(priorityFlag)
+        if err != nil { return err }
+        prioPtr = &v
+    }
+    filter := api.IssueListFilter{ProjectID: projectID, AssigneeID: assigneeID, StateName: state, Priority: prioPtr, Limit: limit}
+    // --parent lists an issue's sub-issues; --rollup adds each one's totals
+    // over its own sub-issue tree
+    var parent *api.Issue
+    var rollupOn bool
+    if cmd.Flags().Lookup("parent") != nil {
+        parentRaw, _ := cmd.Flags().GetString("parent")
+        rollupOn, _ = cmd.Flags().GetBool("rollup")
+        if rollupOn && strings.TrimSpace(parentRaw) == "" { return errors.New("--rollup needs --parent <issue-key>") }
+        if rollupOn && (board || groupBy != "" || urlsOnly) { return errors.New("--rollup cannot be combined with --board, --group-by or --output url") }
+        if strings.TrimSpace(parentRaw) != "" {
+            if parent, err = resolveIssue(client, parentRaw); err != nil { return err }
+            if parent == nil { return fmt.Errorf("issue '%s' not found", parentRaw) }
+            filter.ParentID = parent.ID
+        }
+    }
+    if err := applyViewerFilters(cmd, client, &filter); err != nil { return err }
+    if err := applyNegationFilters(cmd, client, &filter); err != nil { return err }
+    af, err := applyActivityFilters(cmd, client, &filter)
     if err != nil { return err }
+    // --since-last-run lists every change after the filter's watermark, and
+    // moves the watermark once the listing has been written out
+    var mark *listWatermark
+    var returned []api.IssueDetails
+    if on, _ := cmd.Flags().GetBool("since-last-run"); on {
+        key := filter.FilterKey()
+        w := loadListWatermarks()[client.CacheScope()][key]
+        mark = &w
+        filter.Limit, filter.UpdatedSince = 0, w.UpdatedAt
+        defer func() {
+            if err != nil { return }
+            if w.LastRun.IsZero() {
+                ui.Infof("First run for this filter: listed all %d matching issue(s); later runs list only what changed", len(returned))
+            } else {
+                ui.Infof("%d issue(s) changed since the last run (%s)", len(returned), ui.When(w.LastRun, time.Now()))
+            }
+            err = saveListWatermark(client.CacheScope(), key, advanceWatermark(w, returned, time.Now()))
+        }()
+    }
     p := printer(cmd)
-    if p.JSONEnabled() { return p.PrintJSON(items) }
-    head := []string{"Key", "State", "Title"}
+    if p.JSONLines && groupBy == "" && !board && copyRow == 0 && !rollupOn && !af.active() {
+        // Emit each page as it arrives so consumers can start before pagination ends
+        err = client.EachIssueFiltered(filter, func(page []api.IssueDetails) error {
+            if mark != nil { page = sinceWatermark(page, *mark) }
+            for _, it := range page {
+                if err := p.StreamJSON(it); err != nil { return err }
+                returned = append(returned, it)
+            }
+            return nil
+        })
+        if mark != nil && errors.Is(err, api.ErrPageCap) { return fmt.Errorf("--since-last-run: %w, so the watermark was not moved", err) }
+        return err
+    }
+    var list *api.IssueList
+    if af.active() {
+        list, err = listIssuesByActor(client, filter, af)
+    } else {
+        list, err = client.ListIssuesCounted(filter)
+    }
+    if err != nil { return err }
+    if mark != nil {
+        // Advancing past a cut-off listing would skip the unlisted issues for good
+        if list.HasMore { return fmt.Errorf("--since-last-run: the listing was cut off after %d issues, so the watermark was not moved; narrow the filter", len(list.Issues)) }
+        list.Issues = sinceWatermark(list.Issues, *mark)
+        list.TotalCount = len(list.Issues)
+        returned = list.Issues
+    }
+    items := list.Issues
+    if copyRow > 0 {
+        if err := copyIssueURL(items, copyRow); err != nil { return err }
+    }
+    if urlsOnly {
+        for _, it := range items { fmt.Println(it.URL) }
+        return nil
+    }
+    if groupBy != "" {
+        groups, err := groupIssues(items, groupBy)
+        if err != nil { return err }
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"groupBy": groupBy, "total": len(items), "totalCount": list.TotalCount, "hasMore": list.HasMore, "groups": groups}) }
+        if err := printIssueGroups(p, groupBy, groups); err != nil { return err }
+        printListTotal(list)
+        return nil
+    }
+    if board {
+        cols := boardColumns(items)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"total": len(items), "totalCount": list.TotalCount, "hasMore": list.HasMore, "columns": cols}) }
+        if len(cols) == 0 {
+            fmt.Println("No issues found")
+            return nil
+        }
+        fmt.Print(renderBoard(cols, terminalWidth()))
+        printListTotal(list)
+        return nil
+    }
+    if rollupOn { return printListRollup(client, p, parent, list) }
+    // --template formats each issue, so it gets the list itself
+    if p.Template != "" { return p.PrintJSON(items) }
+    if p.JSONEnabled() { return p.PrintJSON(list) }
+    head := []string{"Key", "State", "Priority", "Title"}
     rows := make([][]string, 0, len(items))
     for _, it := range items {
-        rows = append(rows, []string{it.Identifier, it.StateName, it.Title})
+        rows = append(rows, []string{it.Identifier, it.StateName, priorityLabel(it.Priority), it.Title})
     }
-    return p.Table(head, rows)
+    if err := p.Table(head, rows); err != nil { return err }
+    printListTotal(list)
+    return nil
+}
+
+// printListTotal says how much of the match a listing shows, with how to see
+// the rest when the limit cut it short
+func printListTotal(list *api.IssueList) {
+    switch {
+    case list.Truncated:
+        // --all cannot help here: paging itself stopped at its cap
+        fmt.Printf("\nShowing %d of at least %d issues (paging stopped at its cap; narrow the filter to see the rest)\n", len(list.Issues), list.TotalCount)
+    case list.HasMore && list.TotalCount <= len(list.Issues):
+        // Listings filtered by history stop at the limit without counting the rest
+        fmt.Printf("\nShowing the first %d matching issues (--limit N or --all for more)\n", len(list.Issues))
+    case list.HasMore:
+        fmt.Printf("\nShowing %d of %d issues (--limit N or --all for more)\n", len(list.Issues), list.TotalCount)
+    case len(list.Issues) > 0:
+        fmt.Printf("\nShowing all %d issues\n", len(list.Issues))
+    }
+}
+
+func addViewerFilterFlags(c *cobra.Command) {
+    c.Flags().Bool("mine", false, "Only issues assigned to me")
+    c.Flags().Bool("review", false, "Only issues I'm subscribed to")
+    c.Flags().Bool("mentions", false, "Only issues I was mentioned in during the last 30 days")
+    c.Flags().Bool("favorites", false, "Only issues in my Linear sidebar favorites")
+}
+
+// addCopyFlag adds --copy, which copies the first listed issue's URL, or with
+// --copy=N the N-th one's
+func addCopyFlag(c *cobra.Command) {
+    c.Flags().Int("copy", 1, "Copy the URL of the first listed issue (or --copy=N for the N-th) to the clipboard")
+    c.Flags().Lookup("copy").NoOptDefVal = "1"
+}
+
+func addNegationFilterFlags(c *cobra.Command) {
+    c.Flags().Bool("no-project", false, "Only issues not in any project")
+    c.Flags().Bool("project-none", false, "Same as --no-project")
+    _ = c.Flags().MarkHidden("project-none")
+    c.Flags().Bool("no-label", false, "Only issues without labels")
+    c.Flags().String("assignee-not", "", "Leave out issues assigned to this user (name, email, id or me); unassigned issues are kept")
+}
+
+// applyNegationFilters adds the --no-project, --no-label, --not-state and
+// --assignee-not exclusions to a listing
+func applyNegationFilters(cmd *cobra.Command, client *api.Client, f *api.IssueListFilter) error {
+    noProject, _ := cmd.Flags().GetBool("no-project")
+    projectNone, _ := cmd.Flags().GetBool("project-none")
+    noLabel, _ := cmd.Flags().GetBool("no-label")
+    assigneeNot, _ := cmd.Flags().GetString("assignee-not")
+    f.NoProject, f.NoLabel = noProject || projectNone, noLabel
+    if f.NoProject && f.ProjectID != "" { return errors.New("--no-project cannot be combined with --project") }
+    if cmd.Flags().Lookup("not-state") != nil {
+        notStates, _ := cmd.Flags().GetStringArray("not-state")
+        for _, s := range splitListFlags(notStates) { f.NotStateNames = append(f.NotStateNames, normalizeState(s)) }
+        if f.StateName != "" && containsFold(f.NotStateNames, f.StateName) { return fmt.Errorf("--not-state excludes the '%s' state being listed", f.StateName) }
+    }
+    if strings.TrimSpace(assigneeNot) != "" {
+        u, err := resolveUserOrMe(client, assigneeNot)
+        if err != nil { return err }
+        if u.ID == f.AssigneeID { return errors.New("--assignee-not excludes the assignee being listed") }
+        f.NotAssigneeID = u.ID
+    }
+    return nil
+}
+
+// mentionsWindow is how far back --mentions looks through notifications
+const mentionsWindow = 30 * 24 * time.Hour
+
+// applyViewerFilters narrows a listing with --mine (assigned to me), --review
+// (I'm subscribed), --mentions (I was mentioned in the last 30 days) and
+// --favorites (in my sidebar favorites).
+func applyViewerFilters(cmd *cobra.Command, client *api.Client, f *api.IssueListFilter) error {
+    mine, _ := cmd.Flags().GetBool("mine")
+    review, _ := cmd.Flags().GetBool("review")
+    mentions, _ := cmd.Flags().GetBool("mentions")
+    favorites, _ := cmd.Flags().GetBool("favorites")
+    if mine && f.AssigneeID != "" { return errors.New("--mine cannot be combined with --assignee") }
+    if mine || review {
+        v, err := client.Viewer()
+        if err != nil { return err }
+        if v == nil || v.ID == "" { return errors.New("could not determine the authenticated user") }
+        if mine { f.AssigneeID = v.ID }
+        if review { f.SubscriberID = v.ID }
+    }
+    if mentions {
+        ids, err := client.ListMentionedIssueIDs(time.Now().Add(-mentionsWindow))
+        if err != nil { return err }
+        // A non-nil empty list matches nothing rather than everything
+        f.IssueIDs = append([]string{}, ids...)
+    }
+    if favorites {
+        favs, err := client.ListFavorites()
+        if err != nil { return err }
+        f.IssueIDs = restrictIssueIDs(f.IssueIDs, favoriteIssueIDs(favs))
+    }
+    return nil
+}
+
+// copyIssueURL puts the URL of the row-th listed issue on the clipboard
+func copyIssueURL(items []api.IssueDetails, row int) error {
+    if row > len(items) { return fmt.Errorf("--copy %d: only %d issue(s) listed", row, len(items)) }
+    it := items[row-1]
+    if err := writeClipboard(it.URL); err != nil { return err }
+    ui.Infof("Copied %s's URL to the clipboard", it.Identifier)
+    return nil
+}
+
+// restrictIssueIDs narrows an id restriction (nil meaning none) to ids
+func restrictIssueIDs(current, ids []string) []string {
+    if current == nil { return append([]string{}, ids...) }
+    out := []string{}
+    for _, id := range current { if containsString(ids, id) { out = append(out, id) } }
+    return out
 }
 
 func normalizeState(s string) string {
@@ -502,7 +858,11 @@ func normalizeState(s string) string {
 var issuesListAdvCmd = &cobra.Command{
     Use:   "list",
     Short: "List issues with optional filters",
-    Long:  "List issues with optional filters for project, assignee, and state. Use convenience shortcuts --todo/--doing/--done or explicit --state.",
+    Long:  "List issues with optional filters for project, assignee, and state. Use convenience shortcuts --todo/--doing/--done or explicit --state. --no-project, --no-label, --not-state and --assignee-not leave issues out instead. --board lays the issues out as side-by-side state columns sized to the terminal. --since-last-run turns a listing into a change feed: each run returns only the issues updated since the previous run with the same filters.",
+    Example: `  linear-cli issues list --mine --limit 20
+  linear-cli issues list --project "Mobile App" --board --limit 50
+  linear-cli issues list --no-project --not-state Done --not-state Canceled --assignee-not me
+  linear-cli issues list --project "Mobile App" --since-last-run --json`,
     RunE: func(cmd *cobra.Command, args []string) error { return runIssuesListWithArgs(cmd, "") },
 }
 
@@ -556,7 +916,8 @@ Create fully structured Linear issues in a single command. Designed for AI agent
   1. Run: linear-cli issues create --team TEAM
   2. Select issue type (Feature/Bug/Spike) 
   3. Enter title (auto-prefixed: "Feat:", "Bug:", "Spike:")
-  4. Fill template sections interactively
+  4. Pick from existing labels the title suggests ("timeout" → performance)
+  5. Fill template sections interactively
 
 🔧 TECHNICAL DETAILS:
   - Templates applied server-side by Linear's API (ensures consistency)
@@ -584,11 +945,21 @@ issues are created exactly as if done through Linear's web interface.`,
     --sections Summary="Add dark theme toggle" Context="Users need low-light option"
   
   # Interactive creation
-  linear-cli issues create --team ENG`,
+  linear-cli issues create --team ENG
+
+  # Multi-paragraph sections from a YAML or JSON file (or '-' for stdin)
+  linear-cli issues create --team ENG --template "Bug Template" --title "Login timeout" \
+    --sections-file sections.yaml
+
+  # Draft elsewhere, then create from the clipboard ("Title\n---\nBody" sets both)
+  linear-cli issues create --team ENG --from-clipboard --no-interactive
+
+  # File the last commit as an issue and add "Refs: ENG-123" to its message
+  linear-cli issues create --team ENG --from-commit HEAD --amend-commit --no-interactive`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, _ := config.Load()
 		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
-		client := api.NewClient(cfg.APIKey)
+		client := newAPIClient(cmd, cfg.APIKey)
 
         title, _ := cmd.Flags().GetString("title")
         description, _ := cmd.Flags().GetString("description")
@@ -599,6 +970,13 @@ issues are created exactly as if done through Linear's web interface.`,
         
         // AI-friendly template section flags  
         sections, _ := cmd.Flags().GetStringToString("sections")
+        if sectionsFile, _ := cmd.Flags().GetString("sections-file"); strings.TrimSpace(sectionsFile) != "" {
+            fromFile, err := readSectionsFile(strings.TrimSpace(sectionsFile), cmd.InOrStdin())
+            if err != nil { return err }
+            // --sections given on the command line override the file
+            for k, v := range sections { fromFile[k] = v }
+            sections = fromFile
+        }
         previewFlag, _ := cmd.Flags().GetBool("preview")
         noPreview, _ := cmd.Flags().GetBool("no-preview")
         yes, _ := cmd.Flags().GetBool("yes")
@@ -611,17 +989,99 @@ issues are created exactly as if done through Linear's web interface.`,
         baseOverride, _ := cmd.Flags().GetString("templates-base-url")
         source, _ := cmd.Flags().GetString("templates-source")
 		assignee, _ := cmd.Flags().GetString("assignee")
-		label, _ := cmd.Flags().GetString("label")
-		priority, _ := cmd.Flags().GetInt("priority")
+		labelFlags, _ := cmd.Flags().GetStringArray("label")
+		labels := splitListFlags(labelFlags)
+        createLabels, _ := cmd.Flags().GetBool("create-missing-labels")
+        suggestLabelsFlag, _ := cmd.Flags().GetBool("suggest-labels")
+		priorityFlag, _ := cmd.Flags().GetString("priority")
+        stateFlag, _ := cmd.Flags().GetString("state")
+        dueFlag, _ := cmd.Flags().GetString("due")
+        estimateFlag, _ := cmd.Flags().GetInt("estimate")
+        fromClipboard, _ := cmd.Flags().GetBool("from-clipboard")
+        draft, _ := cmd.Flags().GetBool("draft")
+        // Refuse before any prompting; --draft and --dry-run send nothing
+        if dryRun, _ := cmd.Flags().GetBool("dry-run"); client.ReadOnly() != "" && !draft && !dryRun {
+            return fmt.Errorf("issues create is disabled: %s. --draft saves the issue locally instead", client.ReadOnly())
+        }
+        if linkPR, _ := cmd.Flags().GetString("link-pr"); strings.TrimSpace(linkPR) != "" {
+            // Resolve before creating so a missing PR fails fast
+            if draft { return errors.New("--link-pr cannot be combined with --draft") }
+            prURL, err := resolveLinkPR(linkPR)
+            if err != nil { return err }
+            _ = cmd.Flags().Set("link-pr", prURL)
+        }
+        idemKey, err := idempotencyKeyFlag(cmd)
+        if err != nil { return err }
+        mapFlags, _ := cmd.Flags().GetStringArray("map")
+        paragraphMap, err := parseParagraphMap(mapFlags)
+        if err != nil { return err }
+        if idemKey != "" {
+            // A retried run returns what the first run created
+            if draft { return errors.New("--idempotency-key cannot be combined with --draft") }
+            existing, err := findIdempotentIssue(client, idemKey)
+            if err != nil { return err }
+            if existing != nil {
+                ui.Infof("Idempotency key %s was already used; not creating another issue", idemKey)
+                p := printer(cmd)
+                if p.JSONEnabled() { return p.PrintJSON(existing) }
+                fmt.Printf("Exists %s: %s\n", existing.Identifier, existing.URL)
+                return nil
+            }
+        }
+        if fromClipboard {
+            if strings.TrimSpace(description) != "" { return errors.New("--from-clipboard cannot be combined with --description") }
+            clip, err := readClipboard()
+            if err != nil { return err }
+            clipTitle, clipBody := splitClipboardDraft(clip)
+            if clipTitle == "" && clipBody == "" { return errors.New("clipboard is empty") }
+            if strings.TrimSpace(title) == "" { title = clipTitle }
+            description = clipBody
+        }
+        amendCommit, _ := cmd.Flags().GetBool("amend-commit")
+        if fromCommit, _ := cmd.Flags().GetString("from-commit"); strings.TrimSpace(fromCommit) != "" {
+            if fromClipboard || strings.TrimSpace(description) != "" { return errors.New("--from-commit cannot be combined with --description or --from-clipboard") }
+            commit, err := readGitCommit(strings.TrimSpace(fromCommit))
+            if err != nil { return err }
+            if amendCommit && draft { return errors.New("--amend-commit cannot be combined with --draft") }
+            if amendCommit && !commit.IsHead { return fmt.Errorf("--amend-commit needs --from-commit to name HEAD, not %s", fromCommit) }
+            if strings.TrimSpace(title) == "" { title = commit.Subject }
+            description = commitIssueDescription(commit)
+            // The amend step after creation checks HEAD against the full SHA
+            _ = cmd.Flags().Set("from-commit", commit.SHA)
+        } else if amendCommit {
+            return errors.New("--amend-commit requires --from-commit")
+        }
+        if force, _ := cmd.Flags().GetBool("force-amend"); force && !amendCommit { return errors.New("--force-amend requires --amend-commit") }
         // Title can be gathered interactively if not provided
         // Compute default behavior: interactive by default with templates unless explicitly disabled.
         // If prefill vars are provided, default to preview unless explicitly disabled.
         varsProvided := len(varsKVs) > 0 || strings.TrimSpace(varsFile) != ""
+        // Team default template from 'config set-team KEY default_template=NAME': applied directly
+        // when nothing will prompt, otherwise offered as the picker's default choice
+        var defaultTemplate string
+        if strings.TrimSpace(templateName) == "" && strings.TrimSpace(templateID) == "" && strings.TrimSpace(description) == "" && strings.TrimSpace(teamKey) != "" {
+            defaultTemplate = cfg.TeamPrefs[strings.ToUpper(strings.TrimSpace(teamKey))].DefaultTemplate
+        }
+        if defaultTemplate != "" && (noInteractive || noInput || draft || len(sections) > 0) {
+            templateName = defaultTemplate
+        }
         // Determine if this is AI-friendly mode
         isAIMode := strings.TrimSpace(templateName) != "" && len(sections) > 0 && strings.TrimSpace(title) != ""
         
         // Interactive is the default unless explicitly disabled or in AI mode
         interactive := interactiveFlag
+        if noInput {
+            if interactiveFlag { return errors.New("--interactive cannot be combined with --no-input") }
+            noInteractive = true
+            if strings.TrimSpace(title) == "" { return errors.New("--title is required when --no-input is set") }
+        }
+        if draft {
+            // Drafts are saved as given; prompting and previews happen on 'drafts edit'
+            if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required with --draft") }
+            if strings.TrimSpace(title) == "" { return errors.New("--title is required with --draft") }
+            if strings.TrimSpace(templateID) != "" { return errors.New("--template-id cannot be combined with --draft") }
+            noInteractive, interactive, previewFlag, noPreview = true, false, false, true
+        }
         if !noInteractive && !cmd.Flags().Changed("interactive") && !isAIMode {
             interactive = true
         } else if isAIMode {
@@ -632,19 +1092,39 @@ issues are created exactly as if done through Linear's web interface.`,
             preview = true
         }
 
+        // Issue fields declared in template front matter apply on every create
+        // path below; explicit flags win
+        tplFields, err := localTemplateFields(templateName, source, templatesDir, baseOverride)
+        if err != nil { return err }
+
         // AI-FRIENDLY MODE: Seamless single-command creation
         if isAIMode {
             if strings.TrimSpace(teamKey) == "" {
                 return errors.New("--team is required for AI-friendly mode")
             }
             
-            return createIssueAIFriendly(client, teamKey, templateName, title, sections, cmd)
+            if draft {
+                return saveDraftFromCreate(cmd, Draft{Team: teamKey, Title: title, Template: templateName, Sections: sections})
+            }
+            fields := tplFields
+            fields.Labels = append(append([]string{}, labels...), tplFields.Labels...)
+            if project != "" { fields.Project = project }
+            if assignee != "" { fields.Assignee = assignee }
+            if cmd.Flags().Changed("priority") {
+                v, err := parsePriority(priorityFlag)
+                if err != nil { return err }
+                fields.Priority = &v
+            }
+            if cmd.Flags().Changed("estimate") { fields.Estimate = &estimateFlag }
+            return createIssueAIFriendly(client, teamKey, templateName, title, sections, fields, cmd)
         }
 
         // If user requested interactive but provided no template or description, offer to pick a template
         if interactive && strings.TrimSpace(templateName) == "" && strings.TrimSpace(description) == "" {
-            tmpl, pickErr := interactivePickTemplate(cmd, client, teamKey)
-            if pickErr == nil && strings.TrimSpace(tmpl) != "" { templateName = tmpl }
+            tmpl, pickErr := interactivePickTemplate(cmd, client, teamKey, defaultTemplate)
+            if errors.Is(pickErr, errInputRequired) { return pickErr }
+            if pickErr == nil && strings.TrimSpace(tmpl) != "" { templateName = tmpl } else if pickErr != nil && defaultTemplate != "" { templateName = defaultTemplate }
+            if tplFields, err = localTemplateFields(templateName, source, templatesDir, baseOverride); err != nil { return err }
         }
 
         // Fast path: server-side creation from API template id
@@ -653,8 +1133,17 @@ issues are created exactly as if done through Linear's web interface.`,
             t, errT := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
             if errT != nil { return errT }
             if t == nil { return fmt.Errorf("team with key %s not found", teamKey) }
-            created, err := client.CreateIssueFromTemplate(t.ID, templateID, title)
+            in := api.IssueCreateInput{TeamID: t.ID, TemplateID: templateID, Title: title}
+            if idemKey != "" {
+                // A description replaces the template's, so its body goes along with the marker
+                tpl, err := client.IssueTemplateByID(templateID)
+                if err != nil { return err }
+                if tpl != nil { in.Description = tpl.Description }
+                in.Description = withIdempotencyMarker(in.Description, idemKey)
+            }
+            created, err := client.CreateIssueAdvanced(in)
             if err != nil { return err }
+            if err := finishCreatedIssue(cmd, client, created); err != nil { return err }
             p := printer(cmd)
             if p.JSONEnabled() { return p.PrintJSON(created) }
             fmt.Printf("Created %s: %s\n", created.Identifier, created.URL)
@@ -668,7 +1157,7 @@ issues are created exactly as if done through Linear's web interface.`,
             var err error
             if source == "api" {
                 // Fetch template content via API, resolving by id or by name within team
-                client := api.NewClient(cfg.APIKey)
+                client := newAPIClient(cmd, cfg.APIKey)
                 if tpl, e := client.IssueTemplateByID(templateName); e == nil && tpl != nil {
                     tplContent = tpl.Description
                 } else {
@@ -682,8 +1171,12 @@ issues are created exactly as if done through Linear's web interface.`,
                     tplContent = tpl.Description
                 }
             } else {
-                tplContent, err = loadTemplateContent(templateName, templatesDir, baseOverride)
-                if err != nil { return fmt.Errorf("failed to load template '%s': %w", templateName, err) }
+                tpl, errL := loadTemplate(templateName, templatesDir, baseOverride)
+                if errL != nil { return fmt.Errorf("failed to load template '%s': %w", templateName, errL) }
+                if tpl != nil {
+                    tplContent = tpl.Body
+                    if tplFields, err = templateFieldsFromMeta(tpl.Meta); err != nil { return fmt.Errorf("template '%s': %w", templateName, err) }
+                }
             }
             // Extract optional title prefix metadata and strip it from the template body
             if prefix, body := parseTitlePrefixAndStrip(tplContent); prefix != "" {
@@ -698,44 +1191,105 @@ issues are created exactly as if done through Linear's web interface.`,
             if err != nil { return err }
             // If template had no placeholders and description is still empty, prompt by sections
             if interactive && strings.TrimSpace(description) == "" && !hasTemplatePlaceholders(tplContent) {
-                description = promptSectionsFromTemplate(tplContent)
+                if description, err = promptSectionsFromTemplate(tplContent); err != nil { return err }
+            }
+        }
+
+        if project == "" { project = tplFields.Project }
+        if assignee == "" { assignee = tplFields.Assignee }
+        if draft {
+            if strings.TrimSpace(stateFlag) != "" || strings.TrimSpace(dueFlag) != "" { return errors.New("--state and --due cannot be combined with --draft") }
+            if createLabels { return errors.New("--create-missing-labels cannot be combined with --draft") }
+            d := Draft{Team: teamKey, Title: title, Description: description, Project: project, Assignee: assignee, Labels: tplFields.Labels, Priority: tplFields.Priority, Estimate: tplFields.Estimate}
+            if len(labels) > 0 { d.Labels = append(append([]string{}, labels...), d.Labels...) }
+            if cmd.Flags().Changed("priority") {
+                v, err := parsePriority(priorityFlag)
+                if err != nil { return err }
+                d.Priority = &v
             }
+            if cmd.Flags().Changed("estimate") { d.Estimate = &estimateFlag }
+            return saveDraftFromCreate(cmd, d)
         }
 
         // (moved) Description prompting happens later within the interactive walkthrough,
         // after type/template/title so it aligns with the intended flow.
         var projectID string
         var teamID string
-        if project != "" {
-            pr, err := client.ResolveProject(project)
-            if err != nil { return err }
-            if pr == nil { return fmt.Errorf("project '%s' not found", project) }
+        pr, rc, err := resolveCreateTargets(cmd.Context(), client, project, teamKey)
+        if err != nil { return err }
+        if pr != nil {
             projectID = pr.ID
             if pr.TeamID != "" { teamID = pr.TeamID }
         }
+        if rc != nil && teamID != "" && teamID != rc.Team.ID { rc = nil }
         if teamKey != "" && teamID == "" {
-            t, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
-            if err != nil { return err }
-            if t == nil { return fmt.Errorf("team with key %s not found", teamKey) }
-            teamID = t.ID
+            if rc != nil {
+                teamID = rc.Team.ID
+            } else {
+                t, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
+                if err != nil { return err }
+                if t == nil { return fmt.Errorf("team with key %s not found", teamKey) }
+                teamID = t.ID
+            }
         }
         if teamID == "" { return errors.New("--team is required") }
-		var assigneeID string
-		if assignee != "" {
-			u, err := client.ResolveUser(assignee)
-			if err != nil { return err }
-			if u == nil { return fmt.Errorf("assignee '%s' not found", assignee) }
-			assigneeID = u.ID
-		}
-		var labelIDs []string
-		if label != "" {
-			l, err := client.ResolveLabelByName(label)
-			if err != nil { return err }
-			if l == nil { return fmt.Errorf("label '%s' not found", label) }
-			labelIDs = []string{l.ID}
-		}
-        var prioPtr *int
-        if cmd.Flags().Changed("priority") { prioPtr = &priority }
+        teamStates := func() []api.State {
+            if rc != nil { return rc.States }
+            states, _ := client.TeamStates(teamID)
+            return states
+        }
+        templateByName := func(name string) *api.IssueTemplate {
+            if rc != nil && len(rc.Templates) > 0 { return rc.TemplateByName(name) }
+            tpl, _ := client.IssueTemplateByNameForTeam(teamID, name)
+            return tpl
+        }
+        supportsTemplateID := func() bool {
+            if rc != nil { return rc.SupportsTemplateID }
+            return client.SupportsIssueCreateTemplateId()
+        }
+        // templateDescription is the description of a create applying tpl on the
+        // server: empty, or with --idempotency-key the template body plus the
+        // marker, since a description replaces the template's
+        templateDescription := func(tpl *api.IssueTemplate) string {
+            if idemKey == "" { return "" }
+            return withIdempotencyMarker(tpl.Description, idemKey)
+        }
+		labelNames := append(append([]string{}, labels...), tplFields.Labels...)
+		// Check every field up front so all problems are reported together
+		fields, err := preflightIssueFields(client, rc, teamID, issueFieldsInput{Priority: priorityFlag, PrioritySet: cmd.Flags().Changed("priority"), Labels: labelNames, Assignee: assignee, State: stateFlag, Due: dueFlag, CreateMissingLabels: createLabels}, time.Now())
+		if err != nil { return err }
+		assigneeID, labelIDs := fields.AssigneeID, fields.LabelIDs
+        // Labels named by the title or description: offered in the interactive
+        // walkthrough, added without asking with --suggest-labels
+        addSuggestedLabels := func(ask bool) error {
+            suggestions, err := teamLabelSuggestions(client, cfg, teamID, title, description, labelIDs)
+            if err != nil {
+                ui.Warnf("could not suggest labels: %v", err)
+                return nil
+            }
+            if len(suggestions) == 0 { return nil }
+            names := make([]string, len(suggestions))
+            for i, s := range suggestions { names[i] = describeLabelSuggestion(s) }
+            chosen := names
+            if ask {
+                if chosen, err = promptMultiSelect("Suggested labels (numbers, comma-separated; Enter to skip):", names); err != nil { return err }
+            }
+            for i, s := range suggestions {
+                if containsString(chosen, names[i]) { labelIDs = append(labelIDs, s.Label.ID) }
+            }
+            if !ask { ui.Infof("Adding suggested labels: %s", strings.Join(names, ", ")) }
+            return nil
+        }
+        if suggestLabelsFlag && !interactive { _ = addSuggestedLabels(false) }
+        prioPtr := fields.Priority
+        if prioPtr == nil && tplFields.Priority != nil { prioPtr = tplFields.Priority }
+        // --state wins over the silent Todo/Backlog default
+        initialStateID := func() string {
+            if fields.StateID != "" { return fields.StateID }
+            return defaultStateID(teamStates())
+        }
+        estimate := tplFields.Estimate
+        if cmd.Flags().Changed("estimate") { estimate = &estimateFlag }
         // Load last-used preferences for this team as defaults where applicable
         teamKeyNorm := strings.ToUpper(strings.TrimSpace(teamKey))
         tp := cfg.TeamPrefs[teamKeyNorm]
@@ -745,7 +1299,7 @@ issues are created exactly as if done through Linear's web interface.`,
             // Show the rendered description and exit
             p := printer(cmd)
             if p.JSONEnabled() {
-                _ = p.PrintJSON(map[string]any{"title": title, "projectId": projectID, "teamId": teamID, "assigneeId": assigneeID, "labelIds": labelIDs, "priority": prioPtr, "description": description})
+                _ = p.PrintJSON(map[string]any{"title": title, "projectId": projectID, "teamId": teamID, "assigneeId": assigneeID, "labelIds": labelIDs, "priority": prioPtr, "estimate": estimate, "description": description})
             } else {
                 fmt.Println("--- Issue Preview ---")
                 fmt.Printf("Title: %s\n", title)
@@ -753,7 +1307,9 @@ issues are created exactly as if done through Linear's web interface.`,
                 if teamID != "" { fmt.Printf("TeamID: %s\n", teamID) }
                 if assigneeID != "" { fmt.Printf("AssigneeID: %s\n", assigneeID) }
                 if len(labelIDs) > 0 { fmt.Printf("Labels: %s\n", strings.Join(labelIDs, ",")) }
-                if prioPtr != nil { fmt.Printf("Priority: %d\n", *prioPtr) }
+                if len(fields.MissingLabels) > 0 { fmt.Printf("New labels: %s\n", strings.Join(fields.MissingLabels, ", ")) }
+                if prioPtr != nil { fmt.Printf("Priority: %s\n", priorityLabel(*prioPtr)) }
+                if estimate != nil { fmt.Printf("Estimate: %d\n", *estimate) }
                 fmt.Println()
                 fmt.Println(description)
             }
@@ -761,15 +1317,22 @@ issues are created exactly as if done through Linear's web interface.`,
                 return nil
             }
         }
+        if len(fields.MissingLabels) > 0 {
+            ids, err := createMissingLabels(client, teamID, fields.MissingLabels)
+            if err != nil { return err }
+            labelIDs = append(labelIDs, ids...)
+        }
         // Track issue type for template selection
         var kind string
         
         // If interactive and still missing, walk through all fields in this order to match the desired UX
         if interactive {
             // Kind first, so we can apply prefixes and pick templates by type
-            kind = promptChoiceStrict("Issue type", []string{"Feature", "Bug", "Spike"}, false)
+            if kind, err = promptChoiceStrict("Issue type", []string{"Feature", "Bug", "Spike"}, false); err != nil { return err }
             // Title next (so we can apply any template/type prefix consistently)
-            if strings.TrimSpace(title) == "" { title = promptLine("Title: ") }
+            if strings.TrimSpace(title) == "" {
+                if title, err = promptLine("Title: "); err != nil { return err }
+            }
             if strings.TrimSpace(kind) != "" {
                 var pref string
                 switch strings.ToLower(kind) {
@@ -781,18 +1344,18 @@ issues are created exactly as if done through Linear's web interface.`,
                     title = strings.TrimSpace(pref + " " + title)
                 }
             }
+            if err := addSuggestedLabels(true); err != nil { return err }
             
+            if len(paragraphMap) > 0 {
+                if strings.TrimSpace(description) == "" { return errors.New("--map needs --description") }
+                if err := checkParagraphMap(paragraphMap, len(splitDescription(description))); err != nil { return err }
+            }
             // Interactive section filling for template-based issues
-            if client.SupportsIssueCreateTemplateId() && strings.TrimSpace(kind) != "" {
+            if supportsTemplateID() && strings.TrimSpace(kind) != "" {
                 // Find the template for this issue type
                 if tpl, _ := client.FindTemplateForTeamByKeywords(teamID, []string{kind, kind + " template"}); tpl != nil {
                     // Create issue with server-side template first to get the structure
-                    var chosenStateID string
-                    if states, _ := client.TeamStates(teamID); len(states) > 0 {
-                        idByName := map[string]string{}
-                        for _, s := range states { idByName[s.Name] = s.ID }
-                        if id, ok := idByName["Todo"]; ok { chosenStateID = id } else if id, ok := idByName["Backlog"]; ok { chosenStateID = id } else { chosenStateID = states[0].ID }
-                    }
+                    chosenStateID := initialStateID()
                     
                     // Set default priority to Medium (3)
                     if prioPtr == nil { v := 3; prioPtr = &v }
@@ -804,20 +1367,29 @@ issues are created exactly as if done through Linear's web interface.`,
                         StateID: chosenStateID, 
                         TemplateID: tpl.ID, 
                         Title: title, 
+                        Description: templateDescription(tpl),
                         AssigneeID: assigneeID, 
                         LabelIDs: labelIDs, 
                         Priority: prioPtr,
+                        Estimate: estimate,
+                        DueDate: fields.DueDate,
                     })
                     if err != nil { return err }
                     
                     // If user provided description, intelligently fill template sections
                     var filledDescription string
                     if strings.TrimSpace(description) != "" {
-                        filledDescription = fillTemplateFromDescription(tempIssue.Description, description)
+                        filledDescription, err = fillTemplateFromDescription(tempIssue.Description, description, paragraphMap)
+                        if err != nil {
+                            // The issue exists already; fall back to automatic routing
+                            ui.Warnf("%v; filling sections automatically", err)
+                            filledDescription, _ = fillTemplateFromDescription(tempIssue.Description, description, nil)
+                        }
                     } else {
                         // Interactive prompting for each section
-                        filledDescription = promptTemplateInteractively(tempIssue.Description)
+                        if filledDescription, err = promptTemplateInteractively(tempIssue.Description); err != nil { return err }
                     }
+                    filledDescription = withIdempotencyMarker(filledDescription, idemKey)
                     
                     // Update the issue with filled content
                     if filledDescription != tempIssue.Description {
@@ -826,6 +1398,7 @@ issues are created exactly as if done through Linear's web interface.`,
                         tempIssue = updatedIssue
                     }
                     
+                    if err := finishCreatedIssue(cmd, client, tempIssue); err != nil { return err }
                     p := printer(cmd)
                     if p.JSONEnabled() { return p.PrintJSON(tempIssue) }
                     fmt.Printf("Created %s: %s\n", tempIssue.Identifier, tempIssue.URL)
@@ -838,7 +1411,9 @@ issues are created exactly as if done through Linear's web interface.`,
 
             // Optional editor for final tweaks when a description exists
             if strings.TrimSpace(description) != "" {
-                if promptYesNo("Open in editor to finalize description? (y/N): ", false) {
+                edit, err := promptYesNo("Open in editor to finalize description? (y/N): ", false)
+                if err != nil { return err }
+                if edit {
                     if edited, err := openInEditor(description); err == nil { description = edited }
                 }
             }
@@ -858,17 +1433,12 @@ issues are created exactly as if done through Linear's web interface.`,
         }
 
         // Final: create with server-side template application and silent state defaults
-        var chosenStateID string
-        if states, _ := client.TeamStates(teamID); len(states) > 0 {
-            idByName := map[string]string{}
-            for _, s := range states { idByName[s.Name] = s.ID }
-            if id, ok := idByName["Todo"]; ok { chosenStateID = id } else if id, ok := idByName["Backlog"]; ok { chosenStateID = id } else { chosenStateID = states[0].ID }
-        }
+        chosenStateID := initialStateID()
         
         // AI-friendly mode: use --template and --sections to create structured issues
         if !interactive && strings.TrimSpace(templateName) != "" && len(sections) > 0 {
             // Find template by name
-            if tpl, _ := client.IssueTemplateByNameForTeam(teamID, templateName); tpl != nil {
+            if tpl := templateByName(templateName); tpl != nil {
                 // Create issue with template to get structure
                 tempIssue, err := client.CreateIssueAdvanced(api.IssueCreateInput{
                     ProjectID: projectID, 
@@ -876,14 +1446,17 @@ issues are created exactly as if done through Linear's web interface.`,
                     StateID: chosenStateID, 
                     TemplateID: tpl.ID, 
                     Title: title, 
+                    Description: templateDescription(tpl),
                     AssigneeID: assigneeID, 
                     LabelIDs: labelIDs, 
                     Priority: prioPtr,
+                    Estimate: estimate,
+                    DueDate: fields.DueDate,
                 })
                 if err != nil { return err }
                 
                 // Fill template sections dynamically
-                filledDescription := fillTemplateSectionsDynamically(tempIssue.Description, sections)
+                filledDescription := withIdempotencyMarker(fillTemplateSectionsDynamically(tempIssue.Description, sections), idemKey)
                 
                 // Update the issue with filled content
                 if filledDescription != tempIssue.Description {
@@ -892,6 +1465,7 @@ issues are created exactly as if done through Linear's web interface.`,
                     tempIssue = updatedIssue
                 }
                 
+                if err := finishCreatedIssue(cmd, client, tempIssue); err != nil { return err }
                 p := printer(cmd)
                 if p.JSONEnabled() { return p.PrintJSON(tempIssue) }
                 fmt.Printf("Created %s: %s\n", tempIssue.Identifier, tempIssue.URL)
@@ -901,15 +1475,17 @@ issues are created exactly as if done through Linear's web interface.`,
         
         // For non-interactive flows, use server-side template application if no description provided
         var templateIDForServer string
-        if !interactive && client.SupportsIssueCreateTemplateId() && strings.TrimSpace(description) == "" && strings.TrimSpace(templateName) != "" {
+        if !interactive && strings.TrimSpace(description) == "" && strings.TrimSpace(templateName) != "" && supportsTemplateID() {
             // Use specified template name
-            if tpl, _ := client.IssueTemplateByNameForTeam(teamID, templateName); tpl != nil {
+            if tpl := templateByName(templateName); tpl != nil {
                 templateIDForServer = tpl.ID
+                description = templateDescription(tpl)
             }
         }
         
-        created, err := client.CreateIssueAdvanced(api.IssueCreateInput{ProjectID: projectID, TeamID: teamID, StateID: chosenStateID, TemplateID: templateIDForServer, Title: title, Description: description, AssigneeID: assigneeID, LabelIDs: labelIDs, Priority: prioPtr})
+        created, err := client.CreateIssueAdvanced(api.IssueCreateInput{ProjectID: projectID, TeamID: teamID, StateID: chosenStateID, TemplateID: templateIDForServer, Title: title, Description: withIdempotencyMarker(description, idemKey), AssigneeID: assigneeID, LabelIDs: labelIDs, Priority: prioPtr, Estimate: estimate, DueDate: fields.DueDate})
 		if err != nil { return err }
+		if err := finishCreatedIssue(cmd, client, created); err != nil { return err }
 		p := printer(cmd)
 		if p.JSONEnabled() { return p.PrintJSON(created) }
 		fmt.Printf("Created %s: %s\n", created.Identifier, created.URL)
@@ -917,6 +1493,16 @@ issues are created exactly as if done through Linear's web interface.`,
 	},
 }
 
+// defaultStateID picks the state new issues land in: Todo, then Backlog, then the first state.
+func defaultStateID(states []api.State) string {
+    if len(states) == 0 { return "" }
+    idByName := map[string]string{}
+    for _, s := range states { idByName[s.Name] = s.ID }
+    if id, ok := idByName["Todo"]; ok { return id }
+    if id, ok := idByName["Backlog"]; ok { return id }
+    return states[0].ID
+}
+
 func init() {
 	// override list/create with advanced versions and add view
 	issuesCmd.RemoveCommand(issuesListCmd)
@@ -927,26 +1513,57 @@ func init() {
     issuesCmd.AddCommand(issuesTodoCmd)
     issuesCmd.AddCommand(issuesDoingCmd)
     issuesCmd.AddCommand(issuesDoneCmd)
+    addOutputTemplateFlags(issuesListAdvCmd, issuesTodoCmd, issuesDoingCmd, issuesDoneCmd, issuesViewCmd)
     issuesCmd.AddCommand(issuesTemplateCmd)
     issuesTemplateCmd.AddCommand(issuesTemplateStructureCmd)
 
-    issuesListAdvCmd.Flags().Int("limit", 10, "Maximum number of issues to list")
+    issuesListAdvCmd.Flags().Int("limit", 10, "Maximum number of issues to list (0 for all)")
+    issuesListAdvCmd.Flags().Bool("all", false, "List every matching issue (same as --limit 0)")
+    issuesListAdvCmd.MarkFlagsMutuallyExclusive("limit", "all")
+    issuesListAdvCmd.Flags().Bool("since-last-run", false, "Only issues changed since the previous run with the same filters (the first run lists all)")
+    issuesListAdvCmd.MarkFlagsMutuallyExclusive("limit", "since-last-run")
+    issuesListAdvCmd.MarkFlagsMutuallyExclusive("all", "since-last-run")
     issuesListAdvCmd.Flags().String("project", "", "Filter by project name or id")
     issuesListAdvCmd.Flags().String("assignee", "", "Filter by assignee name or id")
     issuesListAdvCmd.Flags().StringP("state", "s", "", "Filter by state (e.g. Todo, In Progress, Done)")
     issuesListAdvCmd.Flags().Bool("todo", false, "Shortcut for --state 'Todo'")
     issuesListAdvCmd.Flags().Bool("doing", false, "Shortcut for --state 'In Progress'")
     issuesListAdvCmd.Flags().Bool("done", false, "Shortcut for --state 'Done'")
+    issuesListAdvCmd.Flags().String("priority", "", "Filter by priority: urgent|high|medium|low|none")
+    issuesListAdvCmd.Flags().String("group-by", "", "Group output by state|assignee|project|priority")
+    issuesListAdvCmd.Flags().String("parent", "", "Only the sub-issues of this issue (key or id)")
+    issuesListAdvCmd.Flags().Bool("rollup", false, "With --parent, total each sub-issue's own sub-issues and estimates, plus the parent's")
+    issuesListAdvCmd.Flags().Bool("board", false, "Show issues as a board with one column per state")
+    addCopyFlag(issuesListAdvCmd)
+    issuesListAdvCmd.Flags().StringArray("not-state", nil, "Leave out issues in this state (repeatable, or comma-separated)")
+    addViewerFilterFlags(issuesListAdvCmd)
+    addNegationFilterFlags(issuesListAdvCmd)
+    addActivityFilterFlags(issuesListAdvCmd)
 
     // Reuse common flags for state subcommands
     for _, c := range []*cobra.Command{issuesTodoCmd, issuesDoingCmd, issuesDoneCmd} {
-        c.Flags().Int("limit", 10, "Maximum number of issues to list")
+        c.Flags().Int("limit", 10, "Maximum number of issues to list (0 for all)")
+        c.Flags().Bool("all", false, "List every matching issue (same as --limit 0)")
+        c.MarkFlagsMutuallyExclusive("limit", "all")
         c.Flags().String("project", "", "Filter by project name or id")
         c.Flags().String("assignee", "", "Filter by assignee name or id")
+        c.Flags().String("priority", "", "Filter by priority: urgent|high|medium|low|none")
+        c.Flags().String("group-by", "", "Group output by state|assignee|project|priority")
+        addViewerFilterFlags(c)
+        addNegationFilterFlags(c)
+        addActivityFilterFlags(c)
+        addCopyFlag(c)
     }
 
     issuesCreateAdvCmd.Flags().String("title", "", "Issue title (prompted if not provided)")
     issuesCreateAdvCmd.Flags().String("description", "", "Issue description")
+    issuesCreateAdvCmd.Flags().Bool("draft", false, "Save the issue as a local draft instead of creating it (see 'drafts')")
+    issuesCreateAdvCmd.Flags().String("idempotency-key", "", "Return the issue an earlier run created with this key instead of creating a duplicate")
+    issuesCreateAdvCmd.Flags().String("link-pr", "", "Attach a GitHub pull request URL to the new issue ('auto' uses the current branch's open PR via gh)")
+    issuesCreateAdvCmd.Flags().String("from-commit", "", "Use a git commit (e.g. HEAD) for the title (subject) and description (body, plus a link to the commit)")
+    issuesCreateAdvCmd.Flags().Bool("amend-commit", false, "With --from-commit HEAD, amend the commit message to reference the created issue")
+    issuesCreateAdvCmd.Flags().Bool("force-amend", false, "With --amend-commit, amend even a commit that is already on a remote branch")
+    issuesCreateAdvCmd.Flags().Bool("from-clipboard", false, "Read the description from the system clipboard (a single title line followed by a '---' line sets the title)")
     issuesCreateAdvCmd.Flags().String("template", "", "Template name (e.g. bug, feature, spike) or file path")
     issuesCreateAdvCmd.Flags().String("template-id", "", "Linear API template id to use for server-side creation (requires --team)")
     issuesCreateAdvCmd.Flags().BoolP("interactive", "i", false, "Interactive walkthrough (default: on; disable with --no-interactive)")
@@ -954,6 +1571,8 @@ func init() {
     
     // AI-friendly template section flags
     issuesCreateAdvCmd.Flags().StringToString("sections", nil, "Template sections as key=value pairs (e.g. --sections Summary='Brief description' Context='Background info')")
+    issuesCreateAdvCmd.Flags().StringArray("map", nil, "Route --description paragraphs to template sections, e.g. Summary=1,Context=2-3 (default: by headings, then in order)")
+    issuesCreateAdvCmd.Flags().String("sections-file", "", "JSON or YAML file mapping template sections to markdown content ('-' for stdin)")
     issuesCreateAdvCmd.Flags().Bool("preview", false, "Preview the rendered issue and exit without creating (default: on when --var/--vars-file provided)")
     issuesCreateAdvCmd.Flags().Bool("no-preview", false, "Disable automatic preview when vars are provided")
     issuesCreateAdvCmd.Flags().BoolP("yes", "y", false, "Proceed with creation after preview without prompting")
@@ -963,62 +1582,86 @@ func init() {
     issuesCreateAdvCmd.Flags().String("project", "", "Project name or id")
     issuesCreateAdvCmd.Flags().String("team", "", "Team key (e.g. ENG)")
     issuesCreateAdvCmd.Flags().String("assignee", "", "Assignee name or id")
-    issuesCreateAdvCmd.Flags().String("label", "", "Label name")
-    issuesCreateAdvCmd.Flags().Int("priority", 0, "Priority (1 highest .. 4 lowest)")
+    issuesCreateAdvCmd.Flags().StringArray("label", nil, "Label name (repeatable, or comma-separated)")
+    issuesCreateAdvCmd.Flags().Bool("create-missing-labels", false, "Create labels that do not exist yet in the team (default color) instead of failing")
+    issuesCreateAdvCmd.Flags().Bool("suggest-labels", false, "Add existing labels named by the title or description (e.g. \"timeout\" suggests performance); the interactive walkthrough always offers them")
+    issuesCreateAdvCmd.Flags().String("priority", "", "Priority: urgent|high|medium|low|none (or 0-4)")
+    issuesCreateAdvCmd.Flags().Int("estimate", 0, "Estimate in points (overrides template front matter)")
+    issuesCreateAdvCmd.Flags().String("state", "", "Initial workflow state name (default: Todo, then Backlog)")
+    issuesCreateAdvCmd.Flags().String("due", "", "Due date: YYYY-MM-DD, today, tomorrow, a weekday or an offset like 3d")
     issuesCreateAdvCmd.Flags().String("templates-dir", "", "Override templates directory (default search: $LINEAR_TEMPLATES_DIR, UserConfigDir/linear/templates, ~/.config/linear/templates)")
     issuesCreateAdvCmd.Flags().String("templates-base-url", "", "Remote templates base URL (fallback: $LINEAR_TEMPLATES_BASE_URL). Names resolve to <base>/<name>.md")
     issuesCreateAdvCmd.Flags().String("templates-source", "auto", "Template source: auto|local|remote|api")
     issuesViewCmd.Flags().Int("comments", 0, "Include up to N comments")
+    issuesViewCmd.Flags().String("comments-since", "", "Include comments created at or after this time (yesterday, 7d, 2024-01-01)")
+    issuesViewCmd.Flags().Bool("all-comments", false, "Include every comment (paginated)")
+    issuesViewCmd.Flags().Bool("history", false, "Include the audit trail of state, assignee and label changes")
+    issuesViewCmd.Flags().Bool("children", false, "Include sub-issues")
+    issuesViewCmd.Flags().Bool("relations", false, "Include blocking, duplicate and related issues")
+    issuesViewCmd.Flags().Bool("attachments", false, "Include attachments and links")
+    issuesViewCmd.Flags().Bool("rollup", false, "Total the issue counts and estimates of all sub-issues, at every depth")
+    issuesViewCmd.Flags().Bool("full", false, "Include sub-issues, relations, attachments, recent comments and history, fetched in one request")
     issuesTemplateStructureCmd.Flags().String("team", "", "Team key (required)")
     issuesTemplateStructureCmd.Flags().String("template", "", "Template name (optional - if not provided, lists all templates)")
+    issuesTemplateStructureCmd.Flags().String("format", "text", "Output format: text|json-schema")
+    issuesTemplateCmd.AddCommand(issuesTemplatePreviewCmd)
+    issuesTemplatePreviewCmd.Flags().String("team", "", "Team key, to preview the team's template from the API")
+    issuesTemplatePreviewCmd.Flags().StringArray("var", nil, "Template variable assignment key=value (repeatable)")
+    issuesTemplatePreviewCmd.Flags().String("vars-file", "", "JSON file with string key-value pairs for template variables")
+    issuesTemplatePreviewCmd.Flags().Bool("sample", false, "Fill placeholders and sections without a value with realistic sample data")
+    issuesTemplatePreviewCmd.Flags().String("templates-dir", "", "Override templates directory")
+    issuesTemplatePreviewCmd.Flags().String("templates-base-url", "", "Remote templates base URL (fallback: $LINEAR_TEMPLATES_BASE_URL)")
+    issuesTemplatePreviewCmd.Flags().String("templates-source", "auto", "Template source: auto|local|remote|api")
+    issuesTemplatePreviewCmd.Flags().Bool("debug", false, "Print the API template candidates considered")
 }
 
 // loadTemplateContent resolves a template by name, path, or URL.
 // - If value is an http(s) URL, it is fetched directly
 // - If value looks like a path, it is read from disk
 // - Otherwise, it is treated as a name and resolved from local dirs or a remote base URL
+// Front matter is stripped and `extends:`/`{{> partial}}` references are expanded.
 func loadTemplateContent(value string, overrideDir string, baseOverride string) (string, error) {
+    t, err := loadTemplate(value, overrideDir, baseOverride)
+    if err != nil || t == nil { return "", err }
+    return t.Body, nil
+}
+
+// readTemplateRaw locates a template by URL, path or name and returns its unprocessed
+// content together with its origin (file path or URL) for resolving relative references.
+func readTemplateRaw(value string, overrideDir string, baseOverride string) (string, string, error) {
     v := strings.TrimSpace(value)
-    if v == "" { return "", nil }
+    if v == "" { return "", "", nil }
     if strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://") {
-        return fetchURL(v)
-    }
-    // Expand ~
-    expandHome := func(p string) string {
-        if strings.HasPrefix(p, "~") {
-            if home, err := os.UserHomeDir(); err == nil {
-                return filepath.Join(home, strings.TrimPrefix(p, "~"))
-            }
-        }
-        return p
+        s, err := fetchURL(v)
+        return s, v, err
     }
     // If it's a path-like string, read it directly
     pathLike := strings.Contains(v, string(os.PathSeparator)) || strings.HasPrefix(v, ".") || strings.HasPrefix(v, "~")
     if pathLike {
-        p := expandHome(v)
+        p := expandUserPath(v)
         b, err := os.ReadFile(p)
-        if err != nil { return "", err }
-        return string(b), nil
+        if err != nil { return "", "", err }
+        return string(b), p, nil
     }
     // Try remote base first if provided
     if base := templateBaseURL(baseOverride); base != "" {
         url := joinURL(base, v+".md")
-        if s, err := fetchURL(url); err == nil { return s, nil }
+        if s, err := fetchURL(url); err == nil { return s, url, nil }
     }
     // Resolve from local directories
     dirs := templateSearchDirs(overrideDir)
     for _, dir := range dirs {
         cand := filepath.Join(dir, v+".md")
         if b, err := os.ReadFile(cand); err == nil {
-            return string(b), nil
+            return string(b), cand, nil
         }
     }
     // If remote base exists, mention it in error for clarity
     base := templateBaseURL(baseOverride)
     if base != "" {
-        return "", fmt.Errorf("template '%s' not found. Searched local: %s and remote: %s", v, strings.Join(dirs, ", "), joinURL(base, v+".md"))
+        return "", "", fmt.Errorf("template '%s' not found. Searched local: %s and remote: %s", v, strings.Join(dirs, ", "), joinURL(base, v+".md"))
     }
-    return "", fmt.Errorf("template '%s' not found in any of: %s", v, strings.Join(dirs, ", "))
+    return "", "", fmt.Errorf("template '%s' not found in any of: %s", v, strings.Join(dirs, ", "))
 }
 
 // templateSearchDirs returns candidate directories to look for templates in priority order.
@@ -1050,7 +1693,8 @@ func expandUserPath(p string) string {
 }
 
 // interactivePickTemplate offers the user a list of available templates (from auto/remote/local/API based on flags/env) and returns the chosen name.
-func interactivePickTemplate(cmd *cobra.Command, client *api.Client, teamKey string) (string, error) {
+// An empty answer selects defaultName when one is set.
+func interactivePickTemplate(cmd *cobra.Command, client *api.Client, teamKey, defaultName string) (string, error) {
     // Determine source preferences
     source, _ := cmd.Flags().GetString("templates-source")
     templatesDir, _ := cmd.Flags().GetString("templates-dir")
@@ -1098,13 +1742,16 @@ func interactivePickTemplate(cmd *cobra.Command, client *api.Client, teamKey str
     if len(names) == 0 {
         return "", errors.New("no templates available to choose from")
     }
+    if err := ensureInteractive("template selection"); err != nil { return "", err }
     // Prompt
     fmt.Println("Select a template:")
     for i, n := range names { fmt.Printf("  %d) %s\n", i+1, n) }
+    if defaultName != "" { fmt.Printf("[default: %s] ", defaultName) }
     fmt.Print("> ")
     rdr := bufio.NewReader(os.Stdin)
-    line, _ := rdr.ReadString('\n')
+    line, _ := readInputLine(rdr)
     choice := strings.TrimSpace(line)
+    if choice == "" && defaultName != "" { return defaultName, nil }
     // Try number
     if idx, err := strconv.Atoi(choice); err == nil {
         if idx >= 1 && idx <= len(names) { return names[idx-1], nil }
@@ -1116,65 +1763,70 @@ func interactivePickTemplate(cmd *cobra.Command, client *api.Client, teamKey str
 }
 
 // promptMultilineDescription asks the user for a multi-line description terminated by a single '.' on its own line.
-func promptMultilineDescription() string {
+func promptMultilineDescription() (string, error) {
+    if err := ensureInteractive("description"); err != nil { return "", err }
     fmt.Println("Enter issue description. End with a single '.' on its own line:")
     rdr := bufio.NewReader(os.Stdin)
     var lines []string
     for {
-        line, _ := rdr.ReadString('\n')
+        line, err := readInputLine(rdr)
         line = strings.TrimRight(line, "\r\n")
-        if line == "." { break }
+        if line == "." || (err != nil && line == "") { break }
         lines = append(lines, line)
     }
-    return strings.TrimSpace(strings.Join(lines, "\n"))
+    return strings.TrimSpace(strings.Join(lines, "\n")), nil
 }
 
 // promptLine prints a prompt and returns a single line input (trimmed)
-func promptLine(label string) string {
+func promptLine(label string) (string, error) {
+    if err := ensureInteractive(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(label), ":"))); err != nil { return "", err }
     fmt.Print(label)
     rdr := bufio.NewReader(os.Stdin)
-    line, _ := rdr.ReadString('\n')
-    return strings.TrimSpace(line)
+    line, _ := readInputLine(rdr)
+    return strings.TrimSpace(line), nil
 }
 
 // promptChoice prints a label and numbered options; returns the chosen option string.
-func promptChoice(label string, options []string) string {
-    if len(options) == 0 { return "" }
+func promptChoice(label string, options []string) (string, error) {
+    if len(options) == 0 { return "", nil }
+    if err := ensureInteractive(label); err != nil { return "", err }
     fmt.Println(label + ":")
     for i, opt := range options { fmt.Printf("  %d) %s\n", i+1, opt) }
     fmt.Print("> ")
     rdr := bufio.NewReader(os.Stdin)
-    line, _ := rdr.ReadString('\n')
+    line, _ := readInputLine(rdr)
     choice := strings.TrimSpace(line)
     if idx, err := strconv.Atoi(choice); err == nil {
-        if idx >= 1 && idx <= len(options) { return options[idx-1] }
+        if idx >= 1 && idx <= len(options) { return options[idx-1], nil }
     }
     // fallback to matching by text
-    for _, opt := range options { if strings.EqualFold(opt, choice) { return opt } }
-    return options[0]
+    for _, opt := range options { if strings.EqualFold(opt, choice) { return opt, nil } }
+    return options[0], nil
 }
 
 // promptChoiceStrict enforces valid selection; allowSkip adds a "(skip)" option.
-func promptChoiceStrict(label string, options []string, allowSkip bool) string {
+func promptChoiceStrict(label string, options []string, allowSkip bool) (string, error) {
     opts := append([]string{}, options...)
     if allowSkip { opts = append([]string{"(skip)"}, opts...) }
     for {
-        choice := promptChoice(label, opts)
-        if allowSkip && (strings.EqualFold(choice, "(skip)") || strings.EqualFold(choice, "skip")) { return "" }
-        for _, opt := range opts { if strings.EqualFold(opt, choice) { return opt } }
+        choice, err := promptChoice(label, opts)
+        if err != nil { return "", err }
+        if allowSkip && (strings.EqualFold(choice, "(skip)") || strings.EqualFold(choice, "skip")) { return "", nil }
+        for _, opt := range opts { if strings.EqualFold(opt, choice) { return opt, nil } }
         fmt.Println("Invalid selection. Please choose a number or matching text.")
     }
 }
 
 // promptMultiSelect lets user select multiple items by entering comma-separated indexes or names.
-func promptMultiSelect(label string, options []string) []string {
+func promptMultiSelect(label string, options []string) ([]string, error) {
+    if err := ensureInteractive(label); err != nil { return nil, err }
     fmt.Println(label)
     for i, opt := range options { fmt.Printf("  %d) %s\n", i+1, opt) }
     fmt.Print("> ")
     rdr := bufio.NewReader(os.Stdin)
-    line, _ := rdr.ReadString('\n')
+    line, _ := readInputLine(rdr)
     line = strings.TrimSpace(line)
-    if line == "" { return nil }
+    if line == "" { return nil, nil }
     parts := strings.Split(line, ",")
     var out []string
     for _, p := range parts {
@@ -1187,25 +1839,36 @@ func promptMultiSelect(label string, options []string) []string {
         // match by name
         for _, opt := range options { if strings.EqualFold(opt, v) { out = append(out, opt); break } }
     }
-    return out
+    return out, nil
 }
 
 // promptYesNo asks a yes/no question; defaultYes controls default on empty input.
-func promptYesNo(label string, defaultYes bool) bool {
+func promptYesNo(label string, defaultYes bool) (bool, error) {
+    if err := ensureInteractive(strings.TrimSpace(label)); err != nil { return false, err }
     fmt.Print(label)
     rdr := bufio.NewReader(os.Stdin)
-    line, _ := rdr.ReadString('\n')
+    line, _ := readInputLine(rdr)
     v := strings.TrimSpace(strings.ToLower(line))
-    if v == "" { return defaultYes }
-    return v == "y" || v == "yes"
+    if v == "" { return defaultYes, nil }
+    return v == "y" || v == "yes", nil
+}
+
+// confirmed reports whether to go ahead, asking unless yes (--yes) is set
+func confirmed(yes bool, label string) (bool, error) {
+    if yes { return true, nil }
+    return promptYesNo(label, false)
 }
 
 // openInEditor opens $VISUAL or $EDITOR (falls back to vi) to edit text; returns the updated content.
 func openInEditor(initial string) (string, error) {
+    if err := ensureInteractive("editor"); err != nil { return "", err }
     tmp, err := os.CreateTemp("", "linear-cli-*.md")
     if err != nil { return "", err }
     path := tmp.Name()
     _ = tmp.Close()
+    // Removed on every path, including an interrupt while the editor is open
+    defer os.Remove(path)
+    defer addCleanup(func() { _ = os.Remove(path) })()
     if err := os.WriteFile(path, []byte(initial), 0o600); err != nil { return "", err }
     editor := strings.TrimSpace(os.Getenv("VISUAL"))
     if editor == "" { editor = strings.TrimSpace(os.Getenv("EDITOR")) }
@@ -1217,7 +1880,6 @@ func openInEditor(initial string) (string, error) {
     if err := cmd.Run(); err != nil { return "", err }
     b, err := os.ReadFile(path)
     if err != nil { return "", err }
-    _ = os.Remove(path)
     return string(b), nil
 }
 
@@ -1292,12 +1954,13 @@ func fillTemplate(tpl string, vars map[string]string, interactive bool, failOnMi
         }
     }
     if interactive && len(missing) > 0 {
+        if err := ensureInteractive("template variables: " + strings.Join(missing, ", ")); err != nil { return "", err }
         rdr := bufio.NewReader(os.Stdin)
         for _, key := range missing {
             prompt := key
             if p, ok := prompts[key]; ok { prompt = fmt.Sprintf("%s\n> ", p) } else { prompt = prompt + ": " }
             fmt.Print(prompt)
-            line, _ := rdr.ReadString('\n')
+            line, _ := readInputLine(rdr)
             vars[key] = strings.TrimSpace(line)
         }
         missing = missing[:0]
@@ -1325,7 +1988,7 @@ func hasTemplatePlaceholders(tpl string) bool {
 
 // promptSectionsFromTemplate extracts markdown-style sections (lines ending with ':' or '## Heading')
 // and prompts the user to fill each one, composing a structured description.
-func promptSectionsFromTemplate(tpl string) string {
+func promptSectionsFromTemplate(tpl string) (string, error) {
     lines := strings.Split(tpl, "\n")
     type section struct{ title string }
     var sections []section
@@ -1349,23 +2012,25 @@ func promptSectionsFromTemplate(tpl string) string {
         b.WriteString("## ")
         b.WriteString(sec.title)
         b.WriteString("\n")
-        b.WriteString(promptMultilineBlock(sec.title))
+        text, err := promptMultilineBlock(sec.title)
+        if err != nil { return "", err }
+        b.WriteString(text)
     }
-    return strings.TrimSpace(b.String())
+    return strings.TrimSpace(b.String()), nil
 }
 
 // buildDescriptionFromTemplate chooses the best interactive strategy to produce a description
 // from a template: placeholder prompting when tokens exist, otherwise section-by-section prompts.
 func buildDescriptionFromTemplate(tpl string, vars map[string]string, interactive bool, failOnMissing bool) (string, error) {
     if strings.TrimSpace(tpl) == "" {
-        if interactive { return promptMultilineBlock("Description"), nil }
+        if interactive { return promptMultilineBlock("Description") }
         return "", nil
     }
     if hasTemplatePlaceholders(tpl) {
         return fillTemplate(tpl, vars, interactive, failOnMissing)
     }
     if interactive {
-        return promptSectionsFromTemplate(tpl), nil
+        return promptSectionsFromTemplate(tpl)
     }
     // Non-interactive, no placeholders: return raw body
     return tpl, nil
@@ -1382,17 +2047,19 @@ func isStandaloneHeading(s string) bool {
 }
 
 // promptMultilineBlock prompts the user for a multi-line block with a clear label; end on empty line.
-func promptMultilineBlock(label string) string {
+func promptMultilineBlock(label string) (string, error) {
+    if err := ensureInteractive(label); err != nil { return "", err }
     fmt.Printf("%s (finish with an empty line):\n", label)
     rdr := bufio.NewReader(os.Stdin)
     var lines []string
     for {
-        line, _ := rdr.ReadString('\n')
+        line, err := readInputLine(rdr)
         line = strings.TrimRight(line, "\r\n")
         if strings.TrimSpace(line) == "" { break }
+        if err != nil { lines = append(lines, line); break }
         lines = append(lines, line)
     }
-    return strings.TrimSpace(strings.Join(lines, "\n"))
+    return strings.TrimSpace(strings.Join(lines, "\n")), nil
 }
 
 // parseTitlePrefixAndStrip allows templates to declare a title prefix on the first line like:
@@ -1403,7 +2070,7 @@ func parseTitlePrefixAndStrip(tpl string) (prefix string, body string) {
     if len(lines) == 0 { return "", tpl }
     first := strings.TrimSpace(lines[0])
     if strings.HasPrefix(strings.ToLower(first), "title-prefix:") {
-        val := strings.TrimSpace(strings.TrimPrefix(first, "title-prefix:"))
+        val := strings.TrimSpace(first[len("title-prefix:"):])
         return val, strings.Join(lines[1:], "\n")
     }
     return "", tpl
@@ -1434,45 +2101,8 @@ func gatherVars(kvs []string, file string) (map[string]string, error) {
     return out, nil
 }
 
-// fillTemplateFromDescription intelligently fills template sections from a provided description
-func fillTemplateFromDescription(templateContent, userDescription string) string {
-	// Parse the template to find sections
-	sections := parseTemplateSections(templateContent)
-	if len(sections) == 0 {
-		return userDescription // No template structure, just use user description
-	}
-	
-	// Smart filling: try to extract relevant parts from user description
-	filled := templateContent
-	
-	// Simple heuristic: use the first sentence(s) for Summary
-	sentences := strings.Split(strings.TrimSpace(userDescription), ". ")
-	if len(sentences) > 0 {
-		summary := sentences[0]
-		if !strings.HasSuffix(summary, ".") { summary += "." }
-		filled = strings.Replace(filled, "One or two sentences describing what this issue is and why it matters.", summary, 1)
-	}
-	
-	// Use remaining content for Context if we have more than one sentence
-	if len(sentences) > 1 {
-		context := strings.Join(sentences[1:], ". ")
-		if strings.TrimSpace(context) != "" {
-			filled = strings.Replace(filled, "Relevant background, links, or reasoning behind the request.", context, 1)
-		}
-	}
-	
-	// For Requirements and DOD, provide helpful defaults that can be edited
-	filled = strings.Replace(filled, "- [ ] Requirement 1\n- [ ] Requirement 2\n- [ ] (Optional) Stretch goal", 
-		"- [ ] Implement core functionality\n- [ ] Add appropriate tests\n- [ ] Update documentation", 1)
-	
-	filled = strings.Replace(filled, "Clear outcome that marks this task as complete.", 
-		"Feature is implemented, tested, and ready for production use.", 1)
-	
-	return filled
-}
-
 // promptTemplateInteractively prompts user to fill each template section
-func promptTemplateInteractively(templateContent string) string {
+func promptTemplateInteractively(templateContent string) (string, error) {
 	sections := parseTemplateSections(templateContent)
 	if len(sections) == 0 {
 		// No structured template, just prompt for description
@@ -1483,34 +2113,46 @@ func promptTemplateInteractively(templateContent string) string {
 	
 	// Prompt for each section
 	if strings.Contains(filled, "One or two sentences describing what this issue is and why it matters.") {
-		summary := promptLine("Summary (1-2 sentences): ")
+		summary, err := promptLine("Summary (1-2 sentences): ")
+		if err != nil {
+			return "", err
+		}
 		if strings.TrimSpace(summary) != "" {
 			filled = strings.Replace(filled, "One or two sentences describing what this issue is and why it matters.", summary, 1)
 		}
 	}
 	
 	if strings.Contains(filled, "Relevant background, links, or reasoning behind the request.") {
-		context := promptMultilineBlock("Context")
+		context, err := promptMultilineBlock("Context")
+		if err != nil {
+			return "", err
+		}
 		if strings.TrimSpace(context) != "" {
 			filled = strings.Replace(filled, "Relevant background, links, or reasoning behind the request.", context, 1)
 		}
 	}
 	
 	if strings.Contains(filled, "- [ ] Requirement 1\n- [ ] Requirement 2\n- [ ] (Optional) Stretch goal") {
-		requirements := promptMultilineBlock("Requirements (one per line, use - [ ] format)")
+		requirements, err := promptMultilineBlock("Requirements (one per line, use - [ ] format)")
+		if err != nil {
+			return "", err
+		}
 		if strings.TrimSpace(requirements) != "" {
 			filled = strings.Replace(filled, "- [ ] Requirement 1\n- [ ] Requirement 2\n- [ ] (Optional) Stretch goal", requirements, 1)
 		}
 	}
 	
 	if strings.Contains(filled, "Clear outcome that marks this task as complete.") {
-		dod := promptLine("Definition of Done: ")
+		dod, err := promptLine("Definition of Done: ")
+		if err != nil {
+			return "", err
+		}
 		if strings.TrimSpace(dod) != "" {
 			filled = strings.Replace(filled, "Clear outcome that marks this task as complete.", dod, 1)
 		}
 	}
 	
-	return filled
+	return filled, nil
 }
 
 // parseTemplateSections extracts section headers from template content
@@ -1596,7 +2238,7 @@ func buildExampleSections(sections []string) string {
 }
 
 // createIssueAIFriendly handles AI-optimized issue creation with auto-discovery and seamless workflow
-func createIssueAIFriendly(client *api.Client, teamKey, templateName, title string, sections map[string]string, cmd *cobra.Command) error {
+func createIssueAIFriendly(client *api.Client, teamKey, templateName, title string, sections map[string]string, fields templateFields, cmd *cobra.Command) error {
 	// Get team info
 	team, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
 	if err != nil {
@@ -1610,7 +2252,7 @@ func createIssueAIFriendly(client *api.Client, teamKey, templateName, title stri
 	templateInfo, _, err := GetLocalTemplate(teamKey, templateName)
 	if err != nil {
 		// Local template not found - auto-sync and try again
-		fmt.Printf("🔄 Template not cached locally, auto-syncing templates for team %s...\n", teamKey)
+		prog := ui.StartProgress(fmt.Sprintf("Template not cached locally, auto-syncing templates for team %s", teamKey), 0)
 		
 		// Get templates directory
 		templatesDir, err := getTemplatesDir()
@@ -1627,19 +2269,20 @@ func createIssueAIFriendly(client *api.Client, teamKey, templateName, title stri
 		}
 
 		// Auto-sync this team's templates
-		syncResult, err := syncTeamTemplatesIntelligent(client, *team, templatesDir, metadata)
+		syncResult, err := syncTeamTemplatesIntelligent(client, *team, templatesDir, metadata, prog)
 		if err != nil {
+			prog.Done("Template auto-sync failed")
 			return fmt.Errorf("failed to auto-sync templates: %w", err)
 		}
 
 		// Save metadata
 		metadata.LastSync = time.Now()
-		_ = saveTemplateMetadata(templatesDir, metadata) // Best effort
+		_ = saveTemplateTeams(templatesDir, metadata, []string{team.Key}, true) // Best effort
 
 		if syncResult.SkipReason != "" {
-			fmt.Printf("   %s\n", syncResult.SkipReason)
+			prog.Done("%s", syncResult.SkipReason)
 		} else {
-			fmt.Printf("   %s\n", syncResult.SyncSummary)
+			prog.Done("%s", syncResult.SyncSummary)
 		}
 
 		// Try to get template info again
@@ -1661,12 +2304,12 @@ func createIssueAIFriendly(client *api.Client, teamKey, templateName, title stri
 		}
 	}
 
-	fmt.Printf("📋 Using template: %s (ID: %s)\n", templateInfo.Name, templateInfo.ID)
+	ui.Infof("📋 Using template: %s (ID: %s)", templateInfo.Name, templateInfo.ID)
 
 	// Pre-fill template sections using local template content
 	var prefilledDescription string
 	if len(sections) > 0 {
-		fmt.Printf("📝 Pre-filling %d template sections...\n", len(sections))
+		ui.Infof("📝 Pre-filling %d template sections...", len(sections))
 		
 		// Get the local template content and fill sections
 		_, localTemplateContent, err := GetLocalTemplate(teamKey, templateName)
@@ -1674,8 +2317,15 @@ func createIssueAIFriendly(client *api.Client, teamKey, templateName, title stri
 			return fmt.Errorf("failed to get local template content: %w", err)
 		}
 		
-		prefilledDescription = fillTemplateSectionsDynamically(localTemplateContent, sections)
-		fmt.Printf("   ✓ Template sections pre-filled\n")
+		matched, missing, err := matchTemplateSections(localTemplateContent, sections)
+		if err != nil {
+			return fmt.Errorf("template '%s': %w", templateInfo.Name, err)
+		}
+		if len(missing) > 0 {
+			ui.Warnf("sections left as in the template: %s", strings.Join(missing, ", "))
+		}
+		prefilledDescription = fillTemplateSectionsDynamically(localTemplateContent, matched)
+		ui.Infof("   ✓ Template sections pre-filled")
 	}
 
 	// Create issue with server-side template application and pre-filled description
@@ -1690,15 +2340,49 @@ func createIssueAIFriendly(client *api.Client, teamKey, templateName, title stri
 	if prefilledDescription != "" {
 		createInput.Description = prefilledDescription
 	}
+	idemKey, err := idempotencyKeyFlag(cmd)
+	if err != nil {
+		return err
+	}
+	if idemKey != "" {
+		// The marker goes into the create itself; a description replaces the
+		// template's, so an unfilled one carries the template body
+		if createInput.Description == "" {
+			if _, content, err := GetLocalTemplate(teamKey, templateName); err == nil {
+				createInput.Description = content
+			}
+		}
+		createInput.Description = withIdempotencyMarker(createInput.Description, idemKey)
+	}
+	// Template front matter and flags: labels, assignee, project, priority, estimate
+	resolved, err := preflightIssueFields(client, nil, team.ID, issueFieldsInput{Labels: fields.Labels, Assignee: fields.Assignee}, time.Now())
+	if err != nil {
+		return err
+	}
+	createInput.LabelIDs, createInput.AssigneeID, createInput.Estimate = resolved.LabelIDs, resolved.AssigneeID, fields.Estimate
+	if fields.Priority != nil {
+		createInput.Priority = fields.Priority
+	}
+	if strings.TrimSpace(fields.Project) != "" {
+		pr, err := client.ResolveProject(fields.Project)
+		if err != nil {
+			return err
+		}
+		if pr == nil {
+			return fmt.Errorf("project '%s' not found", fields.Project)
+		}
+		createInput.ProjectID = pr.ID
+	}
 
 	created, err := client.CreateIssueAdvanced(createInput)
 	if err != nil {
 		return fmt.Errorf("failed to create issue: %w", err)
 	}
 
-	fmt.Printf("✅ Created issue: %s\n", created.Identifier)
+	ui.Infof("✅ Created issue: %s", created.Identifier)
+	if err := finishCreatedIssue(cmd, client, created); err != nil { return err }
 	if len(sections) > 0 {
-		fmt.Printf("   ✓ %d template sections filled\n", len(sections))
+		ui.Infof("   ✓ %d template sections filled", len(sections))
 	}
 
 	// Output result
@@ -1719,7 +2403,11 @@ func createIssueAIFriendly(client *api.Client, teamKey, templateName, title stri
 		})
 	}
 
-	fmt.Printf("\n🎉 Issue created successfully!\n")
+	if p.Quiet {
+		fmt.Printf("Created %s: %s\n", created.Identifier, created.URL)
+		return nil
+	}
+	fmt.Printf("\n%sIssue created successfully!\n", p.Symbol("🎉 ", ""))
 	fmt.Printf("   Title: %s\n", created.Title)
 	fmt.Printf("   URL: %s\n", created.URL)
 	fmt.Printf("   Template: %s\n", templateInfo.Name)
@@ -1729,3 +2417,27 @@ func createIssueAIFriendly(client *api.Client, teamKey, templateName, title stri
 	
 	return nil
 }
+
+// resolveCreateTargets looks up the project and the team's create context,
+// which are independent, concurrently; a failed project lookup cancels the
+// other. The context resolves team, states, labels, members and templates in
+// one round trip when the schema allows it; rc stays nil and the individual
+// resolvers are used otherwise.
+func resolveCreateTargets(ctx context.Context, client *api.Client, project, teamKey string) (pr *api.Project, rc *api.CreateContext, err error) {
+    g, gctx := errgroup.WithContext(ctx)
+    client = client.WithContext(gctx)
+    g.Go(func() error {
+        if project == "" { return nil }
+        var err error
+        pr, err = client.ResolveProject(project)
+        if err == nil && pr == nil { err = fmt.Errorf("project '%s' not found", project) }
+        return err
+    })
+    g.Go(func() error {
+        if teamKey == "" { return nil }
+        if c, err := teamCreateContext(client, teamKey); err == nil { rc = c }
+        return nil
+    })
+    if err := g.Wait(); err != nil { return nil, nil, err }
+    return pr, rc, nil
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+    "fmt"
+    "os"
+    "sort"
+    "strconv"
+    "strings"
+    "unicode/utf8"
+
+    "linear-cli/internal/api"
+
+    "golang.org/x/term"
+)
+
+// Kanban-style text output for issue listings (--board)
+
+const (
+    boardMinColumn = 18
+    boardSeparator = " │ "
+    // boardPlainSeparator has the same width, for --plain
+    boardPlainSeparator = " | "
+)
+
+// boardColumns buckets issues by state in workflow order (triage → canceled,
+// then name); states of unknown type go last. Issue order is preserved.
+func boardColumns(items []api.IssueDetails) []issueGroup {
+    rank := func(typ string) int {
+        if r, ok := stateTypeRank[typ]; ok { return r }
+        return len(stateTypeRank)
+    }
+    byName := map[string]*issueGroup{}
+    types := map[string]string{}
+    var names []string
+    for _, it := range items {
+        g := byName[it.StateName]
+        if g == nil {
+            g = &issueGroup{Group: it.StateName}
+            byName[it.StateName] = g
+            types[it.StateName] = it.StateType
+            names = append(names, it.StateName)
+        }
+        g.Issues = append(g.Issues, it)
+        g.Count++
+    }
+    sort.SliceStable(names, func(i, j int) bool {
+        a, b := rank(types[names[i]]), rank(types[names[j]])
+        if a != b { return a < b }
+        return strings.ToLower(names[i]) < strings.ToLower(names[j])
+    })
+    cols := make([]issueGroup, 0, len(names))
+    for _, n := range names { cols = append(cols, *byName[n]) }
+    return cols
+}
+
+// renderBoard lays the columns out side by side within width. Columns share the
+// width evenly; those that would be narrower than boardMinColumn are left out
+// and listed with their counts in a footer.
+func renderBoard(cols []issueGroup, width int) string {
+    if len(cols) == 0 { return "" }
+    separator, ruleChar := ui.Symbol(boardSeparator, boardPlainSeparator), ui.Symbol("─", "-")
+    sep := utf8.RuneCountInString(separator)
+    fit := (width + sep) / (boardMinColumn + sep)
+    if fit < 1 { fit = 1 }
+    shown, hidden := cols, []issueGroup(nil)
+    if len(cols) > fit { shown, hidden = cols[:fit], cols[fit:] }
+    colWidth := (width - sep*(len(shown)-1)) / len(shown)
+    if colWidth < boardMinColumn { colWidth = boardMinColumn }
+
+    cell := func(s string) string {
+        s = truncate(s, colWidth)
+        return s + strings.Repeat(" ", colWidth-utf8.RuneCountInString(s))
+    }
+    line := func(cells []string) string { return strings.TrimRight(strings.Join(cells, separator), " ") + "\n" }
+    var b strings.Builder
+    head := make([]string, len(shown))
+    rule := make([]string, len(shown))
+    height := 0
+    for i, c := range shown {
+        head[i] = cell(fmt.Sprintf("%s (%d)", c.Group, c.Count))
+        rule[i] = strings.Repeat(ruleChar, colWidth)
+        if len(c.Issues) > height { height = len(c.Issues) }
+    }
+    b.WriteString(line(head))
+    b.WriteString(line(rule))
+    for r := 0; r < height; r++ {
+        row := make([]string, len(shown))
+        for i, c := range shown {
+            row[i] = cell("")
+            if r < len(c.Issues) { row[i] = cell(c.Issues[r].Identifier + " " + c.Issues[r].Title) }
+        }
+        b.WriteString(line(row))
+    }
+    if len(hidden) > 0 {
+        more := make([]string, len(hidden))
+        for i, c := range hidden { more[i] = fmt.Sprintf("%s (%d)", c.Group, c.Count) }
+        fmt.Fprintf(&b, "\n+%d more column(s) not shown: %s\n", len(hidden), strings.Join(more, ", "))
+    }
+    return b.String()
+}
+
+// terminalWidth is stdout's width, else $COLUMNS, else 100 columns
+func terminalWidth() int {
+    if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 { return w }
+    if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 { return n }
+    return 100
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Moving issues between cycles. A cycle is named relative to now (current,
+// next, previous), by number (12 or #12) or by name, and always within the
+// issue's own team; "none" takes the issue out of its cycle.
+
+var issuesCycleCmd = &cobra.Command{
+    Use:   "cycle <issue-key>... --set <cycle>",
+    Short: "Move issues into another cycle, or out of their cycle",
+    Long: `Move issues into a cycle of their team, or out of any cycle with --set none.
+The cycle is current, next or previous, a number (12 or #12), or a name
+(unique prefixes work). Issues already in that cycle are left alone.`,
+    Example: `  linear-cli issues cycle ENG-3 --set next
+  linear-cli issues cycle ENG-3 ENG-7 --set 42
+  linear-cli issues cycle ENG-3 --set none`,
+    Args: cobra.MinimumNArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        ref, _ := cmd.Flags().GetString("set")
+        if strings.TrimSpace(ref) == "" { return errors.New("--set is required: current, next, previous, a cycle number or name, or none") }
+        return runIssueCycleMove(cmd, args, ref)
+    },
+}
+
+var cyclesAddCmd = &cobra.Command{
+    Use:   "add <cycle> <issue-key>...",
+    Short: "Add issues to a cycle (current, next, previous, number or name)",
+    Example: `  linear-cli cycles add current ENG-3 ENG-7
+  linear-cli cycles add "Sprint 12" ENG-3`,
+    Args: cobra.MinimumNArgs(2),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        if strings.EqualFold(strings.TrimSpace(args[0]), "none") { return errors.New("use 'cycles remove' to take issues out of their cycle") }
+        return runIssueCycleMove(cmd, args[1:], args[0])
+    },
+}
+
+var cyclesRemoveCmd = &cobra.Command{
+    Use:     "remove <issue-key>...",
+    Aliases: []string{"rm"},
+    Short:   "Take issues out of their cycle",
+    Example: `  linear-cli cycles remove ENG-3`,
+    Args:    cobra.MinimumNArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        return runIssueCycleMove(cmd, args, "none")
+    },
+}
+
+// cycleMove is the outcome for one issue; From and To are cycle numbers, nil
+// for no cycle
+type cycleMove struct {
+    Issue  string `json:"issue"`
+    From   *int   `json:"from"`
+    To     *int   `json:"to"`
+    Status string `json:"status"`
+    Error  string `json:"error,omitempty"`
+}
+
+func runIssueCycleMove(cmd *cobra.Command, keys []string, ref string) error {
+    cfg, _ := config.Load()
+    if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+    client := newAPIClient(cmd, cfg.APIKey)
+    p := printer(cmd)
+    ref = strings.TrimSpace(ref)
+    remove := strings.EqualFold(ref, "none")
+    now := time.Now()
+
+    issues, errs := resolveIssueKeys(client, keys)
+    teamCycles := map[string][]api.Cycle{}
+    var moves []cycleMove
+    failed := 0
+    fail := func(key string, err error) {
+        failed++
+        moves = append(moves, cycleMove{Issue: key, Status: "failed", Error: err.Error()})
+        ui.Warnf("%s: %v", key, err)
+    }
+    for i, iss := range issues {
+        if errs[i] != nil { fail(keys[i], errs[i]); continue }
+        cur, err := client.GetIssueCycle(iss.ID)
+        if err != nil { fail(iss.Identifier, err); continue }
+        var target *api.Cycle
+        if !remove {
+            if cur.Team == nil { fail(cur.Identifier, errors.New("issue has no team")); continue }
+            cycles, ok := teamCycles[cur.Team.ID]
+            if !ok {
+                if cycles, err = client.AllTeamCycles(cur.Team.ID); err != nil { fail(cur.Identifier, err); continue }
+                teamCycles[cur.Team.ID] = cycles
+            }
+            if target, err = resolveCycleRef(cycles, ref, now); err != nil { fail(cur.Identifier, fmt.Errorf("team %s: %w", cur.Team.Key, err)); continue }
+        }
+        m := cycleMove{Issue: cur.Identifier, From: cycleNumber(cur.Cycle), To: cycleNumber(target)}
+        if (cur.Cycle == nil && target == nil) || (cur.Cycle != nil && target != nil && cur.Cycle.ID == target.ID) {
+            m.Status = "unchanged"
+            moves = append(moves, m)
+            if p.JSONEnabled() { continue }
+            if target == nil { fmt.Printf("%s is not in a cycle\n", cur.Identifier) } else { fmt.Printf("%s is already in %s\n", cur.Identifier, cycleLabel(target)) }
+            continue
+        }
+        in := api.IssueUpdateInput{ClearCycle: remove}
+        if target != nil { in.CycleID = target.ID }
+        _, err = client.UpdateIssueAdvanced(cur.ID, in)
+        switch {
+        case errors.Is(err, api.ErrDryRun):
+            continue
+        case err != nil:
+            fail(cur.Identifier, err)
+            continue
+        }
+        m.Status = "moved"
+        moves = append(moves, m)
+        if !p.JSONEnabled() { fmt.Printf("%s: %s %s %s\n", cur.Identifier, cycleLabel(cur.Cycle), p.Symbol("→", "->"), cycleLabel(target)) }
+    }
+    if p.JSONEnabled() {
+        if moves == nil { moves = []cycleMove{} }
+        if err := p.PrintJSON(moves); err != nil { return err }
+    }
+    if failed > 0 { return fmt.Errorf("%d of %d issue(s) could not be moved", failed, len(keys)) }
+    return nil
+}
+
+// resolveCycleRef finds the cycle ref names among a team's cycles: current,
+// next or previous relative to now, a number (12 or #12), or a name, exact or
+// a unique prefix, ignoring case
+func resolveCycleRef(cycles []api.Cycle, ref string, now time.Time) (*api.Cycle, error) {
+    var found *api.Cycle
+    switch strings.ToLower(ref) {
+    case "current", "active":
+        for i := range cycles {
+            if !cycles[i].StartsAt.After(now) && now.Before(cycles[i].EndsAt) { found = &cycles[i] }
+        }
+        if found == nil { return nil, errors.New("no current cycle") }
+        return found, nil
+    case "next", "upcoming":
+        for i := range cycles {
+            if cycles[i].StartsAt.After(now) && (found == nil || cycles[i].StartsAt.Before(found.StartsAt)) { found = &cycles[i] }
+        }
+        if found == nil { return nil, errors.New("no upcoming cycle") }
+        return found, nil
+    case "previous", "prev", "last":
+        for i := range cycles {
+            if !cycles[i].EndsAt.After(now) && (found == nil || cycles[i].EndsAt.After(found.EndsAt)) { found = &cycles[i] }
+        }
+        if found == nil { return nil, errors.New("no previous cycle") }
+        return found, nil
+    }
+    if n, err := strconv.Atoi(strings.TrimPrefix(ref, "#")); err == nil {
+        for i := range cycles {
+            if cycles[i].Number == n { return &cycles[i], nil }
+        }
+        return nil, fmt.Errorf("no cycle #%d", n)
+    }
+    var prefixed []*api.Cycle
+    for i := range cycles {
+        name := strings.TrimSpace(cycles[i].Name)
+        if strings.EqualFold(name, ref) { return &cycles[i], nil }
+        if name != "" && strings.HasPrefix(strings.ToLower(name), strings.ToLower(ref)) { prefixed = append(prefixed, &cycles[i]) }
+    }
+    switch len(prefixed) {
+    case 0:
+        return nil, fmt.Errorf("no cycle named '%s' (use current, next, previous, a number or a name)", ref)
+    case 1:
+        return prefixed[0], nil
+    }
+    names := make([]string, len(prefixed))
+    for i, c := range prefixed { names[i] = cycleLabel(c) }
+    return nil, fmt.Errorf("'%s' matches several cycles: %s", ref, strings.Join(names, ", "))
+}
+
+// cycleLabel names a cycle for messages: "cycle #12 (Sprint 12)", or "no cycle"
+func cycleLabel(c *api.Cycle) string {
+    if c == nil { return "no cycle" }
+    if strings.TrimSpace(c.Name) != "" { return fmt.Sprintf("cycle #%d (%s)", c.Number, c.Name) }
+    return fmt.Sprintf("cycle #%d", c.Number)
+}
+
+func cycleNumber(c *api.Cycle) *int {
+    if c == nil { return nil }
+    n := c.Number
+    return &n
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesCycleCmd)
+    issuesCycleCmd.Flags().String("set", "", "Target cycle: current, next, previous, a number, a name, or none")
+    cyclesCmd.AddCommand(cyclesAddCmd)
+    cyclesCmd.AddCommand(cyclesRemoveCmd)
+}
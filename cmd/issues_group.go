@@ -0,0 +1,93 @@
+package cmd
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/output"
+)
+
+// Grouped output for issue listings (--group-by)
+
+var issueGroupFields = []string{"state", "assignee", "project", "priority"}
+
+type issueGroup struct {
+    Group  string             `json:"group"`
+    Count  int                `json:"count"`
+    Issues []api.IssueDetails `json:"issues"`
+}
+
+// groupIssues buckets issues by field. Priority groups are ordered urgent → none;
+// other fields alphabetically with the empty bucket last. Issue order is preserved.
+func groupIssues(items []api.IssueDetails, field string) ([]issueGroup, error) {
+    // key returns the group's display name and its sort key ("" sorts last)
+    var key func(it api.IssueDetails) (string, string)
+    switch field {
+    case "state":
+        key = func(it api.IssueDetails) (string, string) { return it.StateName, strings.ToLower(it.StateName) }
+    case "assignee":
+        key = func(it api.IssueDetails) (string, string) {
+            if it.Assignee == nil { return "Unassigned", "" }
+            return it.Assignee.Name, strings.ToLower(it.Assignee.Name)
+        }
+    case "project":
+        key = func(it api.IssueDetails) (string, string) {
+            if it.Project == nil { return "No project", "" }
+            return it.Project.Name, strings.ToLower(it.Project.Name)
+        }
+    case "priority":
+        key = func(it api.IssueDetails) (string, string) {
+            // 0 (none) sorts after 4 (low)
+            rank := it.Priority
+            if rank == 0 { rank = 5 }
+            return priorityLabel(it.Priority), fmt.Sprintf("%d", rank)
+        }
+    default:
+        return nil, fmt.Errorf("invalid --group-by '%s' (use %s)", field, strings.Join(issueGroupFields, "|"))
+    }
+    byName := map[string]*issueGroup{}
+    sortKeys := map[string]string{}
+    var names []string
+    for _, it := range items {
+        name, sk := key(it)
+        g := byName[name]
+        if g == nil {
+            g = &issueGroup{Group: name}
+            byName[name] = g
+            sortKeys[name] = sk
+            names = append(names, name)
+        }
+        g.Issues = append(g.Issues, it)
+        g.Count++
+    }
+    sort.SliceStable(names, func(i, j int) bool {
+        a, b := sortKeys[names[i]], sortKeys[names[j]]
+        if (a == "") != (b == "") { return b == "" }
+        return a < b
+    })
+    groups := make([]issueGroup, 0, len(names))
+    for _, n := range names { groups = append(groups, *byName[n]) }
+    return groups, nil
+}
+
+// printIssueGroups renders one headed table per group, omitting the grouped column
+func printIssueGroups(p output.Printer, field string, groups []issueGroup) error {
+    cols := []string{"Key", "State", "Priority", "Title"}
+    for i, g := range groups {
+        if i > 0 { fmt.Println() }
+        fmt.Printf("%s (%d)\n", g.Group, g.Count)
+        head := make([]string, 0, len(cols))
+        for _, c := range cols { if !strings.EqualFold(c, field) { head = append(head, c) } }
+        rows := make([][]string, 0, len(g.Issues))
+        for _, it := range g.Issues {
+            row := []string{it.Identifier}
+            if field != "state" { row = append(row, it.StateName) }
+            if field != "priority" { row = append(row, priorityLabel(it.Priority)) }
+            rows = append(rows, append(row, it.Title))
+        }
+        if err := p.Table(head, rows); err != nil { return err }
+    }
+    return nil
+}
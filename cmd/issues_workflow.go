@@ -0,0 +1,197 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "os/exec"
+    "sort"
+    "strconv"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Workflow shortcuts that combine several issue updates into one command
+
+var issuesTakeCmd = &cobra.Command{
+    Use:   "take <issue-key>",
+    Short: "Assign an issue to yourself and move it to In Progress",
+    Long: `Pick up an issue: assigns it to the authenticated user and moves it to the team's
+first "started" workflow state. With --branch, also creates (or switches to) the issue's
+git branch in the current repository.`,
+    Example: `  linear-cli issues take ENG-77
+  linear-cli issues take ENG-77 --branch`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        branch, _ := cmd.Flags().GetBool("branch")
+        client := newAPIClient(cmd, cfg.APIKey)
+
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+        det, err := client.GetIssueDetails(iss.ID)
+        if err != nil { return err }
+        if det == nil || det.Team == nil { return fmt.Errorf("issue %s not found", args[0]) }
+        viewer, err := client.Viewer()
+        if err != nil { return err }
+        states, err := client.TeamStates(det.Team.ID)
+        if err != nil { return err }
+        started := firstStateOfType(states, "started")
+        if started == nil { return fmt.Errorf("team %s has no started workflow state", det.Team.Key) }
+
+        updated, err := client.UpdateIssueAdvanced(det.ID, api.IssueUpdateInput{AssigneeID: viewer.ID, StateID: started.ID})
+        if err != nil { return err }
+
+        var branchName string
+        if branch {
+            branchName = det.BranchName
+            if strings.TrimSpace(branchName) == "" { branchName = strings.ToLower(det.Identifier) }
+            if err := gitCheckoutBranch(branchName); err != nil { return fmt.Errorf("issue taken, but creating branch failed: %w", err) }
+        }
+
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            out := map[string]any{"issue": updated}
+            if branchName != "" { out["branch"] = branchName }
+            return p.PrintJSON(out)
+        }
+        fmt.Printf("Took %s: assigned to %s, moved to %s\n", updated.Identifier, viewer.Name, updated.StateName)
+        if branchName != "" { fmt.Printf("Switched to branch %s\n", branchName) }
+        return nil
+    },
+}
+
+var issuesAssignCmd = &cobra.Command{
+    Use:   "assign <issue-key> (--to <user> | --auto)",
+    Short: "Assign an issue to a user, or to the least-loaded team member",
+    Long: `Assign an issue. With --to, assigns the named user ("me" for yourself). With
+--auto, counts open issues per member of the issue's team and assigns the
+least-loaded one (ties go alphabetically), which suits rotating duties like bug
+triage. --exclude skips members by name, email or id; --dry-run only prints the
+workload table.`,
+    Example: `  linear-cli issues assign ENG-31 --to ada@example.com
+  linear-cli issues assign ENG-31 --auto --exclude "Grace Hopper,bot@example.com"
+  linear-cli issues assign ENG-31 --auto --dry-run`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        to, _ := cmd.Flags().GetString("to")
+        auto, _ := cmd.Flags().GetBool("auto")
+        exclude, _ := cmd.Flags().GetStringSlice("exclude")
+        dryRun, _ := cmd.Flags().GetBool("dry-run")
+        if (strings.TrimSpace(to) == "") == !auto { return errors.New("specify exactly one of --to or --auto") }
+        client := newAPIClient(cmd, cfg.APIKey)
+
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+        det, err := client.GetIssueDetails(iss.ID)
+        if err != nil { return err }
+        if det == nil || det.Team == nil { return fmt.Errorf("issue %s not found", args[0]) }
+
+        p := printer(cmd)
+        var user *api.User
+        var loads []memberLoad
+        if auto {
+            members, err := client.TeamMembers(det.Team.ID)
+            if err != nil { return err }
+            counts, err := client.OpenIssueCountsByAssignee(det.Team.ID)
+            if err != nil { return err }
+            loads = rankMemberLoad(members, counts, exclude)
+            if len(loads) == 0 { return fmt.Errorf("no eligible members in team %s", det.Team.Key) }
+            user = &loads[0].User
+            if dryRun {
+                if p.JSONEnabled() { return p.PrintJSON(map[string]any{"issue": det.Identifier, "candidates": loads}) }
+                rows := make([][]string, 0, len(loads))
+                for _, l := range loads { rows = append(rows, []string{l.User.Name, l.User.Email, strconv.Itoa(l.Open)}) }
+                return p.Table([]string{"Member", "Email", "Open"}, rows)
+            }
+        } else if user, err = resolveUserOrMe(client, to); err != nil {
+            return err
+        }
+        if det.Assignee != nil && det.Assignee.ID == user.ID {
+            ui.Infof("%s is already assigned to %s", det.Identifier, user.Name)
+        }
+
+        updated, err := client.UpdateIssueAdvanced(det.ID, api.IssueUpdateInput{AssigneeID: user.ID})
+        if err != nil { return err }
+        if p.JSONEnabled() {
+            out := map[string]any{"issue": updated}
+            if auto { out["candidates"] = loads }
+            return p.PrintJSON(out)
+        }
+        if auto {
+            fmt.Printf("Assigned %s to %s (%d open issues)\n", updated.Identifier, user.Name, loads[0].Open)
+        } else {
+            fmt.Printf("Assigned %s to %s\n", updated.Identifier, user.Name)
+        }
+        return nil
+    },
+}
+
+// memberLoad is a team member with their count of open assigned issues
+type memberLoad struct {
+    User api.User `json:"user"`
+    Open int      `json:"open"`
+}
+
+// rankMemberLoad orders members by open issue count, then name, dropping any
+// matched by exclude (name, email or id, case-insensitive).
+func rankMemberLoad(members []api.User, counts map[string]int, exclude []string) []memberLoad {
+    skip := func(u api.User) bool {
+        for _, e := range exclude {
+            e = strings.TrimSpace(e)
+            if e != "" && (strings.EqualFold(e, u.Name) || strings.EqualFold(e, u.Email) || e == u.ID) { return true }
+        }
+        return false
+    }
+    out := []memberLoad{}
+    for _, m := range members {
+        if !skip(m) { out = append(out, memberLoad{User: m, Open: counts[m.ID]}) }
+    }
+    sort.SliceStable(out, func(i, j int) bool {
+        if out[i].Open != out[j].Open { return out[i].Open < out[j].Open }
+        return strings.ToLower(out[i].User.Name) < strings.ToLower(out[j].User.Name)
+    })
+    return out
+}
+
+// firstStateOfType returns the lowest-position workflow state of the given type
+// (backlog, unstarted, started, completed, canceled).
+func firstStateOfType(states []api.State, typ string) *api.State {
+    var best *api.State
+    for i := range states {
+        s := &states[i]
+        if !strings.EqualFold(s.Type, typ) { continue }
+        if best == nil || s.Position < best.Position { best = s }
+    }
+    return best
+}
+
+// gitCheckoutBranch switches to branch, creating it from HEAD if it does not exist.
+func gitCheckoutBranch(branch string) error {
+    run := func(args ...string) error {
+        c := exec.Command("git", args...)
+        c.Stdout = os.Stderr
+        c.Stderr = os.Stderr
+        return c.Run()
+    }
+    if err := exec.Command("git", "rev-parse", "--verify", "--quiet", "refs/heads/"+branch).Run(); err == nil {
+        return run("checkout", branch)
+    }
+    return run("checkout", "-b", branch)
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesTakeCmd)
+    issuesTakeCmd.Flags().Bool("branch", false, "Create or switch to the issue's git branch")
+    issuesCmd.AddCommand(issuesAssignCmd)
+    issuesAssignCmd.Flags().String("to", "", "Assignee (id, name, email, or \"me\")")
+    issuesAssignCmd.Flags().Bool("auto", false, "Assign the team member with the fewest open issues")
+    issuesAssignCmd.Flags().StringSlice("exclude", nil, "Members to skip with --auto (name, email or id; repeatable or comma-separated)")
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+    "fmt"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+)
+
+// 'issues create' can suggest existing labels from the title and description:
+// a label is suggested when its name appears there as words ("flaky test" for
+// a "Flaky Test" label) or when one of its keywords does ("timeout" for
+// "performance"). Keywords come from defaultLabelKeywords and the config's
+// label_keywords table; they only ever point at labels the team already has.
+
+// defaultLabelKeywords maps common label names to words that suggest them
+var defaultLabelKeywords = map[string][]string{
+    "performance":    {"slow", "timeout", "timeouts", "latency", "lag", "laggy", "memory", "cpu", "perf", "speed"},
+    "bug":            {"error", "errors", "crash", "crashes", "broken", "fails", "failing", "failure", "exception", "regression"},
+    "security":       {"vulnerability", "xss", "csrf", "injection", "cve", "exploit", "permission", "permissions"},
+    "documentation":  {"docs", "readme", "typo", "guide"},
+    "docs":           {"documentation", "readme", "typo", "guide"},
+    "ui":             {"button", "layout", "css", "alignment", "modal"},
+    "design":         {"figma", "mockup", "mockups", "layout"},
+    "accessibility":  {"a11y", "aria", "contrast", "screen reader"},
+    "tech debt":      {"refactor", "cleanup", "deprecated", "deprecation"},
+    "infrastructure": {"deploy", "deployment", "kubernetes", "terraform", "docker"},
+    "testing":        {"flaky", "tests", "coverage"},
+}
+
+// labelSuggestion is a label worth adding and the words that suggested it
+type labelSuggestion struct {
+    Label api.Label `json:"label"`
+    Match string    `json:"match"`
+}
+
+// suggestLabels returns the labels, other than those in have (IDs), whose name
+// or keywords appear as words in text, in the order of labels
+func suggestLabels(labels []api.Label, text string, have []string, keywords map[string][]string) []labelSuggestion {
+    words := labelWords(text)
+    var out []labelSuggestion
+    seen := map[string]bool{}
+    for _, l := range labels {
+        name := strings.ToLower(strings.TrimSpace(l.Name))
+        if containsString(have, l.ID) || seen[name] || len(name) < 2 { continue }
+        candidates := append([]string{l.Name}, keywords[name]...)
+        for _, c := range candidates {
+            if containsWords(words, labelWords(c)) {
+                seen[name] = true
+                out = append(out, labelSuggestion{Label: l, Match: strings.ToLower(c)})
+                break
+            }
+        }
+    }
+    return out
+}
+
+// labelKeywords merges the built-in keywords with the config's label_keywords
+func labelKeywords(cfg *config.Config) map[string][]string {
+    out := map[string][]string{}
+    for k, v := range defaultLabelKeywords { out[k] = append(out[k], v...) }
+    if cfg != nil {
+        for k, v := range cfg.LabelKeywords {
+            key := strings.ToLower(strings.TrimSpace(k))
+            out[key] = append(out[key], v...)
+        }
+    }
+    return out
+}
+
+// labelWords lowercases s and splits it at anything but letters and digits
+func labelWords(s string) []string {
+    return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+        return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r > 127)
+    })
+}
+
+// containsWords reports whether seq appears as consecutive words of words
+func containsWords(words, seq []string) bool {
+    if len(seq) == 0 { return false }
+outer:
+    for i := 0; i+len(seq) <= len(words); i++ {
+        for j, w := range seq {
+            if words[i+j] != w { continue outer }
+        }
+        return true
+    }
+    return false
+}
+
+// teamLabelSuggestions suggests labels of the team or the workspace for an issue
+func teamLabelSuggestions(client *api.Client, cfg *config.Config, teamID, title, description string, have []string) ([]labelSuggestion, error) {
+    all, err := client.ListAllLabels()
+    if err != nil { return nil, err }
+    var labels []api.Label
+    for _, l := range all {
+        if l.Team == nil || l.Team.ID == teamID { labels = append(labels, l) }
+    }
+    return suggestLabels(labels, title+"\n"+description, have, labelKeywords(cfg)), nil
+}
+
+// describeLabelSuggestion reads e.g. `performance ("timeout")`
+func describeLabelSuggestion(s labelSuggestion) string {
+    if strings.EqualFold(s.Match, s.Label.Name) { return s.Label.Name }
+    return fmt.Sprintf("%s (%q)", s.Label.Name, s.Match)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "sort"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var labelsCmd = &cobra.Command{
+    Use:   "labels",
+    Short: "Work with issue labels",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var labelsListCmd = &cobra.Command{
+    Use:   "list",
+    Short: "List labels with their scope and color",
+    Long: `List issue labels. Workspace labels can be used by every team; team labels only
+by their team. With --team, shows the labels usable on that team's issues (the
+workspace's and the team's own). Colors are shown as swatches on a terminal.`,
+    Example: `  linear-cli labels list
+  linear-cli labels list --team ENG
+  linear-cli labels list --team ENG --template '{{.Name}}'`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        client := newAPIClient(cmd, cfg.APIKey)
+        labels, err := client.ListAllLabels()
+        if err != nil { return err }
+        if strings.TrimSpace(teamKey) != "" {
+            team, err := cachedTeamByKeyOrError(client, teamKey)
+            if err != nil { return err }
+            labels = labelsForTeam(labels, team.ID)
+        }
+        sortLabelsByScope(labels)
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(labels) }
+        if len(labels) == 0 {
+            fmt.Println("No labels")
+            return nil
+        }
+        rows := make([][]string, 0, len(labels))
+        for _, l := range labels {
+            rows = append(rows, []string{l.Name, labelScope(l), p.Swatch(l.Color) + l.Color})
+        }
+        return p.Table([]string{"Label", "Scope", "Color"}, rows)
+    },
+}
+
+// labelsForTeam keeps workspace labels and those belonging to teamID
+func labelsForTeam(labels []api.Label, teamID string) []api.Label {
+    out := []api.Label{}
+    for _, l := range labels {
+        if l.Team == nil || l.Team.ID == teamID { out = append(out, l) }
+    }
+    return out
+}
+
+// sortLabelsByScope orders workspace labels first, then by team key and name
+func sortLabelsByScope(labels []api.Label) {
+    sort.SliceStable(labels, func(i, j int) bool {
+        si, sj := labelScope(labels[i]), labelScope(labels[j])
+        if (si == "workspace") != (sj == "workspace") { return si == "workspace" }
+        if si != sj { return si < sj }
+        return strings.ToLower(labels[i].Name) < strings.ToLower(labels[j].Name)
+    })
+}
+
+func labelScope(l api.Label) string {
+    if l.Team == nil { return "workspace" }
+    return l.Team.Key
+}
+
+// cachedTeamByKeyOrError looks a team up through the local team cache and
+// turns a missing team into an error
+func cachedTeamByKeyOrError(client *api.Client, key string) (*api.Team, error) {
+    team, _, err := cachedTeamByKey(client, key)
+    if err != nil { return nil, err }
+    if team == nil { return nil, fmt.Errorf("team with key %s not found", strings.ToUpper(strings.TrimSpace(key))) }
+    return team, nil
+}
+
+func init() {
+    rootCmd.AddCommand(labelsCmd)
+    labelsCmd.AddCommand(labelsListCmd)
+    labelsListCmd.Flags().String("team", "", "Only labels usable by this team (workspace and team labels)")
+    addOutputTemplateFlags(labelsListCmd)
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "net/url"
+    "os/exec"
+    "regexp"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesLinkCmd = &cobra.Command{
+    Use:   "link <issue-key> <url>",
+    Short: "Attach an external URL to an issue",
+    Long:  "Attach an external URL (error tracker event, doc, dashboard...) to an issue as a Linear link attachment.",
+    Example: `  linear-cli issues link ENG-9 https://sentry.io/organizations/acme/issues/123/ --title "Sentry event"
+  linear-cli --json issues link ENG-9 https://docs.example.com/spec`,
+    Args: cobra.ExactArgs(2),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        title, _ := cmd.Flags().GetString("title")
+        raw := strings.TrimSpace(args[1])
+        if u, err := url.Parse(raw); err != nil || u.Scheme == "" || u.Host == "" {
+            return fmt.Errorf("invalid url '%s': expected an absolute http(s) URL", raw)
+        }
+        client := newAPIClient(cmd, cfg.APIKey)
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+        att, err := client.LinkURL(iss.ID, raw, title)
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"issue": iss.Identifier, "attachment": att}) }
+        fmt.Printf("Linked %s to %s\n", att.URL, iss.Identifier)
+        return nil
+    },
+}
+
+// rePullURL matches GitHub (and GitHub Enterprise) pull request URLs
+var rePullURL = regexp.MustCompile(`^https?://[^/]+/[^/]+/[^/]+/pull/\d+`)
+
+// resolveLinkPR validates a --link-pr value, or with "auto" asks gh for the
+// current branch's open pull request.
+func resolveLinkPR(value string) (string, error) {
+    value = strings.TrimSpace(value)
+    if !strings.EqualFold(value, "auto") {
+        if !rePullURL.MatchString(value) { return "", fmt.Errorf("invalid --link-pr '%s': expected a GitHub pull request URL or 'auto'", value) }
+        return value, nil
+    }
+    if _, err := exec.LookPath("gh"); err != nil { return "", errors.New("--link-pr auto needs the GitHub CLI (gh); pass the PR URL instead") }
+    out, err := exec.Command("gh", "pr", "view", "--json", "url,number,state").Output()
+    if err != nil { return "", errors.New("no pull request found for the current branch (gh pr view failed)") }
+    var pr struct {
+        URL    string `json:"url"`
+        Number int    `json:"number"`
+        State  string `json:"state"`
+    }
+    if err := json.Unmarshal(out, &pr); err != nil { return "", fmt.Errorf("unexpected gh output: %w", err) }
+    if !strings.EqualFold(pr.State, "open") { return "", fmt.Errorf("the current branch's pull request #%d is %s, not open", pr.Number, strings.ToLower(pr.State)) }
+    return pr.URL, nil
+}
+
+// linkCreatedPR attaches the pull request resolved for 'issues create --link-pr'
+// to a freshly created issue; Linear's GitHub integration recognizes the URL and
+// tracks the PR from there. Commands without the flag are left alone.
+func linkCreatedPR(cmd *cobra.Command, client *api.Client, created *api.IssueDetails) error {
+    f := cmd.Flags().Lookup("link-pr")
+    if f == nil || strings.TrimSpace(f.Value.String()) == "" { return nil }
+    att, err := client.LinkURL(created.ID, f.Value.String(), "")
+    if err != nil { return fmt.Errorf("created %s, but linking the pull request failed: %w", created.Identifier, err) }
+    ui.Infof("Linked %s to %s", att.URL, created.Identifier)
+    return nil
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesLinkCmd)
+    issuesLinkCmd.Flags().String("title", "", "Link title shown in Linear (defaults to the URL)")
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+    "encoding/json"
+    "os"
+    "path/filepath"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+)
+
+// 'issues list --since-last-run' lists only what changed since the previous
+// run with the same filter. Each filter (see api.IssueListFilter.FilterKey)
+// keeps a watermark per workspace credentials: the latest updatedAt it has
+// returned, with the issues updated at exactly that moment. The next run asks
+// for issues updated at or after the watermark and drops those, so an issue
+// updated in the same millisecond as the last one returned is not lost.
+
+type listWatermark struct {
+    UpdatedAt time.Time `json:"updatedAt"`
+    // IDs are the issues already returned that were updated at UpdatedAt
+    IDs     []string  `json:"ids,omitempty"`
+    LastRun time.Time `json:"lastRun"`
+}
+
+type listWatermarkFile map[string]map[string]listWatermark
+
+func listWatermarkPath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "list_watermarks.json"), nil
+}
+
+func loadListWatermarks() listWatermarkFile {
+    all := listWatermarkFile{}
+    p, err := listWatermarkPath()
+    if err != nil { return all }
+    if b, err := os.ReadFile(p); err == nil { _ = json.Unmarshal(b, &all) }
+    return all
+}
+
+// watermarkLockWait bounds how long a run waits for another to save its watermark
+var watermarkLockWait = 5 * time.Second
+
+// saveListWatermark stores w under a lock, so parallel runs with different
+// filters keep each other's watermarks
+func saveListWatermark(scope, key string, w listWatermark) error {
+    p, err := listWatermarkPath()
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    unlock, err := lockFile(p+".lock", watermarkLockWait)
+    if err != nil { return err }
+    defer unlock()
+    all := loadListWatermarks()
+    if all[scope] == nil { all[scope] = map[string]listWatermark{} }
+    all[scope][key] = w
+    b, err := json.MarshalIndent(all, "", "  ")
+    if err != nil { return err }
+    return writeFileAtomic(p, b, 0o600)
+}
+
+// sinceWatermark drops the issues w has already returned
+func sinceWatermark(items []api.IssueDetails, w listWatermark) []api.IssueDetails {
+    out := make([]api.IssueDetails, 0, len(items))
+    for _, it := range items {
+        if it.UpdatedAt != nil && it.UpdatedAt.Equal(w.UpdatedAt) && containsString(w.IDs, it.ID) { continue }
+        out = append(out, it)
+    }
+    return out
+}
+
+// advanceWatermark moves w past items; with nothing new it stays where it was
+func advanceWatermark(w listWatermark, items []api.IssueDetails, now time.Time) listWatermark {
+    w.LastRun = now.UTC()
+    for _, it := range items {
+        if it.UpdatedAt == nil { continue }
+        switch {
+        case it.UpdatedAt.After(w.UpdatedAt):
+            w.UpdatedAt, w.IDs = it.UpdatedAt.UTC(), []string{it.ID}
+        case it.UpdatedAt.Equal(w.UpdatedAt) && !containsString(w.IDs, it.ID):
+            w.IDs = append(w.IDs, it.ID)
+        }
+    }
+    return w
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+
+    "linear-cli/internal/api"
+)
+
+// Comment bodies may mention people as @handle. Linear only notifies someone
+// when the body links their profile, so before a comment is sent each @handle
+// is looked up among the workspace's active users and replaced by the user's
+// profile URL, which Linear renders as a mention. A handle matches a user's
+// display name, then the part of their email before '@', then their full name
+// without spaces, ignoring case. Handles that match nobody, or more than one
+// user, are left as typed and reported. Code spans and fenced blocks are left
+// alone. --no-mentions sends the body unchanged.
+
+// reMentionHandle finds @handle not preceded by a word character, so emails and
+// URLs are skipped; a handle does not end in '.', '-' or '_' so punctuation
+// after it is kept
+var reMentionHandle = regexp.MustCompile(`(^|[^\w@/.])@([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)`)
+
+// mentionResult is what resolveMentions did with one @handle
+type mentionResult struct {
+    Handle string           `json:"handle"`
+    User   *api.MentionUser `json:"user,omitempty"`
+    // Problem explains why the handle was left as typed
+    Problem string `json:"problem,omitempty"`
+}
+
+// hasMentionHandles reports whether body has anything to resolve, so bodies
+// without mentions cost no request
+func hasMentionHandles(body string) bool {
+    found := false
+    eachMentionText(body, func(s string) string {
+        if reMentionHandle.MatchString(s) { found = true }
+        return s
+    })
+    return found
+}
+
+// eachMentionText calls fn on the parts of body outside code spans and fenced
+// blocks and puts back what it returns
+func eachMentionText(body string, fn func(string) string) string {
+    lines := strings.Split(body, "\n")
+    fenced := false
+    for i, line := range lines {
+        if t := strings.TrimSpace(line); strings.HasPrefix(t, "```") || strings.HasPrefix(t, "~~~") {
+            fenced = !fenced
+            continue
+        }
+        if fenced { continue }
+        parts := strings.Split(line, "`")
+        // Odd parts are inside `code`; an unclosed backtick leaves the rest as text
+        for j := 0; j < len(parts); j++ {
+            if j%2 == 0 || j == len(parts)-1 { parts[j] = fn(parts[j]) }
+        }
+        lines[i] = strings.Join(parts, "`")
+    }
+    return strings.Join(lines, "\n")
+}
+
+// matchMentionUser finds the one user a handle names
+func matchMentionUser(handle string, users []api.MentionUser) (*api.MentionUser, string) {
+    h := strings.ToLower(handle)
+    tiers := []func(u api.MentionUser) bool{
+        func(u api.MentionUser) bool { return strings.ToLower(u.DisplayName) == h },
+        func(u api.MentionUser) bool {
+            local, _, ok := strings.Cut(strings.ToLower(u.Email), "@")
+            return ok && local == h
+        },
+        func(u api.MentionUser) bool { return strings.ToLower(strings.ReplaceAll(u.Name, " ", "")) == h },
+    }
+    for _, match := range tiers {
+        var found []api.MentionUser
+        for _, u := range users {
+            if match(u) { found = append(found, u) }
+        }
+        switch {
+        case len(found) == 1:
+            return &found[0], ""
+        case len(found) > 1:
+            names := make([]string, len(found))
+            for i, u := range found { names[i] = fmt.Sprintf("%s <%s>", u.Name, u.Email) }
+            return nil, "matches " + strings.Join(names, ", ")
+        }
+    }
+    return nil, "no active user with that display name, email or name"
+}
+
+// resolveMentions replaces each @handle in body that names exactly one user by
+// that user's profile URL. Results are per distinct handle, in order of appearance.
+func resolveMentions(body string, users []api.MentionUser) (string, []mentionResult) {
+    var results []mentionResult
+    seen := map[string]int{}
+    out := eachMentionText(body, func(s string) string {
+        return reMentionHandle.ReplaceAllStringFunc(s, func(m string) string {
+            sub := reMentionHandle.FindStringSubmatch(m)
+            lead, handle := sub[1], sub[2]
+            key := strings.ToLower(handle)
+            i, ok := seen[key]
+            if !ok {
+                u, problem := matchMentionUser(handle, users)
+                if u != nil && u.URL == "" { u, problem = nil, "the user has no profile URL" }
+                results = append(results, mentionResult{Handle: handle, User: u, Problem: problem})
+                i = len(results) - 1
+                seen[key] = i
+            }
+            if results[i].User == nil { return m }
+            return lead + results[i].User.URL
+        })
+    })
+    return out, results
+}
+
+// mentionBody resolves the mentions of a comment body unless disabled, warning
+// about handles it left as typed
+func mentionBody(client *api.Client, body string, disabled bool) (string, error) {
+    if disabled || !hasMentionHandles(body) { return body, nil }
+    users, err := client.ListMentionUsers()
+    if err != nil { return "", fmt.Errorf("looking up @mentions (use --no-mentions to send the body as is): %w", err) }
+    out, results := resolveMentions(body, users)
+    for _, r := range results {
+        if r.User == nil {
+            ui.Warnf("@%s was not turned into a mention: %s", r.Handle, r.Problem)
+            continue
+        }
+        ui.Infof("Mentioning @%s: %s", r.Handle, r.User.Name)
+    }
+    return out, nil
+}
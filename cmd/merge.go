@@ -0,0 +1,122 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesMergeCmd = &cobra.Command{
+    Use:   "merge <duplicate> --into <canonical>",
+    Short: "Resolve a duplicate issue into its canonical issue",
+    Long: `Mark <duplicate> as a duplicate of the canonical issue and fold it in:
+labels the canonical issue lacks are copied over, open sub-issues are re-parented
+under it, and both issues get a comment linking the other. With --close the
+duplicate is moved to the team's "Duplicate" state (or its first canceled state).`,
+    Example: `  linear-cli issues merge ENG-50 --into ENG-12
+  linear-cli issues merge ENG-50 --into ENG-12 --close`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        into, _ := cmd.Flags().GetString("into")
+        closeDup, _ := cmd.Flags().GetBool("close")
+        if strings.TrimSpace(into) == "" { return errors.New("--into is required") }
+        client := newAPIClient(cmd, cfg.APIKey)
+
+        dup, err := issueDetailsByKey(client, args[0])
+        if err != nil { return err }
+        canon, err := issueDetailsByKey(client, into)
+        if err != nil { return err }
+        if dup.ID == canon.ID { return errors.New("an issue cannot be merged into itself") }
+        res, err := mergeIssues(client, dup, canon, closeDup)
+        if err != nil { return err }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(res) }
+        fmt.Printf("Marked %s as a duplicate of %s\n", dup.Identifier, canon.Identifier)
+        if len(res.LabelsCopied) > 0 { fmt.Printf("Copied labels: %s\n", strings.Join(res.LabelsCopied, ", ")) }
+        if len(res.SubIssuesMoved) > 0 { fmt.Printf("Moved sub-issues: %s\n", strings.Join(res.SubIssuesMoved, ", ")) }
+        if res.ClosedAs != "" { fmt.Printf("Closed %s as %s\n", dup.Identifier, res.ClosedAs) }
+        return nil
+    },
+}
+
+// mergeResult reports what 'issues merge' changed
+type mergeResult struct {
+    Duplicate      string   `json:"duplicate"`
+    Into           string   `json:"into"`
+    LabelsCopied   []string `json:"labelsCopied"`
+    SubIssuesMoved []string `json:"subIssuesMoved"`
+    ClosedAs       string   `json:"closedAs,omitempty"`
+}
+
+// mergeIssues applies the merge steps in order. The duplicate relation comes
+// first; a later failure is reported together with what was already done.
+func mergeIssues(client *api.Client, dup, canon *api.IssueDetails, closeDup bool) (*mergeResult, error) {
+    res := &mergeResult{Duplicate: dup.Identifier, Into: canon.Identifier, LabelsCopied: []string{}, SubIssuesMoved: []string{}}
+    if err := client.CreateIssueRelation(dup.ID, canon.ID, "duplicate"); err != nil { return nil, err }
+    partial := func(step string, err error) error {
+        return fmt.Errorf("marked %s as a duplicate of %s, but %s failed: %w", dup.Identifier, canon.Identifier, step, err)
+    }
+
+    have := map[string]bool{}
+    for _, l := range canon.Labels { have[l.ID] = true }
+    var labelIDs []string
+    for _, l := range dup.Labels {
+        if !have[l.ID] { have[l.ID] = true; labelIDs = append(labelIDs, l.ID); res.LabelsCopied = append(res.LabelsCopied, l.Name) }
+    }
+    if len(labelIDs) > 0 {
+        if _, err := client.UpdateIssueAdvanced(canon.ID, api.IssueUpdateInput{AddedLabelIDs: labelIDs}); err != nil { return nil, partial("copying labels", err) }
+    }
+
+    children, err := client.ListSubIssues(dup.ID)
+    if err != nil { return nil, partial("listing sub-issues", err) }
+    for _, ch := range children {
+        if ch.StateType == "completed" || ch.StateType == "canceled" { continue }
+        if _, err := client.UpdateIssueAdvanced(ch.ID, api.IssueUpdateInput{ParentID: canon.ID}); err != nil { return nil, partial("moving "+ch.Identifier, err) }
+        res.SubIssuesMoved = append(res.SubIssuesMoved, ch.Identifier)
+    }
+
+    if _, err := client.CreateComment(canon.ID, fmt.Sprintf("Merged duplicate %s: %s\n%s", dup.Identifier, dup.Title, dup.URL)); err != nil { return nil, partial("commenting", err) }
+    if _, err := client.CreateComment(dup.ID, fmt.Sprintf("Duplicate of %s: %s\n%s", canon.Identifier, canon.Title, canon.URL)); err != nil { return nil, partial("commenting", err) }
+
+    if closeDup && dup.Team != nil {
+        states, err := client.TeamStates(dup.Team.ID)
+        if err != nil { return nil, partial("closing", err) }
+        target := duplicateState(states)
+        if target == nil { return nil, partial("closing", errors.New("team has no canceled workflow state")) }
+        if _, err := client.UpdateIssueAdvanced(dup.ID, api.IssueUpdateInput{StateID: target.ID}); err != nil { return nil, partial("closing", err) }
+        res.ClosedAs = target.Name
+    }
+    return res, nil
+}
+
+// duplicateState prefers a canceled-type state named "Duplicate" over the first canceled state
+func duplicateState(states []api.State) *api.State {
+    for i := range states {
+        if states[i].Type == "canceled" && strings.EqualFold(states[i].Name, "duplicate") { return &states[i] }
+    }
+    return firstStateOfType(states, "canceled")
+}
+
+// issueDetailsByKey resolves an id or TEAM-123 key to full details (team and labels included)
+func issueDetailsByKey(client *api.Client, raw string) (*api.IssueDetails, error) {
+    iss, err := resolveIssue(client, raw)
+    if err != nil { return nil, err }
+    det, err := client.GetIssueDetails(iss.ID)
+    if err != nil { return nil, err }
+    if det == nil { return nil, fmt.Errorf("issue %s not found", raw) }
+    return det, nil
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesMergeCmd)
+    issuesMergeCmd.Flags().String("into", "", "Canonical issue to merge into (required)")
+    issuesMergeCmd.Flags().Bool("close", false, "Also close the duplicate")
+}
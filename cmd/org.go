@@ -0,0 +1,68 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strconv"
+    "strings"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var orgCmd = &cobra.Command{
+    Use:   "org",
+    Short: "Show the workspace the API key belongs to",
+    Long: `Show the Linear workspace (organization) behind the current credentials: name,
+URL key, members and seats, enabled integrations and SAML/SCIM status. Billing
+and SSO settings need an admin key; they show as "not visible" otherwise.`,
+    Example: `  linear-cli org
+  linear-cli --profile work org
+  linear-cli --json org`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        org, err := client.GetOrganization()
+        if err != nil { return err }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(org) }
+        seats := strconv.Itoa(org.UserCount) + " members"
+        if org.Seats != nil { seats += fmt.Sprintf(" / %d seats", *org.Seats) }
+        plan := org.Plan
+        if plan == "" { plan = "not visible" }
+        integrations := "not visible"
+        if org.Integrations != nil {
+            integrations = "none"
+            if len(org.Integrations) > 0 { integrations = strings.Join(org.Integrations, ", ") }
+        }
+        rows := [][]string{
+            {"Workspace", org.Name},
+            {"URL key", org.URLKey},
+            {"URL", org.URL},
+            {"Created", ui.Date(org.CreatedAt)},
+            {"Users", seats},
+            {"Plan", plan},
+            {"SAML", enabledLabel(org.SAMLEnabled)},
+            {"SCIM", enabledLabel(org.SCIMEnabled)},
+            {"Integrations", integrations},
+        }
+        for _, r := range rows { fmt.Printf("%-13s %s\n", r[0]+":", r[1]) }
+        return nil
+    },
+}
+
+// enabledLabel renders an optional setting the key may not be allowed to read
+func enabledLabel(b *bool) string {
+    if b == nil { return "not visible" }
+    if *b { return "enabled" }
+    return "disabled"
+}
+
+func init() {
+    rootCmd.AddCommand(orgCmd)
+    addOutputTemplateFlags(orgCmd)
+}
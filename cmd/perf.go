@@ -0,0 +1,165 @@
+package cmd
+
+import (
+    "encoding/json"
+    "fmt"
+    "io"
+    "os"
+    "sort"
+    "strings"
+    "sync"
+    "text/tabwriter"
+    "time"
+
+    "linear-cli/internal/api"
+)
+
+// --profile-perf (or LINEAR_PROFILE_PERF=1) prints, after any command, every API
+// request it sent with its status, attempts and duration, the time per operation,
+// and how often the local caches answered. The report goes to stderr, as JSON
+// under --json, so it never mixes with a command's output.
+
+var profilePerf bool
+
+// perfStats collects the requests and cache lookups of this run
+var perfStats struct {
+    sync.Mutex
+    start    time.Time
+    requests []api.RequestStat
+    // cache counts hits and misses per cache name
+    cache map[string]*perfCacheCount
+}
+
+type perfCacheCount struct {
+    Hits   int `json:"hits"`
+    Misses int `json:"misses"`
+}
+
+func resetPerfStats() {
+    perfStats.Lock()
+    defer perfStats.Unlock()
+    perfStats.start = time.Now()
+    perfStats.requests = nil
+    perfStats.cache = map[string]*perfCacheCount{}
+}
+
+func notePerfRequest(st api.RequestStat) {
+    perfStats.Lock()
+    defer perfStats.Unlock()
+    perfStats.requests = append(perfStats.requests, st)
+}
+
+// notePerfCache records whether cache answered a lookup
+func notePerfCache(cache string, hit bool) {
+    perfStats.Lock()
+    defer perfStats.Unlock()
+    if perfStats.cache == nil { perfStats.cache = map[string]*perfCacheCount{} }
+    c := perfStats.cache[cache]
+    if c == nil {
+        c = &perfCacheCount{}
+        perfStats.cache[cache] = c
+    }
+    if hit { c.Hits++ } else { c.Misses++ }
+}
+
+type perfOperation struct {
+    Operation string  `json:"operation"`
+    Count     int     `json:"count"`
+    Retries   int     `json:"retries"`
+    TotalMs   float64 `json:"totalMs"`
+    MaxMs     float64 `json:"maxMs"`
+}
+
+type perfReport struct {
+    WallMs     float64                    `json:"wallMs"`
+    RequestMs  float64                    `json:"requestMs"`
+    Requests   []perfRequest              `json:"requests"`
+    Operations []perfOperation            `json:"operations"`
+    Retries    int                        `json:"retries"`
+    Cache      map[string]perfCacheCount  `json:"cache"`
+}
+
+type perfRequest struct {
+    Operation  string  `json:"operation"`
+    Status     int     `json:"status"`
+    Attempts   int     `json:"attempts"`
+    DurationMs float64 `json:"durationMs"`
+    Error      string  `json:"error,omitempty"`
+}
+
+func ms(d time.Duration) float64 { return float64(d.Microseconds()) / 1000 }
+
+// buildPerfReport summarizes the run so far; operations are slowest in total first
+func buildPerfReport(now time.Time) perfReport {
+    perfStats.Lock()
+    defer perfStats.Unlock()
+    r := perfReport{WallMs: ms(now.Sub(perfStats.start)), Requests: []perfRequest{}, Operations: []perfOperation{}, Cache: map[string]perfCacheCount{}}
+    byOp := map[string]*perfOperation{}
+    for _, st := range perfStats.requests {
+        r.Requests = append(r.Requests, perfRequest{Operation: st.Operation, Status: st.Status, Attempts: st.Attempts, DurationMs: ms(st.Duration), Error: st.Error})
+        r.RequestMs += ms(st.Duration)
+        retries := max(st.Attempts-1, 0)
+        r.Retries += retries
+        op := byOp[st.Operation]
+        if op == nil {
+            op = &perfOperation{Operation: st.Operation}
+            byOp[st.Operation] = op
+        }
+        op.Count++
+        op.Retries += retries
+        op.TotalMs += ms(st.Duration)
+        op.MaxMs = max(op.MaxMs, ms(st.Duration))
+    }
+    for _, op := range byOp { r.Operations = append(r.Operations, *op) }
+    sort.SliceStable(r.Operations, func(i, j int) bool {
+        if r.Operations[i].TotalMs != r.Operations[j].TotalMs { return r.Operations[i].TotalMs > r.Operations[j].TotalMs }
+        return r.Operations[i].Operation < r.Operations[j].Operation
+    })
+    for name, c := range perfStats.cache { r.Cache[name] = *c }
+    return r
+}
+
+func fmtMs(v float64) string {
+    if v >= 1000 { return fmt.Sprintf("%.2fs", v/1000) }
+    return fmt.Sprintf("%.0fms", v)
+}
+
+// writePerfReport prints r as a table, or as one JSON object when asJSON
+func writePerfReport(w io.Writer, r perfReport, asJSON bool) {
+    if asJSON {
+        _ = json.NewEncoder(w).Encode(map[string]any{"perf": r})
+        return
+    }
+    fmt.Fprintf(w, "\nPerformance: %d request(s) in %s, %d retry(ies), wall time %s\n", len(r.Requests), fmtMs(r.RequestMs), r.Retries, fmtMs(r.WallMs))
+    tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+    if len(r.Requests) > 0 {
+        fmt.Fprintln(tw, "#\tOPERATION\tSTATUS\tATTEMPTS\tTIME")
+        for i, q := range r.Requests {
+            status := "-"
+            if q.Status > 0 { status = fmt.Sprint(q.Status) }
+            if q.Error != "" { status += " (" + truncate(q.Error, 40) + ")" }
+            fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\n", i+1, q.Operation, status, q.Attempts, fmtMs(q.DurationMs))
+        }
+    }
+    if len(r.Operations) < len(r.Requests) {
+        fmt.Fprintln(tw, "\nOPERATION\tCOUNT\tRETRIES\tTOTAL\tMAX")
+        for _, op := range r.Operations {
+            fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\n", op.Operation, op.Count, op.Retries, fmtMs(op.TotalMs), fmtMs(op.MaxMs))
+        }
+    }
+    _ = tw.Flush()
+    if len(r.Cache) > 0 {
+        var parts []string
+        for _, name := range sortedKeys(r.Cache) {
+            c := r.Cache[name]
+            parts = append(parts, fmt.Sprintf("%s %d hit(s), %d miss(es)", name, c.Hits, c.Misses))
+        }
+        fmt.Fprintf(w, "Cache: %s\n", strings.Join(parts, "; "))
+    }
+}
+
+// printPerfReport writes the report to stderr when --profile-perf is on
+func printPerfReport() {
+    if !profilePerf { return }
+    writePerfReport(os.Stderr, buildPerfReport(time.Now()), ui.JSONEnabled())
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "regexp"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+)
+
+// issueFieldsInput holds the raw issue field flags of 'issues create'
+type issueFieldsInput struct {
+    Priority    string
+    PrioritySet bool
+    Labels      []string
+    Assignee    string
+    State       string
+    Due         string
+    // CreateMissingLabels lists unknown labels in MissingLabels instead of failing
+    CreateMissingLabels bool
+}
+
+// issueFields are the preflighted fields, resolved to ids
+type issueFields struct {
+    Priority   *int
+    LabelIDs   []string
+    AssigneeID string
+    StateID    string
+    DueDate    string
+    // MissingLabels are the labels to create (see createMissingLabels)
+    MissingLabels []string
+}
+
+var reISODate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// preflightIssueFields checks every field flag against the team's metadata
+// (rc, from the create context query) before anything is sent, and reports all
+// problems in one error. When rc is nil the team's states and members are fetched
+// instead. Labels missing from the team fall back to workspace labels; client
+// may be nil to skip that lookup.
+func preflightIssueFields(client *api.Client, rc *api.CreateContext, teamID string, in issueFieldsInput, now time.Time) (issueFields, error) {
+    var out issueFields
+    var problems []string
+    if rc == nil {
+        rc = &api.CreateContext{}
+        var err error
+        if strings.TrimSpace(in.State) != "" {
+            if rc.States, err = client.TeamStates(teamID); err != nil { return out, err }
+        }
+        if strings.TrimSpace(in.Assignee) != "" {
+            if rc.Members, err = client.TeamMembers(teamID); err != nil { return out, err }
+        }
+    }
+    teamName := "the team"
+    if rc.Team.Key != "" { teamName = "team " + rc.Team.Key }
+
+    if in.PrioritySet {
+        if v, err := parsePriority(in.Priority); err != nil {
+            problems = append(problems, fmt.Sprintf("priority '%s' is out of range (use urgent|high|medium|low|none or 0-4)", in.Priority))
+        } else {
+            out.Priority = &v
+        }
+    }
+    for _, name := range in.Labels {
+        l := rc.LabelByName(name)
+        if l == nil && client != nil {
+            var err error
+            if l, err = client.ResolveLabelForTeam(name, teamID); err != nil { return out, err }
+        }
+        if l == nil && in.CreateMissingLabels {
+            if !containsFold(out.MissingLabels, name) { out.MissingLabels = append(out.MissingLabels, strings.TrimSpace(name)) }
+            continue
+        }
+        if l == nil { problems = append(problems, fmt.Sprintf("label '%s' does not exist in %s or the workspace (pass --create-missing-labels to create it)", name, teamName)); continue }
+        if !containsString(out.LabelIDs, l.ID) { out.LabelIDs = append(out.LabelIDs, l.ID) }
+    }
+    if a := strings.TrimSpace(in.Assignee); a != "" {
+        u, err := rc.MemberByName(a)
+        switch {
+        case err != nil:
+            problems = append(problems, fmt.Sprintf("assignee: %v", err))
+        case u == nil && client != nil:
+            if other, _ := client.ResolveUser(a); other != nil {
+                problems = append(problems, fmt.Sprintf("assignee '%s' (%s) is not a member of %s", a, other.Name, teamName))
+            } else {
+                problems = append(problems, fmt.Sprintf("assignee '%s' not found", a))
+            }
+        case u == nil:
+            problems = append(problems, fmt.Sprintf("assignee '%s' is not a member of %s", a, teamName))
+        default:
+            out.AssigneeID = u.ID
+        }
+    }
+    if s := strings.TrimSpace(in.State); s != "" {
+        if st := findState(rc.States, s); st != nil {
+            out.StateID = st.ID
+        } else {
+            names := make([]string, len(rc.States))
+            for i, st := range rc.States { names[i] = st.Name }
+            problems = append(problems, fmt.Sprintf("state '%s' does not belong to %s (have: %s)", s, teamName, strings.Join(names, ", ")))
+        }
+    }
+    if d := strings.TrimSpace(in.Due); d != "" {
+        if due, err := parseDueDate(d, now); err != nil {
+            problems = append(problems, err.Error())
+        } else {
+            out.DueDate = due
+        }
+    }
+    if len(problems) > 0 {
+        return out, fmt.Errorf("%d invalid field(s), nothing was created:\n  - %s", len(problems), strings.Join(problems, "\n  - "))
+    }
+    return out, nil
+}
+
+// parseDueDate turns YYYY-MM-DD, today, tomorrow, a weekday or an offset such as
+// 3d or 2w into the YYYY-MM-DD date Linear expects
+func parseDueDate(s string, now time.Time) (string, error) {
+    v := strings.ToLower(strings.TrimSpace(s))
+    bad := fmt.Errorf("due date '%s' is not a date (use YYYY-MM-DD, today, tomorrow, friday or 3d)", s)
+    if reISODate.MatchString(v) {
+        t, err := time.Parse("2006-01-02", v)
+        if err != nil { return "", bad }
+        return t.Format("2006-01-02"), nil
+    }
+    if v == "today" { return now.Format("2006-01-02"), nil }
+    // Sub-day durations and timestamps are not dates
+    if _, err := time.ParseDuration(v); err == nil { return "", bad }
+    t, err := parseUntil(v, now)
+    if err != nil { return "", bad }
+    return t.Format("2006-01-02"), nil
+}
+
+// createMissingLabels creates the named labels in the team with the default
+// color and returns their ids. In dry-run mode nothing is created and no ids
+// are returned.
+func createMissingLabels(client *api.Client, teamID string, names []string) ([]string, error) {
+    var ids []string
+    for _, name := range names {
+        l, err := client.CreateLabel(teamID, name, "")
+        if errors.Is(err, api.ErrDryRun) { continue }
+        if err != nil { return ids, fmt.Errorf("creating label '%s': %w", name, err) }
+        ui.Infof("Created label %s", l.Name)
+        ids = append(ids, l.ID)
+    }
+    return ids, nil
+}
+
+// splitListFlags flattens the values of a repeatable flag such as --label, each
+// of which may also be a comma-separated list, dropping duplicates
+func splitListFlags(values []string) []string {
+    var out []string
+    for _, v := range values {
+        for _, name := range strings.Split(v, ",") {
+            if name = strings.TrimSpace(name); name != "" && !containsFold(out, name) { out = append(out, name) }
+        }
+    }
+    return out
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strconv"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+    "linear-cli/internal/output"
+
+    "github.com/spf13/cobra"
+)
+
+// Linear stores priority as an integer: 0 = none, 1 = urgent .. 4 = low.
+// These helpers let users type names and see labels instead of raw numbers.
+
+var priorityByName = map[string]int{
+    "none":   0,
+    "no":     0,
+    "urgent": 1,
+    "high":   2,
+    "medium": 3,
+    "normal": 3,
+    "low":    4,
+}
+
+var priorityNames = []string{"None", "Urgent", "High", "Medium", "Low"}
+
+// parsePriority accepts a priority name (urgent|high|medium|low|none) or a number 0-4.
+func parsePriority(s string) (int, error) {
+    v := strings.ToLower(strings.TrimSpace(s))
+    if n, ok := priorityByName[v]; ok { return n, nil }
+    if n, err := strconv.Atoi(v); err == nil && n >= 0 && n <= 4 { return n, nil }
+    return 0, fmt.Errorf("invalid priority '%s': use urgent|high|medium|low|none or 0-4", s)
+}
+
+// priorityName returns the human name for a priority value.
+func priorityName(n int) string {
+    if n < 0 || n >= len(priorityNames) { return strconv.Itoa(n) }
+    return priorityNames[n]
+}
+
+// priorityLabel returns the priority name prefixed with an icon for text output,
+// or just the name in plain mode.
+func priorityLabel(n int) string {
+    if ui.Plain { return priorityName(n) }
+    var icon string
+    switch n {
+    case 1:
+        icon = "🔴"
+    case 2:
+        icon = "🟠"
+    case 3:
+        icon = "🟡"
+    case 4:
+        icon = "🔵"
+    default:
+        icon = "⚪"
+    }
+    return icon + " " + priorityName(n)
+}
+
+var issuesPriorityCmd = &cobra.Command{
+    Use:   "priority [<key>] <priority>",
+    Short: "Set the priority of one issue, or of many read from stdin",
+    Long: `Set an issue's priority by name (urgent, high, medium, low, none) or number 0-4.
+With --from-stdin the issue keys are read from standard input instead, one per
+line; the first key on each line is used, so the output of other commands can be
+piped in directly. Every issue is attempted and failures are reported at the end.`,
+    Example: `  linear-cli issues priority ENG-3 high
+  printf 'ENG-3\nENG-7\n' | linear-cli issues priority --from-stdin urgent
+  linear-cli issues list --team ENG --limit 5 | linear-cli issues priority --from-stdin high`,
+    Args: func(cmd *cobra.Command, args []string) error {
+        if fromStdin, _ := cmd.Flags().GetBool("from-stdin"); fromStdin { return cobra.ExactArgs(1)(cmd, args) }
+        return cobra.ExactArgs(2)(cmd, args)
+    },
+    RunE: func(cmd *cobra.Command, args []string) error {
+        fromStdin, _ := cmd.Flags().GetBool("from-stdin")
+        prio, err := parsePriority(args[len(args)-1])
+        if err != nil { return err }
+        keys := args[:len(args)-1]
+        if fromStdin {
+            noteStdinRead()
+            keys, err = readIssueKeys(cmd.InOrStdin())
+            if err != nil { return err }
+            if len(keys) == 0 { return errors.New("no issue keys found on stdin") }
+        }
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+
+        results := setIssuesPriority(client, keys, prio)
+        failed := 0
+        for _, r := range results {
+            if r.Error != "" { failed++ }
+        }
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            if err := p.PrintJSON(results); err != nil { return err }
+        } else {
+            for _, r := range results {
+                if r.Error != "" { fmt.Printf("Failed %s: %s\n", r.Key, r.Error); continue }
+                if r.DryRun { continue }
+                fmt.Printf("Set %s to %s\n", r.Identifier, priorityLabel(r.Priority))
+            }
+        }
+        if failed > 0 { return fmt.Errorf("%d of %d issue(s) could not be updated", failed, len(results)) }
+        return nil
+    },
+}
+
+// priorityResult is the outcome of setting one issue's priority
+type priorityResult struct {
+    Key          string `json:"key"`
+    Identifier   string `json:"identifier,omitempty"`
+    Priority     int    `json:"priority"`
+    PriorityName string `json:"priorityName"`
+    Error        string `json:"error,omitempty"`
+    DryRun       bool   `json:"dryRun,omitempty"`
+}
+
+// setIssuesPriority resolves keys and sets each issue's priority, carrying on
+// past failures so one bad key does not stop an incident-wide escalation
+func setIssuesPriority(client *api.Client, keys []string, prio int) []priorityResult {
+    issues, errs := resolveIssueKeys(client, keys)
+    results := make([]priorityResult, len(keys))
+    var prog *output.Progress
+    if len(keys) > 1 { prog = ui.StartProgress("Setting priority", len(keys)) }
+    for i, k := range keys {
+        r := priorityResult{Key: k, Priority: prio, PriorityName: priorityName(prio)}
+        if errs[i] == nil {
+            r.Identifier = issues[i].Identifier
+            _, errs[i] = client.UpdateIssueAdvanced(issues[i].ID, api.IssueUpdateInput{Priority: &prio})
+        }
+        if errors.Is(errs[i], api.ErrDryRun) {
+            r.DryRun = true
+        } else if errs[i] != nil {
+            r.Error = errs[i].Error()
+        }
+        results[i] = r
+        prog.Step("%s", k)
+    }
+    prog.Done("Set priority on %d issue(s)", len(keys))
+    return results
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesPriorityCmd)
+    issuesPriorityCmd.Flags().Bool("from-stdin", false, "Read issue keys from stdin, one per line")
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var projectsBadgeCmd = &cobra.Command{
+    Use:   "badge <project>",
+    Short: "Print a progress badge for a project or initiative",
+    Long: `Print the progress of a project (or, with --initiative, of every project in
+an initiative) as a badge. Progress counts completed issues against all issues
+that are not canceled, as in 'projects issues'.
+
+--format shields-json (the default) prints a shields.io endpoint JSON. Write it
+somewhere public from a cron job and point a badge at it:
+
+  https://img.shields.io/endpoint?url=<url of the JSON file>
+
+--format text prints one line; the global --json prints the counts.
+--write replaces a file atomically instead of printing, so a web server never
+serves a half-written badge.`,
+    Example: `  linear-cli projects badge "Website"
+  linear-cli projects badge "Website" --write public/website-badge.json
+  linear-cli projects badge "Q3 Reliability" --initiative --label "Q3 reliability"`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        format, _ := cmd.Flags().GetString("format")
+        format = strings.ToLower(strings.TrimSpace(format))
+        if format != "shields-json" && format != "text" { return fmt.Errorf("invalid --format '%s' (use shields-json or text)", format) }
+        initiative, _ := cmd.Flags().GetBool("initiative")
+        label, _ := cmd.Flags().GetString("label")
+        write, _ := cmd.Flags().GetString("write")
+        client := newAPIClient(cmd, cfg.APIKey)
+
+        var progress badgeProgress
+        if initiative {
+            in, err := client.ResolveInitiative(args[0])
+            if err != nil { return err }
+            if in == nil { return fmt.Errorf("initiative '%s' not found", args[0]) }
+            var issues []api.ProjectIssue
+            seen := map[string]bool{}
+            for _, pr := range in.Projects {
+                more, err := client.ListProjectIssues(pr.ID)
+                if err != nil { return err }
+                // An issue belongs to one project, but be safe against repeats
+                for _, it := range more {
+                    if seen[it.ID] { continue }
+                    seen[it.ID] = true
+                    issues = append(issues, it)
+                }
+            }
+            progress = newBadgeProgress(in.Name, "initiative", issues)
+            progress.Projects = len(in.Projects)
+        } else {
+            pr, err := client.ResolveProject(args[0])
+            if err != nil { return err }
+            if pr == nil { return fmt.Errorf("project '%s' not found", args[0]) }
+            issues, err := client.ListProjectIssues(pr.ID)
+            if err != nil { return err }
+            progress = newBadgeProgress(pr.Name, "project", issues)
+        }
+        if strings.TrimSpace(label) == "" { label = progress.Name }
+
+        var out []byte
+        p := printer(cmd)
+        switch {
+        case p.JSONEnabled() && write == "":
+            return p.PrintJSON(progress)
+        case format == "text":
+            out = []byte(progress.text() + "\n")
+        default:
+            b, err := json.Marshal(shieldsBadge(label, progress))
+            if err != nil { return err }
+            out = append(b, '\n')
+        }
+        if write == "" {
+            _, err := os.Stdout.Write(out)
+            return err
+        }
+        if err := writeBadgeFile(expandUserPath(write), out); err != nil { return err }
+        ui.Infof("%s Wrote %s badge (%s) to %s", p.Symbol("✅", "OK"), progress.Name, progress.text(), write)
+        return nil
+    },
+}
+
+// badgeProgress is the progress of a project or initiative; Percent is rounded
+// down, so 100 means every issue that is not canceled is completed
+type badgeProgress struct {
+    Name     string `json:"name"`
+    Kind     string `json:"kind"`
+    Percent  int    `json:"percent"`
+    Done     int    `json:"done"`
+    Total    int    `json:"total"`
+    Canceled int    `json:"canceled"`
+    Projects int    `json:"projects,omitempty"`
+}
+
+func newBadgeProgress(name, kind string, issues []api.ProjectIssue) badgeProgress {
+    b := badgeProgress{Name: name, Kind: kind}
+    b.Done, b.Total = projectProgress(issues)
+    b.Canceled = len(issues) - b.Total
+    if b.Total > 0 { b.Percent = b.Done * 100 / b.Total }
+    return b
+}
+
+func (b badgeProgress) text() string {
+    if b.Total == 0 { return "no issues" }
+    return fmt.Sprintf("%d%% (%d/%d)", b.Percent, b.Done, b.Total)
+}
+
+// shieldsBadge builds a shields.io endpoint response
+// (https://shields.io/badges/endpoint-badge) coloured by progress
+func shieldsBadge(label string, b badgeProgress) map[string]any {
+    color := "lightgrey"
+    if b.Total > 0 {
+        switch {
+        case b.Percent >= 100:
+            color = "brightgreen"
+        case b.Percent >= 75:
+            color = "green"
+        case b.Percent >= 50:
+            color = "yellowgreen"
+        case b.Percent >= 25:
+            color = "yellow"
+        default:
+            color = "orange"
+        }
+    }
+    return map[string]any{"schemaVersion": 1, "label": label, "message": b.text(), "color": color}
+}
+
+// writeBadgeFile replaces path through a temporary file in the same directory
+func writeBadgeFile(path string, data []byte) error {
+    dir := filepath.Dir(path)
+    if err := os.MkdirAll(dir, 0o755); err != nil { return err }
+    f, err := os.CreateTemp(dir, "."+filepath.Base(path)+"-*")
+    if err != nil { return err }
+    defer os.Remove(f.Name())
+    _, err = f.Write(data)
+    if cerr := f.Close(); err == nil { err = cerr }
+    if err != nil { return err }
+    // CreateTemp makes the file private; a badge is meant to be served
+    if err := os.Chmod(f.Name(), 0o644); err != nil { return err }
+    return os.Rename(f.Name(), path)
+}
+
+func init() {
+    projectsCmd.AddCommand(projectsBadgeCmd)
+    projectsBadgeCmd.Flags().String("format", "shields-json", "Badge format: shields-json or text")
+    projectsBadgeCmd.Flags().Bool("initiative", false, "Treat the argument as an initiative and combine its projects")
+    projectsBadgeCmd.Flags().String("label", "", "Badge label (default: the project or initiative name)")
+    projectsBadgeCmd.Flags().String("write", "", "Write the badge to this file atomically instead of printing it")
+}
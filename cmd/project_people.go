@@ -0,0 +1,170 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+    "golang.org/x/term"
+)
+
+var projectsMembersCmd = &cobra.Command{
+    Use:   "members <project> [--add <user>]... [--remove <user>]...",
+    Short: "List or change a project's members",
+    Long: `Without flags, list the project's lead and members. --add and --remove (each
+repeatable, or comma-separated) change the member list in one update. Users are
+matched by name, email or id, or "me"; when a name matches several people you
+are asked to pick one (or, with --no-input, shown the candidates).`,
+    Example: `  linear-cli projects members "Website"
+  linear-cli projects members "Website" --add alice --remove bob`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        addFlags, _ := cmd.Flags().GetStringArray("add")
+        removeFlags, _ := cmd.Flags().GetStringArray("remove")
+        client := newAPIClient(cmd, cfg.APIKey)
+        pr, err := client.ResolveProject(args[0])
+        if err != nil { return err }
+        if pr == nil { return fmt.Errorf("project '%s' not found", args[0]) }
+        people, err := client.GetProjectPeople(pr.ID)
+        if err != nil { return err }
+        p := printer(cmd)
+        if len(addFlags) == 0 && len(removeFlags) == 0 {
+            if p.JSONEnabled() { return p.PrintJSON(people) }
+            printProjectPeople(people)
+            return nil
+        }
+
+        var add, remove []api.User
+        for _, who := range splitListFlags(addFlags) {
+            u, err := pickUser(client, who)
+            if err != nil { return err }
+            add = append(add, *u)
+        }
+        for _, who := range splitListFlags(removeFlags) {
+            u, err := pickUser(client, who)
+            if err != nil { return err }
+            remove = append(remove, *u)
+        }
+        ids, added, removed := applyMemberChanges(people.Members, add, remove)
+        if len(added) == 0 && len(removed) == 0 {
+            fmt.Printf("No change: members of '%s' already match\n", pr.Name)
+            return nil
+        }
+        updated, err := client.SetProjectMembers(pr.ID, ids)
+        if err != nil { return err }
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"project": updated.Project.Name, "added": added, "removed": removed, "members": updated.Members}) }
+        if len(added) > 0 { fmt.Printf("Added to '%s': %s\n", pr.Name, strings.Join(added, ", ")) }
+        if len(removed) > 0 { fmt.Printf("Removed from '%s': %s\n", pr.Name, strings.Join(removed, ", ")) }
+        if people.Lead != nil && containsString(removed, people.Lead.Name) { ui.Warnf("%s is still the project lead; change it with 'projects set-lead'", people.Lead.Name) }
+        return nil
+    },
+}
+
+var projectsSetLeadCmd = &cobra.Command{
+    Use:   "set-lead <project> <user>",
+    Short: "Make a user the project's lead",
+    Example: `  linear-cli projects set-lead "Website" carol
+  linear-cli projects set-lead "Website" me`,
+    Args: cobra.ExactArgs(2),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        pr, err := client.ResolveProject(args[0])
+        if err != nil { return err }
+        if pr == nil { return fmt.Errorf("project '%s' not found", args[0]) }
+        u, err := pickUser(client, args[1])
+        if err != nil { return err }
+        updated, err := client.SetProjectLead(pr.ID, u.ID)
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(updated) }
+        fmt.Printf("%s now leads '%s'\n", u.Name, pr.Name)
+        return nil
+    },
+}
+
+// pickUser resolves who (name, email, id or "me") to one user. A name matching
+// several people is narrowed to an exact name match, else the user is asked to
+// choose when a terminal is attached.
+func pickUser(client *api.Client, who string) (*api.User, error) {
+    who = strings.TrimSpace(who)
+    if strings.EqualFold(who, "me") { return resolveUserOrMe(client, who) }
+    matches, err := client.SearchUsers(who)
+    if err != nil { return nil, err }
+    if len(matches) == 0 {
+        // Ids are not matched by the search
+        return resolveUserOrMe(client, who)
+    }
+    u, candidates := narrowUsers(matches, who)
+    if u != nil { return u, nil }
+    options := make([]string, len(candidates))
+    for i, c := range candidates { options[i] = fmt.Sprintf("%s <%s>", c.Name, c.Email) }
+    if noInput || !term.IsTerminal(int(os.Stdin.Fd())) {
+        return nil, fmt.Errorf("'%s' matches %d users; use an email:\n  %s", who, len(candidates), strings.Join(options, "\n  "))
+    }
+    choice, err := promptChoice(fmt.Sprintf("'%s' matches several users", who), options)
+    if err != nil { return nil, err }
+    for i, opt := range options {
+        if opt == choice { return &candidates[i], nil }
+    }
+    return nil, fmt.Errorf("no user chosen for '%s'", who)
+}
+
+// narrowUsers picks the single match, or the only one whose email or full
+// name equals who; otherwise it returns the candidates to choose from
+func narrowUsers(matches []api.User, who string) (*api.User, []api.User) {
+    if len(matches) == 1 { return &matches[0], nil }
+    var exact []api.User
+    for _, m := range matches {
+        if strings.EqualFold(m.Email, who) || strings.EqualFold(m.Name, who) { exact = append(exact, m) }
+    }
+    if len(exact) == 1 { return &exact[0], nil }
+    if len(exact) > 1 { return nil, exact }
+    return nil, matches
+}
+
+// applyMemberChanges returns the member ids after adding and removing, and the
+// names of those actually added or removed
+func applyMemberChanges(members, add, remove []api.User) (ids, added, removed []string) {
+    drop := map[string]bool{}
+    for _, u := range remove { drop[u.ID] = true }
+    have := map[string]bool{}
+    for _, m := range members {
+        if drop[m.ID] { removed = append(removed, m.Name); continue }
+        have[m.ID] = true
+        ids = append(ids, m.ID)
+    }
+    for _, u := range add {
+        if have[u.ID] || drop[u.ID] { continue }
+        have[u.ID] = true
+        ids = append(ids, u.ID)
+        added = append(added, u.Name)
+    }
+    return ids, added, removed
+}
+
+func printProjectPeople(pp *api.ProjectPeople) {
+    lead := "none"
+    if pp.Lead != nil { lead = fmt.Sprintf("%s <%s>", pp.Lead.Name, pp.Lead.Email) }
+    fmt.Printf("%s\nLead: %s\n", pp.Project.Name, lead)
+    if len(pp.Members) == 0 {
+        fmt.Println("No members")
+        return
+    }
+    fmt.Printf("Members (%d):\n", len(pp.Members))
+    for _, m := range pp.Members { fmt.Printf("  %s <%s>\n", m.Name, m.Email) }
+}
+
+func init() {
+    projectsCmd.AddCommand(projectsMembersCmd, projectsSetLeadCmd)
+    projectsMembersCmd.Flags().StringArray("add", nil, "User to add (repeatable, or comma-separated)")
+    projectsMembersCmd.Flags().StringArray("remove", nil, "User to remove (repeatable, or comma-separated)")
+}
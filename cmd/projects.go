@@ -3,6 +3,9 @@ package cmd
 import (
     "errors"
     "fmt"
+    "math"
+    "sort"
+    "strings"
 
     "linear-cli/internal/api"
     "linear-cli/internal/config"
@@ -22,7 +25,7 @@ var projectsListCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, _ := config.Load()
 		if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
-		client := api.NewClient(cfg.APIKey)
+		client := newAPIClient(cmd, cfg.APIKey)
         details, _ := cmd.Flags().GetBool("details")
         var ps []api.Project
         var err error
@@ -52,8 +55,194 @@ var projectsListCmd = &cobra.Command{
 	},
 }
 
+var projectsIssuesCmd = &cobra.Command{
+    Use:   "issues <project> [--group-by milestone|state]",
+    Short: "List a project's issues, optionally grouped by milestone or state",
+    Long: `List every issue in a project. With --group-by milestone, issues are grouped
+under their milestones (in the project's milestone order, issues without one last)
+with a progress bar per milestone; --group-by state groups them by workflow state.
+Progress counts completed issues against all issues that are not canceled.`,
+    Example: `  linear-cli projects issues "Mobile App"
+  linear-cli projects issues "Mobile App" --group-by milestone
+  linear-cli --json projects issues "Mobile App" --group-by state`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        groupBy, _ := cmd.Flags().GetString("group-by")
+        groupBy = strings.ToLower(strings.TrimSpace(groupBy))
+        if groupBy != "" && groupBy != "milestone" && groupBy != "state" { return fmt.Errorf("invalid --group-by '%s' (use milestone|state)", groupBy) }
+        client := newAPIClient(cmd, cfg.APIKey)
+        pr, err := client.ResolveProject(args[0])
+        if err != nil { return err }
+        if pr == nil { return fmt.Errorf("project '%s' not found", args[0]) }
+        issues, err := client.ListProjectIssues(pr.ID)
+        if err != nil { return err }
+
+        p := printer(cmd)
+        if groupBy == "" {
+            if p.JSONEnabled() { return p.PrintJSON(issues) }
+            return p.Table([]string{"Key", "State", "Priority", "Milestone", "Title"}, projectIssueRows(issues, ""))
+        }
+        groups := groupProjectIssues(issues, groupBy)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"project": pr.Name, "groupBy": groupBy, "groups": groups}) }
+        done, total := projectProgress(issues)
+        fmt.Printf("%s  %s\n", pr.Name, progressBar(done, total, 20))
+        for _, g := range groups {
+            fmt.Println()
+            if groupBy == "milestone" {
+                fmt.Printf("%s  %s\n", g.Group, progressBar(g.Done, g.Total, 20))
+            } else {
+                fmt.Printf("%s (%d)\n", g.Group, len(g.Issues))
+            }
+            head := []string{"Key", "State", "Priority", "Title"}
+            if groupBy == "state" { head = []string{"Key", "Priority", "Milestone", "Title"} }
+            if err := p.Table(head, projectIssueRows(g.Issues, groupBy)); err != nil { return err }
+        }
+        return nil
+    },
+}
+
+// projectIssueGroup is one milestone or state bucket of a project's issues
+type projectIssueGroup struct {
+    Group  string             `json:"group"`
+    Done   int                `json:"done"`
+    Total  int                `json:"total"`
+    Issues []api.ProjectIssue `json:"issues"`
+}
+
+// stateTypeRank orders workflow state types as they flow on a board
+var stateTypeRank = map[string]int{"triage": 0, "backlog": 1, "unstarted": 2, "started": 3, "completed": 4, "canceled": 5}
+
+// groupProjectIssues buckets issues by milestone (milestone sort order, no
+// milestone last) or by state (workflow order, then name), keeping issue order
+func groupProjectIssues(issues []api.ProjectIssue, field string) []projectIssueGroup {
+    type bucket struct {
+        g    projectIssueGroup
+        rank float64
+        name string
+    }
+    byKey := map[string]*bucket{}
+    var keys []string
+    for _, it := range issues {
+        var key string
+        b := &bucket{}
+        if field == "milestone" {
+            if it.Milestone == nil {
+                key, b.g.Group, b.rank = "", "No milestone", math.Inf(1)
+            } else {
+                key, b.g.Group, b.rank = it.Milestone.ID, it.Milestone.Name, it.Milestone.SortOrder
+                if it.Milestone.TargetDate != "" { b.g.Group += " (due " + it.Milestone.TargetDate + ")" }
+            }
+        } else {
+            key, b.g.Group = it.StateName, it.StateName
+            b.rank = 6
+            if r, ok := stateTypeRank[it.StateType]; ok { b.rank = float64(r) }
+        }
+        b.name = strings.ToLower(b.g.Group)
+        if byKey[key] == nil {
+            byKey[key] = b
+            keys = append(keys, key)
+        }
+        g := &byKey[key].g
+        g.Issues = append(g.Issues, it)
+        d, t := projectProgress([]api.ProjectIssue{it})
+        g.Done += d
+        g.Total += t
+    }
+    sort.SliceStable(keys, func(i, j int) bool {
+        a, b := byKey[keys[i]], byKey[keys[j]]
+        if a.rank != b.rank { return a.rank < b.rank }
+        return a.name < b.name
+    })
+    out := make([]projectIssueGroup, 0, len(keys))
+    for _, k := range keys { out = append(out, byKey[k].g) }
+    return out
+}
+
+// projectProgress counts completed issues and all issues that are not canceled
+func projectProgress(issues []api.ProjectIssue) (done, total int) {
+    for _, it := range issues {
+        if it.StateType == "canceled" { continue }
+        total++
+        if it.StateType == "completed" { done++ }
+    }
+    return done, total
+}
+
+// progressBar draws e.g. "[######--------------] 30% (3/10)"
+func progressBar(done, total, width int) string {
+    filled, pct := 0, 0
+    if total > 0 {
+        filled = done * width / total
+        pct = done * 100 / total
+    }
+    return fmt.Sprintf("[%s%s] %d%% (%d/%d)", strings.Repeat("#", filled), strings.Repeat("-", width-filled), pct, done, total)
+}
+
+func milestoneName(it api.ProjectIssue) string {
+    if it.Milestone == nil { return "" }
+    return it.Milestone.Name
+}
+
+// projectIssueRows renders issues as table rows, leaving out the grouped column
+func projectIssueRows(issues []api.ProjectIssue, groupBy string) [][]string {
+    rows := make([][]string, 0, len(issues))
+    for _, it := range issues {
+        row := []string{it.Identifier}
+        if groupBy != "state" { row = append(row, it.StateName) }
+        row = append(row, priorityLabel(it.Priority))
+        if groupBy != "milestone" { row = append(row, milestoneName(it)) }
+        rows = append(rows, append(row, truncate(it.Title, 60)))
+    }
+    return rows
+}
+
+// newProjectTransitionCmd builds a command that moves a project to a status type
+// after confirmation (skipped with --yes).
+func newProjectTransitionCmd(verb, statusType, short string) *cobra.Command {
+    c := &cobra.Command{
+        Use:     verb + " <project>",
+        Short:   short,
+        Example: fmt.Sprintf("  linear-cli projects %s \"Q2 Revamp\"\n  linear-cli projects %s \"Q2 Revamp\" --yes", verb, verb),
+        Args:    cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            cfg, _ := config.Load()
+            if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+            yes, _ := cmd.Flags().GetBool("yes")
+            client := newAPIClient(cmd, cfg.APIKey)
+            pr, err := client.ResolveProject(args[0])
+            if err != nil { return err }
+            if pr == nil { return fmt.Errorf("project '%s' not found", args[0]) }
+            if strings.EqualFold(pr.State, statusType) { return fmt.Errorf("project '%s' is already %s", pr.Name, statusType) }
+            ok, err := confirmed(yes, fmt.Sprintf("Mark project '%s' (%s) as %s? (y/N): ", pr.Name, pr.State, statusType))
+            if err != nil { return err }
+            if !ok {
+                fmt.Println("Aborted")
+                return nil
+            }
+            updated, err := client.SetProjectStatus(pr.ID, statusType)
+            if err != nil { return err }
+            p := printer(cmd)
+            if p.JSONEnabled() { return p.PrintJSON(updated) }
+            fmt.Printf("Project '%s' is now %s\n", updated.Name, updated.State)
+            return nil
+        },
+    }
+    c.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+    return c
+}
+
 func init() {
 	rootCmd.AddCommand(projectsCmd)
 	projectsCmd.AddCommand(projectsListCmd)
+    projectsCmd.AddCommand(projectsIssuesCmd)
+    projectsCmd.AddCommand(
+        newProjectTransitionCmd("complete", "completed", "Mark a project as completed"),
+        newProjectTransitionCmd("cancel", "canceled", "Mark a project as canceled"),
+        newProjectTransitionCmd("pause", "paused", "Pause a project"),
+    )
+    addOutputTemplateFlags(projectsListCmd)
     projectsListCmd.Flags().BoolP("details", "d", false, "Show additional fields (state, url)")
+    projectsIssuesCmd.Flags().String("group-by", "", "Group issues by milestone or state")
 }
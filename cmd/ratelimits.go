@@ -0,0 +1,238 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "sort"
+    "sync"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Every run adds its API requests to api_usage.json, per workspace credentials
+// and per hour: requests (retries included, as each attempt counts against the
+// limit), the complexity Linear charged, rate-limited responses and the last
+// budget reported. 'org rate-limits' shows that history next to the live budget.
+
+// usageHistory is how long hourly usage is kept
+const usageHistory = 48 * time.Hour
+
+// usageHour is this CLI's API consumption during one hour
+type usageHour struct {
+    Hour        time.Time `json:"hour"`
+    Requests    int       `json:"requests"`
+    Retries     int       `json:"retries"`
+    Complexity  int       `json:"complexity"`
+    RateLimited int       `json:"rateLimited"`
+    // Charged counts the requests Linear reported a complexity for
+    Charged int `json:"charged"`
+}
+
+// usageScope is the recorded usage of one set of credentials
+type usageScope struct {
+    Hours []usageHour `json:"hours"`
+    // Last is the most recent budget a response reported, at LastAt
+    Last   *api.RateLimit `json:"last,omitempty"`
+    LastAt time.Time      `json:"lastAt,omitempty"`
+}
+
+type usageFile map[string]*usageScope
+
+// pendingUsage collects this run's requests until flushUsage writes them out
+var pendingUsage struct {
+    sync.Mutex
+    scopes usageFile
+}
+
+// noteUsage records a finished request for scope
+func noteUsage(scope string, st api.RequestStat, now time.Time) {
+    pendingUsage.Lock()
+    defer pendingUsage.Unlock()
+    if pendingUsage.scopes == nil { pendingUsage.scopes = usageFile{} }
+    u := pendingUsage.scopes[scope]
+    if u == nil {
+        u = &usageScope{}
+        pendingUsage.scopes[scope] = u
+    }
+    h := usageHour{Hour: now.UTC().Truncate(time.Hour), Requests: st.Attempts, Complexity: st.Complexity}
+    if h.Requests < 1 { h.Requests = 1 }
+    h.Retries = h.Requests - 1
+    if st.Status == 429 { h.RateLimited = 1 }
+    if st.Complexity > 0 { h.Charged = 1 }
+    u.addHour(h)
+    if st.RateLimit != nil { u.Last, u.LastAt = st.RateLimit, now }
+}
+
+// addHour adds h to the entry for its hour, keeping Hours in order
+func (u *usageScope) addHour(h usageHour) {
+    for i := range u.Hours {
+        if u.Hours[i].Hour.Equal(h.Hour) {
+            u.Hours[i].Requests += h.Requests
+            u.Hours[i].Retries += h.Retries
+            u.Hours[i].Complexity += h.Complexity
+            u.Hours[i].RateLimited += h.RateLimited
+            u.Hours[i].Charged += h.Charged
+            return
+        }
+    }
+    u.Hours = append(u.Hours, h)
+    sort.Slice(u.Hours, func(i, j int) bool { return u.Hours[i].Hour.Before(u.Hours[j].Hour) })
+}
+
+// merge adds another run's usage and drops hours older than usageHistory
+func (u *usageScope) merge(o *usageScope, now time.Time) {
+    for _, h := range o.Hours { u.addHour(h) }
+    if o.Last != nil && !o.LastAt.Before(u.LastAt) { u.Last, u.LastAt = o.Last, o.LastAt }
+    cutoff := now.Add(-usageHistory)
+    kept := u.Hours[:0]
+    for _, h := range u.Hours {
+        if !h.Hour.Before(cutoff) { kept = append(kept, h) }
+    }
+    u.Hours = kept
+}
+
+func usagePath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "api_usage.json"), nil
+}
+
+func loadUsage() usageFile {
+    out := usageFile{}
+    p, err := usagePath()
+    if err != nil { return out }
+    if b, err := os.ReadFile(p); err == nil { _ = json.Unmarshal(b, &out) }
+    return out
+}
+
+// usageLockWait bounds how long a run waits for another run's flush
+var usageLockWait = 5 * time.Second
+
+// flushUsage merges this run's requests into api_usage.json, under a lock so
+// commands run in parallel keep each other's counts. It is best effort: usage
+// that cannot be written is only missing from the history, and a file that
+// does not parse is left alone rather than replaced.
+func flushUsage() {
+    pendingUsage.Lock()
+    pending := pendingUsage.scopes
+    pendingUsage.scopes = nil
+    pendingUsage.Unlock()
+    if len(pending) == 0 { return }
+    p, err := usagePath()
+    if err != nil { return }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return }
+    unlock, err := lockFile(p+".lock", usageLockWait)
+    if err != nil { return }
+    defer unlock()
+    all := usageFile{}
+    if b, err := os.ReadFile(p); err == nil {
+        if json.Unmarshal(b, &all) != nil { return }
+    }
+    now := time.Now()
+    for scope, u := range pending {
+        if all[scope] == nil { all[scope] = &usageScope{} }
+        all[scope].merge(u, now)
+    }
+    if b, err := json.MarshalIndent(all, "", "  "); err == nil { _ = writeFileAtomic(p, b, 0o600) }
+}
+
+var orgRateLimitsCmd = &cobra.Command{
+    Use:   "rate-limits",
+    Short: "Show the API rate limit budget and this CLI's recent API usage",
+    Long: `Show how much of Linear's API rate limits is left: requests and query
+complexity per window, and when each window resets. Checking costs one request.
+
+Below it is this CLI's own usage on this machine, per hour, for the same
+credentials: requests (each retry counts), the complexity Linear charged, and
+rate-limited (429) responses. Use it to tune how many commands automation runs
+in parallel: the average complexity per request tells how many more requests
+the complexity budget allows.`,
+    Example: `  linear-cli org rate-limits
+  linear-cli org rate-limits --hours 48 --json`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        hours, _ := cmd.Flags().GetInt("hours")
+        if hours < 1 || time.Duration(hours)*time.Hour > usageHistory { return fmt.Errorf("--hours must be between 1 and %d", int(usageHistory/time.Hour)) }
+        client := newAPIClient(cmd, cfg.APIKey)
+        rl, err := client.RateLimits()
+        if err != nil { return err }
+        now := time.Now()
+
+        // The history on disk plus what this run has sent so far
+        scope := client.CacheScope()
+        u := loadUsage()[scope]
+        if u == nil { u = &usageScope{} }
+        pendingUsage.Lock()
+        if mine := pendingUsage.scopes[scope]; mine != nil { u.merge(mine, now) }
+        pendingUsage.Unlock()
+        var recent []usageHour
+        cutoff := now.UTC().Truncate(time.Hour).Add(-time.Duration(hours-1) * time.Hour)
+        for _, h := range u.Hours {
+            if !h.Hour.Before(cutoff) { recent = append(recent, h) }
+        }
+        avg := averageComplexity(recent)
+
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            return p.PrintJSON(map[string]any{"rateLimit": rl, "usage": nonNil(recent), "averageComplexity": avg})
+        }
+        if rl == nil {
+            fmt.Println("The API did not report rate limits.")
+        } else {
+            fmt.Printf("Requests:   %s\n", budgetLine(rl.RequestsRemaining, rl.RequestsLimit, rl.RequestsReset, now))
+            fmt.Printf("Complexity: %s\n", budgetLine(rl.ComplexityRemaining, rl.ComplexityLimit, rl.ComplexityReset, now))
+            if avg > 0 {
+                fmt.Printf("\nAt %.0f complexity per request, the complexity budget allows about %d more requests.\n", avg, int(float64(rl.ComplexityRemaining)/avg))
+            }
+        }
+        fmt.Printf("\nThis CLI's usage, last %dh (this machine):\n", hours)
+        if len(recent) == 0 {
+            fmt.Println("  none recorded")
+            return nil
+        }
+        rows := make([][]string, 0, len(recent)+1)
+        var total usageHour
+        for _, h := range recent {
+            rows = append(rows, []string{ui.In(h.Hour).Format("01-02 15:04"), fmt.Sprint(h.Requests), fmt.Sprint(h.Retries), fmt.Sprint(h.Complexity), fmt.Sprint(h.RateLimited)})
+            total.Requests += h.Requests
+            total.Retries += h.Retries
+            total.Complexity += h.Complexity
+            total.RateLimited += h.RateLimited
+        }
+        rows = append(rows, []string{"Total", fmt.Sprint(total.Requests), fmt.Sprint(total.Retries), fmt.Sprint(total.Complexity), fmt.Sprint(total.RateLimited)})
+        return p.Table([]string{"Hour", "Requests", "Retries", "Complexity", "429s"}, rows)
+    },
+}
+
+// budgetLine reads e.g. "1480 of 1500 left, resets 2026-03-02 15:00 (in 23m)"
+func budgetLine(remaining, limit int, reset, now time.Time) string {
+    s := fmt.Sprintf("%d of %d left", remaining, limit)
+    if !reset.IsZero() { s += ", resets " + ui.When(reset, now) }
+    return s
+}
+
+// averageComplexity is the complexity charged per request, over the requests
+// that reported one
+func averageComplexity(hours []usageHour) float64 {
+    var complexity, charged int
+    for _, h := range hours {
+        complexity += h.Complexity
+        charged += h.Charged
+    }
+    if charged == 0 { return 0 }
+    return float64(complexity) / float64(charged)
+}
+
+func init() {
+    orgCmd.AddCommand(orgRateLimitsCmd)
+    orgRateLimitsCmd.Flags().Int("hours", 24, "Hours of usage history to show (up to 48)")
+}
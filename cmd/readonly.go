@@ -0,0 +1,92 @@
+package cmd
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+    "time"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Read-only mode. Mutations are refused before they are sent when --read-only
+// or LINEAR_READ_ONLY asks for it (shared automation that must never write), or
+// when the key in use is known to lack the write scope: a key added with
+// 'auth login --sso' without it, or one 'auth status' or a rejected mutation
+// found to be read-only. The finding is remembered per workspace credentials
+// (see api.Client.CacheScope) for tokenAccessTTL, so a key that gains the scope
+// is picked up again without any action.
+
+// readOnlyForcedReason is what refused mutations say under --read-only
+const readOnlyForcedReason = "read-only mode is on (--read-only or LINEAR_READ_ONLY)"
+
+// tokenAccessTTL is how long a detected read-only key stays read-only
+const tokenAccessTTL = 24 * time.Hour
+
+type tokenAccess struct {
+    Write     bool      `json:"write"`
+    CheckedAt time.Time `json:"checkedAt"`
+}
+
+func tokenAccessPath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "token_access.json"), nil
+}
+
+func loadTokenAccess() map[string]tokenAccess {
+    out := map[string]tokenAccess{}
+    p, err := tokenAccessPath()
+    if err != nil { return out }
+    if b, err := os.ReadFile(p); err == nil { _ = json.Unmarshal(b, &out) }
+    return out
+}
+
+// tokenAccessLockWait bounds how long a run waits for another to record its key
+var tokenAccessLockWait = 5 * time.Second
+
+// recordTokenAccess remembers whether the credentials of scope may write, under
+// a lock so parallel runs keep each other's findings; like the team cache it is
+// best effort
+func recordTokenAccess(scope string, write bool) {
+    p, err := tokenAccessPath()
+    if err != nil { return }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return }
+    unlock, err := lockFile(p+".lock", tokenAccessLockWait)
+    if err != nil { return }
+    defer unlock()
+    all := loadTokenAccess()
+    all[scope] = tokenAccess{Write: write, CheckedAt: time.Now().UTC()}
+    if b, err := json.MarshalIndent(all, "", "  "); err == nil { _ = writeFileAtomic(p, b, 0o600) }
+}
+
+// readOnlyForced reports --read-only or a truthy LINEAR_READ_ONLY
+func readOnlyForced(cmd *cobra.Command) bool {
+    if on, _ := cmd.Flags().GetBool("read-only"); on { return true }
+    switch strings.ToLower(strings.TrimSpace(os.Getenv("LINEAR_READ_ONLY"))) {
+    case "1", "true", "yes", "on":
+        return true
+    }
+    return false
+}
+
+// detectedReadOnly explains why the key of cfg is known to be read-only, or
+// returns "" when it is not (or nobody has checked recently)
+func detectedReadOnly(cfg *config.Config, scope string) string {
+    const fix = "use a key with the write scope ('linear-cli auth login'), or re-check with 'linear-cli auth status'"
+    if info := cfg.CurrentKeyInfo(); info != nil && len(info.Scopes) > 0 && !containsString(info.Scopes, "write") {
+        return fmt.Sprintf("the API key was added with scopes %s and cannot write; %s", strings.Join(info.Scopes, ", "), fix)
+    }
+    if a, ok := loadTokenAccess()[scope]; ok && !a.Write && time.Since(a.CheckedAt) < tokenAccessTTL {
+        return fmt.Sprintf("the API key has no write scope (found %s); %s", ui.When(a.CheckedAt, time.Now()), fix)
+    }
+    return ""
+}
+
+func init() {
+    rootCmd.PersistentFlags().Bool("read-only", false, "Refuse every command that would change data (or set LINEAR_READ_ONLY=1)")
+}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// RecurringSchedule creates an issue from a team template on a cron schedule.
+// Schedules live in recurring.json under the config directory; 'recurring run'
+// creates whatever is due. Each occurrence is created under an idempotency key,
+// so reruns, or runs on another machine, do not create it twice.
+type RecurringSchedule struct {
+    ID       string `json:"id"`
+    Team     string `json:"team"`
+    Template string `json:"template"`
+    // Title defaults to the template name; {{date}} is replaced by the occurrence date
+    Title     string    `json:"title,omitempty"`
+    Cron      string    `json:"cron"`
+    CreatedAt time.Time `json:"created_at"`
+    // LastRun is the latest occurrence an issue exists for, and LastIssue its key
+    LastRun   time.Time `json:"last_run,omitempty"`
+    LastIssue string    `json:"last_issue,omitempty"`
+}
+
+// recurringResult is what 'recurring run' did for one schedule
+type recurringResult struct {
+    Schedule   string    `json:"schedule"`
+    Occurrence time.Time `json:"occurrence"`
+    Status     string    `json:"status"` // created, exists, dry-run or failed
+    Issue      string    `json:"issue,omitempty"`
+    URL        string    `json:"url,omitempty"`
+    Error      string    `json:"error,omitempty"`
+}
+
+var recurringCmd = &cobra.Command{
+    Use:   "recurring",
+    Short: "Create issues from team templates on a schedule",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var recurringAddCmd = &cobra.Command{
+    Use:   "add --team <KEY> --template <name> --cron <expr>",
+    Short: "Schedule an issue to be created from a template",
+    Long: `Schedule an issue to be created from one of a team's templates. The schedule
+is stored locally; 'linear-cli recurring run' creates the issues that are due.
+
+--cron takes a standard five-field expression (minute hour day-of-month month
+day-of-week) in local time, e.g. "0 9 * * MON", "30 8 1 * *" or "@daily".
+--title defaults to the template name; {{date}} in it becomes the date the
+issue is scheduled for.`,
+    Example: `  linear-cli recurring add --team ENG --template "Ops Checklist" --cron "0 9 * * MON"
+  linear-cli recurring add --team OPS --template "On-call handover" --cron "0 17 * * FRI" --title "Handover {{date}}"`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        tplName, _ := cmd.Flags().GetString("template")
+        expr, _ := cmd.Flags().GetString("cron")
+        title, _ := cmd.Flags().GetString("title")
+        teamKey, tplName, expr = strings.ToUpper(strings.TrimSpace(teamKey)), strings.TrimSpace(tplName), strings.TrimSpace(expr)
+        if teamKey == "" || tplName == "" || expr == "" { return errors.New("--team, --template and --cron are required") }
+        spec, err := parseCron(expr)
+        if err != nil { return err }
+        now := time.Now()
+        if spec.next(now).IsZero() { return fmt.Errorf("cron expression '%s' never fires", expr) }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := cachedTeamByKeyOrError(client, teamKey)
+        if err != nil { return err }
+        tpl, err := client.IssueTemplateByNameForTeam(team.ID, tplName)
+        if err != nil { return err }
+        if tpl == nil { return fmt.Errorf("team %s has no template named '%s' (see 'linear-cli templates list --team %s')", teamKey, tplName, teamKey) }
+
+        s := RecurringSchedule{Team: teamKey, Template: tpl.Name, Title: strings.TrimSpace(title), Cron: expr, CreatedAt: now}
+        err = updateRecurring(func(items []RecurringSchedule) ([]RecurringSchedule, error) {
+            s.ID = recurringID(items, teamKey, tpl.Name)
+            return append(items, s), nil
+        })
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"schedule": s, "next": spec.next(now)}) }
+        fmt.Printf("Added %s: %s from '%s', next %s\n", s.ID, teamKey, s.Template, ui.When(spec.next(now), now))
+        fmt.Println("Run 'linear-cli recurring run' from cron or a systemd timer to create due issues.")
+        return nil
+    },
+}
+
+var recurringListCmd = &cobra.Command{
+    Use:   "list",
+    Short: "List recurring schedules with their next run",
+    Args:  cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        items, err := loadRecurring()
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(items) }
+        if len(items) == 0 {
+            fmt.Println("No recurring schedules")
+            return nil
+        }
+        now := time.Now()
+        rows := make([][]string, 0, len(items))
+        for _, s := range items {
+            next := "invalid cron"
+            if spec, err := parseCron(s.Cron); err == nil {
+                if due, ok := dueOccurrence(s, spec, now); ok {
+                    next = "due (" + ui.Time(due) + ")"
+                } else if t := spec.next(now); !t.IsZero() {
+                    next = ui.When(t, now)
+                } else {
+                    next = "never"
+                }
+            }
+            last := "-"
+            if s.LastIssue != "" { last = s.LastIssue }
+            rows = append(rows, []string{s.ID, s.Team, truncate(s.Template, 40), s.Cron, next, last})
+        }
+        return p.Table([]string{"ID", "Team", "Template", "Cron", "Next", "Last issue"}, rows)
+    },
+}
+
+var recurringRemoveCmd = &cobra.Command{
+    Use:     "remove <id>",
+    Aliases: []string{"rm"},
+    Short:   "Delete a recurring schedule (issues already created stay)",
+    Args:    cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        err := updateRecurring(func(items []RecurringSchedule) ([]RecurringSchedule, error) {
+            kept := items[:0]
+            removed := false
+            for _, s := range items {
+                if strings.EqualFold(s.ID, strings.TrimSpace(args[0])) { removed = true; continue }
+                kept = append(kept, s)
+            }
+            if !removed { return nil, fmt.Errorf("no recurring schedule '%s' (see 'linear-cli recurring list')", args[0]) }
+            return kept, nil
+        })
+        if err != nil { return err }
+        fmt.Printf("Removed %s\n", args[0])
+        return nil
+    },
+}
+
+var recurringRunCmd = &cobra.Command{
+    Use:   "run",
+    Short: "Create the issues whose schedule is due",
+    Long: `Create an issue for every schedule that fired since its last issue, then report
+what was created. Meant to run unattended from cron or a systemd timer; every
+few minutes is enough, since a run catches up on what it missed. Occurrences
+missed while nothing ran collapse into one issue for the latest of them.
+
+Rerunning, or running on another machine, does not duplicate issues: each
+occurrence is created under an idempotency key and an issue that already
+exists is reported instead. Exits non-zero when a schedule failed. --dry-run
+shows what would be created.`,
+    Example: `  # crontab: check every 5 minutes
+  */5 * * * * linear-cli recurring run --quiet`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        items, err := loadRecurring()
+        if err != nil { return err }
+        now := time.Now()
+        var due []int
+        for i, s := range items {
+            spec, err := parseCron(s.Cron)
+            if err != nil { ui.Warnf("%s: %v", s.ID, err); continue }
+            if _, ok := dueOccurrence(s, spec, now); ok { due = append(due, i) }
+        }
+        p := printer(cmd)
+        results := []recurringResult{}
+        if len(due) > 0 {
+            cfg, _ := config.Load()
+            if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+            client := newAPIClient(cmd, cfg.APIKey)
+            for _, i := range due {
+                spec, _ := parseCron(items[i].Cron)
+                occ, _ := dueOccurrence(items[i], spec, now)
+                r := runRecurring(client, &items[i], occ)
+                results = append(results, r)
+                if r.Status == "failed" { ui.Warnf("%s: %s", r.Schedule, r.Error) }
+            }
+            // Schedules added or removed while the issues were created are kept as they are now
+            err := updateRecurring(func(current []RecurringSchedule) ([]RecurringSchedule, error) {
+                for _, i := range due { settleRecurring(current, items[i]) }
+                return current, nil
+            })
+            if err != nil { return err }
+        }
+
+        failed := 0
+        for _, r := range results { if r.Status == "failed" { failed++ } }
+        if p.JSONEnabled() {
+            if err := p.PrintJSON(results); err != nil { return err }
+        } else if len(results) == 0 {
+            ui.Infof("Nothing due")
+        } else {
+            for _, r := range results {
+                switch r.Status {
+                case "created":
+                    fmt.Printf("Created %s for %s (%s): %s\n", r.Issue, r.Schedule, ui.Time(r.Occurrence), r.URL)
+                case "exists":
+                    fmt.Printf("%s already has %s for %s\n", r.Schedule, r.Issue, ui.Time(r.Occurrence))
+                case "dry-run":
+                    fmt.Printf("Would create an issue for %s (%s)\n", r.Schedule, ui.Time(r.Occurrence))
+                }
+            }
+        }
+        if failed > 0 { return fmt.Errorf("%d of %d due schedule(s) failed", failed, len(results)) }
+        return nil
+    },
+}
+
+// dueOccurrence returns the latest occurrence of s at or before now, when no
+// issue has been created for it yet and it is not older than the schedule
+func dueOccurrence(s RecurringSchedule, spec *cronSpec, now time.Time) (time.Time, bool) {
+    occ := spec.prev(now)
+    if occ.IsZero() || !occ.After(s.CreatedAt) || !occ.After(s.LastRun) { return time.Time{}, false }
+    return occ, true
+}
+
+// recurringKey is the idempotency key of one occurrence of a schedule
+func recurringKey(s RecurringSchedule, occ time.Time) string {
+    return "recurring:" + s.ID + ":" + occ.UTC().Format("20060102T1504Z")
+}
+
+// recurringTitle is the issue title for an occurrence
+func recurringTitle(s RecurringSchedule, occ time.Time) string {
+    if s.Title == "" { return s.Template + " (" + occ.Format("2006-01-02") + ")" }
+    return strings.ReplaceAll(s.Title, "{{date}}", occ.Format("2006-01-02"))
+}
+
+// runRecurring creates the issue for occurrence occ of s, unless one exists,
+// and advances s.LastRun when the occurrence is settled
+func runRecurring(client *api.Client, s *RecurringSchedule, occ time.Time) recurringResult {
+    r := recurringResult{Schedule: s.ID, Occurrence: occ}
+    fail := func(err error) recurringResult {
+        r.Status, r.Error = "failed", err.Error()
+        return r
+    }
+    key := recurringKey(*s, occ)
+    existing, err := findIdempotentIssue(client, key)
+    if err != nil { return fail(err) }
+    if existing != nil {
+        r.Status, r.Issue, r.URL = "exists", existing.Identifier, existing.URL
+        s.LastRun, s.LastIssue = occ, existing.Identifier
+        return r
+    }
+    team, err := cachedTeamByKeyOrError(client, s.Team)
+    if err != nil { return fail(err) }
+    tpl, err := client.IssueTemplateByNameForTeam(team.ID, s.Template)
+    if err != nil { return fail(err) }
+    if tpl == nil { return fail(fmt.Errorf("team %s has no template named '%s' any more", s.Team, s.Template)) }
+    // The template body goes along because a description replaces the template's
+    created, err := client.CreateIssueAdvanced(api.IssueCreateInput{TeamID: team.ID, TemplateID: tpl.ID, Title: recurringTitle(*s, occ), Description: withIdempotencyMarker(tpl.Description, key)})
+    if errors.Is(err, api.ErrDryRun) {
+        r.Status = "dry-run"
+        return r
+    }
+    if err != nil { return fail(err) }
+    r.Status, r.Issue, r.URL = "created", created.Identifier, created.URL
+    s.LastRun, s.LastIssue = occ, created.Identifier
+    recordIdempotentIssue(client, key, created)
+    return r
+}
+
+// recurringID derives a readable, unique id from the team and template
+func recurringID(items []RecurringSchedule, team, template string) string {
+    base := sanitizeFilename(team + "-" + template)
+    id := base
+    for n := 2; ; n++ {
+        taken := false
+        for _, s := range items { if s.ID == id { taken = true; break } }
+        if !taken { return id }
+        id = base + "-" + strconv.Itoa(n)
+    }
+}
+
+func recurringPath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "recurring.json"), nil
+}
+
+func loadRecurring() ([]RecurringSchedule, error) {
+    p, err := recurringPath()
+    if err != nil { return nil, err }
+    b, err := os.ReadFile(p)
+    if errors.Is(err, os.ErrNotExist) { return []RecurringSchedule{}, nil }
+    if err != nil { return nil, err }
+    var items []RecurringSchedule
+    if err := json.Unmarshal(b, &items); err != nil { return nil, fmt.Errorf("failed to parse %s: %w", p, err) }
+    return items, nil
+}
+
+// recurringLockWait is how long a write waits for another run's write
+var recurringLockWait = 5 * time.Second
+
+// updateRecurring applies fn to the schedules under the file lock, re-reading
+// them first, so a 'recurring run' from cron and an add or remove never drop
+// each other's changes
+func updateRecurring(fn func([]RecurringSchedule) ([]RecurringSchedule, error)) error {
+    p, err := recurringPath()
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    unlock, err := lockFile(p+".lock", recurringLockWait)
+    if err != nil { return err }
+    defer unlock()
+    items, err := loadRecurring()
+    if err != nil { return err }
+    if items, err = fn(items); err != nil { return err }
+    b, err := json.MarshalIndent(items, "", "  ")
+    if err != nil { return err }
+    return writeFileAtomic(p, b, 0o600)
+}
+
+// settleRecurring copies the last run of ran onto the same schedule in items,
+// unless that one is gone or has a later run already
+func settleRecurring(items []RecurringSchedule, ran RecurringSchedule) {
+    for i := range items {
+        if items[i].ID == ran.ID && ran.LastRun.After(items[i].LastRun) {
+            items[i].LastRun, items[i].LastIssue = ran.LastRun, ran.LastIssue
+        }
+    }
+}
+
+func init() {
+    rootCmd.AddCommand(recurringCmd)
+    recurringCmd.AddCommand(recurringAddCmd, recurringListCmd, recurringRemoveCmd, recurringRunCmd)
+    addOutputTemplateFlags(recurringListCmd)
+    recurringAddCmd.Flags().String("team", "", "Team key (required)")
+    recurringAddCmd.Flags().String("template", "", "Template name (required)")
+    recurringAddCmd.Flags().String("cron", "", "Cron expression in local time, e.g. \"0 9 * * MON\" (required)")
+    recurringAddCmd.Flags().String("title", "", "Issue title; {{date}} becomes the scheduled date (default: the template name and date)")
+}
@@ -0,0 +1,245 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "os/exec"
+    "path/filepath"
+    "sort"
+    "strconv"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "time"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+    "github.com/spf13/pflag"
+)
+
+// Mutating commands are recorded in history.json so 'history' can list them and
+// 'redo' can run one again. A command is recorded when it finished without error
+// and the server accepted at least one of its mutations. Flags are stored as they
+// stood after the run, so values a command resolved (--link-pr auto, --from-commit
+// HEAD) are replayed resolved. LINEAR_CLI_HISTORY=off turns recording off.
+//
+// Secret-bearing flags are stored as <redacted> and listed in Redacted; redo
+// refuses such a record rather than replay a placeholder. Commands that cannot
+// be replayed are not recorded: long-running ones (annotated noHistory) and
+// runs that read stdin or prompted.
+type historyRecord struct {
+    ID        int       `json:"id"`
+    At        time.Time `json:"at"`
+    Command   string    `json:"command"`
+    Args      []string  `json:"args"`
+    Mutations []string  `json:"mutations"`
+    Redacted  []string  `json:"redacted,omitempty"`
+}
+
+const maxHistoryRecords = 200
+
+// noHistory is the annotation that keeps a command out of the history
+const noHistory = "linear-cli/no-history"
+
+const redactedValue = "<redacted>"
+
+// historyLockWait is how long recording waits for another run's write
+var historyLockWait = 5 * time.Second
+
+// stdinRead is set when this run read stdin or prompted, so it cannot be replayed
+var stdinRead atomic.Bool
+
+// noteStdinRead marks the run as not replayable
+func noteStdinRead() { stdinRead.Store(true) }
+
+// sentMutations collects the mutations the server accepted during this run
+var sentMutations struct {
+    sync.Mutex
+    ops []string
+}
+
+func noteMutation(op string) {
+    sentMutations.Lock()
+    defer sentMutations.Unlock()
+    sentMutations.ops = append(sentMutations.ops, strings.TrimPrefix(op, "mutation "))
+}
+
+func resetSentMutations() {
+    sentMutations.Lock()
+    defer sentMutations.Unlock()
+    sentMutations.ops = nil
+    stdinRead.Store(false)
+}
+
+// isSecretFlag reports whether a flag carries a credential that must not be
+// written to disk
+func isSecretFlag(name string) bool {
+    for _, w := range []string{"secret", "token", "password", "passphrase", "api-key"} {
+        if name == w || strings.HasSuffix(name, "-"+w) { return true }
+    }
+    return false
+}
+
+func historyPath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "history.json"), nil
+}
+
+func loadCommandHistory() []historyRecord {
+    var recs []historyRecord
+    p, err := historyPath()
+    if err != nil { return nil }
+    if b, err := os.ReadFile(p); err == nil { _ = json.Unmarshal(b, &recs) }
+    return recs
+}
+
+// recordCommandHistory appends the finished command to the history when it sent
+// mutations; failures to write are only warned about
+func recordCommandHistory(cmd *cobra.Command, args []string) {
+    sentMutations.Lock()
+    ops := append([]string(nil), sentMutations.ops...)
+    sentMutations.Unlock()
+    if len(ops) == 0 || strings.EqualFold(os.Getenv("LINEAR_CLI_HISTORY"), "off") { return }
+    if stdinRead.Load() || cmd.Annotations[noHistory] != "" { return }
+    argv, redacted := commandReplayArgs(cmd, args)
+    path := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+    rec := historyRecord{At: time.Now().UTC(), Command: path, Args: argv, Mutations: ops, Redacted: redacted}
+    if err := appendCommandHistory(rec); err != nil { ui.Warnf("could not record command history: %v", err) }
+}
+
+// appendCommandHistory adds rec under the history lock, re-reading the file so
+// parallel runs do not drop each other's records, and writes it atomically
+func appendCommandHistory(rec historyRecord) error {
+    p, err := historyPath()
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    unlock, err := lockFile(p+".lock", historyLockWait)
+    if err != nil { return err }
+    defer unlock()
+    recs := loadCommandHistory()
+    rec.ID = 1
+    if len(recs) > 0 { rec.ID = recs[len(recs)-1].ID + 1 }
+    recs = append(recs, rec)
+    if len(recs) > maxHistoryRecords { recs = recs[len(recs)-maxHistoryRecords:] }
+    b, err := json.MarshalIndent(recs, "", "  ")
+    if err != nil { return err }
+    return writeFileAtomic(p, b, 0o600)
+}
+
+// commandReplayArgs rebuilds an argv (without the program name) that runs cmd
+// again with the same positional arguments and changed flags. Secret flags are
+// replaced by a placeholder and returned as redacted.
+func commandReplayArgs(cmd *cobra.Command, args []string) (argv, redacted []string) {
+    out := strings.Fields(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()))
+    var flags []string
+    cmd.Flags().Visit(func(f *pflag.Flag) {
+        if f.Name == "help" || f.Name == "dry-run" { return }
+        if isSecretFlag(f.Name) {
+            flags = append(flags, "--"+f.Name+"="+redactedValue)
+            redacted = append(redacted, f.Name)
+            return
+        }
+        switch v := f.Value.(type) {
+        case pflag.SliceValue:
+            for _, item := range v.GetSlice() { flags = append(flags, "--"+f.Name+"="+item) }
+            return
+        }
+        if f.Value.Type() == "stringToString" {
+            m, _ := cmd.Flags().GetStringToString(f.Name)
+            for _, k := range sortedKeys(m) { flags = append(flags, "--"+f.Name+"="+k+"="+m[k]) }
+            return
+        }
+        flags = append(flags, "--"+f.Name+"="+f.Value.String())
+    })
+    out = append(out, flags...)
+    for _, a := range args {
+        if strings.HasPrefix(a, "-") { out = append(out, "--"); break }
+    }
+    return append(out, args...), redacted
+}
+
+// shellJoin renders argv for display, quoting words a shell would split
+func shellJoin(argv []string) string {
+    parts := make([]string, len(argv))
+    for i, a := range argv {
+        if a == "" || strings.ContainsAny(a, " \t\n'\"$`\\*?&|;<>()[]{}#~!") { a = strconv.Quote(a) }
+        parts[i] = a
+    }
+    return strings.Join(parts, " ")
+}
+
+var historyCmd = &cobra.Command{
+    Use:   "history",
+    Short: "List recently run commands that changed data in Linear",
+    Long: `List the mutating commands run from this machine, newest first, with the
+arguments they resolved to. Re-run one with 'linear-cli redo <id>'. Set
+LINEAR_CLI_HISTORY=off to stop recording.`,
+    Example: `  linear-cli history
+  linear-cli history --limit 50
+  linear-cli --json history`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        limit, _ := cmd.Flags().GetInt("limit")
+        recs := loadCommandHistory()
+        sort.SliceStable(recs, func(i, j int) bool { return recs[i].ID > recs[j].ID })
+        if limit > 0 && len(recs) > limit { recs = recs[:limit] }
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            if recs == nil { recs = []historyRecord{} }
+            return p.PrintJSON(recs)
+        }
+        if len(recs) == 0 {
+            fmt.Println("No commands recorded yet")
+            return nil
+        }
+        rows := make([][]string, 0, len(recs))
+        for _, r := range recs {
+            rows = append(rows, []string{strconv.Itoa(r.ID), ui.Time(r.At), truncate(shellJoin(r.Args), 90)})
+        }
+        return p.Table([]string{"ID", "When", "Command"}, rows)
+    },
+}
+
+var redoCmd = &cobra.Command{
+    Use:   "redo [<id>]",
+    Short: "Run a command from 'history' again (the latest by default)",
+    Example: `  linear-cli redo
+  linear-cli redo 42
+  linear-cli --dry-run redo 42`,
+    Args: cobra.MaximumNArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        recs := loadCommandHistory()
+        if len(recs) == 0 { return errors.New("no commands recorded yet") }
+        rec := recs[len(recs)-1]
+        if len(args) == 1 {
+            id, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+            if err != nil { return fmt.Errorf("invalid history id '%s'", args[0]) }
+            found := false
+            for _, r := range recs {
+                if r.ID == id { rec, found = r, true }
+            }
+            if !found { return fmt.Errorf("no command #%d in history (see 'linear-cli history')", id) }
+        }
+        if len(rec.Redacted) > 0 {
+            return fmt.Errorf("#%d was run with secrets that are not stored (--%s); run it again yourself: linear-cli %s", rec.ID, strings.Join(rec.Redacted, ", --"), shellJoin(rec.Args))
+        }
+        argv := rec.Args
+        if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun { argv = append([]string{"--dry-run"}, argv...) }
+        exe, err := os.Executable()
+        if err != nil { return err }
+        ui.Infof("Running #%d: linear-cli %s", rec.ID, shellJoin(argv))
+        c := exec.CommandContext(cmd.Context(), exe, argv...)
+        c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+        if err := c.Run(); err != nil { return fmt.Errorf("re-running #%d failed (%v)", rec.ID, err) }
+        return nil
+    },
+}
+
+func init() {
+    rootCmd.AddCommand(historyCmd, redoCmd)
+    historyCmd.Flags().IntP("limit", "n", 20, "Number of commands to show (0 for all)")
+}
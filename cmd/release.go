@@ -0,0 +1,175 @@
+package cmd
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesReleaseCmd = &cobra.Command{
+    Use:   "release --project <name> --from <state> --to <state>",
+    Short: "Move a project's issues from one state to another and comment on each",
+    Long: `Release-day helper: every issue of the project in the --from state is moved to
+the --to state (looked up in each issue's team), with --comment posted on it
+first. Failures do not stop the run; a summary lists what happened to each
+issue and the command exits non-zero if any failed.
+
+Re-running after a partial failure is safe: issues already moved no longer
+match --from, and the comment is not posted again on an issue that already
+has it.`,
+    Example: `  linear-cli issues release --project "v2.0" --from "In Review" --to Done --comment "Shipped in v2.0"
+  linear-cli issues release --project "v2.0" --from "In Review" --to Done --yes --json`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        projectName, _ := cmd.Flags().GetString("project")
+        from, _ := cmd.Flags().GetString("from")
+        to, _ := cmd.Flags().GetString("to")
+        comment, _ := cmd.Flags().GetString("comment")
+        yes, _ := cmd.Flags().GetBool("yes")
+        if strings.TrimSpace(projectName) == "" || strings.TrimSpace(from) == "" || strings.TrimSpace(to) == "" { return errors.New("--project, --from and --to are required") }
+        if strings.EqualFold(strings.TrimSpace(from), strings.TrimSpace(to)) { return errors.New("--from and --to are the same state") }
+        p := printer(cmd)
+        if p.JSONEnabled() && !yes { return errors.New("--json needs --yes") }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        pr, err := client.ResolveProject(projectName)
+        if err != nil { return err }
+        if pr == nil { return fmt.Errorf("project '%s' not found", projectName) }
+        all, err := client.ListProjectIssues(pr.ID)
+        if err != nil { return err }
+        var issues []api.ProjectIssue
+        for _, it := range all {
+            if strings.EqualFold(it.StateName, strings.TrimSpace(from)) { issues = append(issues, it) }
+        }
+        if len(issues) == 0 {
+            if p.JSONEnabled() { return p.PrintJSON(map[string]any{"project": pr.Name, "results": []releaseResult{}}) }
+            fmt.Printf("No issues of '%s' are in %s\n", pr.Name, from)
+            return nil
+        }
+        // Every team must have the target state before anything changes
+        targets := map[string]*api.State{}
+        for _, it := range issues {
+            if _, ok := targets[it.TeamID]; ok { continue }
+            states, err := client.TeamStates(it.TeamID)
+            if err != nil { return err }
+            st := findState(states, to)
+            if st == nil { return fmt.Errorf("%s's team has no state '%s'; nothing was changed", it.Identifier, to) }
+            targets[it.TeamID] = st
+        }
+
+        if !p.JSONEnabled() {
+            fmt.Printf("%d issue(s) of '%s' in %s %s %s:\n", len(issues), pr.Name, from, p.Symbol("→", "->"), to)
+            for _, it := range issues { fmt.Printf("  %s  %s\n", it.Identifier, truncate(it.Title, 70)) }
+        }
+        if ok, err := confirmed(yes, fmt.Sprintf("Release %d issue(s)? [y/N] ", len(issues))); err != nil || !ok { return err }
+
+        results := releaseIssues(cmd.Context(), client, issues, targets, comment)
+        failed := 0
+        for _, r := range results { if r.Error != "" { failed++ } }
+        if p.JSONEnabled() {
+            if err := p.PrintJSON(map[string]any{"project": pr.Name, "from": from, "to": to, "results": results}); err != nil { return err }
+        } else {
+            rows := make([][]string, 0, len(results))
+            for _, r := range results { rows = append(rows, []string{r.Identifier, r.outcome(), truncate(r.Title, 50)}) }
+            if err := p.Table([]string{"Key", "Result", "Title"}, rows); err != nil { return err }
+            fmt.Printf("\nReleased %d of %d issue(s) to %s\n", len(results)-failed, len(issues), to)
+        }
+        if err := cmd.Context().Err(); err != nil { return fmt.Errorf("stopped after %d of %d issue(s); re-run the same command to release the rest: %w", len(results), len(issues), err) }
+        if failed > 0 { return fmt.Errorf("%d of %d issue(s) failed; re-run the same command to retry them", failed, len(results)) }
+        return nil
+    },
+}
+
+// releaseResult is what 'issues release' did to one issue
+type releaseResult struct {
+    Identifier string `json:"identifier"`
+    Title      string `json:"title"`
+    Moved      bool   `json:"moved"`
+    Commented  bool   `json:"commented"`
+    // CommentExisted is set when an earlier run had already posted the comment
+    CommentExisted bool   `json:"commentExisted,omitempty"`
+    DryRun         bool   `json:"dryRun,omitempty"`
+    Error          string `json:"error,omitempty"`
+}
+
+func (r releaseResult) outcome() string {
+    switch {
+    case r.Error != "":
+        return "failed: " + r.Error
+    case r.DryRun:
+        return "dry run"
+    case r.CommentExisted:
+        return "moved (comment already posted)"
+    case r.Commented:
+        return "moved, commented"
+    }
+    return "moved"
+}
+
+// releaseIssues comments on and then moves each issue. Commenting first means a
+// failed move leaves the comment behind, which a re-run detects and skips. Once
+// ctx is cancelled the remaining issues are left alone rather than reported as
+// failed; the one in flight is only reported if its comment was posted.
+func releaseIssues(ctx context.Context, client *api.Client, issues []api.ProjectIssue, targets map[string]*api.State, comment string) []releaseResult {
+    comment = strings.TrimSpace(comment)
+    prog := ui.StartProgress("Releasing", len(issues))
+    results := make([]releaseResult, 0, len(issues))
+    moved := 0
+    for _, it := range issues {
+        if ctx.Err() != nil { break }
+        r := releaseResult{Identifier: it.Identifier, Title: it.Title}
+        err := func() error {
+            if comment != "" {
+                existing, err := client.ListIssueComments(it.ID, time.Time{}, 0)
+                if err != nil { return err }
+                if hasComment(existing, comment) {
+                    r.CommentExisted = true
+                } else {
+                    if _, err := client.CreateComment(it.ID, comment); err != nil { return err }
+                    r.Commented = true
+                }
+            }
+            if _, err := client.UpdateIssueAdvanced(it.ID, api.IssueUpdateInput{StateID: targets[it.TeamID].ID}); err != nil { return err }
+            r.Moved = true
+            return nil
+        }()
+        if err != nil && ctx.Err() != nil && !r.Commented { break }
+        switch {
+        case errors.Is(err, api.ErrDryRun):
+            r.DryRun = true
+        case err != nil:
+            r.Error = err.Error()
+        }
+        if r.Moved { moved++ }
+        results = append(results, r)
+        prog.Step("%s", it.Identifier)
+    }
+    prog.Done("Released %d issue(s)", moved)
+    return results
+}
+
+// hasComment reports whether body was already posted on the issue
+func hasComment(comments []api.Comment, body string) bool {
+    for _, c := range comments {
+        if strings.TrimSpace(c.Body) == body { return true }
+    }
+    return false
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesReleaseCmd)
+    issuesReleaseCmd.Flags().String("project", "", "Project name or id (required)")
+    issuesReleaseCmd.Flags().String("from", "", "Only issues in this state (required)")
+    issuesReleaseCmd.Flags().String("to", "", "State to move them to, e.g. Done (required)")
+    issuesReleaseCmd.Flags().String("comment", "", "Comment to post on each issue")
+    issuesReleaseCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
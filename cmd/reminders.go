@@ -0,0 +1,292 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "os/exec"
+    "path/filepath"
+    "runtime"
+    "sort"
+    "strconv"
+    "strings"
+    "time"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+// Reminder is a locally stored snooze for an issue. Reminders never touch Linear;
+// they live in reminders.json under the config directory.
+type Reminder struct {
+    IssueID   string    `json:"issue_id"`
+    IssueKey  string    `json:"issue_key"`
+    Title     string    `json:"title"`
+    URL       string    `json:"url"`
+    Until     time.Time `json:"until"`
+    Note      string    `json:"note,omitempty"`
+    CreatedAt time.Time `json:"created_at"`
+    Notified  bool      `json:"notified,omitempty"`
+}
+
+var issuesSnoozeCmd = &cobra.Command{
+    Use:   "snooze <issue-key> --until <when>",
+    Short: "Snooze an issue with a local reminder",
+    Long: `Store a local reminder for an issue. Nothing is changed in Linear.
+
+--until accepts: tomorrow, a weekday (monday..sunday), a duration like 3d or 4h,
+a date (YYYY-MM-DD) or an RFC3339 timestamp.`,
+    Example: `  linear-cli issues snooze ENG-12 --until monday
+  linear-cli issues snooze ENG-12 --until 3d --note "check deploy"`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        untilFlag, _ := cmd.Flags().GetString("until")
+        note, _ := cmd.Flags().GetString("note")
+        if strings.TrimSpace(untilFlag) == "" { return errors.New("--until is required") }
+        until, err := parseUntil(untilFlag, time.Now())
+        if err != nil { return err }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+
+        rem := Reminder{IssueID: iss.ID, IssueKey: iss.Identifier, Title: iss.Title, URL: iss.URL, Until: until, Note: note, CreatedAt: time.Now()}
+        err = updateReminders(func(items []Reminder) ([]Reminder, error) {
+            // Re-snoozing replaces any existing reminder for the same issue
+            kept := items[:0]
+            for _, r := range items { if r.IssueID != iss.ID { kept = append(kept, r) } }
+            return append(kept, rem), nil
+        })
+        if err != nil { return err }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(rem) }
+        fmt.Printf("Snoozed %s until %s\n", iss.Identifier, ui.When(until, time.Now()))
+        return nil
+    },
+}
+
+var remindersCmd = &cobra.Command{
+    Use:   "reminders",
+    Short: "Manage local issue reminders created by 'issues snooze'",
+    RunE: func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var remindersListCmd = &cobra.Command{
+    Use:   "list",
+    Short: "List snoozed issues and when they are due",
+    RunE: func(cmd *cobra.Command, args []string) error {
+        items, err := loadReminders()
+        if err != nil { return err }
+        dueOnly, _ := cmd.Flags().GetBool("due")
+        now := time.Now()
+        if dueOnly { items = dueReminders(items, now) }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(items) }
+        if len(items) == 0 {
+            fmt.Println("No reminders")
+            return nil
+        }
+        rows := make([][]string, 0, len(items))
+        for _, r := range items {
+            status := "snoozed"
+            if !r.Until.After(now) { status = "due" }
+            rows = append(rows, []string{r.IssueKey, ui.When(r.Until, time.Now()), status, r.Title})
+        }
+        return p.Table([]string{"Key", "Until", "Status", "Title"}, rows)
+    },
+}
+
+var remindersRemoveCmd = &cobra.Command{
+    Use:   "remove <issue-key>",
+    Short: "Remove a reminder",
+    Args:  cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        key := strings.ToUpper(strings.TrimSpace(args[0]))
+        err := updateReminders(func(items []Reminder) ([]Reminder, error) {
+            kept := items[:0]
+            removed := 0
+            for _, r := range items {
+                if strings.EqualFold(r.IssueKey, key) || r.IssueID == args[0] { removed++; continue }
+                kept = append(kept, r)
+            }
+            if removed == 0 { return nil, fmt.Errorf("no reminder for %s", args[0]) }
+            return kept, nil
+        })
+        if err != nil { return err }
+        fmt.Printf("Removed reminder for %s\n", key)
+        return nil
+    },
+}
+
+var remindersNotifyCmd = &cobra.Command{
+    Use:   "notify",
+    Short: "Print (or send as desktop notifications) reminders that are due",
+    Long: `Print reminders that are due and mark them as notified.
+
+With --desktop, also sends a desktop notification (notify-send on Linux, osascript on macOS).
+Set notify_reminders = true in config.toml to print due reminders on every command invocation.`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        desktop, _ := cmd.Flags().GetBool("desktop")
+        all, _ := cmd.Flags().GetBool("all")
+        due := make([]Reminder, 0)
+        err := updateReminders(func(items []Reminder) ([]Reminder, error) {
+            for i := range items {
+                r := &items[i]
+                if r.Until.After(time.Now()) || (r.Notified && !all) { continue }
+                due = append(due, *r)
+                r.Notified = true
+            }
+            return items, nil
+        })
+        if err != nil { return err }
+        if desktop {
+            for _, r := range due {
+                if err := desktopNotify("Linear reminder: "+r.IssueKey, r.Title); err != nil {
+                    ui.Warnf("desktop notification failed: %v", err)
+                    break
+                }
+            }
+        }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(due) }
+        if len(due) == 0 {
+            fmt.Println("No reminders due")
+            return nil
+        }
+        for _, r := range due { fmt.Println(formatReminder(r)) }
+        return nil
+    },
+}
+
+// notifyDueReminders prints newly due reminders to stderr. It is best effort and
+// only runs when notify_reminders is enabled in config.
+func notifyDueReminders() {
+    cfg, err := config.Load()
+    if err != nil || !cfg.NotifyReminders { return }
+    // Most commands have nothing due, so only those that do take the lock
+    items, err := loadReminders()
+    if err != nil || !hasUnnotifiedDue(items, time.Now()) { return }
+    var due []Reminder
+    err = updateReminders(func(items []Reminder) ([]Reminder, error) {
+        for i := range items {
+            r := &items[i]
+            if r.Notified || r.Until.After(time.Now()) { continue }
+            due = append(due, *r)
+            r.Notified = true
+        }
+        return items, nil
+    })
+    if err != nil { return }
+    for _, r := range due { ui.Infof("⏰ %s", formatReminder(r)) }
+}
+
+func hasUnnotifiedDue(items []Reminder, now time.Time) bool {
+    for _, r := range items { if !r.Notified && !r.Until.After(now) { return true } }
+    return false
+}
+
+func formatReminder(r Reminder) string {
+    s := fmt.Sprintf("%s %s (snoozed until %s) %s", r.IssueKey, r.Title, ui.Time(r.Until), r.URL)
+    if r.Note != "" { s += " — " + r.Note }
+    return s
+}
+
+func dueReminders(items []Reminder, now time.Time) []Reminder {
+    out := make([]Reminder, 0, len(items))
+    for _, r := range items { if !r.Until.After(now) { out = append(out, r) } }
+    return out
+}
+
+func desktopNotify(title, body string) error {
+    switch runtime.GOOS {
+    case "darwin":
+        script := fmt.Sprintf("display notification %s with title %s", strconv.Quote(body), strconv.Quote(title))
+        return exec.Command("osascript", "-e", script).Run()
+    case "linux":
+        return exec.Command("notify-send", title, body).Run()
+    default:
+        return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+    }
+}
+
+// parseUntil converts a human snooze target into an absolute time.
+// Day-based targets resolve to 09:00 local time.
+func parseUntil(s string, now time.Time) (time.Time, error) {
+    v := strings.ToLower(strings.TrimSpace(s))
+    morning := func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 9, 0, 0, 0, t.Location()) }
+    switch v {
+    case "tomorrow":
+        return morning(now.AddDate(0, 0, 1)), nil
+    case "nextweek", "next-week", "next week":
+        return parseUntil("monday", now)
+    }
+    weekdays := map[string]time.Weekday{"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday, "thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday}
+    if wd, ok := weekdays[v]; ok {
+        days := (int(wd) - int(now.Weekday()) + 7) % 7
+        if days == 0 { days = 7 }
+        return morning(now.AddDate(0, 0, days)), nil
+    }
+    if days, d, ok := parseSpan(v); ok { return now.AddDate(0, 0, days).Add(d), nil }
+    if t, err := time.ParseInLocation("2006-01-02", v, now.Location()); err == nil { return morning(t), nil }
+    if t, err := time.Parse(time.RFC3339, strings.TrimSpace(s)); err == nil { return t, nil }
+    return time.Time{}, fmt.Errorf("unrecognized --until value '%s' (try tomorrow, monday, 3d, 4h, 2025-02-01)", s)
+}
+
+func remindersPath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "reminders.json"), nil
+}
+
+func loadReminders() ([]Reminder, error) {
+    p, err := remindersPath()
+    if err != nil { return nil, err }
+    b, err := os.ReadFile(p)
+    if errors.Is(err, os.ErrNotExist) { return []Reminder{}, nil }
+    if err != nil { return nil, err }
+    var items []Reminder
+    if err := json.Unmarshal(b, &items); err != nil { return nil, fmt.Errorf("failed to parse %s: %w", p, err) }
+    sort.Slice(items, func(i, j int) bool { return items[i].Until.Before(items[j].Until) })
+    return items, nil
+}
+
+// remindersLockWait is how long a write waits for another run's write
+var remindersLockWait = 5 * time.Second
+
+// updateReminders applies fn to the reminders under the file lock, re-reading
+// them first, so a snooze and the notify check of a parallel command never
+// drop each other's changes
+func updateReminders(fn func([]Reminder) ([]Reminder, error)) error {
+    p, err := remindersPath()
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    unlock, err := lockFile(p+".lock", remindersLockWait)
+    if err != nil { return err }
+    defer unlock()
+    items, err := loadReminders()
+    if err != nil { return err }
+    if items, err = fn(items); err != nil { return err }
+    b, err := json.MarshalIndent(items, "", "  ")
+    if err != nil { return err }
+    return writeFileAtomic(p, b, 0o600)
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesSnoozeCmd)
+    rootCmd.AddCommand(remindersCmd)
+    remindersCmd.AddCommand(remindersListCmd)
+    remindersCmd.AddCommand(remindersRemoveCmd)
+    remindersCmd.AddCommand(remindersNotifyCmd)
+
+    issuesSnoozeCmd.Flags().String("until", "", "When to be reminded: tomorrow, monday, 3d, 4h, YYYY-MM-DD")
+    issuesSnoozeCmd.Flags().String("note", "", "Optional note shown with the reminder")
+    addOutputTemplateFlags(remindersListCmd)
+    remindersListCmd.Flags().Bool("due", false, "Only show reminders that are due")
+    remindersNotifyCmd.Flags().Bool("desktop", false, "Also send desktop notifications")
+    remindersNotifyCmd.Flags().Bool("all", false, "Include reminders that were already notified")
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+    "fmt"
+    "strconv"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/output"
+)
+
+// --rollup on 'issues view' and 'issues list --parent' totals issue counts and
+// estimates over the whole sub-issue tree (children, their children, ...).
+// Canceled issues are counted apart and left out of the totals, as in project
+// progress.
+
+// issueRollup totals the issues of a sub-issue tree
+type issueRollup struct {
+    // Issues counts the issues that are not canceled
+    Issues            int     `json:"issues"`
+    Completed         int     `json:"completed"`
+    Canceled          int     `json:"canceled"`
+    Estimate          float64 `json:"estimate"`
+    CompletedEstimate float64 `json:"completedEstimate"`
+    // Unestimated counts the issues counted in Issues that have no estimate
+    Unestimated int `json:"unestimated"`
+}
+
+func rollupIssues(items []api.DescendantIssue) issueRollup {
+    var r issueRollup
+    for _, it := range items {
+        if it.StateType == "canceled" {
+            r.Canceled++
+            continue
+        }
+        r.Issues++
+        done := it.StateType == "completed"
+        if done { r.Completed++ }
+        if it.Estimate == nil {
+            r.Unestimated++
+            continue
+        }
+        r.Estimate += *it.Estimate
+        if done { r.CompletedEstimate += *it.Estimate }
+    }
+    return r
+}
+
+// subtreeOf returns the issue id and everything below it among the descendants
+// of some ancestor, in their order
+func subtreeOf(id string, all []api.DescendantIssue) []api.DescendantIssue {
+    in := map[string]bool{id: true}
+    var out []api.DescendantIssue
+    // Descendants come breadth first, so parents are seen before their children
+    for _, it := range all {
+        if it.ID == id || in[it.ParentID] {
+            in[it.ID] = true
+            out = append(out, it)
+        }
+    }
+    return out
+}
+
+func fmtPoints(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+
+// issuesText reads e.g. "3 of 5 done, 1 canceled"
+func (r issueRollup) issuesText() string {
+    s := fmt.Sprintf("%d of %d done", r.Completed, r.Issues)
+    if r.Canceled > 0 { s += fmt.Sprintf(", %d canceled", r.Canceled) }
+    return s
+}
+
+// estimateText reads e.g. "8 of 13 points done, 2 unestimated"
+func (r issueRollup) estimateText() string {
+    s := fmt.Sprintf("%s of %s points done", fmtPoints(r.CompletedEstimate), fmtPoints(r.Estimate))
+    if r.Unestimated > 0 { s += fmt.Sprintf(", %d unestimated", r.Unestimated) }
+    return s
+}
+
+// printRollup renders the roll-up section of 'issues view'
+func printRollup(p output.Printer, r issueRollup) {
+    viewSection(p, "Roll-up of sub-issues", r.Issues+r.Canceled)
+    fmt.Printf("  Issues:   %s\n  Estimate: %s\n", r.issuesText(), r.estimateText())
+}
+
+// printListRollup lists the sub-issues of parent with the roll-up of each one's
+// subtree (the issue itself and everything below it), then the parent's total
+func printListRollup(client *api.Client, p output.Printer, parent *api.Issue, list *api.IssueList) error {
+    below, err := client.ListIssueDescendants(parent.ID)
+    if err != nil { return err }
+    total := rollupIssues(below)
+    if p.JSONEnabled() {
+        rows := make([]map[string]any, 0, len(list.Issues))
+        for _, it := range list.Issues { rows = append(rows, map[string]any{"issue": it, "rollup": rollupIssues(subtreeOf(it.ID, below))}) }
+        return p.PrintJSON(map[string]any{"parent": parent.Identifier, "rollup": total, "issues": rows, "totalCount": list.TotalCount, "hasMore": list.HasMore})
+    }
+    rows := make([][]string, 0, len(list.Issues))
+    for _, it := range list.Issues {
+        r := rollupIssues(subtreeOf(it.ID, below))
+        rows = append(rows, []string{it.Identifier, it.StateName, it.Title, fmt.Sprintf("%d/%d", r.Completed, r.Issues), fmtPoints(r.CompletedEstimate) + "/" + fmtPoints(r.Estimate)})
+    }
+    if err := p.Table([]string{"Key", "State", "Title", "Done", "Points"}, rows); err != nil { return err }
+    printListTotal(list)
+    fmt.Printf("\nRoll-up of %s: %s; %s\n", parent.Identifier, total.issuesText(), total.estimateText())
+    return nil
+}
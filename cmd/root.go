@@ -1,14 +1,23 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"runtime"
 	"strings"
+	"syscall"
+	"time"
 
+	"linear-cli/internal/api"
+	"linear-cli/internal/config"
 	"linear-cli/internal/output"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 // These are injected at build time via -ldflags. Defaults are for dev builds.
@@ -17,6 +26,13 @@ var (
     buildCommit  = ""
 )
 
+// Global modes resolved from persistent flags before any command runs. They are
+// package-level so helpers without access to the cobra command can honor them.
+var (
+    ui      output.Printer
+    noInput bool
+)
+
 var rootCmd = &cobra.Command{
     Use:   "linear-cli",
     Short: "AI-optimized CLI for Linear issue management",
@@ -41,6 +57,38 @@ var rootCmd = &cobra.Command{
   linear-cli issues list --project "Website"`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := logLevel(cmd); err != nil { return err }
+		ui = printer(cmd)
+		noInput, _ = cmd.Flags().GetBool("no-input")
+		resetSentMutations()
+		resetPerfStats()
+		profilePerf, _ = cmd.Flags().GetBool("profile-perf")
+		if v := strings.TrimSpace(os.Getenv("LINEAR_PROFILE_PERF")); v != "" && v != "0" && !strings.EqualFold(v, "false") { profilePerf = true }
+		// config.Load reads the active profile and connection overrides from the environment
+		if profile, _ := cmd.Flags().GetString("profile"); strings.TrimSpace(profile) != "" {
+			_ = os.Setenv("LINEAR_PROFILE", strings.TrimSpace(profile))
+		}
+		if endpoint, _ := cmd.Flags().GetString("api-endpoint"); strings.TrimSpace(endpoint) != "" {
+			_ = os.Setenv("LINEAR_API_ENDPOINT", strings.TrimSpace(endpoint))
+		}
+		if timeout, _ := cmd.Flags().GetString("timeout"); strings.TrimSpace(timeout) != "" {
+			if _, err := config.ParseTimeout(timeout); err != nil { return err }
+			_ = os.Setenv("LINEAR_TIMEOUT", strings.TrimSpace(timeout))
+		}
+		if cfg, err := config.Load(); err == nil {
+			if _, err := cfg.RequestTimeout(); err != nil { return err }
+			if err := cfg.KeyCommandError(); err != nil { ui.Warnf("%v", err) }
+		} else if enc, _ := config.IsEncrypted(); enc {
+			// A config that cannot be unlocked would otherwise look empty and
+			// commands would report "not authenticated"
+			return err
+		}
+		// Surface due snoozed-issue reminders when notify_reminders is enabled
+		if cmd != remindersNotifyCmd { notifyDueReminders() }
+		return nil
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) { recordCommandHistory(cmd, args) },
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmd.Help()
 	},
@@ -51,7 +99,22 @@ func Execute() {
 	// Show friendly suggestions for mistyped commands
 	rootCmd.SuggestionsMinimumDistance = 1
 
-	if err := rootCmd.Execute(); err != nil {
+	// Cancel in-flight API requests and retry backoff on Ctrl-C / SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	finished := make(chan struct{})
+	go handleInterrupt(ctx, stop, finished)
+	err := rootCmd.ExecuteContext(ctx)
+	close(finished)
+	printPerfReport()
+	flushUsage()
+	if err != nil {
+		// --dry-run stops a command at its first mutation; that is the expected outcome
+		if errors.Is(err, api.ErrDryRun) { return }
+		if ctx.Err() != nil {
+			fmt.Fprintln(os.Stderr, "interrupted")
+			os.Exit(130)
+		}
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -60,8 +123,20 @@ func Execute() {
 func init() {
     // Global flags
     rootCmd.PersistentFlags().BoolP("json", "j", false, "Output JSON for scripting")
-    rootCmd.PersistentFlags().StringP("output", "o", "", "Output format: json|text (alias of --json)")
-    rootCmd.MarkFlagsMutuallyExclusive("json", "output")
+    rootCmd.PersistentFlags().StringP("output", "o", "", "Output format: json|jsonl|text (aliases of --json/--json-lines), or url for issue lists (one URL per line)")
+    rootCmd.PersistentFlags().Bool("json-lines", false, "Output newline-delimited JSON (one object per line, streamed as pages arrive)")
+    rootCmd.MarkFlagsMutuallyExclusive("json", "output", "json-lines")
+    rootCmd.PersistentFlags().String("profile", "", "Credentials profile to use (or set LINEAR_PROFILE)")
+    rootCmd.PersistentFlags().String("api-endpoint", "", "GraphQL endpoint, e.g. a self-hosted proxy (or set LINEAR_API_ENDPOINT / api_endpoint in config)")
+    rootCmd.PersistentFlags().String("timeout", "", "Per-request timeout such as 60s or 2m (or set LINEAR_TIMEOUT / timeout in config; default 30s)")
+    rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Suppress decorative output (emojis, progress lines); same as --verbosity warn")
+    rootCmd.PersistentFlags().String("verbosity", "", "Messages to show on stderr: debug|info|warn|error (or set LINEAR_CLI_LOG; default info)")
+    rootCmd.MarkFlagsMutuallyExclusive("quiet", "verbosity")
+    rootCmd.PersistentFlags().Bool("utc", false, "Show times in UTC instead of the local timezone (or set LINEAR_CLI_UTC=1); JSON always has RFC 3339 timestamps")
+    rootCmd.PersistentFlags().Bool("plain", false, "Plain text without emojis, colors or box drawing (default when stdout is not a terminal; --plain=false to keep them)")
+    rootCmd.PersistentFlags().Bool("no-input", false, "Never prompt; fail fast when input would be required (for CI)")
+    rootCmd.PersistentFlags().Bool("dry-run", false, "Print the GraphQL mutation and variables a command would send, without sending it; multi-step commands stop at their first mutation, since later steps need its result")
+    rootCmd.PersistentFlags().Bool("profile-perf", false, "After the command, report each API request's duration and retries, and cache hits/misses, on stderr (or set LINEAR_PROFILE_PERF=1)")
     // Allow tests to inject a custom API endpoint via env; document via hidden flag if needed later
 
     // Provide a version flag for packaging (Homebrew requires a simple version output)
@@ -113,6 +188,11 @@ Examples:
 Environment:
   LINEAR_API_KEY        Linear API key used for authentication
   LINEAR_API_ENDPOINT   Override GraphQL endpoint (testing)
+  LINEAR_PROFILE        Named credentials profile (same as --profile)
+  LINEAR_MAX_ATTEMPTS   Attempts per API request on network errors, 429 and 5xx (default 4)
+  LINEAR_CLI_LOG        Log level for stderr messages: debug|info|warn|error (same as --verbosity)
+  LINEAR_CLI_HISTORY    Set to "off" to stop recording mutating commands for 'history' and 'redo'
+  HTTPS_PROXY           Proxy for API requests (also HTTP_PROXY, NO_PROXY)
 
 Configuration:
   Config file is stored at ~/.config/linear/config.toml (created by 'auth login').
@@ -131,9 +211,115 @@ Configuration:
 // helper to access a shared output.Printer from commands
 func printer(cmd *cobra.Command) output.Printer {
     jsonOut, _ := cmd.Root().Flags().GetBool("json")
+    jsonLines, _ := cmd.Root().Flags().GetBool("json-lines")
     outFmt, _ := cmd.Root().Flags().GetString("output")
-    if strings.EqualFold(strings.TrimSpace(outFmt), "json") {
+    switch strings.ToLower(strings.TrimSpace(outFmt)) {
+    case "json":
         jsonOut = true
+    case "jsonl", "ndjson":
+        jsonLines = true
+    }
+    quiet, _ := cmd.Root().Flags().GetBool("quiet")
+    level, _ := logLevel(cmd)
+    plain := plainOutput(cmd)
+    utc, _ := cmd.Root().Flags().GetBool("utc")
+    if v := strings.TrimSpace(os.Getenv("LINEAR_CLI_UTC")); v != "" && v != "0" && !strings.EqualFold(v, "false") { utc = true }
+    // A --template registered by addOutputTemplateFlag replaces JSON formatting
+    if f := cmd.Flags().Lookup("template"); f != nil && f.Annotations[outputTemplateAnnotation] != nil && f.Value.String() != "" {
+        return output.Printer{Template: f.Value.String(), Quiet: quiet, Plain: plain, UTC: utc, Level: level}
+    }
+    return output.Printer{JSON: jsonOut && !jsonLines, JSONLines: jsonLines, Quiet: quiet, Plain: plain, UTC: utc, Level: level}
+}
+
+// plainOutput is --plain when given, else whether stdout is not a terminal
+func plainOutput(cmd *cobra.Command) bool {
+    if f := cmd.Root().Flags().Lookup("plain"); f != nil && f.Changed {
+        plain, _ := cmd.Root().Flags().GetBool("plain")
+        return plain
+    }
+    return !term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// outputFormat is the lowercased --output value
+func outputFormat(cmd *cobra.Command) string {
+    v, _ := cmd.Root().Flags().GetString("output")
+    return strings.ToLower(strings.TrimSpace(v))
+}
+
+// logLevel resolves the stderr log level: --verbosity, then -q (warn), then
+// LINEAR_CLI_LOG, else info
+func logLevel(cmd *cobra.Command) (output.Level, error) {
+    if v, _ := cmd.Root().Flags().GetString("verbosity"); strings.TrimSpace(v) != "" { return output.ParseLevel(v) }
+    if quiet, _ := cmd.Root().Flags().GetBool("quiet"); quiet { return output.LevelWarn, nil }
+    level, err := output.ParseLevel(os.Getenv("LINEAR_CLI_LOG"))
+    if err != nil { return level, fmt.Errorf("LINEAR_CLI_LOG: %w", err) }
+    return level, nil
+}
+
+// outputTemplateAnnotation marks --template flags that format output, as opposed
+// to the issue template name taken by 'issues create --template'.
+const outputTemplateAnnotation = "linear-cli/output-template"
+
+// addOutputTemplateFlags gives list/view commands a --template flag that formats
+// each result with a Go text/template instead of a table.
+func addOutputTemplateFlags(cmds ...*cobra.Command) {
+    for _, c := range cmds {
+        c.Flags().String("template", "", "Format each result with a Go template, e.g. '{{.Identifier}} {{.Title}} ({{.StateName}})'")
+        _ = c.Flags().SetAnnotation("template", outputTemplateAnnotation, []string{"true"})
+    }
+}
+
+// newAPIClient returns an API client bound to the command's context, so Ctrl-C
+// cancels in-flight requests and retry backoff.
+func newAPIClient(cmd *cobra.Command, apiKey string) *api.Client {
+    c := api.NewClient(apiKey).WithContext(cmd.Context())
+    // Endpoint and timeout: flags (exported as env in PersistentPreRunE), then the active profile, then top-level config
+    cfg, err := config.Load()
+    if err == nil {
+        c = c.WithEndpoint(cfg.Endpoint())
+        if d, err := cfg.RequestTimeout(); err == nil { c = c.WithTimeout(d) }
+    }
+    scope := c.CacheScope()
+    c = c.WithWriteDeniedHook(func() { recordTokenAccess(scope, false) })
+    if readOnlyForced(cmd) {
+        c = c.WithReadOnly(readOnlyForcedReason)
+    } else if cfg != nil && cfg.APIKey == apiKey {
+        // Only the stored key has known scopes; a key being tried in 'auth login' does not
+        c = c.WithReadOnly(detectedReadOnly(cfg, scope))
+    }
+    if p := printer(cmd); p.Enabled(output.LevelDebug) { c = c.WithDebugLog(p.Debugf) }
+    c = c.WithMutationHook(noteMutation)
+    c = c.WithRequestStats(func(st api.RequestStat) {
+        noteUsage(scope, st, time.Now())
+        if profilePerf { notePerfRequest(st) }
+    })
+    if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+        p := printer(cmd)
+        c = c.WithDryRun(func(op, query string, vars map[string]interface{}) { printDryRun(p, op, query, vars) })
+    }
+    return c
+}
+
+// printDryRun shows a mutation --dry-run kept from the server: the operation,
+// the exact query and its variables
+func printDryRun(p output.Printer, op, query string, vars map[string]interface{}) {
+    if vars == nil { vars = map[string]interface{}{} }
+    if p.JSONEnabled() {
+        _ = p.PrintJSON(map[string]any{"dryRun": true, "operation": op, "query": query, "variables": vars})
+        return
     }
-    return output.Printer{JSON: jsonOut}
+    b, _ := json.MarshalIndent(vars, "", "  ")
+    fmt.Printf("# dry run: %s (not sent)\n%s\n# variables\n%s\n", op, strings.TrimSpace(query), b)
+}
+
+// errInputRequired is returned when a prompt is reached under --no-input, so CI
+// runs fail with a message instead of hanging on stdin
+var errInputRequired = errors.New("input required")
+
+// ensureInteractive reports errInputRequired (naming what was asked for) under
+// --no-input; prompts call it before reading stdin
+func ensureInteractive(what string) error {
+    noteStdinRead()
+    if !noInput { return nil }
+    return fmt.Errorf("%w (%s) but --no-input is set; provide it via flags", errInputRequired, what)
 }
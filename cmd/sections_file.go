@@ -0,0 +1,82 @@
+package cmd
+
+import (
+    "encoding/json"
+    "fmt"
+    "io"
+    "os"
+    "path/filepath"
+    "strings"
+)
+
+// readSectionsFile loads --sections-file: a JSON object or a flat YAML mapping
+// of section name to markdown content. "-" reads stdin. The format follows the
+// file extension, or the content when there is none.
+func readSectionsFile(path string, stdin io.Reader) (map[string]string, error) {
+    var b []byte
+    var err error
+    if path == "-" {
+        noteStdinRead()
+        b, err = io.ReadAll(stdin)
+    } else {
+        b, err = os.ReadFile(expandUserPath(path))
+    }
+    if err != nil { return nil, err }
+    isJSON := strings.HasPrefix(strings.TrimSpace(string(b)), "{")
+    switch strings.ToLower(filepath.Ext(path)) {
+    case ".json":
+        isJSON = true
+    case ".yaml", ".yml":
+        isJSON = false
+    }
+    if isJSON {
+        var m map[string]string
+        if err := json.Unmarshal(b, &m); err != nil { return nil, fmt.Errorf("invalid sections file %s: expected a JSON object of strings (%v)", path, err) }
+        if m == nil { m = map[string]string{} }
+        return m, nil
+    }
+    m, err := parseSectionsYAML(string(b))
+    if err != nil { return nil, fmt.Errorf("invalid sections file %s: %w", path, err) }
+    return m, nil
+}
+
+// parseSectionsYAML reads a sections file with the shared YAML subset parser
+// (see parseYAMLSubset). Section content is text, so lists are rejected.
+func parseSectionsYAML(src string) (map[string]string, error) {
+    entries, err := parseYAMLSubset(src)
+    if err != nil { return nil, err }
+    out := map[string]string{}
+    for _, e := range entries {
+        if e.IsList { return nil, &yamlError{e.Line, fmt.Sprintf("section '%s': lists are not supported; use 'key: |' for markdown", e.Key)} }
+        out[e.Key] = e.Value
+    }
+    return out, nil
+}
+
+// matchTemplateSections maps the given section names onto the template's
+// headings (case-insensitively) and rejects names the template does not have.
+// missing lists the required headings left unfilled.
+func matchTemplateSections(content string, sections map[string]string) (matched map[string]string, missing []string, err error) {
+    specs := templateSectionSpecs(content)
+    if len(specs) == 0 { return sections, nil, nil }
+    byLower := map[string]string{}
+    names := make([]string, len(specs))
+    for i, s := range specs {
+        byLower[strings.ToLower(s.Name)] = s.Name
+        names[i] = s.Name
+    }
+    matched = map[string]string{}
+    var unknown []string
+    for _, k := range sortedKeys(sections) {
+        name, ok := byLower[strings.ToLower(strings.TrimSpace(k))]
+        if !ok { unknown = append(unknown, k); continue }
+        matched[name] = sections[k]
+    }
+    if len(unknown) > 0 {
+        return nil, nil, fmt.Errorf("unknown section(s) %s; the template has: %s", strings.Join(unknown, ", "), strings.Join(names, ", "))
+    }
+    for _, s := range specs {
+        if _, ok := matched[s.Name]; !ok && !s.Optional { missing = append(missing, s.Name) }
+    }
+    return matched, missing, nil
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesSplitCmd = &cobra.Command{
+    Use:   "split <issue-key> [--into <title>...]",
+    Short: "Split an issue into sub-issues, moving its checklist items into them",
+    Long: `Create sub-issues of an issue and move its open checklist items into them.
+
+Without --into, every open '- [ ]' item becomes a sub-issue of its own, titled
+by the item. With --into, one sub-issue is created per title (further arguments
+after the issue key are titles too), and each open item moves to the title it
+is most relevant to: the one sharing the most words with the item or with the
+heading it sits under. Items that fit no title, or two equally, stay on the
+original, as do ticked items.
+
+Sub-issues inherit the team, project, priority and labels. Moved lines are
+removed from the original's description once every sub-issue is created.
+--preview shows the plan without changing anything.`,
+    Example: `  linear-cli issues split ENG-40
+  linear-cli issues split ENG-40 --into "Backend work" "Frontend work"
+  linear-cli issues split ENG-40 --into Backend --into Frontend --preview`,
+    Args: cobra.MinimumNArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        into, _ := cmd.Flags().GetStringArray("into")
+        preview, _ := cmd.Flags().GetBool("preview")
+        var titles []string
+        for _, t := range append(into, args[1:]...) {
+            if t = strings.TrimSpace(t); t != "" { titles = append(titles, t) }
+        }
+        client := newAPIClient(cmd, cfg.APIKey)
+        det, err := issueForChecklist(client, args[0])
+        if err != nil { return err }
+        parts, kept := planSplit(det.Description, titles)
+        if len(parts) == 0 { return fmt.Errorf("%s has no open checklist items to split; name the sub-issues with --into", det.Identifier) }
+
+        p := printer(cmd)
+        if preview {
+            if p.JSONEnabled() { return p.PrintJSON(map[string]any{"issue": det.Identifier, "preview": true, "children": parts, "kept": kept}) }
+            printSplitPlan(det.Identifier, parts, kept, nil)
+            return nil
+        }
+        if det.Team == nil { return fmt.Errorf("cannot tell the team of %s", det.Identifier) }
+
+        base := api.IssueCreateInput{TeamID: det.Team.ID, ParentID: det.ID}
+        if det.Project != nil { base.ProjectID = det.Project.ID }
+        if det.Priority > 0 {
+            prio := det.Priority
+            base.Priority = &prio
+        }
+        for _, l := range det.Labels { base.LabelIDs = append(base.LabelIDs, l.ID) }
+        lines := strings.Split(det.Description, "\n")
+        created := make([]*api.IssueDetails, 0, len(parts))
+        for _, part := range parts {
+            in := base
+            in.Title = part.Title
+            in.Description = splitChildDescription(lines, part, det.Identifier)
+            child, err := client.CreateIssueAdvanced(in)
+            if err != nil {
+                if len(created) == 0 { return err }
+                return fmt.Errorf("creating '%s' failed after %s was created; %s is unchanged: %w", part.Title, splitKeys(created), det.Identifier, err)
+            }
+            created = append(created, child)
+        }
+        var moved []checklistItem
+        for _, part := range parts { moved = append(moved, part.Items...) }
+        if len(moved) > 0 {
+            if _, err := client.UpdateIssueAdvanced(det.ID, api.IssueUpdateInput{Description: removeChecklistLines(det.Description, moved)}); err != nil {
+                return fmt.Errorf("created %s, but removing the moved items from %s failed: %w", splitKeys(created), det.Identifier, err)
+            }
+        }
+
+        if p.JSONEnabled() {
+            children := make([]map[string]any, len(parts))
+            for i, part := range parts {
+                children[i] = map[string]any{"identifier": created[i].Identifier, "title": created[i].Title, "url": created[i].URL, "items": part.Items}
+            }
+            return p.PrintJSON(map[string]any{"issue": det.Identifier, "children": children, "kept": kept})
+        }
+        printSplitPlan(det.Identifier, parts, kept, created)
+        return nil
+    },
+}
+
+// splitPart is one sub-issue 'issues split' creates, with the checklist items
+// that move into it
+type splitPart struct {
+    Title string          `json:"title"`
+    Items []checklistItem `json:"items"`
+}
+
+// splitFillerWords carry no meaning when matching items to sub-issue titles
+var splitFillerWords = []string{"a", "an", "and", "the", "of", "for", "to", "in", "on", "with", "work", "task", "item", "todo", "part", "stuff", "change"}
+
+// planSplit decides the sub-issues of a description. Without titles each open
+// checklist item becomes one; with titles, each open item goes to the title
+// sharing the most words with the item and the heading above it. kept lists
+// the open items that stay on the original.
+func planSplit(desc string, titles []string) (parts []splitPart, kept []checklistItem) {
+    var open []checklistItem
+    for _, it := range parseChecklist(desc) {
+        if !it.Checked { open = append(open, it) }
+    }
+    if len(titles) == 0 {
+        for _, it := range open { parts = append(parts, splitPart{Title: it.Text, Items: []checklistItem{it}}) }
+        return parts, nil
+    }
+    parts = make([]splitPart, len(titles))
+    titleWords := make([][]string, len(titles))
+    for i, t := range titles {
+        parts[i] = splitPart{Title: t, Items: []checklistItem{}}
+        titleWords[i] = splitWords(t)
+    }
+    headings := checklistHeadings(desc)
+    for _, it := range open {
+        words := append(splitWords(it.Text), splitWords(headings[it.Line])...)
+        best, bestScore, tie := -1, 0, false
+        for i, tw := range titleWords {
+            score := 0
+            for _, w := range tw {
+                if containsString(words, w) { score++ }
+            }
+            switch {
+            case score > bestScore:
+                best, bestScore, tie = i, score, false
+            case score == bestScore && score > 0:
+                tie = true
+            }
+        }
+        if best < 0 || tie {
+            kept = append(kept, it)
+            continue
+        }
+        parts[best].Items = append(parts[best].Items, it)
+    }
+    return parts, kept
+}
+
+func splitWords(s string) []string {
+    var out []string
+    for _, w := range headingWords(s) {
+        if !containsString(splitFillerWords, w) { out = append(out, w) }
+    }
+    return out
+}
+
+// checklistHeadings maps each line of desc to the markdown heading above it
+func checklistHeadings(desc string) map[int]string {
+    out := map[int]string{}
+    heading, fenced := "", false
+    for i, line := range strings.Split(desc, "\n") {
+        t := strings.TrimSpace(line)
+        if strings.HasPrefix(t, "```") || strings.HasPrefix(t, "~~~") { fenced = !fenced }
+        if !fenced && strings.HasPrefix(t, "#") { heading = strings.TrimSpace(strings.TrimLeft(t, "#")) }
+        out[i] = heading
+    }
+    return out
+}
+
+// splitChildDescription holds the moved checklist lines as they were written
+func splitChildDescription(lines []string, part splitPart, parent string) string {
+    var b strings.Builder
+    for _, it := range part.Items { b.WriteString(strings.TrimLeft(lines[it.Line], " \t") + "\n") }
+    if b.Len() > 0 { b.WriteString("\n") }
+    b.WriteString("Split from " + parent + ".")
+    return b.String()
+}
+
+// removeChecklistLines drops the lines of items from desc
+func removeChecklistLines(desc string, items []checklistItem) string {
+    drop := map[int]bool{}
+    for _, it := range items { drop[it.Line] = true }
+    var out []string
+    for i, line := range strings.Split(desc, "\n") {
+        if !drop[i] { out = append(out, line) }
+    }
+    return strings.Join(out, "\n")
+}
+
+func splitKeys(issues []*api.IssueDetails) string {
+    keys := make([]string, len(issues))
+    for i, is := range issues { keys[i] = is.Identifier }
+    return strings.Join(keys, ", ")
+}
+
+// printSplitPlan lists each sub-issue with its items; created is nil for a preview
+func printSplitPlan(parent string, parts []splitPart, kept []checklistItem, created []*api.IssueDetails) {
+    verb := "Would split"
+    if created != nil { verb = "Split" }
+    fmt.Printf("%s %s into %d sub-issue(s):\n", verb, parent, len(parts))
+    for i, part := range parts {
+        head := part.Title
+        if created != nil { head = created[i].Identifier + "  " + part.Title }
+        fmt.Printf("\n  %s\n", head)
+        for _, it := range part.Items { fmt.Printf("    - [ ] %s\n", it.Text) }
+    }
+    if len(kept) > 0 {
+        fmt.Printf("\n  Staying on %s:\n", parent)
+        for _, it := range kept { fmt.Printf("    - [ ] %s\n", it.Text) }
+    }
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesSplitCmd)
+    issuesSplitCmd.Flags().StringArray("into", nil, "Title of a sub-issue to create (repeatable; extra arguments are titles too)")
+    issuesSplitCmd.Flags().Bool("preview", false, "Show the sub-issues and the items each gets without creating them")
+}
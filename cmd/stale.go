@@ -0,0 +1,127 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+const defaultNudge = "This issue has had no activity for %s. Is it still relevant? Please update it or close it."
+
+var issuesStaleCmd = &cobra.Command{
+    Use:   "stale",
+    Short: "List open issues with no activity for a while",
+    Long: `List a team's open issues (not completed or canceled) that have not been updated
+within the --inactive window, least recently updated first.
+
+Nothing is changed unless --apply is given. With --apply, each stale issue gets the
+--label label (default "stale"; pass --label "" to skip) and, with --nudge or
+--message, a comment asking for an update.`,
+    Example: `  linear-cli issues stale --team ENG --inactive 14d
+  linear-cli issues stale --team ENG --inactive 30d --apply --nudge
+  linear-cli issues stale --team ENG --apply --label "" --message "Still planned for this cycle?"`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        inactive, _ := cmd.Flags().GetString("inactive")
+        apply, _ := cmd.Flags().GetBool("apply")
+        labelName, _ := cmd.Flags().GetString("label")
+        nudge, _ := cmd.Flags().GetBool("nudge")
+        message, _ := cmd.Flags().GetString("message")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        now := time.Now()
+        cutoff, err := parseSince(inactive, now)
+        if err != nil { return err }
+        if strings.TrimSpace(message) != "" { nudge = true }
+        if nudge && strings.TrimSpace(message) == "" { message = fmt.Sprintf(defaultNudge, strings.TrimSpace(inactive)) }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        key := strings.ToUpper(strings.TrimSpace(teamKey))
+        team, err := client.TeamByKey(key)
+        if err != nil { return err }
+        if team == nil { return fmt.Errorf("team with key %s not found", teamKey) }
+        issues, err := client.ListStaleIssues(team.ID, cutoff)
+        if err != nil { return err }
+
+        type applyResult struct {
+            Issue     string `json:"issue"`
+            Labeled   bool   `json:"labeled,omitempty"`
+            Commented bool   `json:"commented,omitempty"`
+            Error     string `json:"error,omitempty"`
+        }
+        var applied []applyResult
+        if apply && len(issues) > 0 {
+            var staleLabel *api.Label
+            if strings.TrimSpace(labelName) != "" {
+                if rc, err := teamCreateContext(client, key); err == nil && rc != nil { staleLabel = rc.LabelByName(labelName) }
+                if staleLabel == nil {
+                    if staleLabel, err = client.ResolveLabelForTeam(labelName, team.ID); err != nil { return err }
+                }
+                if staleLabel == nil { return fmt.Errorf("label '%s' not found; create it in Linear or pass --label \"\"", labelName) }
+            }
+            if staleLabel == nil && !nudge { return errors.New("--apply needs something to do: a --label and/or --nudge/--message") }
+            prog := ui.StartProgress("Marking stale issues", len(issues))
+            for _, iss := range issues {
+                res := applyResult{Issue: iss.Identifier}
+                if staleLabel != nil && !hasLabel(iss.Labels, staleLabel.ID) {
+                    if _, err := client.UpdateIssueAdvanced(iss.ID, api.IssueUpdateInput{AddedLabelIDs: []string{staleLabel.ID}}); err != nil {
+                        res.Error = err.Error()
+                    } else {
+                        res.Labeled = true
+                    }
+                }
+                if nudge && res.Error == "" {
+                    if _, err := client.CreateComment(iss.ID, message); err != nil { res.Error = err.Error() } else { res.Commented = true }
+                }
+                if res.Error != "" { prog.Warnf("%s: %s", iss.Identifier, res.Error) }
+                prog.Step("%s", iss.Identifier)
+                applied = append(applied, res)
+            }
+            prog.Done("Processed %d stale issue(s)", len(issues))
+        }
+
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            out := map[string]any{"team": team.Key, "inactiveSince": cutoff, "issues": issues}
+            if apply { out["applied"] = applied }
+            return p.PrintJSON(out)
+        }
+        if len(issues) == 0 {
+            fmt.Printf("No open %s issues inactive since %s\n", team.Key, cutoff.Format("2006-01-02"))
+            return nil
+        }
+        rows := make([][]string, 0, len(issues))
+        for _, iss := range issues {
+            assignee := "-"
+            if iss.Assignee != nil { assignee = iss.Assignee.Name }
+            rows = append(rows, []string{iss.Identifier, iss.StateName, assignee, formatHours(now.Sub(iss.UpdatedAt).Hours()), truncate(iss.Title, 60)})
+        }
+        if err := p.Table([]string{"Key", "State", "Assignee", "Idle", "Title"}, rows); err != nil { return err }
+        if !apply {
+            fmt.Printf("\n%d stale issue(s). Re-run with --apply to label them (and --nudge to comment).\n", len(issues))
+        }
+        return nil
+    },
+}
+
+func hasLabel(labels []api.Label, id string) bool {
+    for _, l := range labels { if l.ID == id { return true } }
+    return false
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesStaleCmd)
+    issuesStaleCmd.Flags().String("team", "", "Team key (required)")
+    issuesStaleCmd.Flags().String("inactive", "14d", "Inactivity window (14d, 2w, 2024-01-01)")
+    issuesStaleCmd.Flags().Bool("apply", false, "Label (and optionally comment on) the stale issues")
+    issuesStaleCmd.Flags().String("label", "stale", "Label to add with --apply (empty to skip)")
+    issuesStaleCmd.Flags().Bool("nudge", false, "With --apply, comment a nudge on each stale issue")
+    issuesStaleCmd.Flags().String("message", "", "Custom nudge comment (implies --nudge)")
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var standupCmd = &cobra.Command{
+    Use:   "standup",
+    Short: "Summarize your recent activity as a paste-ready snippet",
+    Long: `List what you completed since a point in time, what you have in progress, and which
+of your issues are blocked by open issues. The text output is formatted for pasting
+into Slack; use --json for structured output.`,
+    Example: `  linear-cli standup --team ENG --since yesterday
+  linear-cli standup --since 3d`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        sinceFlag, _ := cmd.Flags().GetString("since")
+        since, err := parseSince(sinceFlag, time.Now())
+        if err != nil { return err }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        teamID := ""
+        if strings.TrimSpace(teamKey) != "" {
+            team, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
+            if err != nil { return err }
+            if team == nil { return fmt.Errorf("team with key %s not found", teamKey) }
+            teamID = team.ID
+        }
+        issues, err := client.ListMyActivity(teamID, since)
+        if err != nil { return err }
+        s := buildStandup(issues, since)
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(s) }
+        writeStandup(os.Stdout, s)
+        return nil
+    },
+}
+
+type standupSummary struct {
+    Since      time.Time           `json:"since"`
+    Done       []api.ActivityIssue `json:"done"`
+    InProgress []api.ActivityIssue `json:"inProgress"`
+    Blocked    []api.ActivityIssue `json:"blocked"`
+}
+
+func buildStandup(issues []api.ActivityIssue, since time.Time) standupSummary {
+    s := standupSummary{Since: since, Done: []api.ActivityIssue{}, InProgress: []api.ActivityIssue{}, Blocked: []api.ActivityIssue{}}
+    for _, iss := range issues {
+        switch {
+        case iss.CompletedAt != nil && !iss.CompletedAt.Before(since):
+            s.Done = append(s.Done, iss)
+        case iss.StateType == "started":
+            s.InProgress = append(s.InProgress, iss)
+        }
+        if iss.CompletedAt == nil && len(iss.BlockedBy) > 0 { s.Blocked = append(s.Blocked, iss) }
+    }
+    return s
+}
+
+// writeStandup renders Slack-flavored text: *bold* headings and • bullets
+func writeStandup(w io.Writer, s standupSummary) {
+    section := func(title string, items []api.ActivityIssue, line func(api.ActivityIssue) string) {
+        fmt.Fprintf(w, "*%s*\n", title)
+        if len(items) == 0 { fmt.Fprintln(w, "• nothing"); return }
+        for _, iss := range items { fmt.Fprintf(w, "• %s\n", line(iss)) }
+    }
+    plain := func(iss api.ActivityIssue) string { return fmt.Sprintf("%s %s", iss.Identifier, iss.Title) }
+    section(fmt.Sprintf("Done since %s", s.Since.Format("Mon Jan 2")), s.Done, plain)
+    fmt.Fprintln(w)
+    section("In progress", s.InProgress, plain)
+    fmt.Fprintln(w)
+    section("Blockers", s.Blocked, func(iss api.ActivityIssue) string {
+        by := make([]string, 0, len(iss.BlockedBy))
+        for _, b := range iss.BlockedBy { by = append(by, fmt.Sprintf("%s %s (%s)", b.Identifier, b.Title, b.StateName)) }
+        return fmt.Sprintf("%s %s — blocked by %s", iss.Identifier, iss.Title, strings.Join(by, ", "))
+    })
+}
+
+func init() {
+    rootCmd.AddCommand(standupCmd)
+    standupCmd.Flags().String("team", "", "Limit to one team key (default: all teams)")
+    standupCmd.Flags().String("since", "yesterday", "Start of the window for completed work (yesterday, 3d, 2024-01-01)")
+}
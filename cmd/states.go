@@ -0,0 +1,212 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "regexp"
+    "sort"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var statesCmd = &cobra.Command{
+    Use:   "states",
+    Short: "List and manage a team's workflow states",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var statesListCmd = &cobra.Command{
+    Use:   "list --team <key>",
+    Short: "List a team's workflow states in board order",
+    Example: `  linear-cli states list --team ENG
+  linear-cli --json states list --team ENG`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, states, err := teamStatesFlag(cmd, client)
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(states) }
+        if len(states) == 0 {
+            fmt.Printf("No workflow states for %s\n", team.Key)
+            return nil
+        }
+        rows := make([][]string, 0, len(states))
+        for _, s := range states {
+            rows = append(rows, []string{s.Name, s.Type, fmt.Sprintf("%g", s.Position), p.Swatch(s.Color) + s.Color, truncate(s.Description, 50)})
+        }
+        return p.Table([]string{"State", "Type", "Position", "Color", "Description"}, rows)
+    },
+}
+
+var statesCreateCmd = &cobra.Command{
+    Use:   "create --team <key> --name <name> --type <type>",
+    Short: "Add a workflow state to a team (admins)",
+    Long: `Add a workflow state to a team. --type is one of triage, backlog, unstarted,
+started, completed or canceled. The state goes after --after when given (or at
+--position), otherwise last among states of its type. Without --color it gets
+the usual color for its type.`,
+    Example: `  linear-cli states create --team ENG --name "In Review" --type started --after "In Progress"
+  linear-cli states create --team ENG --name "Won't fix" --type canceled --color "#95a2b3"`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        name, _ := cmd.Flags().GetString("name")
+        typ, _ := cmd.Flags().GetString("type")
+        color, _ := cmd.Flags().GetString("color")
+        desc, _ := cmd.Flags().GetString("description")
+        name, typ = strings.TrimSpace(name), strings.ToLower(strings.TrimSpace(typ))
+        if name == "" || typ == "" { return errors.New("--team, --name and --type are required") }
+        if _, ok := stateTypeRank[typ]; !ok { return fmt.Errorf("invalid --type '%s' (use %s)", typ, strings.Join(stateTypes(), "|")) }
+        if color == "" { color = defaultStateColors[typ] }
+        if !reHexColor.MatchString(color) { return fmt.Errorf("invalid --color '%s': expected a hex color like #f2c94c", color) }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, states, err := teamStatesFlag(cmd, client)
+        if err != nil { return err }
+        if findState(states, name) != nil { return fmt.Errorf("%s already has a state named '%s'", team.Key, name) }
+        pos, err := statePositionFlags(cmd, states, typ)
+        if err != nil { return err }
+        created, err := client.CreateWorkflowState(api.WorkflowStateInput{TeamID: team.ID, Name: name, Type: typ, Color: color, Description: desc, Position: pos})
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(created) }
+        fmt.Printf("Created state '%s' (%s) in %s\n", created.Name, created.Type, team.Key)
+        return nil
+    },
+}
+
+var statesUpdateCmd = &cobra.Command{
+    Use:   "update --team <key> <state> [--name] [--color] [--description] [--after <state> | --position <n>]",
+    Short: "Rename, recolor or move a workflow state (admins)",
+    Long: `Change a workflow state's name, color, description or position. The state is
+named by its current name or id. Its type cannot be changed.`,
+    Example: `  linear-cli states update --team ENG "In Review" --name "Code Review"
+  linear-cli states update --team ENG "Code Review" --after "In Progress" --color "#0f783c"`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        name, _ := cmd.Flags().GetString("name")
+        color, _ := cmd.Flags().GetString("color")
+        desc, _ := cmd.Flags().GetString("description")
+        if color != "" && !reHexColor.MatchString(color) { return fmt.Errorf("invalid --color '%s': expected a hex color like #f2c94c", color) }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, states, err := teamStatesFlag(cmd, client)
+        if err != nil { return err }
+        st := findState(states, args[0])
+        if st == nil { return fmt.Errorf("%s has no state named '%s'", team.Key, args[0]) }
+        name = strings.TrimSpace(name)
+        if name != "" && !strings.EqualFold(name, st.Name) && findState(states, name) != nil { return fmt.Errorf("%s already has a state named '%s'", team.Key, name) }
+        var pos *float64
+        if cmd.Flags().Changed("after") || cmd.Flags().Changed("position") {
+            others := make([]api.State, 0, len(states))
+            for _, s := range states { if s.ID != st.ID { others = append(others, s) } }
+            if pos, err = statePositionFlags(cmd, others, st.Type); err != nil { return err }
+        }
+        in := api.WorkflowStateInput{Name: name, Color: color, Description: desc, Position: pos}
+        if in.Name == "" && in.Color == "" && in.Description == "" && in.Position == nil { return errors.New("nothing to update: pass --name, --color, --description, --after or --position") }
+        updated, err := client.UpdateWorkflowState(st.ID, in)
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(updated) }
+        fmt.Printf("Updated state '%s' in %s\n", updated.Name, team.Key)
+        return nil
+    },
+}
+
+var reHexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// defaultStateColors are the colors Linear gives new states of each type
+var defaultStateColors = map[string]string{
+    "triage":    "#fc7840",
+    "backlog":   "#bec2c8",
+    "unstarted": "#e2e2e2",
+    "started":   "#f2c94c",
+    "completed": "#5e6ad2",
+    "canceled":  "#95a2b3",
+}
+
+// stateTypes lists the workflow state types in board order
+func stateTypes() []string {
+    out := make([]string, 0, len(stateTypeRank))
+    for t := range stateTypeRank { out = append(out, t) }
+    sort.Slice(out, func(i, j int) bool { return stateTypeRank[out[i]] < stateTypeRank[out[j]] })
+    return out
+}
+
+// teamStatesFlag resolves the required --team flag and returns the team's states
+// in board order: by type, then position
+func teamStatesFlag(cmd *cobra.Command, client *api.Client) (*api.Team, []api.State, error) {
+    teamKey, _ := cmd.Flags().GetString("team")
+    if strings.TrimSpace(teamKey) == "" { return nil, nil, errors.New("--team is required") }
+    team, err := cachedTeamByKeyOrError(client, teamKey)
+    if err != nil { return nil, nil, err }
+    states, err := client.TeamStates(team.ID)
+    if err != nil { return nil, nil, err }
+    sort.SliceStable(states, func(i, j int) bool {
+        if states[i].Type != states[j].Type { return stateTypeRank[states[i].Type] < stateTypeRank[states[j].Type] }
+        return states[i].Position < states[j].Position
+    })
+    return team, states, nil
+}
+
+// findState matches a state by id or case-insensitive name
+func findState(states []api.State, ref string) *api.State {
+    ref = strings.TrimSpace(ref)
+    for i := range states {
+        if states[i].ID == ref || strings.EqualFold(states[i].Name, ref) { return &states[i] }
+    }
+    return nil
+}
+
+// statePositionFlags picks a position for a state of type typ among states:
+// --position as given, just after the --after state (halfway to the next one of
+// its type), or after the last state of typ. nil leaves the position to Linear.
+func statePositionFlags(cmd *cobra.Command, states []api.State, typ string) (*float64, error) {
+    if cmd.Flags().Changed("position") {
+        pos, _ := cmd.Flags().GetFloat64("position")
+        return &pos, nil
+    }
+    var sameType []api.State
+    for _, s := range states { if s.Type == typ { sameType = append(sameType, s) } }
+    after, _ := cmd.Flags().GetString("after")
+    if strings.TrimSpace(after) == "" {
+        if len(sameType) == 0 { return nil, nil }
+        pos := sameType[len(sameType)-1].Position + 1
+        return &pos, nil
+    }
+    prev := findState(states, after)
+    if prev == nil { return nil, fmt.Errorf("no state named '%s' to place it after", after) }
+    pos := prev.Position + 1
+    for _, s := range sameType {
+        if s.Position > prev.Position { pos = (prev.Position + s.Position) / 2; break }
+    }
+    return &pos, nil
+}
+
+func init() {
+    rootCmd.AddCommand(statesCmd)
+    statesCmd.AddCommand(statesListCmd, statesCreateCmd, statesUpdateCmd)
+    for _, c := range []*cobra.Command{statesListCmd, statesCreateCmd, statesUpdateCmd} {
+        c.Flags().String("team", "", "Team key (required)")
+    }
+    addOutputTemplateFlags(statesListCmd)
+    statesCreateCmd.Flags().String("name", "", "State name (required)")
+    statesCreateCmd.Flags().String("type", "", "State type: triage|backlog|unstarted|started|completed|canceled (required)")
+    for _, c := range []*cobra.Command{statesCreateCmd, statesUpdateCmd} {
+        c.Flags().String("color", "", "Hex color, e.g. #f2c94c")
+        c.Flags().String("description", "", "State description")
+        c.Flags().String("after", "", "Place the state right after this one")
+        c.Flags().Float64("position", 0, "Exact board position (overrides --after)")
+    }
+    statesUpdateCmd.Flags().String("name", "", "New state name")
+}
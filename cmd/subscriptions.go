@@ -0,0 +1,111 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var issuesSubscribeCmd = &cobra.Command{
+    Use:   "subscribe <issue-key>",
+    Short: "Follow an issue to get notified about its updates",
+    Long:  `Subscribe to an issue. With --user, subscribes someone else (name, email or "me").`,
+    Example: `  linear-cli issues subscribe ENG-4
+  linear-cli issues subscribe ENG-4 --user ada@example.com`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error { return runSubscription(cmd, args[0], true) },
+}
+
+var issuesUnsubscribeCmd = &cobra.Command{
+    Use:   "unsubscribe <issue-key>",
+    Short: "Stop following an issue",
+    Long:  `Unsubscribe from an issue. With --user, unsubscribes someone else (name, email or "me").`,
+    Example: `  linear-cli issues unsubscribe ENG-4`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error { return runSubscription(cmd, args[0], false) },
+}
+
+var issuesSubscribersCmd = &cobra.Command{
+    Use:   "subscribers <issue-key>",
+    Short: "List the users following an issue",
+    Example: `  linear-cli issues subscribers ENG-4
+  linear-cli --json issues subscribers ENG-4`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+        users, err := client.IssueSubscribers(iss.ID)
+        if err != nil { return err }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(users) }
+        if len(users) == 0 {
+            fmt.Printf("No one is subscribed to %s\n", iss.Identifier)
+            return nil
+        }
+        rows := make([][]string, 0, len(users))
+        for _, u := range users { rows = append(rows, []string{u.Name, u.Email}) }
+        return p.Table([]string{"Subscriber", "Email"}, rows)
+    },
+}
+
+// runSubscription subscribes or unsubscribes the --user (the viewer by default)
+func runSubscription(cmd *cobra.Command, key string, subscribe bool) error {
+    cfg, _ := config.Load()
+    if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+    who, _ := cmd.Flags().GetString("user")
+    client := newAPIClient(cmd, cfg.APIKey)
+    iss, err := resolveIssue(client, key)
+    if err != nil { return err }
+
+    var user *api.User
+    if strings.TrimSpace(who) != "" {
+        if user, err = resolveUserOrMe(client, who); err != nil { return err }
+    }
+    userID, name := "", "you"
+    if user != nil { userID, name = user.ID, user.Name }
+    if subscribe {
+        err = client.SubscribeToIssue(iss.ID, userID)
+    } else {
+        err = client.UnsubscribeFromIssue(iss.ID, userID)
+    }
+    if err != nil { return err }
+
+    p := printer(cmd)
+    if p.JSONEnabled() { return p.PrintJSON(map[string]any{"issue": iss.Identifier, "user": user, "subscribed": subscribe}) }
+    if subscribe {
+        fmt.Printf("Subscribed %s to %s\n", name, iss.Identifier)
+    } else {
+        fmt.Printf("Unsubscribed %s from %s\n", name, iss.Identifier)
+    }
+    return nil
+}
+
+// resolveUserOrMe looks a user up by name, email or id; "me" is the viewer
+func resolveUserOrMe(client *api.Client, who string) (*api.User, error) {
+    if strings.EqualFold(strings.TrimSpace(who), "me") {
+        v, err := client.Viewer()
+        if err != nil { return nil, err }
+        return &api.User{ID: v.ID, Name: v.Name, Email: v.Email}, nil
+    }
+    user, err := client.ResolveUser(who)
+    if err != nil { return nil, err }
+    if user == nil { return nil, fmt.Errorf("user '%s' not found", who) }
+    return user, nil
+}
+
+func init() {
+    issuesCmd.AddCommand(issuesSubscribeCmd, issuesUnsubscribeCmd, issuesSubscribersCmd)
+    for _, c := range []*cobra.Command{issuesSubscribeCmd, issuesUnsubscribeCmd} {
+        c.Flags().String("user", "", "User to (un)subscribe instead of yourself: name, email or \"me\"")
+    }
+    addOutputTemplateFlags(issuesSubscribersCmd)
+}
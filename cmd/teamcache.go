@@ -0,0 +1,67 @@
+package cmd
+
+import (
+    "encoding/json"
+    "os"
+    "path/filepath"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+)
+
+// The team cache maps team keys to IDs across runs so TEAM-123 lookups skip the
+// teams query. Entries are scoped per workspace credentials (see
+// api.Client.CacheScope). Team IDs are stable; an entry is dropped when a lookup
+// through it finds nothing, which covers renamed keys.
+type teamCacheFile map[string]map[string]api.Team
+
+func teamCachePath() (string, error) {
+    dir, err := config.GetConfigDir()
+    if err != nil { return "", err }
+    return filepath.Join(dir, "teams.json"), nil
+}
+
+func loadTeamCache() teamCacheFile {
+    cache := teamCacheFile{}
+    p, err := teamCachePath()
+    if err != nil { return cache }
+    if b, err := os.ReadFile(p); err == nil { _ = json.Unmarshal(b, &cache) }
+    return cache
+}
+
+// saveTeamCache is best effort: a cache that cannot be written only costs a request
+func saveTeamCache(cache teamCacheFile) {
+    p, err := teamCachePath()
+    if err != nil { return }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return }
+    if b, err := json.MarshalIndent(cache, "", "  "); err == nil { _ = os.WriteFile(p, b, 0o600) }
+}
+
+// cachedTeamByKey returns the team for key from the local cache, falling back to
+// the API and remembering the answer. cached reports whether the cache answered.
+func cachedTeamByKey(client *api.Client, key string) (team *api.Team, cached bool, err error) {
+    key = strings.ToUpper(strings.TrimSpace(key))
+    scope := client.CacheScope()
+    cache := loadTeamCache()
+    if t, ok := cache[scope][key]; ok && t.ID != "" {
+        notePerfCache("teams", true)
+        return &t, true, nil
+    }
+    notePerfCache("teams", false)
+    team, err = client.TeamByKey(key)
+    if err != nil || team == nil { return team, false, err }
+    if cache[scope] == nil { cache[scope] = map[string]api.Team{} }
+    cache[scope][key] = *team
+    saveTeamCache(cache)
+    return team, false, nil
+}
+
+// forgetCachedTeam drops a cache entry that led to a failed lookup
+func forgetCachedTeam(client *api.Client, key string) {
+    cache := loadTeamCache()
+    scope := client.CacheScope()
+    if _, ok := cache[scope][strings.ToUpper(key)]; !ok { return }
+    delete(cache[scope], strings.ToUpper(key))
+    saveTeamCache(cache)
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "regexp"
+    "strconv"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var teamsCmd = &cobra.Command{
+    Use:   "teams",
+    Short: "List, create and configure teams",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var teamsListCmd = &cobra.Command{
+    Use:   "list",
+    Short: "List the teams visible to you",
+    Args:  cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        teams, err := client.ListAllTeams()
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(teams) }
+        rows := make([][]string, 0, len(teams))
+        for _, t := range teams { rows = append(rows, []string{t.Key, t.Name}) }
+        return p.Table([]string{"Key", "Name"}, rows)
+    },
+}
+
+var teamsViewCmd = &cobra.Command{
+    Use:   "view <key>",
+    Short: "Show a team's settings",
+    Example: `  linear-cli teams view ENG`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        ts, err := teamSettingsByKey(client, args[0])
+        if err != nil { return err }
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(ts) }
+        printTeamSettings(ts)
+        return nil
+    },
+}
+
+var teamsCreateCmd = &cobra.Command{
+    Use:   "create --name <name> --key <KEY>",
+    Short: "Create a team (admins)",
+    Long: `Create a team. The key prefixes its issue identifiers (PLAT-1) and must be
+unique: letters and digits, starting with a letter. You are asked to confirm
+unless --yes is given.`,
+    Example: `  linear-cli teams create --name "Platform" --key PLAT
+  linear-cli teams create --name "Platform" --key PLAT --description "Infra and tooling" --yes`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        name, _ := cmd.Flags().GetString("name")
+        key, _ := cmd.Flags().GetString("key")
+        desc, _ := cmd.Flags().GetString("description")
+        yes, _ := cmd.Flags().GetBool("yes")
+        name, key = strings.TrimSpace(name), strings.ToUpper(strings.TrimSpace(key))
+        if name == "" || key == "" { return errors.New("--name and --key are required") }
+        if !reTeamKey.MatchString(key) { return fmt.Errorf("invalid --key '%s': use letters and digits, starting with a letter (at most 7)", key) }
+        p := printer(cmd)
+        if p.JSONEnabled() && !yes { return errors.New("--json needs --yes") }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        existing, err := client.TeamByKey(key)
+        if err != nil { return err }
+        if existing != nil { return fmt.Errorf("team key %s is taken by '%s'", key, existing.Name) }
+        if ok, err := confirmed(yes, fmt.Sprintf("Create team '%s' (%s)? [y/N] ", name, key)); err != nil || !ok { return err }
+        created, err := client.CreateTeam(api.TeamInput{Name: name, Key: key, Description: desc})
+        if err != nil { return err }
+        if p.JSONEnabled() { return p.PrintJSON(created) }
+        fmt.Printf("Created team '%s' (%s)\n", created.Name, created.Key)
+        return nil
+    },
+}
+
+var teamsUpdateCmd = &cobra.Command{
+    Use:   "update <key> [--name] [--description] [--cycles on|off] [--cycle-duration <weeks>] [--estimation <type>] [--default-state <state>]",
+    Short: "Change a team's settings (admins)",
+    Long: `Change a team's name, description, cycles, estimation scale or the state new
+issues start in. The changes are listed and you are asked to confirm unless
+--yes is given.
+
+--estimation is one of none, exponential, fibonacci, linear or tshirt.
+--cycle-duration is in weeks (1-8).`,
+    Example: `  linear-cli teams update ENG --cycles on --cycle-duration 2
+  linear-cli teams update ENG --estimation fibonacci --default-state Backlog --yes`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        yes, _ := cmd.Flags().GetBool("yes")
+        p := printer(cmd)
+        if p.JSONEnabled() && !yes { return errors.New("--json needs --yes") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        ts, err := teamSettingsByKey(client, args[0])
+        if err != nil { return err }
+        var states []api.State
+        if ref, _ := cmd.Flags().GetString("default-state"); strings.TrimSpace(ref) != "" {
+            if states, err = client.TeamStates(ts.ID); err != nil { return err }
+        }
+        in, changes, err := teamUpdateFromFlags(cmd, ts, states)
+        if err != nil { return err }
+        if len(changes) == 0 { return errors.New("nothing to update: pass --name, --description, --cycles, --cycle-duration, --estimation or --default-state") }
+
+        if !p.JSONEnabled() {
+            fmt.Printf("Changes to %s:\n", ts.Key)
+            for _, c := range changes { fmt.Printf("  %s\n", c) }
+        }
+        if ok, err := confirmed(yes, "Apply? [y/N] "); err != nil || !ok { return err }
+        updated, err := client.UpdateTeam(ts.ID, in)
+        if err != nil { return err }
+        if p.JSONEnabled() { return p.PrintJSON(updated) }
+        fmt.Printf("Updated team %s\n", updated.Key)
+        return nil
+    },
+}
+
+var reTeamKey = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,6}$`)
+
+// estimationTypes maps the --estimation names to Linear's values
+var estimationTypes = map[string]string{
+    "none":        "notUsed",
+    "notused":     "notUsed",
+    "exponential": "exponential",
+    "fibonacci":   "fibonacci",
+    "linear":      "linear",
+    "tshirt":      "tShirt",
+    "t-shirt":     "tShirt",
+}
+
+func teamSettingsByKey(client *api.Client, key string) (*api.TeamSettings, error) {
+    team, err := cachedTeamByKeyOrError(client, key)
+    if err != nil { return nil, err }
+    ts, err := client.GetTeamSettings(team.ID)
+    if err != nil { return nil, err }
+    if ts == nil {
+        forgetCachedTeam(client, key)
+        return nil, fmt.Errorf("team %s not found", key)
+    }
+    return ts, nil
+}
+
+// teamUpdateFromFlags turns the update flags into an input holding only what
+// differs from ts, and a "setting: old → new" line per change
+func teamUpdateFromFlags(cmd *cobra.Command, ts *api.TeamSettings, states []api.State) (api.TeamInput, []string, error) {
+    var in api.TeamInput
+    var changes []string
+    change := func(what, from, to string) { changes = append(changes, fmt.Sprintf("%s: %s %s %s", what, from, ui.Symbol("→", "->"), to)) }
+    if v, _ := cmd.Flags().GetString("name"); strings.TrimSpace(v) != "" && strings.TrimSpace(v) != ts.Name {
+        in.Name = strings.TrimSpace(v)
+        change("name", ts.Name, in.Name)
+    }
+    if cmd.Flags().Changed("description") {
+        v, _ := cmd.Flags().GetString("description")
+        if v != ts.Description {
+            if v == "" { return in, nil, errors.New("--description cannot be empty") }
+            in.Description = v
+            change("description", strconv.Quote(truncate(ts.Description, 40)), strconv.Quote(truncate(v, 40)))
+        }
+    }
+    if v, _ := cmd.Flags().GetString("cycles"); strings.TrimSpace(v) != "" {
+        var on bool
+        switch strings.ToLower(strings.TrimSpace(v)) {
+        case "on", "true", "yes":
+            on = true
+        case "off", "false", "no":
+        default:
+            return in, nil, fmt.Errorf("invalid --cycles '%s' (use on|off)", v)
+        }
+        if on != ts.CyclesEnabled {
+            in.CyclesEnabled = &on
+            change("cycles", onOff(ts.CyclesEnabled), onOff(on))
+        }
+    }
+    if cmd.Flags().Changed("cycle-duration") {
+        weeks, _ := cmd.Flags().GetInt("cycle-duration")
+        if weeks < 1 || weeks > 8 { return in, nil, fmt.Errorf("invalid --cycle-duration %d: use 1-8 weeks", weeks) }
+        if weeks != ts.CycleDuration {
+            in.CycleDuration = &weeks
+            change("cycle duration", fmt.Sprintf("%d week(s)", ts.CycleDuration), fmt.Sprintf("%d week(s)", weeks))
+        }
+    }
+    if v, _ := cmd.Flags().GetString("estimation"); strings.TrimSpace(v) != "" {
+        typ, ok := estimationTypes[strings.ToLower(strings.TrimSpace(v))]
+        if !ok { return in, nil, fmt.Errorf("invalid --estimation '%s' (use none|exponential|fibonacci|linear|tshirt)", v) }
+        if typ != ts.IssueEstimationType {
+            in.IssueEstimationType = typ
+            change("estimation", ts.IssueEstimationType, typ)
+        }
+    }
+    if ref, _ := cmd.Flags().GetString("default-state"); strings.TrimSpace(ref) != "" {
+        st := findState(states, ref)
+        if st == nil { return in, nil, fmt.Errorf("%s has no state named '%s'", ts.Key, ref) }
+        if st.Type == "triage" || st.Type == "completed" || st.Type == "canceled" { return in, nil, fmt.Errorf("'%s' is a %s state; new issues must start in a backlog, unstarted or started state", st.Name, st.Type) }
+        current := "none"
+        if ts.DefaultIssueState != nil { current = ts.DefaultIssueState.Name }
+        if ts.DefaultIssueState == nil || ts.DefaultIssueState.ID != st.ID {
+            in.DefaultIssueStateID = st.ID
+            change("default state", current, st.Name)
+        }
+    }
+    return in, changes, nil
+}
+
+func onOff(b bool) string {
+    if b { return "on" }
+    return "off"
+}
+
+func printTeamSettings(ts *api.TeamSettings) {
+    fmt.Printf("%s (%s)\n", ts.Name, ts.Key)
+    if strings.TrimSpace(ts.Description) != "" { fmt.Printf("%s\n", strings.TrimSpace(ts.Description)) }
+    cycles := onOff(ts.CyclesEnabled)
+    if ts.CyclesEnabled { cycles += fmt.Sprintf(", %d week(s)", ts.CycleDuration) }
+    fmt.Printf("Cycles: %s\n", cycles)
+    fmt.Printf("Estimation: %s\n", ts.IssueEstimationType)
+    state := "none"
+    if ts.DefaultIssueState != nil { state = ts.DefaultIssueState.Name }
+    fmt.Printf("Default state: %s\n", state)
+}
+
+func init() {
+    rootCmd.AddCommand(teamsCmd)
+    teamsCmd.AddCommand(teamsListCmd, teamsViewCmd, teamsCreateCmd, teamsUpdateCmd)
+    addOutputTemplateFlags(teamsListCmd)
+    teamsCreateCmd.Flags().String("name", "", "Team name (required)")
+    teamsCreateCmd.Flags().String("key", "", "Team key used in issue identifiers, e.g. PLAT (required)")
+    teamsUpdateCmd.Flags().String("name", "", "New team name")
+    for _, c := range []*cobra.Command{teamsCreateCmd, teamsUpdateCmd} {
+        c.Flags().String("description", "", "Team description")
+        c.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+    }
+    teamsUpdateCmd.Flags().String("cycles", "", "Turn cycles on or off")
+    teamsUpdateCmd.Flags().Int("cycle-duration", 0, "Cycle length in weeks (1-8)")
+    teamsUpdateCmd.Flags().String("estimation", "", "Estimation scale: none|exponential|fibonacci|linear|tshirt")
+    teamsUpdateCmd.Flags().String("default-state", "", "State new issues start in")
+}
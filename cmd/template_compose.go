@@ -0,0 +1,206 @@
+package cmd
+
+import (
+    "fmt"
+    "os"
+    "path"
+    "path/filepath"
+    "regexp"
+    "strconv"
+    "strings"
+)
+
+// Local template composition: YAML-style front matter, `extends: base.md`
+// inheritance and `{{> partial}}` includes.
+//
+// A template may start with a front matter block:
+//
+//   ---
+//   extends: base.md
+//   ---
+//
+// With `extends`, the base template is composed first; the child body replaces a
+// `{{> content}}` slot in the base, or is appended after the base when there is
+// no slot. Front matter keys from the child override the base's.
+//
+// `{{> name}}` includes name.md, looked up next to the including template, in its
+// partials/ subdirectory, then in the template search directories (remote
+// templates resolve includes relative to their URL).
+
+const maxTemplateDepth = 10
+
+var (
+    rePartial     = regexp.MustCompile(`\{\{>\s*([^}\s]+)\s*\}\}`)
+    reContentSlot = regexp.MustCompile(`\{\{>\s*content\s*\}\}`)
+)
+
+// localTemplate is a fully composed template: merged front matter plus body
+type localTemplate struct {
+    Meta map[string]string
+    Body string
+}
+
+// loadTemplate resolves a template like loadTemplateContent and composes it
+func loadTemplate(value string, overrideDir string, baseOverride string) (*localTemplate, error) {
+    raw, origin, err := readTemplateRaw(value, overrideDir, baseOverride)
+    if err != nil || origin == "" { return nil, err }
+    tc := &templateComposer{searchDirs: templateSearchDirs(overrideDir), active: map[string]bool{}}
+    return tc.compose(raw, origin, 0)
+}
+
+type templateComposer struct {
+    searchDirs []string
+    active     map[string]bool // origins currently being composed, for cycle detection
+}
+
+func (tc *templateComposer) compose(raw, origin string, depth int) (*localTemplate, error) {
+    if depth > maxTemplateDepth { return nil, fmt.Errorf("template nesting deeper than %d levels at %s", maxTemplateDepth, origin) }
+    if tc.active[origin] { return nil, fmt.Errorf("template include cycle at %s", origin) }
+    tc.active[origin] = true
+    defer delete(tc.active, origin)
+
+    meta, body, err := parseFrontMatter(raw)
+    if err != nil { return nil, fmt.Errorf("%s: front matter: %w", origin, err) }
+    body, err = tc.expandPartials(body, origin, depth)
+    if err != nil { return nil, err }
+    out := &localTemplate{Meta: meta, Body: body}
+    if ref := meta["extends"]; ref != "" {
+        baseRaw, baseOrigin, err := tc.read(ref, origin)
+        if err != nil { return nil, fmt.Errorf("%s: extends: %w", origin, err) }
+        base, err := tc.compose(baseRaw, baseOrigin, depth+1)
+        if err != nil { return nil, err }
+        merged := map[string]string{}
+        for k, v := range base.Meta { merged[k] = v }
+        for k, v := range meta { merged[k] = v }
+        delete(merged, "extends")
+        out.Meta = merged
+        if reContentSlot.MatchString(base.Body) {
+            out.Body = reContentSlot.ReplaceAllLiteralString(base.Body, strings.TrimSpace(body))
+        } else {
+            out.Body = strings.TrimRight(base.Body, "\n") + "\n\n" + strings.TrimLeft(body, "\n")
+        }
+    }
+    return out, nil
+}
+
+func (tc *templateComposer) expandPartials(body, origin string, depth int) (string, error) {
+    var firstErr error
+    out := rePartial.ReplaceAllStringFunc(body, func(m string) string {
+        name := rePartial.FindStringSubmatch(m)[1]
+        if name == "content" || firstErr != nil { return m }
+        raw, partOrigin, err := tc.read(name, origin)
+        if err != nil { firstErr = fmt.Errorf("%s: include %s: %w", origin, name, err); return m }
+        part, err := tc.compose(raw, partOrigin, depth+1)
+        if err != nil { firstErr = err; return m }
+        return strings.TrimRight(part.Body, "\n")
+    })
+    return out, firstErr
+}
+
+// read loads a referenced template relative to the referencing origin
+func (tc *templateComposer) read(ref, origin string) (string, string, error) {
+    if !strings.HasSuffix(strings.ToLower(ref), ".md") { ref += ".md" }
+    if strings.HasPrefix(origin, "http://") || strings.HasPrefix(origin, "https://") {
+        url := origin[:strings.LastIndex(origin, "/")+1] + path.Clean(ref)
+        s, err := fetchURL(url)
+        return s, url, err
+    }
+    dirs := []string{filepath.Dir(origin), filepath.Join(filepath.Dir(origin), "partials")}
+    for _, d := range tc.searchDirs { dirs = append(dirs, d, filepath.Join(d, "partials")) }
+    for _, d := range dirs {
+        cand := filepath.Join(d, filepath.FromSlash(ref))
+        if b, err := os.ReadFile(cand); err == nil { return string(b), cand, nil }
+    }
+    return "", "", fmt.Errorf("%s not found", ref)
+}
+
+// parseFrontMatter splits a leading "---" delimited block from the body and reads
+// it as the YAML subset (see parseYAMLSubset). Keys are lowercased; lists are
+// kept in flow form ("[a, b]"). Content that does not start with "---" is
+// returned unchanged; a block that is not closed or does not parse is an error
+// naming the line.
+func parseFrontMatter(raw string) (map[string]string, string, error) {
+    meta := map[string]string{}
+    text := strings.ReplaceAll(raw, "\r\n", "\n")
+    if !strings.HasPrefix(text, "---\n") { return meta, raw, nil }
+    end := strings.Index(text[3:], "\n---")
+    if end < 0 { return meta, raw, &yamlError{1, "front matter is not closed by '---'"} }
+    block, rest := text[:3+end], text[3+end+4:]
+    if i := strings.IndexByte(rest, '\n'); i >= 0 { rest = rest[i+1:] } else { rest = "" }
+    entries, err := parseYAMLSubset(block)
+    if err != nil { return meta, raw, err }
+    for _, e := range entries {
+        v := e.Value
+        if e.IsList { v = yamlFlowListString(e.List) }
+        meta[strings.ToLower(e.Key)] = v
+    }
+    return meta, rest, nil
+}
+
+// localTemplateFields reads the front matter fields of a local or remote
+// template. Names without such a file (Linear templates picked by name, or any
+// name with --templates-source api) have none.
+func localTemplateFields(name, source, overrideDir, baseOverride string) (templateFields, error) {
+    if source == "api" || strings.TrimSpace(name) == "" { return templateFields{}, nil }
+    raw, origin, err := readTemplateRaw(name, overrideDir, baseOverride)
+    if err != nil || origin == "" { return templateFields{}, nil }
+    tc := &templateComposer{searchDirs: templateSearchDirs(overrideDir), active: map[string]bool{}}
+    tpl, err := tc.compose(raw, origin, 0)
+    if err != nil { return templateFields{}, fmt.Errorf("template '%s': %w", name, err) }
+    f, err := templateFieldsFromMeta(tpl.Meta)
+    if err != nil { return f, fmt.Errorf("template '%s': %w", name, err) }
+    return f, nil
+}
+
+// templateFields are issue fields a template can preset via front matter:
+//
+//   ---
+//   labels: [bug, triage]
+//   priority: high
+//   assignee: ada@example.com
+//   project: Website
+//   estimate: 3
+//   ---
+type templateFields struct {
+    Labels   []string
+    Priority *int
+    Assignee string
+    Project  string
+    Estimate *int
+}
+
+func templateFieldsFromMeta(meta map[string]string) (templateFields, error) {
+    var f templateFields
+    if v := strings.TrimSpace(meta["labels"]); strings.HasPrefix(v, "[") {
+        labels, err := yamlFlowList(v)
+        if err != nil { return f, fmt.Errorf("invalid labels: %w", err) }
+        f.Labels = labels
+    } else if v != "" {
+        for _, l := range strings.Split(v, ",") {
+            if l = strings.TrimSpace(l); l != "" { f.Labels = append(f.Labels, l) }
+        }
+    }
+    if v := strings.TrimSpace(meta["priority"]); v != "" {
+        n, err := parsePriority(v)
+        if err != nil { return f, err }
+        f.Priority = &n
+    }
+    if v := strings.TrimSpace(meta["estimate"]); v != "" {
+        n, err := strconv.Atoi(v)
+        if err != nil || n < 0 { return f, fmt.Errorf("invalid estimate '%s': expected a non-negative integer", v) }
+        f.Estimate = &n
+    }
+    f.Assignee = strings.TrimSpace(meta["assignee"])
+    f.Project = strings.TrimSpace(meta["project"])
+    return f, nil
+}
+
+func containsString(list []string, s string) bool {
+    for _, v := range list { if v == s { return true } }
+    return false
+}
+
+func containsFold(list []string, s string) bool {
+    for _, v := range list { if strings.EqualFold(strings.TrimSpace(v), strings.TrimSpace(s)) { return true } }
+    return false
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+    "fmt"
+    "regexp"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// Filling a template's sections from a free-text --description. The text is
+// split into paragraphs; a paragraph that starts with a heading ("## Context"
+// or "Context:") goes to the template section whose name is most similar, and
+// the rest fill the empty sections in order: the first paragraph the first
+// empty section, everything after it the next one. --map routes paragraphs
+// explicitly (Summary=1,Context=2-3) and takes precedence.
+
+// descBlock is one paragraph of a description; Heading is set when it started
+// with one, and Raw is the paragraph as written
+type descBlock struct {
+    Heading string
+    Text    string
+    Raw     string
+}
+
+var (
+    reDescHeading = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+    reDescLabel   = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9 /&()'-]{0,40}):\s*(.*)$`)
+)
+
+// sectionMatchThreshold is the similarity a heading needs to claim a section
+const sectionMatchThreshold = 0.5
+
+// splitDescription splits free text into blank-line separated paragraphs
+func splitDescription(text string) []descBlock {
+    var out []descBlock
+    var cur []string
+    flush := func() {
+        raw := strings.TrimSpace(strings.Join(cur, "\n"))
+        cur = nil
+        if raw == "" { return }
+        b := descBlock{Text: raw, Raw: raw}
+        first, rest, _ := strings.Cut(raw, "\n")
+        if m := reDescHeading.FindStringSubmatch(strings.TrimSpace(first)); m != nil {
+            b.Heading, b.Text = m[1], strings.TrimSpace(rest)
+        } else if m := reDescLabel.FindStringSubmatch(strings.TrimSpace(first)); m != nil {
+            b.Heading, b.Text = m[1], strings.TrimSpace(strings.TrimSpace(m[2])+"\n"+rest)
+        }
+        out = append(out, b)
+    }
+    for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+        if strings.TrimSpace(line) == "" { flush(); continue }
+        cur = append(cur, line)
+    }
+    flush()
+    return out
+}
+
+// headingWords lowercases a heading into its words, dropping "(optional)",
+// punctuation and plural s
+func headingWords(s string) []string {
+    s = strings.ReplaceAll(strings.ToLower(s), "(optional)", " ")
+    words := strings.FieldsFunc(s, func(r rune) bool { return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') })
+    for i, w := range words {
+        if len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") { words[i] = strings.TrimSuffix(w, "s") }
+    }
+    return words
+}
+
+// sectionSimilarity scores how well heading names section, from 0 to 1: 1 when
+// their words are equal, 0.8 when one's words all appear in the other, else
+// the share of words they have in common
+func sectionSimilarity(heading, section string) float64 {
+    a, b := headingWords(heading), headingWords(section)
+    if len(a) == 0 || len(b) == 0 { return 0 }
+    if strings.Join(a, " ") == strings.Join(b, " ") { return 1 }
+    inB := map[string]bool{}
+    for _, w := range b { inB[w] = true }
+    common := 0
+    for _, w := range a { if inB[w] { common++ } }
+    if common == len(a) || common == len(b) { return 0.8 }
+    return float64(common) / float64(len(a)+len(b)-common)
+}
+
+// bestSection returns the section most similar to heading, or "" when none
+// reaches the threshold
+func bestSection(heading string, sections []templateSection) string {
+    best, score := "", 0.0
+    for _, s := range sections {
+        if v := sectionSimilarity(heading, s.Name); v > score { best, score = s.Name, v }
+    }
+    if score < sectionMatchThreshold { return "" }
+    return best
+}
+
+// parseParagraphMap reads --map values like "Summary=1,Context=2-3"; a part
+// without "=" adds paragraphs to the section before it ("Context=2,4")
+func parseParagraphMap(values []string) (map[string][]int, error) {
+    out := map[string][]int{}
+    for _, v := range values {
+        section := ""
+        for _, part := range strings.Split(v, ",") {
+            part = strings.TrimSpace(part)
+            if part == "" { continue }
+            if name, nums, ok := strings.Cut(part, "="); ok {
+                section, part = strings.TrimSpace(name), strings.TrimSpace(nums)
+                if section == "" { return nil, fmt.Errorf("invalid --map '%s': missing section name", v) }
+            }
+            if section == "" { return nil, fmt.Errorf("invalid --map '%s': expected Section=paragraphs, e.g. Summary=1,Context=2-3", v) }
+            from, to, isRange := strings.Cut(part, "-")
+            lo, err := strconv.Atoi(strings.TrimSpace(from))
+            hi := lo
+            if err == nil && isRange { hi, err = strconv.Atoi(strings.TrimSpace(to)) }
+            if err != nil || lo < 1 || hi < lo { return nil, fmt.Errorf("invalid --map paragraphs '%s' for %s: use numbers from 1, or ranges like 2-3", part, section) }
+            for n := lo; n <= hi; n++ { out[section] = append(out[section], n) }
+        }
+    }
+    return out, nil
+}
+
+// checkParagraphMap rejects paragraph numbers beyond the description's
+func checkParagraphMap(routes map[string][]int, paragraphs int) error {
+    for section, nums := range routes {
+        for _, n := range nums {
+            if n > paragraphs { return fmt.Errorf("--map %s=%d: the description has %d paragraph(s)", section, n, paragraphs) }
+        }
+    }
+    return nil
+}
+
+// fillTemplateFromDescription fills template sections from a free-text
+// description (see the top of this file). Sections nothing is routed to keep
+// the template's text.
+func fillTemplateFromDescription(templateContent, userDescription string, routes map[string][]int) (string, error) {
+    specs := templateSectionSpecs(templateContent)
+    if len(specs) == 0 { return userDescription, nil }
+    blocks := splitDescription(userDescription)
+    if err := checkParagraphMap(routes, len(blocks)); err != nil { return "", err }
+
+    assigned := map[string][]string{}
+    used := make([]bool, len(blocks))
+    sectionNames := make([]string, 0, len(routes))
+    for name := range routes { sectionNames = append(sectionNames, name) }
+    sort.Strings(sectionNames)
+    for _, name := range sectionNames {
+        section := bestSection(name, specs)
+        if section == "" {
+            names := make([]string, len(specs))
+            for i, s := range specs { names[i] = s.Name }
+            return "", fmt.Errorf("--map: the template has no section like '%s'; it has: %s", name, strings.Join(names, ", "))
+        }
+        for _, n := range routes[name] {
+            if used[n-1] { continue }
+            used[n-1] = true
+            assigned[section] = append(assigned[section], blocks[n-1].Text)
+        }
+    }
+    var rest []descBlock
+    for i, b := range blocks {
+        if used[i] { continue }
+        if b.Heading != "" {
+            if section := bestSection(b.Heading, specs); section != "" {
+                if b.Text != "" { assigned[section] = append(assigned[section], b.Text) }
+                continue
+            }
+        }
+        rest = append(rest, b)
+    }
+    if len(rest) > 0 {
+        var empty []string
+        for _, s := range specs { if len(assigned[s.Name]) == 0 { empty = append(empty, s.Name) } }
+        if len(empty) > 0 {
+            assigned[empty[0]] = append(assigned[empty[0]], rest[0].Raw)
+            target := empty[0]
+            if len(empty) > 1 { target = empty[1] }
+            for _, b := range rest[1:] { assigned[target] = append(assigned[target], b.Raw) }
+        } else {
+            // Every section has text; keep the leftovers under the last one
+            last := specs[len(specs)-1].Name
+            for _, b := range rest { assigned[last] = append(assigned[last], b.Raw) }
+        }
+    }
+
+    filled := templateContent
+    for _, s := range specs {
+        if parts := assigned[s.Name]; len(parts) > 0 { filled = fillSingleSection(filled, s.Name, strings.Join(parts, "\n\n")) }
+    }
+    return filled, nil
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+    "fmt"
+    "hash/fnv"
+    "strings"
+    "time"
+    "unicode"
+)
+
+// Sample data for 'issues template preview --sample', so template authors see
+// a rendered issue without supplying every value. Placeholders get a value
+// that fits their name or prompt ({{DUE_DATE}} a date, {{REPORTER}} a person,
+// anything else a short phrase) and sections without placeholders get text
+// shaped by their heading: steps for "Steps to Reproduce", a checklist for
+// "Acceptance Criteria", and so on. Values are chosen from the key, so the
+// same template always previews the same way. --var values still win.
+
+// sampleKinds map words found in a placeholder name or prompt to sample values
+var sampleKinds = []struct {
+    words  []string
+    values []string
+}{
+    {[]string{"email", "mail"}, []string{"ada.lovelace@example.com", "grace.hopper@example.com"}},
+    {[]string{"url", "link", "href", "website"}, []string{"https://example.com/dashboard", "https://example.com/docs/getting-started"}},
+    {[]string{"version", "release"}, []string{"2.4.1", "1.18.0"}},
+    {[]string{"percent", "pct", "rate"}, []string{"15%", "3.5%"}},
+    {[]string{"count", "number", "num", "qty", "amount", "estimate", "points", "size"}, []string{"3", "8", "42"}},
+    {[]string{"issue", "ticket", "key", "parent"}, []string{"ENG-123", "OPS-42"}},
+    {[]string{"project", "epic", "initiative"}, []string{"Website relaunch", "Mobile onboarding"}},
+    {[]string{"user", "owner", "assignee", "reporter", "author", "person", "customer", "requester", "contact"}, []string{"Ada Lovelace", "Grace Hopper", "Alan Turing"}},
+    {[]string{"env", "environment", "stage"}, []string{"staging", "production"}},
+    {[]string{"browser"}, []string{"Firefox 131", "Chrome 130"}},
+    {[]string{"os", "platform", "device"}, []string{"macOS 15.1", "Ubuntu 24.04", "iOS 18"}},
+    {[]string{"branch"}, []string{"feature/login-timeout", "fix/checkout-rounding"}},
+    {[]string{"commit", "sha", "hash"}, []string{"3f9c2ab", "a71e0d4"}},
+    {[]string{"team"}, []string{"ENG", "Platform"}},
+    {[]string{"priority", "severity", "urgency"}, []string{"High", "Medium"}},
+    {[]string{"component", "service", "module", "area", "feature"}, []string{"checkout", "auth service", "search"}},
+    {[]string{"title", "summary", "subject"}, []string{"Login times out after 30 seconds", "Add CSV export to reports"}},
+}
+
+var sampleDateWords = []string{"date", "due", "deadline", "when", "day", "start", "end", "eta"}
+
+var loremWords = strings.Fields("lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod tempor incididunt ut labore et dolore magna aliqua enim ad minim veniam quis nostrud exercitation ullamco laboris nisi aliquip ex ea commodo consequat")
+
+// sampleSeed hashes s so the same name always picks the same sample
+func sampleSeed(s string) int {
+    h := fnv.New32a()
+    h.Write([]byte(strings.ToLower(s)))
+    return int(h.Sum32() & 0x7fffffff)
+}
+
+// sampleWords splits a placeholder name or prompt into lowercase words,
+// breaking at punctuation and camelCase humps
+func sampleWords(s string) []string {
+    var words []string
+    var cur []rune
+    flush := func() {
+        if len(cur) > 0 { words = append(words, strings.ToLower(string(cur))) }
+        cur = nil
+    }
+    prev := rune(0)
+    for _, r := range s {
+        switch {
+        case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+            flush()
+        case unicode.IsUpper(r) && unicode.IsLower(prev):
+            flush()
+            cur = append(cur, r)
+        default:
+            cur = append(cur, r)
+        }
+        prev = r
+    }
+    flush()
+    return words
+}
+
+// lorem returns n deterministic filler words, capitalized, without a period
+func lorem(seed, n int) string {
+    words := make([]string, n)
+    for i := range words { words[i] = loremWords[(seed+i*7)%len(loremWords)] }
+    s := strings.Join(words, " ")
+    return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// samplePlaceholder returns a value for {{key}} (with its prompt, if any)
+// that fits what the name asks for
+func samplePlaceholder(key, prompt string, now time.Time) string {
+    seed := sampleSeed(key)
+    words := sampleWords(key)
+    // The name decides first; the prompt only when the name says nothing
+    for _, source := range [][]string{words, sampleWords(prompt)} {
+        for _, w := range source {
+            if containsString(sampleDateWords, w) { return now.AddDate(0, 0, 7+seed%21).Format("2006-01-02") }
+        }
+        for _, k := range sampleKinds {
+            for _, w := range source {
+                if containsString(k.words, w) { return k.values[seed%len(k.values)] }
+            }
+        }
+        for _, w := range source {
+            if w == "steps" || w == "step" { return sampleSteps() }
+        }
+    }
+    return lorem(seed, 3+seed%3)
+}
+
+func sampleSteps() string {
+    return "1. Sign in as a regular user\n2. Open Settings → Billing\n3. Click \"Download invoice\""
+}
+
+// sampleSection returns body text for a template section, shaped by its heading
+func sampleSection(name string) string {
+    seed := sampleSeed(name)
+    has := func(ws ...string) bool {
+        for _, w := range headingWords(name) {
+            if containsString(ws, w) { return true }
+        }
+        return false
+    }
+    switch {
+    case has("step", "reproduce", "repro"):
+        return sampleSteps()
+    case has("acceptance", "criteria", "requirement", "definition", "checklist", "task", "todo"):
+        return "- [ ] " + lorem(seed, 5) + "\n- [ ] " + lorem(seed+11, 4) + "\n- [ ] " + lorem(seed+23, 6)
+    case has("expected"):
+        return "The invoice downloads as a PDF within a few seconds."
+    case has("actual", "observed", "current"):
+        return "The page shows a spinner and times out after 30 seconds."
+    case has("environment", "env", "setup", "platform"):
+        return "- Browser: Firefox 131\n- OS: macOS 15.1\n- Version: 2.4.1"
+    case has("impact", "severity", "priority"):
+        return "About 15% of paying customers hit this weekly; support gets 3 to 5 tickets a day."
+    case has("link", "reference", "resource", "related"):
+        return "- https://example.com/docs/getting-started\n- ENG-123"
+    case has("log", "error", "trace", "output"):
+        return "```\nERROR 2026-01-15T10:42:07Z checkout: request timed out after 30s\n```"
+    }
+    return lorem(seed, 12) + ". " + lorem(seed+5, 9) + "."
+}
+
+// sampleTemplate fills the sections of raw that have no placeholders with
+// sample text and returns vars extended with a sample for every placeholder
+// vars lacks. sampled lists what was invented, for the user to see.
+func sampleTemplate(raw string, vars map[string]string, now time.Time) (content string, filled map[string]string, sampled []string) {
+    content = raw
+    for _, s := range templateSectionSpecs(raw) {
+        if reTemplatePlaceholder.MatchString(s.Hint) { continue }
+        content = fillSingleSection(content, s.Name, sampleSection(s.Name))
+        sampled = append(sampled, fmt.Sprintf("section %q", s.Name))
+    }
+    filled = make(map[string]string, len(vars))
+    for k, v := range vars { filled[k] = v }
+    keys, prompts := templatePlaceholders(content)
+    for _, k := range keys {
+        if _, ok := filled[k]; ok { continue }
+        filled[k] = samplePlaceholder(k, prompts[k], now)
+        sampled = append(sampled, "{{"+k+"}}")
+    }
+    return content, filled, sampled
+}
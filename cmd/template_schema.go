@@ -0,0 +1,120 @@
+package cmd
+
+import (
+    "fmt"
+    "regexp"
+    "sort"
+    "strings"
+)
+
+// JSON Schema export of template structure ('issues template structure
+// --format json-schema'), so agents can validate --sections and --var payloads
+// before calling 'issues create'.
+//
+// Sections are the "## " and "### " headings that --sections fills, named
+// exactly as written. A section is required unless its heading says
+// "(optional)". Placeholders are the {{KEY}} and {{KEY|Prompt}} tokens that
+// --var fills; each one is required (see --fail-on-missing).
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+var reTemplatePlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_\-]+)(?:\|([^}]+))?\s*\}\}`)
+
+type jsonSchema struct {
+    Schema               string                 `json:"$schema,omitempty"`
+    Title                string                 `json:"title,omitempty"`
+    Description          string                 `json:"description,omitempty"`
+    Type                 string                 `json:"type,omitempty"`
+    MinLength            int                    `json:"minLength,omitempty"`
+    Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+    Required             []string               `json:"required,omitempty"`
+    AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+    Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// templateSection is a heading of a template body and the hint text under it
+type templateSection struct {
+    Name     string
+    Hint     string
+    Optional bool
+}
+
+// templateSectionSpecs splits a template body into its sections, in order
+func templateSectionSpecs(content string) []templateSection {
+    var out []templateSection
+    var hint []string
+    flush := func() {
+        if len(out) > 0 { out[len(out)-1].Hint = strings.TrimSpace(strings.Join(hint, " ")) }
+        hint = nil
+    }
+    for _, line := range strings.Split(content, "\n") {
+        t := strings.TrimSpace(line)
+        if strings.HasPrefix(t, "### ") || strings.HasPrefix(t, "## ") {
+            flush()
+            name := strings.TrimPrefix(strings.TrimPrefix(t, "### "), "## ")
+            out = append(out, templateSection{Name: name, Optional: strings.Contains(strings.ToLower(name), "(optional)")})
+            continue
+        }
+        if t != "" && len(out) > 0 { hint = append(hint, t) }
+    }
+    flush()
+    return out
+}
+
+// templatePlaceholders returns the template's {{KEY}} tokens in order of first
+// use, with the prompt text of the first token that has one
+func templatePlaceholders(content string) ([]string, map[string]string) {
+    var keys []string
+    prompts := map[string]string{}
+    seen := map[string]bool{}
+    for _, m := range reTemplatePlaceholder.FindAllStringSubmatch(content, -1) {
+        if !seen[m[1]] { seen[m[1]] = true; keys = append(keys, m[1]) }
+        if p := strings.TrimSpace(m[2]); p != "" && prompts[m[1]] == "" { prompts[m[1]] = p }
+    }
+    return keys, prompts
+}
+
+// templateJSONSchema describes the --sections and --var values for one template
+func templateJSONSchema(name, description, content string) *jsonSchema {
+    closed := false
+    sections := &jsonSchema{Type: "object", Description: "Values for --sections, keyed by section heading", Properties: map[string]*jsonSchema{}, AdditionalProperties: &closed}
+    for _, s := range templateSectionSpecs(content) {
+        prop := &jsonSchema{Type: "string", Description: truncate(s.Hint, 200)}
+        if !s.Optional {
+            prop.MinLength = 1
+            sections.Required = append(sections.Required, s.Name)
+        }
+        sections.Properties[s.Name] = prop
+    }
+    keys, prompts := templatePlaceholders(content)
+    vars := &jsonSchema{Type: "object", Description: "Values for --var, keyed by placeholder", Properties: map[string]*jsonSchema{}, Required: keys}
+    for _, k := range keys { vars.Properties[k] = &jsonSchema{Type: "string", Description: prompts[k]} }
+
+    s := &jsonSchema{Title: name, Description: description, Type: "object", Properties: map[string]*jsonSchema{"sections": sections, "vars": vars}}
+    if len(sections.Required) > 0 { s.Required = append(s.Required, "sections") }
+    if len(keys) > 0 { s.Required = append(s.Required, "vars") }
+    return s
+}
+
+// teamTemplatesJSONSchema collects the cached templates of a team (or just the
+// named one) into a schema document; a single template is the document itself
+func teamTemplatesJSONSchema(teamKey, templateName string) (*jsonSchema, error) {
+    teamKey = strings.ToUpper(strings.TrimSpace(teamKey))
+    if strings.TrimSpace(templateName) != "" {
+        info, content, err := GetLocalTemplate(teamKey, templateName)
+        if err != nil { return nil, fmt.Errorf("template not found locally. Run 'linear-cli templates sync --team %s' first. Error: %w", teamKey, err) }
+        s := templateJSONSchema(info.Name, info.Description, content)
+        s.Schema = jsonSchemaDraft
+        return s, nil
+    }
+    infos, err := GetLocalTemplatesForTeam(teamKey)
+    if err != nil { return nil, err }
+    sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+    doc := &jsonSchema{Schema: jsonSchemaDraft, Title: "Issue templates for " + teamKey, Description: "One definition per template under $defs, e.g. {\"$ref\": \"#/$defs/<template name>\"}", Defs: map[string]*jsonSchema{}}
+    for _, info := range infos {
+        _, content, err := GetLocalTemplate(teamKey, info.Name)
+        if err != nil { return nil, err }
+        doc.Defs[info.Name] = templateJSONSchema(info.Name, info.Description, content)
+    }
+    return doc, nil
+}
@@ -1,24 +1,28 @@
 package cmd
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"linear-cli/internal/api"
 	"linear-cli/internal/config"
+	"linear-cli/internal/output"
 
 	"github.com/spf13/cobra"
 )
 
 // TemplateMetadata stores information about synced templates
 type TemplateMetadata struct {
-	Templates map[string]TeamTemplates `json:"templates"`
-	LastSync  time.Time                `json:"last_sync"`
+	// SchemaVersion is the metadata layout version (see templates_store.go)
+	SchemaVersion int                      `json:"schema_version"`
+	Templates     map[string]TeamTemplates `json:"templates"`
+	LastSync      time.Time                `json:"last_sync"`
 }
 
 type TeamTemplates struct {
@@ -59,7 +63,10 @@ Commands:
   sync     Sync templates from Linear API to local storage
   list     List locally cached templates
   show     Show a specific template's content
-  status   Show sync status for teams`,
+  status   Show sync status for teams
+  diff     Show how the server's templates differ from the local cache
+  create   Create a template in Linear from a local markdown file
+  push     Push locally edited templates to Linear (local directory is the source of truth)`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmd.Help()
 	},
@@ -87,7 +94,7 @@ Examples:
 			return errors.New("not authenticated. run 'linear-cli auth login'")
 		}
 
-		client := api.NewClient(cfg.APIKey)
+		client := newAPIClient(cmd, cfg.APIKey)
 		teamKey, _ := cmd.Flags().GetString("team")
 		syncAll, _ := cmd.Flags().GetBool("all")
 
@@ -129,30 +136,34 @@ Examples:
 			teamsToSync = []api.Team{*team}
 		}
 
+		prog := ui.StartProgress("Syncing templates", len(teamsToSync))
+		var changed []string
 		for _, team := range teamsToSync {
-			fmt.Printf("Checking templates for team %s (%s)...\n", team.Key, team.Name)
+			prog.Update("checking %s (%s)", team.Key, team.Name)
 			
-			syncResult, err := syncTeamTemplatesIntelligent(client, team, templatesDir, metadata)
+			syncResult, err := syncTeamTemplatesIntelligent(client, team, templatesDir, metadata, prog)
 			if err != nil {
-				fmt.Printf("  Error syncing %s: %v\n", team.Key, err)
+				prog.Warnf("error syncing %s: %v", team.Key, err)
+				prog.Step("%s: failed", team.Key)
 				continue
 			}
 			
 			if syncResult.SkipReason != "" {
-				fmt.Printf("  %s: %s\n", team.Key, syncResult.SkipReason)
+				prog.Step("%s: %s", team.Key, syncResult.SkipReason)
 			} else {
-				fmt.Printf("  %s: %s\n", team.Key, syncResult.SyncSummary)
+				prog.Step("%s: %s", team.Key, syncResult.SyncSummary)
+				changed = append(changed, team.Key)
 			}
 		}
 
-		// Save updated metadata
+		// Save the synced teams, keeping what parallel syncs stored meanwhile
 		metadata.LastSync = time.Now()
-		err = saveTemplateMetadata(templatesDir, metadata)
+		err = saveTemplateTeams(templatesDir, metadata, changed, true)
 		if err != nil {
 			return fmt.Errorf("failed to save metadata: %w", err)
 		}
 
-		fmt.Println("Template sync completed!")
+		prog.Done("Template sync completed for %d team(s)", len(teamsToSync))
 		return nil
 	},
 }
@@ -199,7 +210,7 @@ With --team: Lists templates for a specific team`,
 				})
 			}
 
-			fmt.Printf("Templates for team %s (synced %v ago):\n", teamKey, time.Since(teamData.LastSync).Round(time.Minute))
+			fmt.Printf("Templates for team %s (synced %s):\n", teamKey, output.Relative(teamData.LastSync, time.Now()))
 			for _, name := range templateNames {
 				fmt.Printf("  - %s\n", name)
 			}
@@ -227,9 +238,9 @@ With --team: Lists templates for a specific team`,
 			return nil
 		}
 
-		fmt.Printf("Cached templates (last sync: %v ago):\n\n", time.Since(metadata.LastSync).Round(time.Minute))
+		fmt.Printf("Cached templates (last sync: %s):\n\n", ui.When(metadata.LastSync, time.Now()))
 		for teamKey, teamData := range metadata.Templates {
-			fmt.Printf("%s (%d templates, synced %v ago):\n", teamKey, len(teamData.Templates), time.Since(teamData.LastSync).Round(time.Minute))
+			fmt.Printf("%s (%d templates, synced %s):\n", teamKey, len(teamData.Templates), output.Relative(teamData.LastSync, time.Now()))
 			for _, template := range teamData.Templates {
 				fmt.Printf("  - %s\n", template.Name)
 			}
@@ -284,11 +295,10 @@ Examples:
 		}
 
 		// Update metadata to remove this team
-		metadata, err := loadTemplateMetadata(templatesDir)
-		if err == nil {
+		_ = updateTemplateMetadata(templatesDir, func(metadata *TemplateMetadata) error { // Best effort
 			delete(metadata.Templates, teamKey)
-			_ = saveTemplateMetadata(templatesDir, metadata) // Best effort
-		}
+			return nil
+		})
 
 		fmt.Printf("Template cache for team %s cleaned successfully!\n", teamKey)
 		return nil
@@ -321,18 +331,18 @@ var templatesStatusCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Template Sync Status (last global sync: %v ago)\n\n", time.Since(metadata.LastSync).Round(time.Minute))
+		fmt.Printf("Template Sync Status (last global sync: %s)\n\n", ui.When(metadata.LastSync, time.Now()))
 		
 		for teamKey, teamData := range metadata.Templates {
-			status := "✓ Current"
+			status := p.Symbol("✓ ", "") + "Current"
 			if time.Since(teamData.LastSync) > 24*time.Hour {
-				status = "⚠ Stale (>24h)"
+				status = p.Symbol("⚠ ", "") + "Stale (>24h)"
 			} else if time.Since(teamData.LastSync) > 1*time.Hour {
-				status = "△ Old (>1h)"
+				status = p.Symbol("△ ", "") + "Old (>1h)"
 			}
 
-			fmt.Printf("%s: %s (%d templates, synced %v ago)\n", 
-				teamKey, status, len(teamData.Templates), time.Since(teamData.LastSync).Round(time.Minute))
+			fmt.Printf("%s: %s (%d templates, synced %s)\n", 
+				teamKey, status, len(teamData.Templates), output.Relative(teamData.LastSync, time.Now()))
 		}
 
 		fmt.Println("\nRun 'linear-cli templates sync --all' to update all teams")
@@ -342,8 +352,270 @@ var templatesStatusCmd = &cobra.Command{
 	},
 }
 
+var templatesCreateCmd = &cobra.Command{
+	Use:   "create --team <key> --name <name> --file <path>",
+	Short: "Create a Linear issue template from a local markdown file",
+	Long: `Create an issue template in Linear from a local markdown file and store it in the
+local template cache, so later edits can be sent back with 'templates push'.
+
+Examples:
+  linear-cli templates create --team ENG --name "Bug" --file bug.md`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, _ := config.Load()
+		if cfg.APIKey == "" {
+			return errors.New("not authenticated. run 'linear-cli auth login'")
+		}
+		teamKey, _ := cmd.Flags().GetString("team")
+		name, _ := cmd.Flags().GetString("name")
+		file, _ := cmd.Flags().GetString("file")
+		if strings.TrimSpace(teamKey) == "" || strings.TrimSpace(name) == "" || strings.TrimSpace(file) == "" {
+			return errors.New("--team, --name and --file are required")
+		}
+		content, err := os.ReadFile(expandUserPath(file))
+		if err != nil {
+			return err
+		}
+
+		client := newAPIClient(cmd, cfg.APIKey)
+		team, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
+		if err != nil {
+			return err
+		}
+		if team == nil {
+			return fmt.Errorf("team with key %s not found", teamKey)
+		}
+
+		templatesDir, err := getTemplatesDir()
+		if err != nil {
+			return fmt.Errorf("failed to access templates directory: %w", err)
+		}
+		metadata, err := loadTemplateMetadata(templatesDir)
+		if err != nil {
+			metadata = &TemplateMetadata{Templates: make(map[string]TeamTemplates)}
+		}
+		if teamData, ok := metadata.Templates[team.Key]; ok {
+			if _, exists := teamData.Templates[name]; exists {
+				return fmt.Errorf("template '%s' already exists for team %s; edit the local file and run 'linear-cli templates push --team %s'", name, team.Key, team.Key)
+			}
+		}
+
+		tpl, err := client.CreateIssueTemplate(api.TemplateInput{TeamID: team.ID, Name: name, Body: string(content)})
+		if err != nil {
+			return fmt.Errorf("failed to create template: %w", err)
+		}
+		info, err := storeLocalTemplate(templatesDir, metadata, *team, tpl.ID, name, string(content))
+		if err != nil {
+			return err
+		}
+		if err := saveTemplateTeams(templatesDir, metadata, []string{team.Key}, false); err != nil {
+			return fmt.Errorf("failed to save metadata: %w", err)
+		}
+
+		p := printer(cmd)
+		if p.JSONEnabled() {
+			return p.PrintJSON(map[string]interface{}{"team": team.Key, "template": info})
+		}
+		fmt.Printf("Created template %s for team %s (ID: %s)\n", name, team.Key, tpl.ID)
+		return nil
+	},
+}
+
+var templatesPushCmd = &cobra.Command{
+	Use:   "push [--team <key>] [--all]",
+	Short: "Push local template edits to Linear",
+	Long: `Push locally authored or edited templates to Linear, treating the local template
+directory as the source of truth.
+
+For each markdown file under the team's template directory:
+- Known templates whose content changed since the last sync/push are updated (templateUpdate)
+- New files are created as templates (templateCreate), named after the file
+
+With --dry-run the plan is listed and nothing is sent.
+
+Examples:
+  linear-cli templates push --team ENG
+  linear-cli templates push --all --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, _ := config.Load()
+		if cfg.APIKey == "" {
+			return errors.New("not authenticated. run 'linear-cli auth login'")
+		}
+		teamKey, _ := cmd.Flags().GetString("team")
+		pushAll, _ := cmd.Flags().GetBool("all")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !pushAll && strings.TrimSpace(teamKey) == "" {
+			return errors.New("either --team <key> or --all is required")
+		}
+
+		templatesDir, err := getTemplatesDir()
+		if err != nil {
+			return fmt.Errorf("failed to access templates directory: %w", err)
+		}
+		metadata, err := loadTemplateMetadata(templatesDir)
+		if err != nil {
+			metadata = &TemplateMetadata{Templates: make(map[string]TeamTemplates)}
+		}
+
+		var teamKeys []string
+		if pushAll {
+			entries, err := os.ReadDir(templatesDir)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if e.IsDir() && !strings.HasPrefix(e.Name(), ".") {
+					teamKeys = append(teamKeys, e.Name())
+				}
+			}
+		} else {
+			teamKeys = []string{strings.ToUpper(strings.TrimSpace(teamKey))}
+		}
+
+		client := newAPIClient(cmd, cfg.APIKey)
+		type pushResult struct {
+			Team   string `json:"team"`
+			Name   string `json:"name"`
+			Action string `json:"action"`
+			Error  string `json:"error,omitempty"`
+		}
+		var results []pushResult
+		var pushed []string
+		prog := ui.StartProgress("Pushing templates", len(teamKeys))
+		for _, key := range teamKeys {
+			team, err := client.TeamByKey(key)
+			if err != nil {
+				return err
+			}
+			if team == nil {
+				results = append(results, pushResult{Team: key, Action: "skipped", Error: "team not found"})
+				prog.Step("%s: team not found", key)
+				continue
+			}
+			teamDir := filepath.Join(templatesDir, team.Key)
+			entries, err := os.ReadDir(teamDir)
+			if err != nil {
+				if os.IsNotExist(err) {
+					prog.Step("%s: no local templates", team.Key)
+					continue
+				}
+				return err
+			}
+			byFile := map[string]TemplateInfo{}
+			for _, info := range metadata.Templates[team.Key].Templates {
+				byFile[info.Filename] = info
+			}
+			for _, e := range entries {
+				if e.IsDir() || !strings.HasSuffix(strings.ToLower(e.Name()), ".md") {
+					continue
+				}
+				b, err := os.ReadFile(filepath.Join(teamDir, e.Name()))
+				if err != nil {
+					return err
+				}
+				content := string(b)
+				prog.Update("%s: %s", key, e.Name())
+				info, known := byFile[e.Name()]
+				res := pushResult{Team: team.Key, Name: info.Name}
+				switch {
+				case known && info.Description == content:
+					res.Action = "unchanged"
+				case known:
+					res.Action = "updated"
+					if !dryRun {
+						if _, err := client.UpdateIssueTemplate(info.ID, api.TemplateInput{Name: info.Name, Body: content}); err != nil {
+							res.Action, res.Error = "failed", err.Error()
+						} else {
+							_, _ = storeLocalTemplate(templatesDir, metadata, *team, info.ID, info.Name, content)
+						}
+					}
+				default:
+					res.Name = templateNameFromFile(e.Name())
+					res.Action = "created"
+					if !dryRun {
+						tpl, err := client.CreateIssueTemplate(api.TemplateInput{TeamID: team.ID, Name: res.Name, Body: content})
+						if err != nil {
+							res.Action, res.Error = "failed", err.Error()
+						} else {
+							_, _ = storeLocalTemplate(templatesDir, metadata, *team, tpl.ID, res.Name, content)
+						}
+					}
+				}
+				results = append(results, res)
+			}
+			prog.Step("%s: checked %d file(s)", team.Key, len(entries))
+			pushed = append(pushed, team.Key)
+		}
+		prog.Done("Checked %d template file(s)", len(results))
+		if !dryRun {
+			if err := saveTemplateTeams(templatesDir, metadata, pushed, false); err != nil {
+				return fmt.Errorf("failed to save metadata: %w", err)
+			}
+		}
+
+		p := printer(cmd)
+		if p.JSONEnabled() {
+			return p.PrintJSON(map[string]interface{}{"dryRun": dryRun, "results": results})
+		}
+		if len(results) == 0 {
+			fmt.Println("No local templates to push")
+			return nil
+		}
+		rows := make([][]string, 0, len(results))
+		for _, r := range results {
+			action := r.Action
+			if dryRun && action != "unchanged" {
+				action = "would be " + action
+			}
+			if r.Error != "" {
+				action += ": " + r.Error
+			}
+			rows = append(rows, []string{r.Team, r.Name, action})
+		}
+		return p.Table([]string{"Team", "Template", "Result"}, rows)
+	},
+}
+
 // Helper functions
 
+// storeLocalTemplate writes template content into the team's cache directory and
+// records it in metadata, making the local copy the baseline for future pushes.
+func storeLocalTemplate(templatesDir string, metadata *TemplateMetadata, team api.Team, id, name, content string) (TemplateInfo, error) {
+	teamDir := filepath.Join(templatesDir, team.Key)
+	if err := os.MkdirAll(teamDir, 0755); err != nil {
+		return TemplateInfo{}, fmt.Errorf("failed to create team directory: %w", err)
+	}
+	teamTemplates, ok := metadata.Templates[team.Key]
+	if !ok {
+		teamTemplates = TeamTemplates{TeamID: team.ID, TeamKey: team.Key, Templates: make(map[string]TemplateInfo)}
+	}
+	info := teamTemplates.Templates[name]
+	if info.Filename == "" {
+		info.Filename = sanitizeFilename(name) + ".md"
+	}
+	if err := os.WriteFile(filepath.Join(teamDir, info.Filename), []byte(content), 0644); err != nil {
+		return TemplateInfo{}, fmt.Errorf("failed to write template file: %w", err)
+	}
+	info.ID = id
+	info.Name = name
+	info.Description = content
+	info.LastSync = time.Now()
+	teamTemplates.Templates[name] = info
+	teamTemplates.LastSync = info.LastSync
+	metadata.Templates[team.Key] = teamTemplates
+	return info, nil
+}
+
+// templateNameFromFile turns "bug-report.md" into "Bug Report"
+func templateNameFromFile(filename string) string {
+	base := strings.TrimSuffix(filename, filepath.Ext(filename))
+	words := strings.FieldsFunc(base, func(r rune) bool { return r == '-' || r == '_' || r == ' ' })
+	for i, w := range words {
+		r, size := utf8.DecodeRuneInString(w)
+		words[i] = string(unicode.ToUpper(r)) + w[size:]
+	}
+	return strings.Join(words, " ")
+}
+
 func getTemplatesDir() (string, error) {
 	configDir, err := config.GetConfigDir()
 	if err != nil {
@@ -359,35 +631,7 @@ func getTemplatesDir() (string, error) {
 	return templatesDir, nil
 }
 
-func loadTemplateMetadata(templatesDir string) (*TemplateMetadata, error) {
-	metadataPath := filepath.Join(templatesDir, ".metadata.json")
-	
-	data, err := os.ReadFile(metadataPath)
-	if err != nil {
-		return nil, err
-	}
-	
-	var metadata TemplateMetadata
-	err = json.Unmarshal(data, &metadata)
-	if err != nil {
-		return nil, err
-	}
-	
-	return &metadata, nil
-}
-
-func saveTemplateMetadata(templatesDir string, metadata *TemplateMetadata) error {
-	metadataPath := filepath.Join(templatesDir, ".metadata.json")
-	
-	data, err := json.MarshalIndent(metadata, "", "  ")
-	if err != nil {
-		return err
-	}
-	
-	return os.WriteFile(metadataPath, data, 0644)
-}
-
-func syncTeamTemplatesIntelligent(client *api.Client, team api.Team, templatesDir string, metadata *TemplateMetadata) (*SyncResult, error) {
+func syncTeamTemplatesIntelligent(client *api.Client, team api.Team, templatesDir string, metadata *TemplateMetadata, prog *output.Progress) (*SyncResult, error) {
 	// Get templates for this team
 	templates, err := client.ListIssueTemplatesForTeam(team.ID)
 	if err != nil {
@@ -441,7 +685,7 @@ func syncTeamTemplatesIntelligent(client *api.Client, team api.Team, templatesDi
 	if len(newTemplates) == 0 && len(updatedTemplates) == 0 && len(removedTemplateNames) == 0 {
 		timeSinceSync := "never"
 		if hasExistingData {
-			timeSinceSync = time.Since(existingTeamData.LastSync).Round(time.Minute).String() + " ago"
+			timeSinceSync = output.Relative(existingTeamData.LastSync, time.Now())
 		}
 		return &SyncResult{
 			SkipReason: fmt.Sprintf("Up to date (%d templates, last synced %s)", len(templates), timeSinceSync),
@@ -449,9 +693,6 @@ func syncTeamTemplatesIntelligent(client *api.Client, team api.Team, templatesDi
 	}
 
 	// Perform the sync
-	fmt.Printf("  Syncing %d new, %d updated, removing %d templates...\n", 
-		len(newTemplates), len(updatedTemplates), len(removedTemplateNames))
-
 	// Create team directory
 	teamDir := filepath.Join(templatesDir, team.Key)
 	err = os.MkdirAll(teamDir, 0755)
@@ -474,25 +715,25 @@ func syncTeamTemplatesIntelligent(client *api.Client, team api.Team, templatesDi
 
 	// Process new templates
 	for _, template := range newTemplates {
-		fmt.Printf("    Adding new template: %s\n", template.Name)
+		prog.Update("%s: adding %s", team.Key, template.Name)
 		err := syncSingleTemplate(client, team, template, teamDir, &teamTemplates)
 		if err != nil {
-			fmt.Printf("      Warning: Failed to sync %s: %v\n", template.Name, err)
+			prog.Warnf("failed to sync %s: %v", template.Name, err)
 		}
 	}
 
 	// Process updated templates
 	for _, template := range updatedTemplates {
-		fmt.Printf("    Updating template: %s\n", template.Name)
+		prog.Update("%s: updating %s", team.Key, template.Name)
 		err := syncSingleTemplate(client, team, template, teamDir, &teamTemplates)
 		if err != nil {
-			fmt.Printf("      Warning: Failed to update %s: %v\n", template.Name, err)
+			prog.Warnf("failed to update %s: %v", template.Name, err)
 		}
 	}
 
 	// Remove old templates
 	for _, templateName := range removedTemplateNames {
-		fmt.Printf("    Removing template: %s\n", templateName)
+		prog.Update("%s: removing %s", team.Key, templateName)
 		if existingTemplate, exists := teamTemplates.Templates[templateName]; exists {
 			templatePath := filepath.Join(teamDir, existingTemplate.Filename)
 			_ = os.Remove(templatePath) // Best effort
@@ -524,7 +765,6 @@ func syncSingleTemplate(client *api.Client, team api.Team, template api.IssueTem
 		existingIssue, err := client.IssueByID(existingTemplate.RefIssueID)
 		if err == nil && existingIssue != nil {
 			refIssue = existingIssue
-			fmt.Printf("      Reusing reference issue: %s\n", existingIssue.Identifier)
 		}
 	}
 	
@@ -545,7 +785,6 @@ func syncSingleTemplate(client *api.Client, team api.Team, template api.IssueTem
 			Description: newRefIssue.Description,
 			URL:         newRefIssue.URL,
 		}
-		fmt.Printf("      Created reference issue: %s\n", refIssue.Identifier)
 	}
 
 	// Extract template content
@@ -626,9 +865,9 @@ func cleanupOldTemplateFiles(teamDir string, currentTemplates []api.IssueTemplat
 			filePath := filepath.Join(teamDir, filename)
 			err := os.Remove(filePath)
 			if err != nil {
-				fmt.Printf("      Warning: Failed to remove old template file %s: %v\n", filename, err)
+				ui.Warnf("failed to remove old template file %s: %v", filename, err)
 			} else {
-				fmt.Printf("      Removed outdated template file: %s\n", filename)
+				ui.Infof("Removed outdated template file: %s", filename)
 			}
 		}
 	}
@@ -645,17 +884,20 @@ func GetLocalTemplate(teamKey, templateName string) (*TemplateInfo, string, erro
 
 	metadata, err := loadTemplateMetadata(templatesDir)
 	if err != nil {
+		notePerfCache("templates", false)
 		return nil, "", fmt.Errorf("no templates found. Run 'linear-cli templates sync --team %s' first", teamKey)
 	}
 
 	teamKey = strings.ToUpper(strings.TrimSpace(teamKey))
 	teamData, exists := metadata.Templates[teamKey]
 	if !exists {
+		notePerfCache("templates", false)
 		return nil, "", fmt.Errorf("no templates found for team %s. Run 'linear-cli templates sync --team %s' first", teamKey, teamKey)
 	}
 
 	template, exists := teamData.Templates[templateName]
 	if !exists {
+		notePerfCache("templates", false)
 		return nil, "", fmt.Errorf("template '%s' not found for team %s", templateName, teamKey)
 	}
 
@@ -663,9 +905,11 @@ func GetLocalTemplate(teamKey, templateName string) (*TemplateInfo, string, erro
 	templatePath := filepath.Join(templatesDir, teamKey, template.Filename)
 	content, err := os.ReadFile(templatePath)
 	if err != nil {
+		notePerfCache("templates", false)
 		return nil, "", fmt.Errorf("failed to read template file: %w", err)
 	}
 
+	notePerfCache("templates", true)
 	return &template, string(content), nil
 }
 
@@ -735,11 +979,20 @@ func init() {
 	templatesCleanCmd.Flags().String("team", "", "Team key to clean templates for")
 	templatesCleanCmd.Flags().Bool("all", false, "Clean all cached templates")
 
+	templatesCreateCmd.Flags().String("team", "", "Team key to create the template for")
+	templatesCreateCmd.Flags().String("name", "", "Template name")
+	templatesCreateCmd.Flags().String("file", "", "Markdown file with the template body")
+
+	templatesPushCmd.Flags().String("team", "", "Team key to push templates for")
+	templatesPushCmd.Flags().Bool("all", false, "Push templates for every team directory")
+
 	// Add subcommands
 	templatesCmd.AddCommand(templatesSyncCmd)
 	templatesCmd.AddCommand(templatesListCmd)
 	templatesCmd.AddCommand(templatesCleanCmd)
 	templatesCmd.AddCommand(templatesStatusCmd)
+	templatesCmd.AddCommand(templatesCreateCmd)
+	templatesCmd.AddCommand(templatesPushCmd)
 
 	// Add to root command
 	rootCmd.AddCommand(templatesCmd)
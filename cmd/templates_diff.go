@@ -0,0 +1,205 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "sort"
+    "strings"
+
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var templatesDiffCmd = &cobra.Command{
+    Use:   "diff --team <key>",
+    Short: "Show how the server's templates differ from the local cache",
+    Long: `Compare each locally cached template with the current body on the server and
+print a unified diff (local as ---, server as +++), so you can see what
+'templates sync' would change before running it. Templates that exist on only
+one side are listed as such.`,
+    Example: `  linear-cli templates diff --team ENG
+  linear-cli --json templates diff --team ENG`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := cachedTeamByKeyOrError(client, teamKey)
+        if err != nil { return err }
+
+        templatesDir, err := getTemplatesDir()
+        if err != nil { return fmt.Errorf("failed to access templates directory: %w", err) }
+        local := map[string]TemplateInfo{}
+        if metadata, err := loadTemplateMetadata(templatesDir); err == nil { local = metadata.Templates[team.Key].Templates }
+        remote, err := client.ListIssueTemplatesForTeam(team.ID)
+        if err != nil { return fmt.Errorf("failed to list templates: %w", err) }
+
+        prog := ui.StartProgress(fmt.Sprintf("Comparing %s templates", team.Key), len(remote))
+        var diffs []templateDiff
+        seen := map[string]bool{}
+        for _, t := range remote {
+            seen[t.Name] = true
+            d := templateDiff{Name: t.Name}
+            body, err := client.TemplateBody(t.ID)
+            if err != nil { prog.Done("Comparing templates failed"); return fmt.Errorf("failed to fetch template %s: %w", t.Name, err) }
+            info, cached := local[t.Name]
+            if !cached {
+                d.Status = "server only"
+            } else {
+                b, err := os.ReadFile(filepath.Join(templatesDir, team.Key, info.Filename))
+                if err != nil && !os.IsNotExist(err) { prog.Done("Comparing templates failed"); return err }
+                d.Diff = unifiedDiff("local/"+info.Filename, "server/"+t.Name, string(b), body, 3)
+                d.Status = "unchanged"
+                if d.Diff != "" { d.Status = "changed" }
+            }
+            diffs = append(diffs, d)
+            prog.Step("%s: %s", t.Name, d.Status)
+        }
+        prog.Done("Compared %d template(s)", len(remote))
+        for _, name := range sortedKeys(local) {
+            if !seen[name] { diffs = append(diffs, templateDiff{Name: name, Status: "local only"}) }
+        }
+        sort.SliceStable(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"team": team.Key, "templates": diffs}) }
+        if len(diffs) == 0 {
+            fmt.Printf("No templates for %s on the server or in the local cache\n", team.Key)
+            return nil
+        }
+        changed := 0
+        for _, d := range diffs {
+            if d.Status == "unchanged" { continue }
+            changed++
+            if d.Diff == "" {
+                fmt.Printf("%s: %s\n", d.Name, d.Status)
+                continue
+            }
+            fmt.Print(colorizeDiff(d.Diff, p.ColorEnabled()))
+        }
+        if changed == 0 {
+            fmt.Printf("Local templates for %s match the server\n", team.Key)
+        } else {
+            fmt.Printf("\n%d of %d template(s) differ; run 'linear-cli templates sync --team %s' to update the cache\n", changed, len(diffs), team.Key)
+        }
+        return nil
+    },
+}
+
+// templateDiff is one template's comparison; Diff is empty unless both sides exist and differ
+type templateDiff struct {
+    Name   string `json:"name"`
+    Status string `json:"status"`
+    Diff   string `json:"diff,omitempty"`
+}
+
+// unifiedDiff returns a unified diff turning a into b with the given lines of
+// context, or "" when they are equal. Lines are matched by longest common
+// subsequence, which is plenty for template-sized inputs.
+func unifiedDiff(aName, bName, a, b string, context int) string {
+    if a == b { return "" }
+    al, bl := splitDiffLines(a), splitDiffLines(b)
+    n, m := len(al), len(bl)
+    lcs := make([][]int, n+1)
+    for i := range lcs { lcs[i] = make([]int, m+1) }
+    for i := n - 1; i >= 0; i-- {
+        for j := m - 1; j >= 0; j-- {
+            if al[i] == bl[j] {
+                lcs[i][j] = lcs[i+1][j+1] + 1
+            } else {
+                lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+            }
+        }
+    }
+
+    // Walk the table into an edit script: ' ' keep, '-' delete from a, '+' insert from b
+    type edit struct {
+        op   byte
+        text string
+        ai   int // lines of a and b consumed before this edit
+        bi   int
+    }
+    var edits []edit
+    i, j := 0, 0
+    for i < n || j < m {
+        switch {
+        case i < n && j < m && al[i] == bl[j]:
+            edits = append(edits, edit{' ', al[i], i, j}); i++; j++
+        case i < n && (j == m || lcs[i+1][j] >= lcs[i][j+1]):
+            edits = append(edits, edit{'-', al[i], i, j}); i++
+        default:
+            edits = append(edits, edit{'+', bl[j], i, j}); j++
+        }
+    }
+
+    changed := false
+    for _, e := range edits { if e.op != ' ' { changed = true; break } }
+    // Only a trailing newline differs
+    if !changed { return "" }
+
+    var out strings.Builder
+    fmt.Fprintf(&out, "--- %s\n+++ %s\n", aName, bName)
+    for k := 0; k < len(edits); {
+        if edits[k].op == ' ' { k++; continue }
+        // Grow the hunk while changes are within 2*context lines of each other
+        start := max(k-context, 0)
+        end := k
+        for {
+            for end < len(edits) && edits[end].op != ' ' { end++ }
+            gap := end
+            for gap < len(edits) && edits[gap].op == ' ' && gap-end < 2*context { gap++ }
+            if gap < len(edits) && edits[gap].op != ' ' { end = gap; continue }
+            break
+        }
+        stop := min(end+context, len(edits))
+        aCount, bCount := 0, 0
+        for _, e := range edits[start:stop] {
+            if e.op != '+' { aCount++ }
+            if e.op != '-' { bCount++ }
+        }
+        fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(edits[start].ai, aCount), hunkRange(edits[start].bi, bCount))
+        for _, e := range edits[start:stop] { fmt.Fprintf(&out, "%c%s\n", e.op, e.text) }
+        k = stop
+    }
+    return out.String()
+}
+
+// hunkRange formats a hunk's start,count the way diff -u does
+func hunkRange(start, count int) string {
+    if count == 0 { return fmt.Sprintf("%d,0", start) }
+    if count == 1 { return fmt.Sprintf("%d", start+1) }
+    return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func splitDiffLines(s string) []string {
+    if s == "" { return nil }
+    return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// colorizeDiff paints removed lines red and added lines green
+func colorizeDiff(diff string, color bool) string {
+    if !color { return diff }
+    lines := strings.SplitAfter(diff, "\n")
+    for i, l := range lines {
+        switch {
+        case strings.HasPrefix(l, "---") || strings.HasPrefix(l, "+++"):
+            lines[i] = "\x1b[1m" + strings.TrimSuffix(l, "\n") + "\x1b[0m\n"
+        case strings.HasPrefix(l, "@@"):
+            lines[i] = "\x1b[36m" + strings.TrimSuffix(l, "\n") + "\x1b[0m\n"
+        case strings.HasPrefix(l, "-"):
+            lines[i] = "\x1b[31m" + strings.TrimSuffix(l, "\n") + "\x1b[0m\n"
+        case strings.HasPrefix(l, "+"):
+            lines[i] = "\x1b[32m" + strings.TrimSuffix(l, "\n") + "\x1b[0m\n"
+        }
+    }
+    return strings.Join(lines, "")
+}
+
+func init() {
+    templatesCmd.AddCommand(templatesDiffCmd)
+    templatesDiffCmd.Flags().String("team", "", "Team key (required)")
+}
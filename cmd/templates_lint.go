@@ -0,0 +1,241 @@
+package cmd
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "regexp"
+    "sort"
+    "strings"
+
+    "github.com/spf13/cobra"
+)
+
+var templatesLintCmd = &cobra.Command{
+    Use:   "lint <file|dir>...",
+    Short: "Check local template files for mistakes before they are used",
+    Long: `Check local template files (*.md, directories searched recursively) for
+problems that would otherwise show up while creating an issue:
+
+  placeholder      {{...}} that is not {{KEY}}, {{KEY|Prompt}} or {{> partial}}
+  duplicate-section  two sections with the same heading
+  title-prefix     a Title-Prefix line without a value, or not on the first line
+                   of the body, where it is ignored (--require-title-prefix also
+                   flags templates without one)
+  front-matter     a malformed '---' block, unknown keys, invalid labels,
+                   priority or estimate values, or an extends/partial that does
+                   not resolve
+  unused-var       a --vars-file key no linted template uses
+
+Findings print as "file:line: severity: message [rule]"; --json gives them as
+an object for CI, and --format github as workflow annotations. Exits non-zero
+when there are errors, or any finding with --strict. Templates in a partials/
+directory are checked for syntax only.`,
+    Example: `  linear-cli templates lint ./templates/
+  linear-cli templates lint ./templates/ --vars-file ci/vars.json --strict
+  linear-cli templates lint ./templates/ --format github`,
+    Args: cobra.MinimumNArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        varsFile, _ := cmd.Flags().GetString("vars-file")
+        requirePrefix, _ := cmd.Flags().GetBool("require-title-prefix")
+        strict, _ := cmd.Flags().GetBool("strict")
+        format, _ := cmd.Flags().GetString("format")
+        format = strings.ToLower(strings.TrimSpace(format))
+        if format != "" && format != "text" && format != "github" { return fmt.Errorf("invalid --format '%s' (use text or github)", format) }
+
+        files, err := templateLintFiles(args)
+        if err != nil { return err }
+        if len(files) == 0 { return errors.New("no template files (*.md) found") }
+        var findings []lintFinding
+        used := map[string]bool{}
+        for _, f := range files {
+            raw, err := os.ReadFile(f)
+            if err != nil { return err }
+            partial := filepath.Base(filepath.Dir(f)) == "partials"
+            findings = append(findings, lintTemplate(f, string(raw), partial, requirePrefix)...)
+            keys, _ := templatePlaceholders(string(raw))
+            for _, k := range keys { used[k] = true }
+        }
+        if varsFile != "" {
+            more, err := lintVarsFile(varsFile, used)
+            if err != nil { return err }
+            findings = append(findings, more...)
+        }
+
+        errs, warns := 0, 0
+        for _, f := range findings {
+            if f.Severity == "error" { errs++ } else { warns++ }
+        }
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            if findings == nil { findings = []lintFinding{} }
+            if err := p.PrintJSON(map[string]any{"files": len(files), "errors": errs, "warnings": warns, "findings": findings}); err != nil { return err }
+        } else {
+            for _, f := range findings {
+                if format == "github" {
+                    fmt.Printf("::%s file=%s,line=%d,title=%s::%s\n", f.Severity, f.File, max(f.Line, 1), f.Rule, f.Message)
+                    continue
+                }
+                loc := f.File
+                if f.Line > 0 { loc = fmt.Sprintf("%s:%d", f.File, f.Line) }
+                fmt.Printf("%s: %s: %s [%s]\n", loc, f.Severity, f.Message, f.Rule)
+            }
+            if format != "github" {
+                if len(findings) == 0 {
+                    fmt.Printf("%d template(s) OK\n", len(files))
+                } else {
+                    fmt.Printf("\n%d template(s): %d error(s), %d warning(s)\n", len(files), errs, warns)
+                }
+            }
+        }
+        if errs > 0 || (strict && warns > 0) { return fmt.Errorf("template lint found %d error(s) and %d warning(s)", errs, warns) }
+        return nil
+    },
+}
+
+// lintFinding is one problem in a template; Line is 1-based, 0 for the file
+type lintFinding struct {
+    File     string `json:"file"`
+    Line     int    `json:"line,omitempty"`
+    Severity string `json:"severity"` // error or warning
+    Rule     string `json:"rule"`
+    Message  string `json:"message"`
+}
+
+// templateFrontMatterKeys are the front matter keys templates understand
+var templateFrontMatterKeys = []string{"extends", "labels", "priority", "assignee", "project", "estimate"}
+
+var reTemplateBraces = regexp.MustCompile(`\{\{[^{}]*\}\}|\{\{|\}\}`)
+
+// lintTemplate checks one template file's raw content. Partials are only
+// checked for placeholder syntax, since they are not used on their own.
+func lintTemplate(file, raw string, partial, requirePrefix bool) []lintFinding {
+    var out []lintFinding
+    add := func(line int, severity, rule, format string, a ...any) {
+        out = append(out, lintFinding{File: file, Line: line, Severity: severity, Rule: rule, Message: fmt.Sprintf(format, a...)})
+    }
+    raw = strings.ReplaceAll(raw, "\r\n", "\n")
+    lines := strings.Split(raw, "\n")
+
+    // Front matter: where the body starts, and whether the block parses
+    bodyStart := 0
+    meta, body, fmErr := parseFrontMatter(raw)
+    var yerr *yamlError
+    switch {
+    case errors.As(fmErr, &yerr):
+        add(yerr.Line, "error", "front-matter", "%s", yerr.Msg)
+    case body != raw:
+        bodyStart = strings.Count(raw[:len(raw)-len(body)], "\n")
+    }
+
+    for i, line := range lines {
+        if i < bodyStart { continue }
+        for _, m := range reTemplateBraces.FindAllString(line, -1) {
+            switch {
+            case m == "{{" || m == "}}":
+                add(i+1, "error", "placeholder", "unbalanced '%s'", m)
+            case !reTemplatePlaceholder.MatchString(m) && !rePartial.MatchString(m):
+                add(i+1, "error", "placeholder", "malformed placeholder %s (use {{KEY}}, {{KEY|Prompt}} or {{> partial}})", m)
+            }
+        }
+    }
+    if partial { return out }
+
+    for k, v := range meta {
+        if !containsString(templateFrontMatterKeys, k) {
+            add(metaLine(lines, k), "warning", "front-matter", "unknown front matter key '%s' (known: %s)", k, strings.Join(templateFrontMatterKeys, ", "))
+            continue
+        }
+        if strings.TrimSpace(v) == "" { add(metaLine(lines, k), "warning", "front-matter", "front matter key '%s' has no value", k) }
+    }
+    if _, err := templateFieldsFromMeta(meta); err != nil { add(0, "error", "front-matter", "%v", err) }
+    // Composition errors of this file's own front matter are reported above
+    if _, err := loadTemplate(file, "", ""); err != nil && fmErr == nil { add(0, "error", "front-matter", "%v", err) }
+
+    // Title-Prefix is only honoured on the first line of the body
+    hasPrefix := false
+    for i := bodyStart; i < len(lines); i++ {
+        t := strings.TrimSpace(lines[i])
+        if !strings.HasPrefix(strings.ToLower(t), "title-prefix:") { continue }
+        if i != bodyStart {
+            add(i+1, "warning", "title-prefix", "Title-Prefix is ignored unless it is the first line of the body")
+            continue
+        }
+        hasPrefix = true
+        if strings.TrimSpace(t[len("title-prefix:"):]) == "" { add(i+1, "error", "title-prefix", "Title-Prefix has no value") }
+    }
+    if requirePrefix && !hasPrefix { add(0, "error", "title-prefix", "template has no Title-Prefix line") }
+
+    seen := map[string]int{}
+    for i := bodyStart; i < len(lines); i++ {
+        t := strings.TrimSpace(lines[i])
+        if !strings.HasPrefix(t, "## ") && !strings.HasPrefix(t, "### ") { continue }
+        name := strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(t, "### "), "## "))
+        key := strings.ToLower(name)
+        if first, dup := seen[key]; dup {
+            add(i+1, "error", "duplicate-section", "section '%s' already appears on line %d; only the first is filled", name, first)
+            continue
+        }
+        seen[key] = i + 1
+    }
+    return out
+}
+
+// metaLine finds the front matter line defining key, or 0
+func metaLine(lines []string, key string) int {
+    for i := 1; i < len(lines) && strings.TrimSpace(lines[i]) != "---"; i++ {
+        if k, _, ok := strings.Cut(lines[i], ":"); ok && strings.EqualFold(strings.TrimSpace(k), key) { return i + 1 }
+    }
+    return 0
+}
+
+// lintVarsFile flags the keys of a --vars-file JSON object that no template uses
+func lintVarsFile(file string, used map[string]bool) ([]lintFinding, error) {
+    b, err := os.ReadFile(expandUserPath(file))
+    if err != nil { return nil, err }
+    var vars map[string]any
+    if err := json.Unmarshal(b, &vars); err != nil {
+        return []lintFinding{{File: file, Severity: "error", Rule: "unused-var", Message: fmt.Sprintf("not a JSON object of template variables: %v", err)}}, nil
+    }
+    var out []lintFinding
+    for _, k := range sortedKeys(vars) {
+        if _, isString := vars[k].(string); !isString {
+            out = append(out, lintFinding{File: file, Severity: "error", Rule: "unused-var", Message: fmt.Sprintf("value of '%s' must be a string", k)})
+        }
+        if !used[k] { out = append(out, lintFinding{File: file, Severity: "warning", Rule: "unused-var", Message: fmt.Sprintf("'%s' is not used by any template ({{%s}})", k, k)}) }
+    }
+    return out, nil
+}
+
+// templateLintFiles expands the arguments into the .md files to lint, sorted
+func templateLintFiles(args []string) ([]string, error) {
+    var files []string
+    for _, a := range args {
+        root := expandUserPath(a)
+        info, err := os.Stat(root)
+        if err != nil { return nil, err }
+        if !info.IsDir() {
+            files = append(files, root)
+            continue
+        }
+        err = filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+            if err != nil { return err }
+            if d.IsDir() && p != root && strings.HasPrefix(d.Name(), ".") { return filepath.SkipDir }
+            if !d.IsDir() && strings.EqualFold(filepath.Ext(p), ".md") { files = append(files, p) }
+            return nil
+        })
+        if err != nil { return nil, err }
+    }
+    sort.Strings(files)
+    return files, nil
+}
+
+func init() {
+    templatesCmd.AddCommand(templatesLintCmd)
+    templatesLintCmd.Flags().String("vars-file", "", "JSON file of template variables; keys no template uses are reported")
+    templatesLintCmd.Flags().Bool("require-title-prefix", false, "Report templates without a Title-Prefix line")
+    templatesLintCmd.Flags().Bool("strict", false, "Exit non-zero on warnings too")
+    templatesLintCmd.Flags().String("format", "text", "Output format: text, or github for workflow annotations")
+}
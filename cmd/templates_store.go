@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	berrors "go.etcd.io/bbolt/errors"
+)
+
+// The template cache metadata lives in a bbolt database, <templates dir>/.metadata.db.
+// Each team is its own record in the "teams" bucket, and the schema version and
+// global last sync time are records in "meta", so a write only rewrites the
+// teams a command changed instead of the whole cache. Every write is a bbolt
+// transaction; opening the database takes an OS file lock (shared for reads,
+// exclusive for writes) that is released when its holder exits, so parallel
+// syncs of different teams (or a push during a sync) no longer overwrite each
+// other and a crashed run never leaves it held.
+//
+// Metadata used to be the JSON file .metadata.json (schema 0, then 1). The first
+// write imports it in the same transaction and then renames it to
+// .metadata.v<N>.json; until then reads fall back to it. A JSON file that does
+// not parse is reported, never replaced. A database from a newer linear-cli is
+// read but never written.
+
+// templateMetadataSchema is the schema version this build reads and writes
+const templateMetadataSchema = 2
+
+const (
+	templateMetadataDB   = ".metadata.db"
+	templateMetadataFile = ".metadata.json"
+)
+
+var (
+	templateMetaBucket  = []byte("meta")
+	templateTeamsBucket = []byte("teams")
+)
+
+// templateLockWait is how long opening the store waits for another process's write
+var templateLockWait = 10 * time.Second
+
+// errTemplateMetadataNewer is returned when the metadata was written by a newer
+// linear-cli with a schema this build does not know
+var errTemplateMetadataNewer = errors.New("template metadata was written by a newer linear-cli; upgrade to change it")
+
+// readTemplateMetadata parses and migrates legacy .metadata.json contents. It
+// also returns the schema version the data was stored with.
+func readTemplateMetadata(data []byte) (*TemplateMetadata, int, error) {
+	var metadata TemplateMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, 0, err
+	}
+	stored := metadata.SchemaVersion
+	if stored > templateMetadataSchema {
+		return &metadata, stored, errTemplateMetadataNewer
+	}
+	migrateTemplateMetadata(&metadata)
+	return &metadata, stored, nil
+}
+
+// migrateTemplateMetadata brings metadata of any older schema up to date
+func migrateTemplateMetadata(metadata *TemplateMetadata) {
+	// 0 -> 1: the version field is added; maps and team keys may be missing
+	if metadata.Templates == nil {
+		metadata.Templates = make(map[string]TeamTemplates)
+	}
+	for key, team := range metadata.Templates {
+		if team.TeamKey == "" {
+			team.TeamKey = key
+		}
+		if team.Templates == nil {
+			team.Templates = make(map[string]TemplateInfo)
+		}
+		metadata.Templates[key] = team
+	}
+	// 1 -> 2: the same records move into the database
+	metadata.SchemaVersion = templateMetadataSchema
+}
+
+// loadTemplateMetadata reads the metadata from the store, or from the legacy
+// JSON file while nothing has been written to the store yet. With neither it
+// returns an error satisfying os.IsNotExist.
+func loadTemplateMetadata(templatesDir string) (*TemplateMetadata, error) {
+	if !fileExists(filepath.Join(templatesDir, templateMetadataDB)) {
+		return loadLegacyTemplateMetadata(templatesDir)
+	}
+	db, err := openTemplateStore(templatesDir, true)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	var metadata *TemplateMetadata
+	var stored int
+	err = db.View(func(tx *bolt.Tx) error {
+		var err error
+		metadata, stored, err = readTemplateStore(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if stored == 0 {
+		// Nothing committed yet, say because the import failed
+		return loadLegacyTemplateMetadata(templatesDir)
+	}
+	return metadata, nil
+}
+
+// loadLegacyTemplateMetadata reads .metadata.json; a newer schema is still returned
+func loadLegacyTemplateMetadata(templatesDir string) (*TemplateMetadata, error) {
+	path := filepath.Join(templatesDir, templateMetadataFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	metadata, _, err := readTemplateMetadata(data)
+	if err != nil && !errors.Is(err, errTemplateMetadataNewer) {
+		return nil, fmt.Errorf("template metadata %s is not valid: %w", path, err)
+	}
+	return metadata, nil
+}
+
+// updateTemplateMetadata runs fn on the current metadata in one store
+// transaction and writes back only the teams it changed. The legacy JSON file
+// is imported first when the store is still empty.
+func updateTemplateMetadata(templatesDir string, fn func(*TemplateMetadata) error) error {
+	db, err := openTemplateStore(templatesDir, false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var legacy string
+	err = db.Update(func(tx *bolt.Tx) error {
+		metadata, stored, err := readTemplateStore(tx)
+		if err != nil {
+			return err
+		}
+		if stored == 0 {
+			m, version, err := importLegacyTemplateMetadata(templatesDir)
+			if err != nil {
+				return err
+			}
+			if m != nil {
+				metadata, legacy = m, filepath.Join(templatesDir, fmt.Sprintf(".metadata.v%d.json", version))
+			}
+		} else if stored > templateMetadataSchema {
+			return fmt.Errorf("%s (schema %d, this build writes %d)", errTemplateMetadataNewer, stored, templateMetadataSchema)
+		}
+		if err := fn(metadata); err != nil {
+			return err
+		}
+		return writeTemplateStore(tx, metadata)
+	})
+	if err != nil {
+		return err
+	}
+	if legacy != "" && !fileExists(legacy) {
+		_ = os.Rename(filepath.Join(templatesDir, templateMetadataFile), legacy) // Best effort
+	}
+	return nil
+}
+
+// importLegacyTemplateMetadata reads .metadata.json for the first write to the
+// store, with the schema it was stored with; with no file it returns nil
+func importLegacyTemplateMetadata(templatesDir string) (*TemplateMetadata, int, error) {
+	path := filepath.Join(templatesDir, templateMetadataFile)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	metadata, version, err := readTemplateMetadata(data)
+	switch {
+	case errors.Is(err, errTemplateMetadataNewer):
+		return nil, 0, fmt.Errorf("%s (schema %d, this build writes %d)", err, version, templateMetadataSchema)
+	case err != nil:
+		return nil, 0, fmt.Errorf("template metadata %s is not valid (%v); fix or remove it, it was left unchanged", path, err)
+	}
+	return metadata, version, nil
+}
+
+// saveTemplateTeams commits the given teams of metadata (removing those it no
+// longer has) on top of whatever other processes stored meanwhile. With synced
+// the global last sync time is set too.
+func saveTemplateTeams(templatesDir string, metadata *TemplateMetadata, teamKeys []string, synced bool) error {
+	return updateTemplateMetadata(templatesDir, func(current *TemplateMetadata) error {
+		for _, key := range teamKeys {
+			if team, ok := metadata.Templates[key]; ok {
+				current.Templates[key] = team
+			} else {
+				delete(current.Templates, key)
+			}
+		}
+		if synced {
+			current.LastSync = metadata.LastSync
+		}
+		return nil
+	})
+}
+
+// openTemplateStore opens the metadata database, waiting for another writer
+func openTemplateStore(templatesDir string, readOnly bool) (*bolt.DB, error) {
+	if !readOnly {
+		if err := os.MkdirAll(templatesDir, 0755); err != nil {
+			return nil, err
+		}
+	}
+	db, err := bolt.Open(filepath.Join(templatesDir, templateMetadataDB), 0644, &bolt.Options{Timeout: templateLockWait, ReadOnly: readOnly})
+	switch {
+	case errors.Is(err, berrors.ErrTimeout):
+		return nil, errors.New("template metadata is locked by another linear-cli that is still writing it; try again")
+	case err != nil:
+		return nil, fmt.Errorf("failed to open template metadata: %w", err)
+	}
+	return db, nil
+}
+
+// readTemplateStore loads the metadata in tx with the schema it was stored
+// with; an empty store is schema 0
+func readTemplateStore(tx *bolt.Tx) (*TemplateMetadata, int, error) {
+	metadata := &TemplateMetadata{SchemaVersion: templateMetadataSchema, Templates: make(map[string]TeamTemplates)}
+	meta := tx.Bucket(templateMetaBucket)
+	if meta == nil {
+		return metadata, 0, nil
+	}
+	stored, err := strconv.Atoi(string(meta.Get([]byte("schema_version"))))
+	if err != nil {
+		return nil, 0, fmt.Errorf("template metadata has an invalid schema version: %w", err)
+	}
+	if v := meta.Get([]byte("last_sync")); v != nil {
+		if err := metadata.LastSync.UnmarshalText(v); err != nil {
+			return nil, 0, fmt.Errorf("template metadata has an invalid last sync time: %w", err)
+		}
+	}
+	if stored > templateMetadataSchema {
+		metadata.SchemaVersion = stored
+	}
+	if teams := tx.Bucket(templateTeamsBucket); teams != nil {
+		err := teams.ForEach(func(k, v []byte) error {
+			var team TeamTemplates
+			if err := json.Unmarshal(v, &team); err != nil {
+				return fmt.Errorf("template metadata for team %s is not valid: %w", k, err)
+			}
+			if team.Templates == nil {
+				team.Templates = make(map[string]TemplateInfo)
+			}
+			metadata.Templates[string(k)] = team
+			return nil
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return metadata, stored, nil
+}
+
+// writeTemplateStore stores metadata in tx, rewriting only the teams whose
+// records changed and deleting those metadata no longer has
+func writeTemplateStore(tx *bolt.Tx, metadata *TemplateMetadata) error {
+	meta, err := tx.CreateBucketIfNotExists(templateMetaBucket)
+	if err != nil {
+		return err
+	}
+	if err := meta.Put([]byte("schema_version"), []byte(strconv.Itoa(templateMetadataSchema))); err != nil {
+		return err
+	}
+	lastSync, err := metadata.LastSync.MarshalText()
+	if err != nil {
+		return err
+	}
+	if err := meta.Put([]byte("last_sync"), lastSync); err != nil {
+		return err
+	}
+	teams, err := tx.CreateBucketIfNotExists(templateTeamsBucket)
+	if err != nil {
+		return err
+	}
+	var gone [][]byte
+	err = teams.ForEach(func(k, _ []byte) error {
+		if _, ok := metadata.Templates[string(k)]; !ok {
+			gone = append(gone, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range gone {
+		if err := teams.Delete(k); err != nil {
+			return err
+		}
+	}
+	for key, team := range metadata.Templates {
+		v, err := json.Marshal(team)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(teams.Get([]byte(key)), v) {
+			continue
+		}
+		if err := teams.Put([]byte(key), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+    "linear-cli/internal/output"
+
+    "github.com/spf13/cobra"
+)
+
+var templatesWhichCmd = &cobra.Command{
+    Use:   "which <name>",
+    Short: "Show which source a template name resolves to, and why",
+    Long: `Show where 'issues create --template <name>' reads a template from: every
+source it checks, in order, what each one found, and which one wins.
+
+  --templates-source api       the team's Linear templates: by id, then by
+                               name within --team
+  --templates-source auto,     a URL or file path is read as given; a name is
+  local or remote              fetched from the remote base URL (--templates-base-url
+                               or $LINEAR_TEMPLATES_BASE_URL) when one is set,
+                               then looked up in the local directories
+                               (--templates-dir, $LINEAR_TEMPLATES_DIR,
+                               UserConfigDir/linear/templates,
+                               ~/.config/linear/templates)
+
+The first hit wins; later hits are shown as shadowed. With --team it also
+shows the synced cache ('templates sync') that 'issues create --sections'
+uses instead. Exits non-zero when the name resolves nowhere.`,
+    Example: `  linear-cli templates which bug
+  linear-cli templates which "Bug Report" --team ENG --templates-source api
+  linear-cli templates which bug --team ENG --json`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        teamKey, _ := cmd.Flags().GetString("team")
+        source, _ := cmd.Flags().GetString("templates-source")
+        dir, _ := cmd.Flags().GetString("templates-dir")
+        base, _ := cmd.Flags().GetString("templates-base-url")
+        source = strings.ToLower(strings.TrimSpace(source))
+        if !containsString([]string{"auto", "local", "remote", "api"}, source) { return fmt.Errorf("invalid --templates-source '%s' (use auto|local|remote|api)", source) }
+        var client *api.Client
+        if cfg, _ := config.Load(); cfg.APIKey != "" { client = newAPIClient(cmd, cfg.APIKey) }
+        if source == "api" && client == nil { return errors.New("not authenticated. run 'linear-cli auth login'") }
+
+        res := resolveTemplateSources(client, args[0], teamKey, source, dir, base)
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            if err := p.PrintJSON(res); err != nil { return err }
+        } else {
+            printTemplateResolution(p, res)
+        }
+        if res.Used < 0 { return fmt.Errorf("template '%s' not found in any source", res.Name) }
+        return nil
+    },
+}
+
+// templateCandidate is one place a template name was looked for
+type templateCandidate struct {
+    Source   string `json:"source"`
+    Location string `json:"location"`
+    Found    bool   `json:"found"`
+    Detail   string `json:"detail,omitempty"`
+}
+
+// templateResolution is where a name resolves for 'issues create --template'
+type templateResolution struct {
+    Name       string              `json:"name"`
+    Team       string              `json:"team,omitempty"`
+    Source     string              `json:"templatesSource"`
+    Rule       string              `json:"rule"`
+    Candidates []templateCandidate `json:"candidates"`
+    // Used indexes the winning candidate; -1 when none found the template
+    Used int `json:"used"`
+    // Sections is the synced cache entry 'issues create --sections' uses
+    Sections *templateCandidate `json:"sections,omitempty"`
+}
+
+// resolveTemplateSources checks the sources 'issues create' reads a template
+// from, in its order (see readTemplateRaw). client may be nil to skip the API.
+func resolveTemplateSources(client *api.Client, name, teamKey, source, dir, base string) templateResolution {
+    name = strings.TrimSpace(name)
+    teamKey = strings.ToUpper(strings.TrimSpace(teamKey))
+    res := templateResolution{Name: name, Team: teamKey, Source: source, Used: -1}
+    add := func(c templateCandidate) {
+        res.Candidates = append(res.Candidates, c)
+        if c.Found && res.Used < 0 { res.Used = len(res.Candidates) - 1 }
+    }
+    fileCandidate := func(src, path string) templateCandidate {
+        c := templateCandidate{Source: src, Location: path}
+        if _, err := os.Stat(path); err != nil {
+            c.Detail = "not found"
+        } else {
+            c.Found = true
+        }
+        return c
+    }
+
+    switch {
+    case source == "api":
+        res.Rule = "--templates-source api looks the name up as a template id, then by name among the team's templates"
+        c := templateCandidate{Source: "api", Location: "template id " + name}
+        if tpl, err := client.IssueTemplateByID(name); err == nil && tpl != nil {
+            c.Found, c.Detail = true, tpl.Name
+        } else {
+            c.Detail = "no template with this id"
+        }
+        add(c)
+        c = templateCandidate{Source: "api", Location: "templates of team " + teamKey}
+        if teamKey == "" {
+            c.Location, c.Detail = "templates of the team", "needs --team"
+        } else if team, err := cachedTeamByKeyOrError(client, teamKey); err != nil {
+            c.Detail = err.Error()
+        } else if tpl, err := client.IssueTemplateByNameForTeam(team.ID, name); err != nil {
+            c.Detail = err.Error()
+        } else if tpl == nil {
+            c.Detail = "no template with this name"
+        } else {
+            c.Found, c.Detail = true, "id "+tpl.ID
+        }
+        add(c)
+    case strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://"):
+        res.Rule = "a URL is fetched as given"
+        c := templateCandidate{Source: "url", Location: name}
+        if _, err := fetchURL(name); err != nil {
+            c.Detail = err.Error()
+        } else {
+            c.Found = true
+        }
+        add(c)
+    case strings.Contains(name, string(os.PathSeparator)) || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "~"):
+        res.Rule = "a file path is read as given"
+        add(fileCandidate("path", expandUserPath(name)))
+    default:
+        res.Rule = "--templates-source " + source + " fetches a name from the remote base URL when one is set, then looks in the local directories in order; the first hit wins"
+        if b := templateBaseURL(base); b != "" {
+            url := joinURL(b, name+".md")
+            c := templateCandidate{Source: "remote", Location: url}
+            if _, err := fetchURL(url); err != nil {
+                c.Detail = err.Error()
+            } else {
+                c.Found = true
+            }
+            add(c)
+        }
+        for _, d := range templateSearchDirs(dir) { add(fileCandidate("local", filepath.Join(d, name+".md"))) }
+    }
+
+    if teamKey != "" {
+        c := templateCandidate{Source: "cache", Location: teamKey + "/" + name}
+        if info, _, err := GetLocalTemplate(teamKey, name); err != nil {
+            c.Detail = err.Error()
+        } else {
+            c.Found, c.Location = true, filepath.Join(teamKey, info.Filename)
+        }
+        res.Sections = &c
+    }
+    return res
+}
+
+func printTemplateResolution(p output.Printer, res templateResolution) {
+    if res.Used >= 0 {
+        c := res.Candidates[res.Used]
+        fmt.Printf("'%s' resolves to %s: %s\n", res.Name, c.Source, c.Location)
+    } else {
+        fmt.Printf("'%s' does not resolve to any template\n", res.Name)
+    }
+    fmt.Printf("Rule: %s.\n\nChecked, in order:\n", res.Rule)
+    for i, c := range res.Candidates {
+        status := c.Detail
+        switch {
+        case i == res.Used:
+            status = p.Symbol("✓ ", "") + "used"
+        case c.Found:
+            status = "found, shadowed"
+        }
+        fmt.Printf("  %d. %-7s %s  (%s)\n", i+1, c.Source, c.Location, status)
+    }
+    if res.Sections != nil {
+        status := "found"
+        if !res.Sections.Found { status = res.Sections.Detail }
+        fmt.Printf("\nWith --sections, issues create uses the synced cache instead: %s  (%s)\n", res.Sections.Location, status)
+    }
+}
+
+func init() {
+    templatesCmd.AddCommand(templatesWhichCmd)
+    templatesWhichCmd.Flags().String("team", "", "Team key, for API templates and the synced cache")
+    templatesWhichCmd.Flags().String("templates-source", "auto", "Template source as for issues create: auto|local|remote|api")
+    templatesWhichCmd.Flags().String("templates-dir", "", "Override templates directory, as for issues create")
+    templatesWhichCmd.Flags().String("templates-base-url", "", "Remote templates base URL, as for issues create")
+}
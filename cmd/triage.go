@@ -0,0 +1,258 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "sort"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+
+    "github.com/spf13/cobra"
+)
+
+var triageCmd = &cobra.Command{
+    Use:   "triage",
+    Short: "Review and route issues in a team's triage queue",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var triageListCmd = &cobra.Command{
+    Use:   "list",
+    Short: "List triage issues with age and customer request counts",
+    Example: `  linear-cli triage list --team SUP
+  linear-cli triage list --team SUP --sort requests`,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        sortBy, _ := cmd.Flags().GetString("sort")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        sortBy = strings.ToLower(strings.TrimSpace(sortBy))
+        if sortBy != "age" && sortBy != "requests" { return fmt.Errorf("invalid --sort %q (use age|requests)", sortBy) }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := client.TeamByKey(strings.ToUpper(strings.TrimSpace(teamKey)))
+        if err != nil { return err }
+        if team == nil { return fmt.Errorf("team with key %s not found", teamKey) }
+        issues, err := client.ListTriageIssues(team.ID)
+        if err != nil { return err }
+        // Issues arrive oldest first; requests ordering keeps that as the tie-breaker
+        if sortBy == "requests" {
+            sort.SliceStable(issues, func(i, j int) bool { return issues[i].CustomerRequests > issues[j].CustomerRequests })
+        }
+
+        p := printer(cmd)
+        if p.JSONEnabled() { return p.PrintJSON(map[string]any{"team": team.Key, "issues": issues}) }
+        if len(issues) == 0 {
+            fmt.Printf("Triage queue for %s is empty\n", team.Key)
+            return nil
+        }
+        now := time.Now()
+        rows := make([][]string, 0, len(issues))
+        for _, iss := range issues {
+            creator := "-"
+            if iss.Creator != nil { creator = iss.Creator.Name }
+            rows = append(rows, []string{iss.Identifier, formatHours(now.Sub(iss.CreatedAt).Hours()), fmt.Sprintf("%d", iss.CustomerRequests), priorityLabel(iss.Priority), creator, truncate(iss.Title, 60)})
+        }
+        return p.Table([]string{"Key", "Age", "Requests", "Priority", "Creator", "Title"}, rows)
+    },
+}
+
+var triageAcceptCmd = &cobra.Command{
+    Use:   "accept <issue-key>",
+    Short: "Accept a triage issue into the team's backlog (or --state)",
+    Example: `  linear-cli triage accept SUP-42
+  linear-cli triage accept SUP-42 --state Todo --assignee jane@example.com --priority high
+  linear-cli triage accept SUP-42 --label bug --label customer --create-missing-labels`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        stateName, _ := cmd.Flags().GetString("state")
+        assignee, _ := cmd.Flags().GetString("assignee")
+        priorityFlag, _ := cmd.Flags().GetString("priority")
+        comment, _ := cmd.Flags().GetString("comment")
+        labelFlags, _ := cmd.Flags().GetStringArray("label")
+        createLabels, _ := cmd.Flags().GetBool("create-missing-labels")
+        return routeTriageIssue(cmd, args[0], "Accepted", comment, func(client *api.Client, teamID string, states []api.State, in *api.IssueUpdateInput) (*api.State, error) {
+            if labels := splitListFlags(labelFlags); len(labels) > 0 {
+                fields, err := preflightIssueFields(client, &api.CreateContext{}, teamID, issueFieldsInput{Labels: labels, CreateMissingLabels: createLabels}, time.Now())
+                if err != nil { return nil, err }
+                created, err := createMissingLabels(client, teamID, fields.MissingLabels)
+                if err != nil { return nil, err }
+                in.AddedLabelIDs = append(fields.LabelIDs, created...)
+            }
+            if strings.TrimSpace(assignee) != "" {
+                u, err := client.ResolveUser(assignee)
+                if err != nil { return nil, err }
+                if u == nil { return nil, fmt.Errorf("assignee '%s' not found", assignee) }
+                in.AssigneeID = u.ID
+            }
+            if strings.TrimSpace(priorityFlag) != "" {
+                v, err := parsePriority(priorityFlag)
+                if err != nil { return nil, err }
+                in.Priority = &v
+            }
+            if strings.TrimSpace(stateName) != "" {
+                for i := range states {
+                    if strings.EqualFold(states[i].Name, strings.TrimSpace(stateName)) { return &states[i], nil }
+                }
+                return nil, fmt.Errorf("state '%s' not found", stateName)
+            }
+            if s := firstStateOfType(states, "backlog"); s != nil { return s, nil }
+            if s := firstStateOfType(states, "unstarted"); s != nil { return s, nil }
+            return nil, errors.New("team has no backlog or unstarted workflow state; pass --state")
+        })
+    },
+}
+
+var triageDeclineCmd = &cobra.Command{
+    Use:   "decline <issue-key>",
+    Short: "Decline a triage issue (moves it to the team's canceled state)",
+    Example: `  linear-cli triage decline SUP-43 --reason "Working as intended"`,
+    Args:    cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        reason, _ := cmd.Flags().GetString("reason")
+        return routeTriageIssue(cmd, args[0], "Declined", reason, func(_ *api.Client, _ string, states []api.State, _ *api.IssueUpdateInput) (*api.State, error) {
+            if s := firstStateOfType(states, "canceled"); s != nil { return s, nil }
+            return nil, errors.New("team has no canceled workflow state")
+        })
+    },
+}
+
+// routeTriageIssue moves an issue out of triage into the state chosen by pick,
+// which may also fill in further update fields, then posts comment if non-empty.
+func routeTriageIssue(cmd *cobra.Command, key, verb, comment string, pick func(client *api.Client, teamID string, states []api.State, in *api.IssueUpdateInput) (*api.State, error)) error {
+    cfg, _ := config.Load()
+    if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+    client := newAPIClient(cmd, cfg.APIKey)
+    iss, err := resolveIssue(client, key)
+    if err != nil { return err }
+    det, err := client.GetIssueDetails(iss.ID)
+    if err != nil { return err }
+    if det == nil || det.Team == nil { return fmt.Errorf("issue %s not found", key) }
+    states, err := client.TeamStates(det.Team.ID)
+    if err != nil { return err }
+    if !isTriageState(states, det.StateName) { return fmt.Errorf("%s is not in triage (state: %s)", det.Identifier, det.StateName) }
+
+    in := api.IssueUpdateInput{}
+    target, err := pick(client, det.Team.ID, states, &in)
+    if err != nil { return err }
+    in.StateID = target.ID
+    updated, err := client.UpdateIssueAdvanced(det.ID, in)
+    if err != nil { return err }
+    if strings.TrimSpace(comment) != "" {
+        if _, err := client.CreateComment(det.ID, comment); err != nil { return fmt.Errorf("issue %s, but adding the comment failed: %w", strings.ToLower(verb), err) }
+    }
+
+    p := printer(cmd)
+    if p.JSONEnabled() { return p.PrintJSON(map[string]any{"issue": updated, "action": strings.ToLower(verb)}) }
+    fmt.Printf("%s %s: moved to %s\n", verb, updated.Identifier, updated.StateName)
+    return nil
+}
+
+// isTriageState reports whether the named state is one of the team's triage-type states
+func isTriageState(states []api.State, name string) bool {
+    for _, s := range states {
+        if strings.EqualFold(s.Type, "triage") && strings.EqualFold(s.Name, name) { return true }
+    }
+    return false
+}
+
+var triageSLACmd = &cobra.Command{
+    Use:   "sla --team <key> --respond-within <window>",
+    Short: "List triage issues nobody has responded to within the SLA window",
+    Long: `List triage issues whose last response is older than --respond-within. A response
+is a comment by anyone other than the issue's creator, or a state change; an issue
+nobody has responded to is measured from its creation. The most overdue issues
+come first.
+
+The command exits with status 1 when any issue breaches the SLA, so it can drive
+paging or alerting from cron or CI; --json output carries the team, window,
+check time and each breach with how long it is overdue.`,
+    Example: `  linear-cli triage sla --team ENG --respond-within 48h
+  linear-cli --json triage sla --team SUP --respond-within 1d | jq '.breaches[].identifier'`,
+    Args: cobra.NoArgs,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        teamKey, _ := cmd.Flags().GetString("team")
+        within, _ := cmd.Flags().GetString("respond-within")
+        if strings.TrimSpace(teamKey) == "" { return errors.New("--team is required") }
+        window, err := parseSLAWindow(within)
+        if err != nil { return err }
+
+        client := newAPIClient(cmd, cfg.APIKey)
+        team, err := cachedTeamByKeyOrError(client, teamKey)
+        if err != nil { return err }
+        issues, err := client.ListTriageActivity(team.ID)
+        if err != nil { return err }
+        now := time.Now()
+        breaches := triageSLABreaches(issues, window, now)
+
+        p := printer(cmd)
+        if p.JSONEnabled() {
+            if err := p.PrintJSON(map[string]any{"team": team.Key, "respondWithinHours": window.Hours(), "checkedAt": now.UTC(), "checked": len(issues), "breaches": breaches}); err != nil { return err }
+        } else if len(breaches) == 0 {
+            fmt.Printf("No %s triage issues past the %s response SLA (%d checked)\n", team.Key, within, len(issues))
+        } else {
+            rows := make([][]string, 0, len(breaches))
+            for _, b := range breaches {
+                last := "never"
+                if b.LastResponseAt != nil { last = formatHours(now.Sub(*b.LastResponseAt).Hours()) + " ago" }
+                rows = append(rows, []string{b.Identifier, formatHours(b.OverdueHours), last, formatHours(now.Sub(b.CreatedAt).Hours()), priorityLabel(b.Priority), truncate(b.Title, 50)})
+            }
+            if err := p.Table([]string{"Key", "Overdue", "Last response", "Age", "Priority", "Title"}, rows); err != nil { return err }
+        }
+        if len(breaches) > 0 { return fmt.Errorf("%d triage issue(s) breach the %s response SLA", len(breaches), within) }
+        return nil
+    },
+}
+
+// triageSLABreach is a triage issue past its response window
+type triageSLABreach struct {
+    api.TriageActivity
+    DueAt        time.Time `json:"dueAt"`
+    OverdueHours float64   `json:"overdueHours"`
+}
+
+// triageSLABreaches returns the issues whose last response (or creation, when
+// nobody responded) is more than window before now, most overdue first
+func triageSLABreaches(issues []api.TriageActivity, window time.Duration, now time.Time) []triageSLABreach {
+    out := []triageSLABreach{}
+    for _, iss := range issues {
+        since := iss.CreatedAt
+        if iss.LastResponseAt != nil { since = *iss.LastResponseAt }
+        due := since.Add(window)
+        if !now.After(due) { continue }
+        out = append(out, triageSLABreach{TriageActivity: iss, DueAt: due, OverdueHours: now.Sub(due).Hours()})
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].DueAt.Before(out[j].DueAt) })
+    return out
+}
+
+// parseSLAWindow reads a response window such as "48h", "2d" or "1w" (see parseSpan)
+func parseSLAWindow(s string) (time.Duration, error) {
+    if strings.TrimSpace(s) == "" { return 0, errors.New("--respond-within is required (e.g. 48h, 2d)") }
+    days, d, ok := parseSpan(s)
+    if !ok { return 0, fmt.Errorf("invalid --respond-within '%s' (try 48h, 2d or 1w)", s) }
+    return time.Duration(days)*24*time.Hour + d, nil
+}
+
+func init() {
+    rootCmd.AddCommand(triageCmd)
+    triageCmd.AddCommand(triageListCmd, triageAcceptCmd, triageDeclineCmd, triageSLACmd)
+    addOutputTemplateFlags(triageListCmd)
+    triageListCmd.Flags().String("team", "", "Team key (required)")
+    triageListCmd.Flags().String("sort", "age", "Order by age (oldest first) or requests (most customer requests first)")
+    triageAcceptCmd.Flags().String("state", "", "Target state name (default: the team's backlog, else first unstarted state)")
+    triageAcceptCmd.Flags().String("assignee", "", "Assign to user (id, name or email)")
+    triageAcceptCmd.Flags().String("priority", "", "Set priority (urgent|high|medium|low|none or 0-4)")
+    triageAcceptCmd.Flags().String("comment", "", "Comment to post when accepting")
+    triageAcceptCmd.Flags().StringArray("label", nil, "Label to add (repeatable, or comma-separated)")
+    triageAcceptCmd.Flags().Bool("create-missing-labels", false, "Create labels that do not exist yet in the team (default color) instead of failing")
+    triageDeclineCmd.Flags().String("reason", "", "Comment explaining why the issue was declined")
+    triageSLACmd.Flags().String("team", "", "Team key (required)")
+    triageSLACmd.Flags().String("respond-within", "", "Response window, e.g. 48h, 2d or 1w (required)")
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "sort"
+    "strings"
+    "time"
+
+    "linear-cli/internal/api"
+    "linear-cli/internal/config"
+    "linear-cli/internal/output"
+
+    "github.com/spf13/cobra"
+)
+
+var watchCmd = &cobra.Command{
+    Use:   "watch",
+    Short: "Follow changes as they happen",
+    RunE:  func(cmd *cobra.Command, args []string) error { return cmd.Help() },
+}
+
+var watchIssueCmd = &cobra.Command{
+    Use:   "issue <issue-key>",
+    Short: "Print an issue's new comments and state, assignee and label changes as they arrive",
+    Long: `Poll an issue and print each new comment and each change to its state,
+assignee, labels, priority or title, oldest first, until interrupted (Ctrl-C).
+Only events after the watch starts are shown, unless --since is given. Under
+--json each event is printed as one JSON object per line.`,
+    Example: `  linear-cli watch issue ENG-5
+  linear-cli watch issue ENG-5 --interval 5s --since 1h
+  linear-cli --json watch issue ENG-5 | jq -r .text`,
+    Args: cobra.ExactArgs(1),
+    RunE: func(cmd *cobra.Command, args []string) error {
+        cfg, _ := config.Load()
+        if cfg.APIKey == "" { return errors.New("not authenticated. run 'linear-cli auth login'") }
+        interval, _ := cmd.Flags().GetDuration("interval")
+        sinceFlag, _ := cmd.Flags().GetString("since")
+        if interval < 2*time.Second { return errors.New("--interval must be at least 2s") }
+        var since time.Time
+        if strings.TrimSpace(sinceFlag) != "" {
+            t, err := parseSince(sinceFlag, time.Now())
+            if err != nil { return err }
+            since = t
+        }
+        client := newAPIClient(cmd, cfg.APIKey)
+        iss, err := resolveIssue(client, args[0])
+        if err != nil { return err }
+        p := printer(cmd)
+
+        seen := map[string]bool{}
+        poll := func() ([]watchEvent, error) {
+            history, err := client.IssueHistoryEntries(iss.ID)
+            if err != nil { return nil, err }
+            comments, err := client.ListIssueComments(iss.ID, time.Time{}, 0)
+            if err != nil { return nil, err }
+            return newWatchEvents(iss.Identifier, history, comments, seen), nil
+        }
+        // Everything already there is the baseline, except what --since asks for
+        initial, err := poll()
+        if err != nil { return err }
+        ui.Infof("Watching %s every %s (Ctrl-C to stop)", iss.Identifier, interval)
+        for _, ev := range initial {
+            if since.IsZero() || ev.At.Before(since) { continue }
+            if err := printWatchEvent(p, ev); err != nil { return err }
+        }
+
+        ctx := cmd.Context()
+        ticker := time.NewTicker(interval)
+        defer ticker.Stop()
+        for {
+            select {
+            case <-ctx.Done():
+                return nil
+            case <-ticker.C:
+            }
+            events, err := poll()
+            if err != nil {
+                if ctx.Err() != nil { return nil }
+                ui.Warnf("polling %s failed, retrying: %v", iss.Identifier, err)
+                continue
+            }
+            for _, ev := range events {
+                if err := printWatchEvent(p, ev); err != nil { return err }
+            }
+        }
+    },
+}
+
+// watchEvent is one new comment or change on a watched issue
+type watchEvent struct {
+    At        time.Time `json:"at"`
+    Issue     string    `json:"issue"`
+    Kind      string    `json:"kind"`
+    Actor     string    `json:"actor"`
+    Text      string    `json:"text"`
+    CommentID string    `json:"commentId,omitempty"`
+}
+
+// newWatchEvents turns history entries and comments not yet in seen into
+// events, oldest first, and marks them seen
+func newWatchEvents(issue string, history []api.HistoryEntry, comments []api.Comment, seen map[string]bool) []watchEvent {
+    var out []watchEvent
+    for _, e := range history {
+        actor := "system"
+        if e.Actor != nil && e.Actor.Name != "" { actor = e.Actor.Name }
+        for _, change := range describeHistoryEntry(e) {
+            key := "h:" + e.At.UTC().Format(time.RFC3339Nano) + ":" + change
+            if seen[key] { continue }
+            seen[key] = true
+            out = append(out, watchEvent{At: e.At, Issue: issue, Kind: "change", Actor: actor, Text: change})
+        }
+    }
+    for _, c := range comments {
+        if seen["c:"+c.ID] { continue }
+        seen["c:"+c.ID] = true
+        actor := "unknown"
+        if c.User != nil && c.User.Name != "" { actor = c.User.Name }
+        out = append(out, watchEvent{At: c.CreatedAt, Issue: issue, Kind: "comment", Actor: actor, Text: strings.TrimSpace(c.Body), CommentID: c.ID})
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].At.Before(out[j].At) })
+    return out
+}
+
+func printWatchEvent(p output.Printer, ev watchEvent) error {
+    if p.JSONEnabled() { return p.StreamJSON(ev) }
+    when := ui.In(ev.At).Format("15:04:05")
+    if ev.Kind == "comment" {
+        lines := strings.Split(ev.Text, "\n")
+        fmt.Printf("%s  %s  %s commented: %s\n", when, ev.Issue, ev.Actor, lines[0])
+        for _, l := range lines[1:] { fmt.Printf("    %s\n", l) }
+        return nil
+    }
+    fmt.Printf("%s  %s  %s: %s\n", when, ev.Issue, ev.Actor, ev.Text)
+    return nil
+}
+
+func init() {
+    rootCmd.AddCommand(watchCmd)
+    watchCmd.AddCommand(watchIssueCmd)
+    watchIssueCmd.Flags().Duration("interval", 15*time.Second, "How often to poll for changes (at least 2s)")
+    watchIssueCmd.Flags().String("since", "", "Also print events since this time first (1h, yesterday, 2024-01-01)")
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+    "errors"
+    "fmt"
+    "strconv"
+    "strings"
+)
+
+// The YAML subset read by template front matter and --sections-file: a flat
+// mapping of keys (plain or quoted) to scalars (plain, quoted, or | and >
+// blocks) or to lists of scalars, written in flow ([a, "b c"]) or block style:
+//
+//   labels:
+//     - bug
+//     - needs triage
+//
+// Nested mappings, anchors and tags are rejected with the line they are on.
+
+// yamlEntry is one top-level key of a YAML subset document
+type yamlEntry struct {
+    Key  string
+    Line int
+    // Value is the scalar; List the items when IsList
+    Value  string
+    List   []string
+    IsList bool
+}
+
+// yamlError is a parse error on one line of the document
+type yamlError struct {
+    Line int
+    Msg  string
+}
+
+func (e *yamlError) Error() string { return fmt.Sprintf("line %d: %s", e.Line, e.Msg) }
+
+// parseYAMLSubset reads the top-level entries of src in order. A "---" first
+// line is skipped, so line numbers can match the file a block came from.
+func parseYAMLSubset(src string) ([]yamlEntry, error) {
+    lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+    var out []yamlEntry
+    seen := map[string]bool{}
+    indented := func(s string) bool { return strings.HasPrefix(s, " ") || strings.HasPrefix(s, "\t") }
+    isItem := func(s string) bool { t := strings.TrimSpace(s); return t == "-" || strings.HasPrefix(t, "- ") }
+    for i := 0; i < len(lines); i++ {
+        line := lines[i]
+        t := strings.TrimSpace(line)
+        if t == "" || strings.HasPrefix(t, "#") || (t == "---" && i == 0) { continue }
+        if indented(line) { return nil, &yamlError{i + 1, "unexpected indentation"} }
+        key, rest, ok := splitYAMLKey(line)
+        if !ok { return nil, &yamlError{i + 1, fmt.Sprintf("expected 'key: value', got %q", t)} }
+        if seen[key] { return nil, &yamlError{i + 1, fmt.Sprintf("key '%s' appears twice", key)} }
+        seen[key] = true
+        e := yamlEntry{Key: key, Line: i + 1}
+        // Indented lines that follow belong to this value, as do the unindented
+        // "- item" lines of a block list
+        j := i + 1
+        for j < len(lines) && (strings.TrimSpace(lines[j]) == "" || indented(lines[j]) || (rest == "" && isItem(lines[j]))) { j++ }
+        block := lines[i+1 : j]
+        for len(block) > 0 && strings.TrimSpace(block[len(block)-1]) == "" { block = block[:len(block)-1] }
+        i = j - 1
+
+        var err error
+        switch {
+        case strings.HasPrefix(rest, "|") || strings.HasPrefix(rest, ">"):
+            e.Value = yamlBlockScalar(block, rest[0] == '>')
+        case rest == "" && len(block) > 0:
+            e.IsList = true
+            e.List, err = yamlBlockList(block)
+        case strings.HasPrefix(rest, "["):
+            e.IsList = true
+            e.List, err = yamlFlowList(yamlFold(rest, block))
+        case strings.HasPrefix(rest, "{") || strings.HasPrefix(rest, "&") || strings.HasPrefix(rest, "*") || strings.HasPrefix(rest, "!"):
+            err = errors.New("mappings, anchors and tags are not supported")
+        case strings.HasPrefix(rest, "\"") || strings.HasPrefix(rest, "'"):
+            e.Value, err = yamlQuoted(yamlFold(rest, block))
+        default:
+            if k := strings.Index(rest, " #"); k >= 0 { rest = strings.TrimSpace(rest[:k]) }
+            e.Value = strings.TrimSpace(yamlFold(rest, block))
+        }
+        if err != nil { return nil, &yamlError{e.Line, fmt.Sprintf("%s: %v", key, err)} }
+        out = append(out, e)
+    }
+    return out, nil
+}
+
+// yamlBlockList reads the "- item" lines of a block list
+func yamlBlockList(block []string) ([]string, error) {
+    var out []string
+    for _, l := range block {
+        t := strings.TrimSpace(l)
+        if t == "" || strings.HasPrefix(t, "#") { continue }
+        if t != "-" && !strings.HasPrefix(t, "- ") { return nil, errors.New("nested values are not supported; use 'key: |' for multi-line text or '- item' lines for a list") }
+        v, err := yamlItem(strings.TrimSpace(strings.TrimPrefix(t, "-")))
+        if err != nil { return nil, err }
+        out = append(out, v)
+    }
+    return out, nil
+}
+
+// yamlFlowList reads "[a, 'b, c', "d"]"; a trailing comment is allowed
+func yamlFlowList(s string) ([]string, error) {
+    s = strings.TrimSpace(s)
+    var out []string
+    var cur strings.Builder
+    var quote byte
+    flush := func() error {
+        v := strings.TrimSpace(cur.String())
+        cur.Reset()
+        if v == "" { return nil }
+        v, err := yamlItem(v)
+        if err == nil { out = append(out, v) }
+        return err
+    }
+    for i := 1; i < len(s); i++ {
+        c := s[i]
+        switch {
+        case quote != 0:
+            cur.WriteByte(c)
+            if c == '\\' && quote == '"' && i+1 < len(s) {
+                i++
+                cur.WriteByte(s[i])
+            } else if c == quote {
+                quote = 0
+            }
+        case c == '"' || c == '\'':
+            quote = c
+            cur.WriteByte(c)
+        case c == '[' || c == '{':
+            return nil, errors.New("nested lists and mappings are not supported")
+        case c == ',':
+            if err := flush(); err != nil { return nil, err }
+        case c == ']':
+            if err := flush(); err != nil { return nil, err }
+            if after := strings.TrimSpace(s[i+1:]); after != "" && !strings.HasPrefix(after, "#") { return nil, fmt.Errorf("unexpected %q after the list", after) }
+            return out, nil
+        default:
+            cur.WriteByte(c)
+        }
+    }
+    return nil, errors.New("list is not closed by ']'")
+}
+
+// yamlItem reads one list item: a plain or quoted scalar
+func yamlItem(v string) (string, error) {
+    if strings.HasPrefix(v, "\"") || strings.HasPrefix(v, "'") { return yamlQuoted(v) }
+    if k := strings.Index(v, " #"); k >= 0 { v = strings.TrimSpace(v[:k]) }
+    if strings.HasPrefix(v, "[") || strings.HasPrefix(v, "{") || strings.HasSuffix(v, ":") || strings.Contains(v, ": ") { return "", errors.New("nested lists and mappings are not supported") }
+    return v, nil
+}
+
+// yamlFlowListString writes items as a flow list that yamlFlowList reads back
+func yamlFlowListString(items []string) string {
+    out := make([]string, len(items))
+    for i, v := range items {
+        out[i] = v
+        if v != strings.TrimSpace(v) || strings.ContainsAny(v, ",[]{}\"'#:") { out[i] = strconv.Quote(v) }
+    }
+    return "[" + strings.Join(out, ", ") + "]"
+}
+
+// splitYAMLKey splits "key: rest" (the key optionally quoted)
+func splitYAMLKey(line string) (string, string, bool) {
+    if strings.HasPrefix(line, "\"") || strings.HasPrefix(line, "'") {
+        end := strings.Index(line[1:], line[:1])
+        if end < 0 { return "", "", false }
+        key, after := line[1:end+1], line[end+2:]
+        if !strings.HasPrefix(after, ":") { return "", "", false }
+        return key, strings.TrimSpace(after[1:]), true
+    }
+    k := strings.Index(line, ": ")
+    if k < 0 && strings.HasSuffix(line, ":") { k = len(line) - 1 }
+    if k <= 0 { return "", "", false }
+    return strings.TrimSpace(line[:k]), strings.TrimSpace(line[k+1:]), true
+}
+
+// yamlBlockScalar strips the block's common indentation; folded (>) blocks join
+// lines with spaces and keep blank lines as paragraph breaks
+func yamlBlockScalar(block []string, folded bool) string {
+    indent := -1
+    for _, l := range block {
+        if strings.TrimSpace(l) == "" { continue }
+        n := len(l) - len(strings.TrimLeft(l, " \t"))
+        if indent < 0 || n < indent { indent = n }
+    }
+    out := make([]string, len(block))
+    for i, l := range block {
+        if len(l) >= indent && indent > 0 { l = l[indent:] } else { l = strings.TrimLeft(l, " \t") }
+        out[i] = strings.TrimRight(l, " \t")
+    }
+    if !folded { return strings.Join(out, "\n") }
+    var b strings.Builder
+    for i, l := range out {
+        switch {
+        case l == "":
+            b.WriteString("\n")
+        case i > 0 && out[i-1] != "":
+            b.WriteString(" " + l)
+        default:
+            b.WriteString(l)
+        }
+    }
+    return b.String()
+}
+
+// yamlFold joins the continuation lines of a plain or quoted scalar with spaces
+func yamlFold(first string, block []string) string {
+    parts := []string{first}
+    for _, l := range block {
+        if t := strings.TrimSpace(l); t != "" { parts = append(parts, t) }
+    }
+    return strings.Join(parts, " ")
+}
+
+func yamlQuoted(s string) (string, error) {
+    s = strings.TrimSpace(s)
+    if k := strings.LastIndex(s, s[:1]); k > 0 && k < len(s)-1 && strings.HasPrefix(strings.TrimSpace(s[k+1:]), "#") { s = s[:k+1] }
+    if len(s) < 2 || s[len(s)-1] != s[0] { return "", fmt.Errorf("unterminated quoted value") }
+    if s[0] == '\'' { return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil }
+    v, err := strconv.Unquote(s)
+    if err != nil { return "", fmt.Errorf("invalid double-quoted value") }
+    return v, nil
+}
+
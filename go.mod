@@ -1,17 +1,17 @@
 module linear-cli
 
-go 1.23.0
+go 1.24.0
 
 toolchain go1.24.2
 
 require (
 	github.com/BurntSushi/toml v1.4.0
-	github.com/spf13/cobra v1.8.0
+	github.com/spf13/cobra v1.8.1
+	github.com/spf13/pflag v1.0.6
+	go.etcd.io/bbolt v1.4.3
+	golang.org/x/sync v0.10.0
+	golang.org/x/sys v0.35.0
 	golang.org/x/term v0.34.0
 )
 
-require (
-	github.com/inconshreveable/mousetrap v1.1.0 // indirect
-	github.com/spf13/pflag v1.0.5 // indirect
-	golang.org/x/sys v0.35.0 // indirect
-)
+require github.com/inconshreveable/mousetrap v1.1.0 // indirect
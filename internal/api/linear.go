@@ -1,13 +1,18 @@
 package api
 
 import (
-	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -18,6 +23,74 @@ type Client struct {
 	endpoint   string
     allowedMutations map[string]struct{}
     supportsTemplates *bool
+    ctx         context.Context
+    maxAttempts int
+    // debugf, when set, traces requests and retries
+    debugf func(format string, a ...interface{})
+    // dryRun, when set, receives mutations instead of the server
+    dryRun func(operation, query string, variables map[string]interface{})
+    // onMutation, when set, is told about each mutation the server accepted
+    onMutation func(operation string)
+    // readOnly, when set, is why mutations are refused without being sent;
+    // onWriteDenied is told when the server rejects a mutation for scope
+    readOnly      string
+    onWriteDenied func()
+    // ownComment is the one comment DeleteOwnComment checked the viewer wrote;
+    // commentDelete passes the delete guard for it and nothing else
+    ownComment string
+    // onRequest, when set, is told about each request sent (see WithRequestStats)
+    onRequest func(RequestStat)
+}
+
+// RequestStat describes one API request as sent, retries included
+type RequestStat struct {
+    Operation string
+    Duration  time.Duration
+    // Attempts is 1 plus the number of retries
+    Attempts int
+    // Status is the HTTP status of the last attempt; 0 when none was received
+    Status int
+    Error  string
+    // Complexity is the cost Linear charged for the query (X-Complexity), and
+    // RateLimit the budget left after it; both are zero when not reported
+    Complexity int
+    RateLimit  *RateLimit
+}
+
+// RateLimit is the budget Linear reports with each response (X-RateLimit-*
+// headers): requests and query complexity allowed per window, what is left of
+// them, and when the windows reset
+type RateLimit struct {
+    RequestsLimit       int       `json:"requestsLimit"`
+    RequestsRemaining   int       `json:"requestsRemaining"`
+    RequestsReset       time.Time `json:"requestsReset"`
+    ComplexityLimit     int       `json:"complexityLimit"`
+    ComplexityRemaining int       `json:"complexityRemaining"`
+    ComplexityReset     time.Time `json:"complexityReset"`
+}
+
+// parseRateLimit reads the rate limit headers; nil when there are none
+func parseRateLimit(h http.Header) *RateLimit {
+    num := func(name string) int {
+        n, _ := strconv.Atoi(strings.TrimSpace(h.Get(name)))
+        return n
+    }
+    // Resets are epoch milliseconds; accept seconds too
+    reset := func(name string) time.Time {
+        n, err := strconv.ParseInt(strings.TrimSpace(h.Get(name)), 10, 64)
+        if err != nil || n <= 0 { return time.Time{} }
+        if n < 1e12 { return time.Unix(n, 0) }
+        return time.UnixMilli(n)
+    }
+    if h.Get("X-RateLimit-Requests-Limit") == "" && h.Get("X-RateLimit-Complexity-Limit") == "" { return nil }
+    return &RateLimit{
+        RequestsLimit:       num("X-RateLimit-Requests-Limit"),
+        RequestsRemaining:   num("X-RateLimit-Requests-Remaining"),
+        RequestsReset:       reset("X-RateLimit-Requests-Reset"),
+        ComplexityLimit:     num("X-RateLimit-Complexity-Limit"),
+        ComplexityRemaining: num("X-RateLimit-Complexity-Remaining"),
+        ComplexityReset:     reset("X-RateLimit-Complexity-Reset"),
+    }
 }
 
 type gqlRequest struct {
@@ -26,7 +99,11 @@ type gqlRequest struct {
 }
 
 type gqlError struct {
-	Message string `json:"message"`
+	Message    string `json:"message"`
+	Extensions struct {
+		Type string `json:"type"`
+		Code string `json:"code"`
+	} `json:"extensions"`
 }
 
 type gqlResponse struct {
@@ -60,18 +137,139 @@ func NewClient(apiKey string) *Client {
     if v := os.Getenv("LINEAR_API_ENDPOINT"); strings.TrimSpace(v) != "" {
         endpoint = strings.TrimSpace(v)
     }
+    maxAttempts := defaultMaxAttempts
+    if v, err := strconv.Atoi(strings.TrimSpace(os.Getenv("LINEAR_MAX_ATTEMPTS"))); err == nil && v > 0 {
+        maxAttempts = v
+    }
     return &Client{
-        httpClient: &http.Client{Timeout: 15 * time.Second},
+        httpClient: newHTTPClient(),
         apiKey:     apiKey,
         endpoint:   endpoint,
+        ctx:         context.Background(),
+        maxAttempts: maxAttempts,
         allowedMutations: map[string]struct{}{
             "issueCreate": {},
             "issueUpdate": {},
+            "projectUpdate": {},
             "commentCreate": {},
+            "commentUpdate": {},
+            "reactionCreate": {},
+            "commentResolve": {},
+            "commentUnresolve": {},
+            "issueSubscribe": {},
+            "issueUnsubscribe": {},
+            "attachmentLinkURL": {},
+            "issueRelationCreate": {},
+            "templateCreate": {},
+            "templateUpdate": {},
+            "documentCreate": {},
+            "documentUpdate": {},
+            "workflowStateCreate": {},
+            "workflowStateUpdate": {},
+            "issueLabelCreate": {},
+            "favoriteCreate": {},
+            "teamCreate": {},
+            "teamUpdate": {},
         },
     }
 }
 
+// WithContext returns a copy of the client whose requests are bound to ctx, so
+// cancelling it (e.g. on Ctrl-C) aborts in-flight requests and retry backoff.
+func (c *Client) WithContext(ctx context.Context) *Client {
+    cp := *c
+    if ctx != nil { cp.ctx = ctx }
+    return &cp
+}
+
+// WithEndpoint returns a copy of the client that sends requests to endpoint
+// (e.g. a self-hosted proxy) instead of the default or LINEAR_API_ENDPOINT.
+func (c *Client) WithEndpoint(endpoint string) *Client {
+    cp := *c
+    if e := strings.TrimSpace(endpoint); e != "" { cp.endpoint = e }
+    return &cp
+}
+
+// WithTimeout returns a copy of the client whose requests time out after d per attempt
+func (c *Client) WithTimeout(d time.Duration) *Client {
+    cp := *c
+    if d > 0 { cp.httpClient = newHTTPClientWithTimeout(d) }
+    return &cp
+}
+
+// WithDebugLog returns a copy of the client that traces each request (operation,
+// status, duration) and retry through logf
+func (c *Client) WithDebugLog(logf func(format string, a ...interface{})) *Client {
+    cp := *c
+    cp.debugf = logf
+    return &cp
+}
+
+// ErrDryRun is returned in place of a mutation's result in dry-run mode
+var ErrDryRun = errors.New("dry run: mutation not sent")
+
+// WithDryRun returns a copy of the client that passes each mutation, after the
+// allowlist checks, to show and returns ErrDryRun instead of sending it. Queries
+// still run, so the variables shown carry resolved IDs.
+func (c *Client) WithDryRun(show func(operation, query string, variables map[string]interface{})) *Client {
+    cp := *c
+    cp.dryRun = show
+    return &cp
+}
+
+// WithReadOnly returns a copy of the client that refuses mutations with a
+// ReadOnlyError explaining reason; an empty reason lifts the restriction.
+// --dry-run still shows mutations, since it sends none.
+func (c *Client) WithReadOnly(reason string) *Client {
+    cp := *c
+    cp.readOnly = reason
+    return &cp
+}
+
+// ReadOnly returns why the client refuses mutations, or "" when it does not
+func (c *Client) ReadOnly() string { return c.readOnly }
+
+// WithWriteDeniedHook returns a copy of the client that calls fn when the
+// server rejects a mutation because the token lacks the write scope
+func (c *Client) WithWriteDeniedHook(fn func()) *Client {
+    cp := *c
+    cp.onWriteDenied = fn
+    return &cp
+}
+
+// WithMutationHook returns a copy of the client that calls fn after each mutation
+// the server accepted
+func (c *Client) WithMutationHook(fn func(operation string)) *Client {
+    cp := *c
+    cp.onMutation = fn
+    return &cp
+}
+
+// WithRequestStats returns a copy of the client that calls fn after each
+// request with its operation, duration and attempts
+func (c *Client) WithRequestStats(fn func(RequestStat)) *Client {
+    cp := *c
+    cp.onRequest = fn
+    return &cp
+}
+
+// noteRequest reports a finished request to the WithRequestStats hook
+func (c *Client) noteRequest(op string, start time.Time, tries int, resp *http.Response, err error) {
+    if c.onRequest == nil { return }
+    st := RequestStat{Operation: op, Duration: time.Since(start), Attempts: tries}
+    if resp != nil {
+        st.Status = resp.StatusCode
+        st.Complexity, _ = strconv.Atoi(strings.TrimSpace(resp.Header.Get("X-Complexity")))
+        st.RateLimit = parseRateLimit(resp.Header)
+    }
+    if err != nil { st.Error = err.Error() }
+    c.onRequest(st)
+}
+
+func (c *Client) debug(format string, a ...interface{}) {
+    if c.debugf != nil { c.debugf(format, a...) }
+}
+
 // SupportsIssueTemplates performs a lightweight introspection check and caches the result.
 func (c *Client) SupportsIssueTemplates() bool {
     if c.supportsTemplates != nil { return *c.supportsTemplates }
@@ -84,70 +282,61 @@ func (c *Client) SupportsIssueTemplates() bool {
 }
 
 func (c *Client) do(query string, variables map[string]interface{}, out interface{}) error {
+    return c.doContext(c.ctx, query, variables, out)
+}
+
+func (c *Client) doContext(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
     // Guard: forbid delete/archive operations and enforce allowlist
     if isMutation(query) {
-        if containsDangerousOperation(query) {
+        names := mutationSelectionNames(query)
+        ownDelete := c.isOwnCommentDelete(names, variables)
+        if containsDangerousOperation(query) && !ownDelete {
             return errors.New("operation rejected: delete/archive mutations are not allowed")
         }
-        names := mutationSelectionNames(query)
         if len(names) == 0 {
             return errors.New("invalid mutation: no selections")
         }
         for _, n := range names {
-            if _, ok := c.allowedMutations[n]; !ok {
+            if _, ok := c.allowedMutations[n]; !ok && !ownDelete {
                 return fmt.Errorf("mutation '%s' is not allowed", n)
             }
         }
+        if c.dryRun != nil {
+            c.dryRun(operationName(query), query, variables)
+            return ErrDryRun
+        }
+        if c.readOnly != "" { return &ReadOnlyError{Operation: operationName(query), Reason: c.readOnly} }
     }
 
     payload := gqlRequest{Query: query, Variables: variables}
     buf, err := json.Marshal(payload)
     if err != nil { return err }
 
-    var resp *http.Response
-    for attempt := 0; attempt < 4; attempt++ {
-        req, err := http.NewRequest("POST", c.endpoint, bytes.NewReader(buf))
-        if err != nil { return err }
-        req.Header.Set("Content-Type", "application/json")
-        // Linear expects raw API key in the Authorization header
-        req.Header.Set("Authorization", c.apiKey)
-
-        resp, err = c.httpClient.Do(req)
-        if err != nil {
-            if attempt == 3 { return err }
-            backoffSleep(attempt)
-            continue
-        }
-        if resp.StatusCode == 429 || (resp.StatusCode >= 500 && resp.StatusCode < 600) {
-            ra := resp.Header.Get("Retry-After")
-            resp.Body.Close()
-            sleepForRetryAfterOrBackoff(ra, attempt)
-            continue
-        }
-        break
-    }
-    if resp == nil { return errors.New("no response from Linear API") }
+    start := time.Now()
+    resp, tries, err := c.send(ctx, buf)
+    c.noteRequest(operationName(query), start, tries, resp, err)
+    if err != nil {
+        c.debug("%s failed after %s: %v", operationName(query), time.Since(start).Round(time.Millisecond), err)
+        return err
+    }
     defer resp.Body.Close()
+    c.debug("%s: %s in %s", operationName(query), resp.Status, time.Since(start).Round(time.Millisecond))
     if resp.StatusCode >= 400 {
-        // Try to decode GraphQL errors for a clearer message, otherwise include body text
+        // Decode GraphQL errors for a clearer message when the body carries them
         var gr gqlResponse
-        dec := json.NewDecoder(resp.Body)
-        if err := dec.Decode(&gr); err == nil && (len(gr.Errors) > 0 || len(gr.Data) > 0) {
-            if len(gr.Errors) > 0 {
-                return fmt.Errorf("linear api error: %s: %s", resp.Status, gr.Errors[0].Message)
-            }
-            return fmt.Errorf("linear api error: %s", resp.Status)
+        if err := json.NewDecoder(resp.Body).Decode(&gr); err == nil && len(gr.Errors) > 0 {
+            if se := scopeError(query, gr.Errors[0], resp.StatusCode); se != nil { return c.scopeDenied(se) }
+            return fmt.Errorf("linear api error: %s: %s", resp.Status, gr.Errors[0].Message)
         }
-        // Fallback: read raw body
-        // Note: resp.Body has been partially read by decoder above only if it succeeded; otherwise we read remaining.
-        // To be robust, we re-issue the request body content from original buffer in future improvements.
-        var raw map[string]any
-        _ = json.NewDecoder(resp.Body).Decode(&raw)
         return fmt.Errorf("linear api error: %s", resp.Status)
     }
     var gr gqlResponse
     if err := json.NewDecoder(resp.Body).Decode(&gr); err != nil { return err }
-    if len(gr.Errors) > 0 { return errors.New(gr.Errors[0].Message) }
+    if len(gr.Errors) > 0 {
+        if se := scopeError(query, gr.Errors[0], resp.StatusCode); se != nil { return c.scopeDenied(se) }
+        return errors.New(gr.Errors[0].Message)
+    }
+    if c.onMutation != nil && isMutation(query) { c.onMutation(operationName(query)) }
     if out != nil && len(gr.Data) > 0 { return json.Unmarshal(gr.Data, out) }
     return nil
 }
@@ -159,7 +348,34 @@ var (
 )
 
 func isMutation(q string) bool { return reMutation.MatchString(q) }
-func containsDangerousOperation(q string) bool { return reDelete.MatchString(q) }
+
+var reFirstField = regexp.MustCompile(`\{\s*(\w+)`)
+
+// operationName names a request in debug logs by its first top-level field,
+// e.g. "query issue" or "mutation issueUpdate"
+func operationName(q string) string {
+    kind := "query"
+    if isMutation(q) { kind = "mutation" }
+    if m := reFirstField.FindStringSubmatch(q); m != nil { return kind + " " + m[1] }
+    return kind
+}
+// containsDangerousOperation reports delete or archive in a mutation, as a word
+// or inside a mutation name such as issueDelete
+func containsDangerousOperation(q string) bool {
+    if reDelete.MatchString(q) { return true }
+    for _, n := range mutationSelectionNames(q) {
+        if l := strings.ToLower(n); strings.Contains(l, "delete") || strings.Contains(l, "archive") { return true }
+    }
+    return false
+}
+
+// isOwnCommentDelete reports the single commentDelete of the comment
+// DeleteOwnComment vetted
+func (c *Client) isOwnCommentDelete(names []string, variables map[string]interface{}) bool {
+    if c.ownComment == "" || len(names) != 1 || names[0] != "commentDelete" { return false }
+    id, _ := variables["id"].(string)
+    return id == c.ownComment
+}
 func mutationSelectionNames(q string) []string {
     m := reSelBlock.FindStringSubmatch(q)
     if len(m) < 2 { return nil }
@@ -185,16 +401,6 @@ func mutationSelectionNames(q string) []string {
     return names
 }
 
-func backoffSleep(attempt int) { time.Sleep(time.Duration(250*(1<<attempt)) * time.Millisecond) }
-func sleepForRetryAfterOrBackoff(retryAfter string, attempt int) {
-    if retryAfter == "" { backoffSleep(attempt); return }
-    if d, err := time.ParseDuration(retryAfter + "s"); err == nil { time.Sleep(d); return }
-    if t, err := time.Parse(time.RFC1123, retryAfter); err == nil {
-        if dur := time.Until(t); dur > 0 { time.Sleep(dur); return }
-    }
-    backoffSleep(attempt)
-}
-
 func (c *Client) Viewer() (*Viewer, error) {
 	const q = `query { viewer { id name email } }`
 	var resp struct {
@@ -206,6 +412,49 @@ func (c *Client) Viewer() (*Viewer, error) {
 	return &resp.Viewer, nil
 }
 
+// CacheScope identifies the workspace credentials (endpoint and API key) without
+// exposing the key, so local caches never mix data across workspaces.
+func (c *Client) CacheScope() string {
+    sum := sha256.Sum256([]byte(c.endpoint + "\x00" + c.apiKey))
+    return hex.EncodeToString(sum[:8])
+}
+
+// Endpoint returns the GraphQL endpoint this client talks to
+func (c *Client) Endpoint() string { return c.endpoint }
+
+// ServerTime issues a trivial query and returns the time reported by the API's
+// Date header, for detecting local clock skew.
+func (c *Client) ServerTime() (time.Time, error) {
+    h, _, err := c.ping()
+    if err != nil { return time.Time{}, err }
+    date := h.Get("Date")
+    if date == "" { return time.Time{}, errors.New("response carried no Date header") }
+    return http.ParseTime(date)
+}
+
+// RateLimits asks for the current rate limit budget with the cheapest query
+// there is (which itself counts against it). Returns nil when the server does
+// not report limits.
+func (c *Client) RateLimits() (*RateLimit, error) {
+    h, status, err := c.ping()
+    if err != nil { return nil, err }
+    if status >= 400 { return nil, fmt.Errorf("linear api error: %d %s", status, http.StatusText(status)) }
+    return parseRateLimit(h), nil
+}
+
+// ping sends `query{ __typename }` and returns the response headers and status
+func (c *Client) ping() (http.Header, int, error) {
+    buf, err := json.Marshal(gqlRequest{Query: `query{ __typename }`})
+    if err != nil { return nil, 0, err }
+    start := time.Now()
+    resp, tries, err := c.send(c.ctx, buf)
+    c.noteRequest("query __typename", start, tries, resp, err)
+    if err != nil { return nil, 0, err }
+    defer resp.Body.Close()
+    _, _ = io.Copy(io.Discard, resp.Body)
+    return resp.Header, resp.StatusCode, nil
+}
+
 func (c *Client) TeamByKey(key string) (*Team, error) {
 	const q = `query($key:String!){ teams(filter:{ key:{ eq:$key } }, first:1){ nodes{ id key name } } }`
 	var resp struct {
@@ -369,8 +618,10 @@ type User struct {
 }
 
 type Label struct {
-    ID   string `json:"id"`
-    Name string `json:"name"`
+    ID    string `json:"id"`
+    Name  string `json:"name"`
+    Color string `json:"color,omitempty"`
+    Team  *Team  `json:"team,omitempty"`
 }
 
 // IssueTemplate represents a team-scoped template in Linear (if supported by schema)
@@ -493,6 +744,34 @@ func (c *Client) ResolveProject(input string) (*Project, error) {
     return &Project{ID: n.ID, Name: n.Name, State: n.State, TeamID: teamID}, nil
 }
 
+// SetProjectStatus moves a project to the first workspace project status of the
+// given type (backlog, planned, started, paused, completed, canceled). Schemas
+// without project statuses fall back to the legacy state field.
+func (c *Client) SetProjectStatus(projectID, statusType string) (*Project, error) {
+    input := map[string]interface{}{"state": statusType}
+    {
+        const q = `query{ projectStatuses{ nodes{ id name type position } } }`
+        var resp struct{ ProjectStatuses struct{ Nodes []struct{ ID, Name, Type string; Position float64 } `json:"nodes"` } `json:"projectStatuses"` }
+        if err := c.do(q, nil, &resp); err == nil {
+            bestID, bestPos := "", 0.0
+            for _, n := range resp.ProjectStatuses.Nodes {
+                if n.Type == statusType && (bestID == "" || n.Position < bestPos) { bestID, bestPos = n.ID, n.Position }
+            }
+            if bestID != "" { input = map[string]interface{}{"statusId": bestID} }
+        }
+    }
+    const q = `mutation($id:String!,$input:ProjectUpdateInput!){ projectUpdate(id:$id, input:$input){ success project{ id name state url } } }`
+    var resp struct {
+        ProjectUpdate struct {
+            Success bool     `json:"success"`
+            Project *Project `json:"project"`
+        } `json:"projectUpdate"`
+    }
+    if err := c.do(q, map[string]interface{}{"id": projectID, "input": input}, &resp); err != nil { return nil, err }
+    if !resp.ProjectUpdate.Success || resp.ProjectUpdate.Project == nil { return nil, errors.New("project update failed") }
+    return resp.ProjectUpdate.Project, nil
+}
+
 // ResolveUser resolves a user by id, or by name/email (single match)
 func (c *Client) ResolveUser(input string) (*User, error) {
     {
@@ -509,15 +788,112 @@ func (c *Client) ResolveUser(input string) (*User, error) {
     return &u, nil
 }
 
-// ResolveLabelByName resolves a label by exact name
+// SearchUsers returns up to 10 users whose name or display name contains q or
+// whose email is q, for callers that disambiguate themselves
+func (c *Client) SearchUsers(q string) ([]User, error) {
+    const query = `query($q:String!){ users(filter:{ or:[{ name:{ containsIgnoreCase:$q } }, { displayName:{ containsIgnoreCase:$q } }, { email:{ eqIgnoreCase:$q } }] }, first:10){ nodes{ id name email } } }`
+    var resp struct { Users struct{ Nodes []User `json:"nodes"` } `json:"users"` }
+    if err := c.do(query, map[string]interface{}{"q": q}, &resp); err != nil { return nil, err }
+    return resp.Users.Nodes, nil
+}
+
+// ProjectPeople is a project's lead and members
+type ProjectPeople struct {
+    Project Project `json:"project"`
+    Lead    *User   `json:"lead"`
+    Members []User  `json:"members"`
+}
+
+// GetProjectPeople returns the lead and members of a project
+func (c *Client) GetProjectPeople(projectID string) (*ProjectPeople, error) {
+    const q = `query($id:String!){ project(id:$id){ id name state url lead{ id name email } members(first:250){ nodes{ id name email } } } }`
+    var resp struct{ Project *struct{ Project; Lead *User `json:"lead"`; Members struct{ Nodes []User `json:"nodes"` } `json:"members"` } `json:"project"` }
+    if err := c.do(q, map[string]interface{}{"id": projectID}, &resp); err != nil { return nil, err }
+    if resp.Project == nil { return nil, fmt.Errorf("project %s not found", projectID) }
+    return &ProjectPeople{Project: resp.Project.Project, Lead: resp.Project.Lead, Members: resp.Project.Members.Nodes}, nil
+}
+
+// SetProjectMembers replaces a project's members with memberIDs
+func (c *Client) SetProjectMembers(projectID string, memberIDs []string) (*ProjectPeople, error) {
+    if memberIDs == nil { memberIDs = []string{} }
+    return c.updateProjectPeople(projectID, map[string]interface{}{"memberIds": memberIDs})
+}
+
+// SetProjectLead makes userID the project's lead
+func (c *Client) SetProjectLead(projectID, userID string) (*ProjectPeople, error) {
+    return c.updateProjectPeople(projectID, map[string]interface{}{"leadId": userID})
+}
+
+func (c *Client) updateProjectPeople(projectID string, input map[string]interface{}) (*ProjectPeople, error) {
+    const q = `mutation($id:String!,$input:ProjectUpdateInput!){ projectUpdate(id:$id, input:$input){ success project{ id name state url lead{ id name email } members(first:250){ nodes{ id name email } } } } }`
+    var resp struct {
+        ProjectUpdate struct {
+            Success bool `json:"success"`
+            Project *struct{ Project; Lead *User `json:"lead"`; Members struct{ Nodes []User `json:"nodes"` } `json:"members"` } `json:"project"`
+        } `json:"projectUpdate"`
+    }
+    if err := c.do(q, map[string]interface{}{"id": projectID, "input": input}, &resp); err != nil { return nil, err }
+    if !resp.ProjectUpdate.Success || resp.ProjectUpdate.Project == nil { return nil, errors.New("project update failed") }
+    pr := resp.ProjectUpdate.Project
+    return &ProjectPeople{Project: pr.Project, Lead: pr.Lead, Members: pr.Members.Nodes}, nil
+}
+
+// ResolveLabelByName resolves a label by exact name. When the name exists both
+// as a workspace label and in teams, the workspace label wins; use
+// ResolveLabelForTeam when the issue's team is known.
 func (c *Client) ResolveLabelByName(name string) (*Label, error) {
-    const q = `query($name:String!){ issueLabels(filter:{ name:{ eq:$name } }, first:2){ nodes{ id name } } }`
+    return c.ResolveLabelForTeam(name, "")
+}
+
+// ResolveLabelForTeam resolves a label by exact name among those usable on
+// teamID's issues: the team's own label is preferred over a workspace label of
+// the same name, and other teams' labels are ignored. With an empty teamID only
+// workspace labels disambiguate; labels that exist only in several teams are an error.
+func (c *Client) ResolveLabelForTeam(name, teamID string) (*Label, error) {
+    const q = `query($name:String!){ issueLabels(filter:{ name:{ eq:$name } }, first:50){ nodes{ id name color team{ id key name } } } }`
     var resp struct { IssueLabels struct{ Nodes []Label `json:"nodes"` } `json:"issueLabels"` }
     if err := c.do(q, map[string]interface{}{"name": name}, &resp); err != nil { return nil, err }
-    if len(resp.IssueLabels.Nodes) == 0 { return nil, nil }
-    if len(resp.IssueLabels.Nodes) > 1 { return nil, fmt.Errorf("multiple labels named '%s'", name) }
-    l := resp.IssueLabels.Nodes[0]
-    return &l, nil
+    return pickLabel(resp.IssueLabels.Nodes, name, teamID)
+}
+
+// pickLabel chooses among same-named labels by scope (see ResolveLabelForTeam)
+func pickLabel(nodes []Label, name, teamID string) (*Label, error) {
+    var team, workspace, other []Label
+    for _, l := range nodes {
+        switch {
+        case l.Team == nil:
+            workspace = append(workspace, l)
+        case teamID != "" && l.Team.ID == teamID:
+            team = append(team, l)
+        default:
+            other = append(other, l)
+        }
+    }
+    for _, set := range [][]Label{team, workspace} {
+        if len(set) == 1 { return &set[0], nil }
+        if len(set) > 1 { return nil, fmt.Errorf("multiple labels named '%s'", name) }
+    }
+    if teamID != "" || len(other) == 0 { return nil, nil }
+    if len(other) == 1 { return &other[0], nil }
+    keys := make([]string, 0, len(other))
+    for _, l := range other { keys = append(keys, l.Team.Key) }
+    return nil, fmt.Errorf("multiple labels named '%s' (teams %s); pass --team to pick one", name, strings.Join(keys, ", "))
+}
+
+// DefaultLabelColor is the color given to labels created without one
+const DefaultLabelColor = "#95a2b3"
+
+// CreateLabel creates a label scoped to teamID (a workspace label when teamID is
+// empty) with color, or DefaultLabelColor
+func (c *Client) CreateLabel(teamID, name, color string) (*Label, error) {
+    const q = `mutation($input:IssueLabelCreateInput!){ issueLabelCreate(input:$input){ success issueLabel{ id name color team{ id key name } } } }`
+    if strings.TrimSpace(color) == "" { color = DefaultLabelColor }
+    input := map[string]interface{}{"name": name, "color": color}
+    if teamID != "" { input["teamId"] = teamID }
+    var resp struct{ IssueLabelCreate struct{ Success bool `json:"success"`; IssueLabel *Label `json:"issueLabel"` } `json:"issueLabelCreate"` }
+    if err := c.do(q, map[string]interface{}{"input": input}, &resp); err != nil { return nil, err }
+    if !resp.IssueLabelCreate.Success || resp.IssueLabelCreate.IssueLabel == nil { return nil, errors.New("label creation failed") }
+    return resp.IssueLabelCreate.IssueLabel, nil
 }
 
 // ListIssueLabels returns up to 200 labels accessible to the token
@@ -537,22 +913,44 @@ type IssueDetails struct {
     Description string  `json:"description"`
     URL        string   `json:"url"`
     StateName  string   `json:"stateName"`
+    StateType  string   `json:"stateType,omitempty"`
+    Priority   int      `json:"priority"`
     Assignee   *User    `json:"assignee,omitempty"`
     Labels     []Label  `json:"labels"`
     Project    *Project `json:"project,omitempty"`
+    Team       *Team    `json:"team,omitempty"`
+    BranchName string   `json:"branchName,omitempty"`
     Comments   []Comment `json:"comments,omitempty"`
+    // UpdatedAt is set by issue listings
+    UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// issueDetailFields selects what IssueDetails holds; issueDetailNode decodes it
+const issueDetailFields = `id identifier title description url branchName state{ name } priority assignee{ id name email } labels{ nodes{ id name } } project{ id name state } team{ id key name }`
+
+type issueDetailNode struct {
+    ID, Identifier, Title, Description, URL, BranchName string
+    State    struct{ Name string `json:"name"` } `json:"state"`
+    Priority int                                 `json:"priority"`
+    Assignee *User                               `json:"assignee"`
+    Labels   struct{ Nodes []Label `json:"nodes"` } `json:"labels"`
+    Project  *struct{ ID, Name, State string }   `json:"project"`
+    Team     *Team                               `json:"team"`
+}
+
+func (n issueDetailNode) details() *IssueDetails {
+    var proj *Project
+    if n.Project != nil { proj = &Project{ID: n.Project.ID, Name: n.Project.Name, State: n.Project.State} }
+    return &IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, Description: n.Description, URL: n.URL, StateName: n.State.Name, Priority: n.Priority, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj, Team: n.Team, BranchName: n.BranchName}
 }
 
 // GetIssueDetails returns a full issue by id
 func (c *Client) GetIssueDetails(id string) (*IssueDetails, error) {
-    const q = `query($id:String!){ issue(id:$id){ id identifier title description url state{ name } assignee{ id name email } labels{ nodes{ id name } } project{ id name state } } }`
-    var resp struct { Issue *struct { ID, Identifier, Title, Description, URL string; State struct{ Name string `json:"name"` } `json:"state"`; Assignee *User `json:"assignee"`; Labels struct{ Nodes []Label `json:"nodes"` } `json:"labels"`; Project *struct{ ID, Name, State string } `json:"project"` } `json:"issue"` }
+    const q = `query($id:String!){ issue(id:$id){ ` + issueDetailFields + ` } }`
+    var resp struct { Issue *issueDetailNode `json:"issue"` }
     if err := c.do(q, map[string]interface{}{"id": id}, &resp); err != nil { return nil, err }
     if resp.Issue == nil { return nil, nil }
-    n := resp.Issue
-    var proj *Project
-    if n.Project != nil { proj = &Project{ID: n.Project.ID, Name: n.Project.Name, State: n.Project.State} }
-    return &IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, Description: n.Description, URL: n.URL, StateName: n.State.Name, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj}, nil
+    return resp.Issue.details(), nil
 }
 
 // GetIssueDetailsWithComments returns full issue details plus up to N comments
@@ -566,35 +964,278 @@ func (c *Client) GetIssueDetailsWithComments(id string, commentsLimit int) (*Iss
     return det, nil
 }
 
+// IssueParts selects the connections GetIssueFull fetches along with an issue
+type IssueParts struct {
+    Children    bool
+    Relations   bool
+    Attachments bool
+    // Comments and History are how many of each to include; 0 skips them
+    Comments int
+    History  int
+}
+
+// IssueRelationRef is a relation seen from one issue. Type reads from that
+// issue: blocks, blocked by, duplicate of, duplicated by, related or similar.
+type IssueRelationRef struct {
+    Type  string       `json:"type"`
+    Issue RelatedIssue `json:"issue"`
+}
+
+// IssueExtras holds what GetIssueFull fetched beyond IssueDetails.
+// HistoryTruncated is set when the issue has more history than was asked for.
+type IssueExtras struct {
+    Children         []RelatedIssue     `json:"children,omitempty"`
+    Relations        []IssueRelationRef `json:"relations,omitempty"`
+    Attachments      []Attachment       `json:"attachments,omitempty"`
+    History          []HistoryEntry     `json:"history,omitempty"`
+    HistoryTruncated bool               `json:"historyTruncated,omitempty"`
+}
+
+// inverseRelationTypes names a relation as seen from its target issue
+var inverseRelationTypes = map[string]string{"blocks": "blocked by", "duplicate": "duplicated by"}
+
+// GetIssueFull returns an issue with the connections parts asks for, all in one
+// request. Comments land in IssueDetails.Comments; history is oldest first.
+func (c *Client) GetIssueFull(id string, parts IssueParts) (*IssueDetails, *IssueExtras, error) {
+    const ref = `id identifier title state{ name type }`
+    fields := issueDetailFields
+    vars := map[string]interface{}{"id": id}
+    decl := "$id:String!"
+    if parts.Children { fields += ` children(first:250){ nodes{ ` + ref + ` } }` }
+    if parts.Relations {
+        fields += ` relations(first:100){ nodes{ type relatedIssue{ ` + ref + ` } } } inverseRelations(first:100){ nodes{ type issue{ ` + ref + ` } } }`
+    }
+    if parts.Attachments { fields += ` attachments(first:100){ nodes{ id title subtitle url } }` }
+    if parts.Comments > 0 {
+        decl += ",$comments:Int!"
+        vars["comments"] = parts.Comments
+        fields += ` comments(first:$comments){ nodes{ ` + commentFields + ` } }`
+    }
+    if parts.History > 0 {
+        decl += ",$history:Int!"
+        vars["history"] = parts.History
+        fields += ` history(first:$history){ nodes{ ` + historyFields + ` } pageInfo{ hasNextPage endCursor } }`
+    }
+    q := `query(` + decl + `){ issue(id:$id){ ` + fields + ` } }`
+
+    type refNode struct {
+        ID, Identifier, Title string
+        State struct{ Name, Type string } `json:"state"`
+    }
+    toRelated := func(r refNode) RelatedIssue { return RelatedIssue{ID: r.ID, Identifier: r.Identifier, Title: r.Title, StateName: r.State.Name, StateType: r.State.Type} }
+    var resp struct {
+        Issue *struct {
+            issueDetailNode
+            Children struct{ Nodes []refNode `json:"nodes"` } `json:"children"`
+            Relations struct {
+                Nodes []struct {
+                    Type         string  `json:"type"`
+                    RelatedIssue refNode `json:"relatedIssue"`
+                } `json:"nodes"`
+            } `json:"relations"`
+            InverseRelations struct {
+                Nodes []struct {
+                    Type  string  `json:"type"`
+                    Issue refNode `json:"issue"`
+                } `json:"nodes"`
+            } `json:"inverseRelations"`
+            Attachments struct{ Nodes []Attachment `json:"nodes"` } `json:"attachments"`
+            Comments    struct{ Nodes []Comment `json:"nodes"` } `json:"comments"`
+            History     struct {
+                Nodes    []historyNode `json:"nodes"`
+                PageInfo PageInfo      `json:"pageInfo"`
+            } `json:"history"`
+        } `json:"issue"`
+    }
+    if err := c.do(q, vars, &resp); err != nil { return nil, nil, err }
+    if resp.Issue == nil { return nil, nil, nil }
+    n := resp.Issue
+    det := n.details()
+    det.Comments = n.Comments.Nodes
+    ex := &IssueExtras{Attachments: n.Attachments.Nodes, HistoryTruncated: n.History.PageInfo.HasNextPage}
+    for _, ch := range n.Children.Nodes { ex.Children = append(ex.Children, toRelated(ch)) }
+    for _, r := range n.Relations.Nodes {
+        typ := r.Type
+        if typ == "duplicate" { typ = "duplicate of" }
+        ex.Relations = append(ex.Relations, IssueRelationRef{Type: typ, Issue: toRelated(r.RelatedIssue)})
+    }
+    for _, r := range n.InverseRelations.Nodes {
+        typ := r.Type
+        if inv, ok := inverseRelationTypes[typ]; ok { typ = inv }
+        ex.Relations = append(ex.Relations, IssueRelationRef{Type: typ, Issue: toRelated(r.Issue)})
+    }
+    for _, h := range n.History.Nodes { ex.History = append(ex.History, h.entry()) }
+    sort.SliceStable(ex.History, func(i, j int) bool { return ex.History[i].At.Before(ex.History[j].At) })
+    return det, ex, nil
+}
+
+// FindIssueByDescription returns an issue whose description contains text, or
+// nil when there is none
+func (c *Client) FindIssueByDescription(text string) (*IssueDetails, error) {
+    const q = `query($text:String!){ issues(first:1, filter:{ description:{ contains:$text } }){ nodes{ id } } }`
+    var resp struct { Issues struct { Nodes []struct{ ID string `json:"id"` } `json:"nodes"` } `json:"issues"` }
+    if err := c.do(q, map[string]interface{}{"text": text}, &resp); err != nil { return nil, err }
+    if len(resp.Issues.Nodes) == 0 { return nil, nil }
+    return c.GetIssueDetails(resp.Issues.Nodes[0].ID)
+}
+
 // IssueListFilter supports optional filters for listing
 type IssueListFilter struct {
     ProjectID  string
     AssigneeID string
     StateName  string
-    Limit      int
+    Priority   *int
+    // SubscriberID keeps issues the user subscribes to
+    SubscriberID string
+    // IssueIDs, when non-nil, restricts the listing to these issues
+    IssueIDs []string
+    // ParentID keeps the direct sub-issues of that issue
+    ParentID string
+    // CreatorID keeps issues that user created; StateType keeps issues whose
+    // state is of that type (e.g. "completed")
+    CreatorID string
+    StateType string
+    // Negations: NoProject and NoLabel keep issues without a project or without
+    // labels, NotStateNames drops issues in those states, and NotAssigneeID drops
+    // issues assigned to that user (unassigned issues are kept)
+    NoProject     bool
+    NoLabel       bool
+    NotStateNames []string
+    NotAssigneeID string
+    // UpdatedSince keeps issues updated at or after it, when set
+    UpdatedSince time.Time
+    // Limit caps how many issues are listed; 0 lists them all
+    Limit int
+}
+
+// vars builds the IssueFilter with a clause per set field
+func (f IssueListFilter) vars() map[string]interface{} {
+    var and []interface{}
+    eq := func(v interface{}) map[string]interface{} { return map[string]interface{}{"eq": v} }
+    if f.ProjectID != "" { and = append(and, map[string]interface{}{"project": map[string]interface{}{"id": eq(f.ProjectID)}}) }
+    if f.AssigneeID != "" { and = append(and, map[string]interface{}{"assignee": map[string]interface{}{"id": eq(f.AssigneeID)}}) }
+    if f.StateName != "" { and = append(and, map[string]interface{}{"state": map[string]interface{}{"name": eq(f.StateName)}}) }
+    if f.Priority != nil { and = append(and, map[string]interface{}{"priority": eq(float64(*f.Priority))}) }
+    if f.SubscriberID != "" { and = append(and, map[string]interface{}{"subscribers": map[string]interface{}{"some": map[string]interface{}{"id": eq(f.SubscriberID)}}}) }
+    if f.IssueIDs != nil { and = append(and, map[string]interface{}{"id": map[string]interface{}{"in": f.IssueIDs}}) }
+    if f.ParentID != "" { and = append(and, map[string]interface{}{"parent": map[string]interface{}{"id": eq(f.ParentID)}}) }
+    if f.CreatorID != "" { and = append(and, map[string]interface{}{"creator": map[string]interface{}{"id": eq(f.CreatorID)}}) }
+    if f.StateType != "" { and = append(and, map[string]interface{}{"state": map[string]interface{}{"type": eq(f.StateType)}}) }
+    if f.NoProject { and = append(and, map[string]interface{}{"project": map[string]interface{}{"null": true}}) }
+    if f.NoLabel { and = append(and, map[string]interface{}{"labels": map[string]interface{}{"length": eq(0)}}) }
+    if len(f.NotStateNames) > 0 { and = append(and, map[string]interface{}{"state": map[string]interface{}{"name": map[string]interface{}{"nin": f.NotStateNames}}}) }
+    if f.NotAssigneeID != "" {
+        // A bare neq would also drop unassigned issues
+        and = append(and, map[string]interface{}{"or": []interface{}{
+            map[string]interface{}{"assignee": map[string]interface{}{"null": true}},
+            map[string]interface{}{"assignee": map[string]interface{}{"id": map[string]interface{}{"neq": f.NotAssigneeID}}},
+        }})
+    }
+    if !f.UpdatedSince.IsZero() { and = append(and, map[string]interface{}{"updatedAt": map[string]interface{}{"gte": f.UpdatedSince.UTC().Format(time.RFC3339Nano)}}) }
+    if len(and) == 0 { return nil }
+    return map[string]interface{}{"and": and}
+}
+
+// FilterKey identifies what f selects, for remembering state per listing; it
+// does not depend on Limit or UpdatedSince
+func (f IssueListFilter) FilterKey() string {
+    f.Limit, f.UpdatedSince = 0, time.Time{}
+    b, _ := json.Marshal(f.vars())
+    sum := sha256.Sum256(b)
+    return hex.EncodeToString(sum[:8])
 }
 
 // ListIssuesFiltered returns issues matching optional filters
 func (c *Client) ListIssuesFiltered(f IssueListFilter) ([]IssueDetails, error) {
-    if f.Limit <= 0 { f.Limit = 10 }
-    const q = `query($first:Int!,$projectId:ID,$assigneeId:ID,$state:String){
-issues(first:$first, filter:{ and:[ { project: { id: { eq: $projectId } } }, { assignee: { id: { eq: $assigneeId } } }, { state: { name: { eq: $state } } } ] }){
-  nodes{ id identifier title url state{ name } assignee{ id name email } labels{ nodes{ id name } } project{ id name state } }
+    var out []IssueDetails
+    err := c.EachIssueFiltered(f, func(page []IssueDetails) error { out = append(out, page...); return nil })
+    if err != nil { return nil, err }
+    if out == nil { out = []IssueDetails{} }
+    return out, nil
+}
+
+// IssueList is a listing together with how many issues match in total;
+// HasMore reports that Limit or the page cap left some out, and Truncated
+// that the page cap did, so TotalCount may then be only a lower bound
+type IssueList struct {
+    Issues     []IssueDetails `json:"issues"`
+    TotalCount int            `json:"totalCount"`
+    HasMore    bool           `json:"hasMore"`
+    Truncated  bool           `json:"truncated,omitempty"`
+}
+
+// ErrPageCap is returned when a listing reached maxPages with issues still
+// left to page through, so callers never take a cut-off listing as complete
+var ErrPageCap = fmt.Errorf("listing stopped after %d pages with more issues left; narrow the filter", maxPages)
+
+// ListIssuesCounted lists like ListIssuesFiltered and counts the matches the
+// limit left out. The API has no total count, so the rest are paged through by
+// id only, which costs one request per 250 of them.
+func (c *Client) ListIssuesCounted(f IssueListFilter) (*IssueList, error) {
+    out := &IssueList{Issues: []IssueDetails{}}
+    cursor, capped, err := c.eachIssueFiltered(f, func(page []IssueDetails) error { out.Issues = append(out.Issues, page...); return nil })
+    if err != nil { return nil, err }
+    out.TotalCount = len(out.Issues)
+    out.Truncated = capped
+    if cursor == "" { return out, nil }
+    const q = `query($after:String,$filter:IssueFilter){ issues(first:250, after:$after, filter:$filter){ nodes{ id } pageInfo{ hasNextPage endCursor } } }`
+    vars := map[string]interface{}{"filter": f.vars(), "after": cursor}
+    more := true
+    for page := 0; page < maxPages && more; page++ {
+        var resp struct{ Issues struct{ Nodes []struct{ ID string `json:"id"` } `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"issues"` }
+        if err := c.do(q, vars, &resp); err != nil { return nil, err }
+        out.TotalCount += len(resp.Issues.Nodes)
+        more = resp.Issues.PageInfo.HasNextPage && len(resp.Issues.Nodes) > 0
+        vars["after"] = resp.Issues.PageInfo.EndCursor
+    }
+    if more { out.Truncated = true }
+    out.HasMore = capped || out.TotalCount > len(out.Issues)
+    return out, nil
+}
+
+// EachIssueFiltered pages through issues matching optional filters, up to f.Limit,
+// calling fn with each page as it arrives so callers can stream results. It
+// returns ErrPageCap when the page cap stopped it before the limit or the end.
+func (c *Client) EachIssueFiltered(f IssueListFilter, fn func([]IssueDetails) error) error {
+    _, capped, err := c.eachIssueFiltered(f, fn)
+    if err == nil && capped { err = ErrPageCap }
+    return err
+}
+
+// eachIssueFiltered is EachIssueFiltered returning the cursor after the last
+// issue listed when the limit or the page cap stopped it short of the end,
+// else "", and whether it was the page cap
+func (c *Client) eachIssueFiltered(f IssueListFilter, fn func([]IssueDetails) error) (string, bool, error) {
+    if f.IssueIDs != nil && len(f.IssueIDs) == 0 { return "", false, nil }
+    const q = `query($first:Int!,$after:String,$filter:IssueFilter){
+issues(first:$first, after:$after, filter:$filter){
+  nodes{ id identifier title url updatedAt state{ name type } priority assignee{ id name email } labels{ nodes{ id name } } project{ id name state } }
+  pageInfo{ hasNextPage endCursor }
 }}
 `
-    vars := map[string]interface{}{"first": f.Limit}
-    if f.ProjectID != "" { vars["projectId"] = f.ProjectID }
-    if f.AssigneeID != "" { vars["assigneeId"] = f.AssigneeID }
-    if f.StateName != "" { vars["state"] = f.StateName }
-    var resp struct { Issues struct{ Nodes []struct { ID, Identifier, Title, URL string; State struct{ Name string `json:"name"` } `json:"state"`; Assignee *User `json:"assignee"`; Labels struct{ Nodes []Label `json:"nodes"` } `json:"labels"`; Project *struct{ ID, Name, State string } `json:"project"` } `json:"nodes"` } `json:"issues"` }
-    if err := c.do(q, vars, &resp); err != nil { return nil, err }
-    out := make([]IssueDetails, 0, len(resp.Issues.Nodes))
-    for _, n := range resp.Issues.Nodes {
-        var proj *Project
-        if n.Project != nil { proj = &Project{ID: n.Project.ID, Name: n.Project.Name, State: n.Project.State} }
-        out = append(out, IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, URL: n.URL, StateName: n.State.Name, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj})
+    vars := map[string]interface{}{"filter": f.vars()}
+    var after interface{}
+    seen := 0
+    for page := 0; page < maxPages; page++ {
+        first := 100
+        if f.Limit > 0 { first = min(f.Limit-seen, 100) }
+        vars["first"], vars["after"] = first, after
+        var resp struct { Issues struct{ Nodes []struct { ID, Identifier, Title, URL string; UpdatedAt *time.Time `json:"updatedAt"`; State struct{ Name string `json:"name"`; Type string `json:"type"` } `json:"state"`; Priority int `json:"priority"`; Assignee *User `json:"assignee"`; Labels struct{ Nodes []Label `json:"nodes"` } `json:"labels"`; Project *struct{ ID, Name, State string } `json:"project"` } `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"issues"` }
+        if err := c.do(q, vars, &resp); err != nil { return "", false, err }
+        out := make([]IssueDetails, 0, len(resp.Issues.Nodes))
+        for _, n := range resp.Issues.Nodes {
+            var proj *Project
+            if n.Project != nil { proj = &Project{ID: n.Project.ID, Name: n.Project.Name, State: n.Project.State} }
+            out = append(out, IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, URL: n.URL, StateName: n.State.Name, StateType: n.State.Type, Priority: n.Priority, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj, UpdatedAt: n.UpdatedAt})
+        }
+        seen += len(out)
+        if err := fn(out); err != nil { return "", false, err }
+        if !resp.Issues.PageInfo.HasNextPage || len(out) == 0 { return "", false, nil }
+        after = resp.Issues.PageInfo.EndCursor
+        if f.Limit > 0 && seen >= f.Limit { return resp.Issues.PageInfo.EndCursor, false, nil }
     }
-    return out, nil
+    // The cap stopped paging with hasNextPage still set
+    return after.(string), true, nil
 }
 
 // IssueCreateInput allows richer creation with project/assignee/labels/priority
@@ -608,6 +1249,11 @@ type IssueCreateInput struct {
     AssigneeID  string
     LabelIDs    []string
     Priority    *int
+    Estimate    *int
+    // DueDate is a YYYY-MM-DD date
+    DueDate     string
+    // ParentID makes the new issue a sub-issue
+    ParentID    string
 }
 
 // CreateIssueAdvanced creates an issue with additional fields
@@ -623,54 +1269,195 @@ func (c *Client) CreateIssueAdvanced(in IssueCreateInput) (*IssueDetails, error)
     if in.AssigneeID != "" { input["assigneeId"] = in.AssigneeID }
     if len(in.LabelIDs) > 0 { input["labelIds"] = in.LabelIDs }
     if in.Priority != nil { input["priority"] = *in.Priority }
+    if in.Estimate != nil { input["estimate"] = *in.Estimate }
+    if in.DueDate != "" { input["dueDate"] = in.DueDate }
+    if in.ParentID != "" { input["parentId"] = in.ParentID }
 
-    const q = `mutation($input: IssueCreateInput!){ issueCreate(input:$input){ success issue{ id identifier title description url state{ name } assignee{ id name email } labels{ nodes{ id name } } project{ id name state } } } }`
-    var resp struct { IssueCreate struct{ Success bool `json:"success"`; Issue *struct { ID, Identifier, Title, Description, URL string; State struct{ Name string `json:"name"` } `json:"state"`; Assignee *User `json:"assignee"`; Labels struct{ Nodes []Label `json:"nodes"` } `json:"labels"`; Project *struct{ ID, Name, State string } `json:"project"` } `json:"issue"` } `json:"issueCreate"` }
+    const q = `mutation($input: IssueCreateInput!){ issueCreate(input:$input){ success issue{ id identifier title description url state{ name } priority assignee{ id name email } labels{ nodes{ id name } } project{ id name state } } } }`
+    var resp struct { IssueCreate struct{ Success bool `json:"success"`; Issue *struct { ID, Identifier, Title, Description, URL string; State struct{ Name string `json:"name"` } `json:"state"`; Priority int `json:"priority"`; Assignee *User `json:"assignee"`; Labels struct{ Nodes []Label `json:"nodes"` } `json:"labels"`; Project *struct{ ID, Name, State string } `json:"project"` } `json:"issue"` } `json:"issueCreate"` }
     if err := c.do(q, map[string]interface{}{"input": input}, &resp); err != nil { return nil, err }
     if !resp.IssueCreate.Success || resp.IssueCreate.Issue == nil { return nil, errors.New("issue creation failed") }
     n := resp.IssueCreate.Issue
     var proj *Project
     if n.Project != nil { proj = &Project{ID: n.Project.ID, Name: n.Project.Name, State: n.Project.State} }
-    return &IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, Description: n.Description, URL: n.URL, StateName: n.State.Name, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj}, nil
+    return &IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, Description: n.Description, URL: n.URL, StateName: n.State.Name, Priority: n.Priority, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj}, nil
 }
 
 // UpdateIssue updates an existing issue's description and/or title
 func (c *Client) UpdateIssue(issueID, title, description string) (*IssueDetails, error) {
+    return c.UpdateIssueAdvanced(issueID, IssueUpdateInput{Title: title, Description: description})
+}
+
+// IssueUpdateInput holds optional fields for UpdateIssueAdvanced; zero values are left unchanged
+type IssueUpdateInput struct {
+    Title       string
+    Description string
+    StateID     string
+    AssigneeID  string
+    ProjectID   string
+    ParentID    string
+    LabelIDs    []string
+    Priority    *int
+    // AddedLabelIDs adds labels without replacing the existing set
+    AddedLabelIDs []string
+    CycleID       string
+    // ClearCycle takes the issue out of its cycle; CycleID is ignored
+    ClearCycle bool
+}
+
+// UpdateIssueAdvanced updates any subset of an issue's fields
+func (c *Client) UpdateIssueAdvanced(issueID string, in IssueUpdateInput) (*IssueDetails, error) {
     if issueID == "" {
         return nil, errors.New("issueID cannot be empty")
     }
-    
-    input := map[string]interface{}{"id": issueID}
-    if title != "" { input["title"] = title }
-    if description != "" { input["description"] = description }
 
-    const q = `mutation($input: IssueUpdateInput!){ issueUpdate(input:$input){ success issue{ id identifier title description url state{ name } assignee{ id name email } labels{ nodes{ id name } } project{ id name state } } } }`
-    var resp struct { IssueUpdate struct{ Success bool `json:"success"`; Issue *struct { ID, Identifier, Title, Description, URL string; State struct{ Name string `json:"name"` } `json:"state"`; Assignee *User `json:"assignee"`; Labels struct{ Nodes []Label `json:"nodes"` } `json:"labels"`; Project *struct{ ID, Name, State string } `json:"project"` } `json:"issue"` } `json:"issueUpdate"` }
-    if err := c.do(q, map[string]interface{}{"input": input}, &resp); err != nil { return nil, err }
+    input := map[string]interface{}{}
+    if in.Title != "" { input["title"] = in.Title }
+    if in.Description != "" { input["description"] = in.Description }
+    if in.StateID != "" { input["stateId"] = in.StateID }
+    if in.AssigneeID != "" { input["assigneeId"] = in.AssigneeID }
+    if in.ProjectID != "" { input["projectId"] = in.ProjectID }
+    if in.ParentID != "" { input["parentId"] = in.ParentID }
+    if len(in.LabelIDs) > 0 { input["labelIds"] = in.LabelIDs }
+    if in.Priority != nil { input["priority"] = *in.Priority }
+    if len(in.AddedLabelIDs) > 0 { input["addedLabelIds"] = in.AddedLabelIDs }
+    if in.CycleID != "" { input["cycleId"] = in.CycleID }
+    if in.ClearCycle { input["cycleId"] = nil }
+
+    const q = `mutation($id:String!,$input:IssueUpdateInput!){ issueUpdate(id:$id, input:$input){ success issue{ id identifier title description url state{ name } priority assignee{ id name email } labels{ nodes{ id name } } project{ id name state } } } }`
+    var resp struct { IssueUpdate struct{ Success bool `json:"success"`; Issue *struct { ID, Identifier, Title, Description, URL string; State struct{ Name string `json:"name"` } `json:"state"`; Priority int `json:"priority"`; Assignee *User `json:"assignee"`; Labels struct{ Nodes []Label `json:"nodes"` } `json:"labels"`; Project *struct{ ID, Name, State string } `json:"project"` } `json:"issue"` } `json:"issueUpdate"` }
+    if err := c.do(q, map[string]interface{}{"id": issueID, "input": input}, &resp); err != nil { return nil, err }
     if !resp.IssueUpdate.Success || resp.IssueUpdate.Issue == nil { return nil, errors.New("issue update failed") }
     n := resp.IssueUpdate.Issue
     var proj *Project
     if n.Project != nil { proj = &Project{ID: n.Project.ID, Name: n.Project.Name, State: n.Project.State} }
-    return &IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, Description: n.Description, URL: n.URL, StateName: n.State.Name, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj}, nil
+    return &IssueDetails{ID: n.ID, Identifier: n.Identifier, Title: n.Title, Description: n.Description, URL: n.URL, StateName: n.State.Name, Priority: n.Priority, Assignee: n.Assignee, Labels: n.Labels.Nodes, Project: proj}, nil
 }
 
 // State represents a workflow state in a team
 type State struct {
-    ID       string `json:"id"`
-    Name     string `json:"name"`
-    Type     string `json:"type"`
-    Position int    `json:"position"`
+    ID          string  `json:"id"`
+    Name        string  `json:"name"`
+    Type        string  `json:"type"`
+    Position    float64 `json:"position"`
+    Color       string  `json:"color,omitempty"`
+    Description string  `json:"description,omitempty"`
+    Team        *Team   `json:"team,omitempty"`
 }
 
 // TeamStates lists the workflow states for a given team
 func (c *Client) TeamStates(teamID string) ([]State, error) {
-    const q = `query($id:String!){ team(id:$id){ states(first:100){ nodes{ id name type position } } } }`
+    const q = `query($id:String!){ team(id:$id){ states(first:100){ nodes{ id name type position color description } } } }`
     var resp struct{ Team *struct{ States struct{ Nodes []State `json:"nodes"` } `json:"states"` } `json:"team"` }
     if err := c.do(q, map[string]interface{}{"id": teamID}, &resp); err != nil { return nil, err }
     if resp.Team == nil { return nil, nil }
     return resp.Team.States.Nodes, nil
 }
 
+// WorkflowStateInput holds the fields for creating or updating a workflow state;
+// empty strings and a nil Position are left out. Type and TeamID only apply on create.
+type WorkflowStateInput struct {
+    TeamID      string
+    Name        string
+    Type        string
+    Color       string
+    Description string
+    Position    *float64
+}
+
+func (in WorkflowStateInput) vars() map[string]interface{} {
+    input := map[string]interface{}{}
+    if in.TeamID != "" { input["teamId"] = in.TeamID }
+    if in.Name != "" { input["name"] = in.Name }
+    if in.Type != "" { input["type"] = in.Type }
+    if in.Color != "" { input["color"] = in.Color }
+    if in.Description != "" { input["description"] = in.Description }
+    if in.Position != nil { input["position"] = *in.Position }
+    return input
+}
+
+// CreateWorkflowState adds a workflow state to a team
+func (c *Client) CreateWorkflowState(in WorkflowStateInput) (*State, error) {
+    const q = `mutation($input:WorkflowStateCreateInput!){ workflowStateCreate(input:$input){ success workflowState{ id name type position color description } } }`
+    var resp struct{ WorkflowStateCreate struct{ Success bool `json:"success"`; WorkflowState *State `json:"workflowState"` } `json:"workflowStateCreate"` }
+    if err := c.do(q, map[string]interface{}{"input": in.vars()}, &resp); err != nil { return nil, err }
+    if !resp.WorkflowStateCreate.Success || resp.WorkflowStateCreate.WorkflowState == nil { return nil, errors.New("workflow state creation failed") }
+    return resp.WorkflowStateCreate.WorkflowState, nil
+}
+
+// UpdateWorkflowState changes a workflow state's name, color, description or position
+func (c *Client) UpdateWorkflowState(id string, in WorkflowStateInput) (*State, error) {
+    const q = `mutation($id:String!,$input:WorkflowStateUpdateInput!){ workflowStateUpdate(id:$id, input:$input){ success workflowState{ id name type position color description } } }`
+    in.TeamID, in.Type = "", ""
+    var resp struct{ WorkflowStateUpdate struct{ Success bool `json:"success"`; WorkflowState *State `json:"workflowState"` } `json:"workflowStateUpdate"` }
+    if err := c.do(q, map[string]interface{}{"id": id, "input": in.vars()}, &resp); err != nil { return nil, err }
+    if !resp.WorkflowStateUpdate.Success || resp.WorkflowStateUpdate.WorkflowState == nil { return nil, errors.New("workflow state update failed") }
+    return resp.WorkflowStateUpdate.WorkflowState, nil
+}
+
+// TeamSettings are a team's identity and the settings the CLI can change.
+// CycleDuration is in weeks; IssueEstimationType is one of notUsed,
+// exponential, fibonacci, linear or tShirt.
+type TeamSettings struct {
+    Team
+    Description         string `json:"description"`
+    CyclesEnabled       bool   `json:"cyclesEnabled"`
+    CycleDuration       int    `json:"cycleDuration"`
+    IssueEstimationType string `json:"issueEstimationType"`
+    DefaultIssueState   *State `json:"defaultIssueState"`
+}
+
+const teamSettingsFields = `id key name description cyclesEnabled cycleDuration issueEstimationType defaultIssueState{ id name type }`
+
+// TeamInput creates or changes a team; empty strings and nil pointers are left out
+type TeamInput struct {
+    Name                string
+    Key                 string
+    Description         string
+    CyclesEnabled       *bool
+    CycleDuration       *int
+    IssueEstimationType string
+    DefaultIssueStateID string
+}
+
+func (in TeamInput) vars() map[string]interface{} {
+    input := map[string]interface{}{}
+    if in.Name != "" { input["name"] = in.Name }
+    if in.Key != "" { input["key"] = in.Key }
+    if in.Description != "" { input["description"] = in.Description }
+    if in.CyclesEnabled != nil { input["cyclesEnabled"] = *in.CyclesEnabled }
+    if in.CycleDuration != nil { input["cycleDuration"] = *in.CycleDuration }
+    if in.IssueEstimationType != "" { input["issueEstimationType"] = in.IssueEstimationType }
+    if in.DefaultIssueStateID != "" { input["defaultIssueStateId"] = in.DefaultIssueStateID }
+    return input
+}
+
+// GetTeamSettings returns a team's settings, or nil when it does not exist
+func (c *Client) GetTeamSettings(teamID string) (*TeamSettings, error) {
+    q := `query($id:String!){ team(id:$id){ ` + teamSettingsFields + ` } }`
+    var resp struct{ Team *TeamSettings `json:"team"` }
+    if err := c.do(q, map[string]interface{}{"id": teamID}, &resp); err != nil { return nil, err }
+    return resp.Team, nil
+}
+
+// CreateTeam creates a team; the caller becomes a member
+func (c *Client) CreateTeam(in TeamInput) (*TeamSettings, error) {
+    q := `mutation($input:TeamCreateInput!){ teamCreate(input:$input){ success team{ ` + teamSettingsFields + ` } } }`
+    var resp struct{ TeamCreate struct{ Success bool `json:"success"`; Team *TeamSettings `json:"team"` } `json:"teamCreate"` }
+    if err := c.do(q, map[string]interface{}{"input": in.vars()}, &resp); err != nil { return nil, err }
+    if !resp.TeamCreate.Success || resp.TeamCreate.Team == nil { return nil, errors.New("team creation failed") }
+    return resp.TeamCreate.Team, nil
+}
+
+// UpdateTeam changes a team's settings
+func (c *Client) UpdateTeam(id string, in TeamInput) (*TeamSettings, error) {
+    q := `mutation($id:String!,$input:TeamUpdateInput!){ teamUpdate(id:$id, input:$input){ success team{ ` + teamSettingsFields + ` } } }`
+    var resp struct{ TeamUpdate struct{ Success bool `json:"success"`; Team *TeamSettings `json:"team"` } `json:"teamUpdate"` }
+    if err := c.do(q, map[string]interface{}{"id": id, "input": in.vars()}, &resp); err != nil { return nil, err }
+    if !resp.TeamUpdate.Success || resp.TeamUpdate.Team == nil { return nil, errors.New("team update failed") }
+    return resp.TeamUpdate.Team, nil
+}
+
 // TeamMembers lists users who are members of the given team
 func (c *Client) TeamMembers(teamID string) ([]User, error) {
     const q = `query($id:String!){ team(id:$id){ members(first:200){ nodes{ user{ id name email } } } } }`
@@ -911,6 +1698,62 @@ func (c *Client) FindTemplateForTeamByKeywords(teamID string, keywords []string)
     return nil, nil
 }
 
+// TemplateInput is the payload for creating or updating a team issue template.
+// Body is the markdown description new issues start with.
+type TemplateInput struct {
+    TeamID      string
+    Name        string
+    Description string
+    Body        string
+}
+
+func (in TemplateInput) vars() map[string]interface{} {
+    input := map[string]interface{}{
+        "name":         in.Name,
+        "templateData": map[string]interface{}{"description": in.Body},
+    }
+    if in.Description != "" { input["description"] = in.Description }
+    return input
+}
+
+// CreateIssueTemplate creates an issue template for a team via templateCreate
+func (c *Client) CreateIssueTemplate(in TemplateInput) (*IssueTemplate, error) {
+    input := in.vars()
+    input["type"] = "issue"
+    input["teamId"] = in.TeamID
+    const q = `mutation($input: TemplateCreateInput!){ templateCreate(input:$input){ success template{ id name description } } }`
+    var resp struct{ TemplateCreate struct{ Success bool `json:"success"`; Template *IssueTemplate `json:"template"` } `json:"templateCreate"` }
+    if err := c.do(q, map[string]interface{}{"input": input}, &resp); err != nil { return nil, err }
+    if !resp.TemplateCreate.Success || resp.TemplateCreate.Template == nil { return nil, errors.New("template creation failed") }
+    return resp.TemplateCreate.Template, nil
+}
+
+// UpdateIssueTemplate replaces a template's name and body via templateUpdate
+func (c *Client) UpdateIssueTemplate(id string, in TemplateInput) (*IssueTemplate, error) {
+    const q = `mutation($id:String!,$input: TemplateUpdateInput!){ templateUpdate(id:$id, input:$input){ success template{ id name description } } }`
+    var resp struct{ TemplateUpdate struct{ Success bool `json:"success"`; Template *IssueTemplate `json:"template"` } `json:"templateUpdate"` }
+    if err := c.do(q, map[string]interface{}{"id": id, "input": in.vars()}, &resp); err != nil { return nil, err }
+    if !resp.TemplateUpdate.Success || resp.TemplateUpdate.Template == nil { return nil, errors.New("template update failed") }
+    return resp.TemplateUpdate.Template, nil
+}
+
+// TemplateBody returns the markdown description a template gives new issues
+func (c *Client) TemplateBody(id string) (string, error) {
+    const q = `query($id:String!){ template(id:$id){ templateData } }`
+    var resp struct{ Template *struct{ TemplateData json.RawMessage `json:"templateData"` } `json:"template"` }
+    if err := c.do(q, map[string]interface{}{"id": id}, &resp); err != nil { return "", err }
+    if resp.Template == nil { return "", fmt.Errorf("template %s not found", id) }
+    var data struct{ Description string `json:"description"` }
+    raw := resp.Template.TemplateData
+    // Some workspaces return templateData as a JSON-encoded string
+    var s string
+    if json.Unmarshal(raw, &s) == nil { raw = json.RawMessage(s) }
+    if len(raw) > 0 && string(raw) != "null" {
+        if err := json.Unmarshal(raw, &data); err != nil { return "", fmt.Errorf("unexpected templateData: %w", err) }
+    }
+    return data.Description, nil
+}
+
 // CreateIssueFromTemplate attempts to create an issue using templateId in IssueCreateInput
 func (c *Client) CreateIssueFromTemplate(teamID, templateID, title string) (*IssueDetails, error) {
     // Backwards-compatible convenience wrapper
@@ -930,8 +1773,30 @@ func (c *Client) SupportsIssueCreateTemplateId() bool {
 // --- Comments ---
 
 type Comment struct {
-    ID   string `json:"id"`
-    Body string `json:"body"`
+    ID        string    `json:"id"`
+    Body      string    `json:"body"`
+    CreatedAt time.Time `json:"createdAt"`
+    User      *User      `json:"user,omitempty"`
+    Reactions []Reaction `json:"reactions,omitempty"`
+    // Parent is set on replies; only a thread's first comment can be resolved
+    Parent        *CommentRef `json:"parent,omitempty"`
+    ResolvedAt    *time.Time  `json:"resolvedAt,omitempty"`
+    ResolvingUser *User       `json:"resolvingUser,omitempty"`
+}
+
+// CommentRef points at another comment
+type CommentRef struct {
+    ID string `json:"id"`
+}
+
+// commentFields are the comment fields listings fetch
+const commentFields = `id body createdAt user{ id name email } reactions{ id emoji user{ id name } } parent{ id } resolvedAt resolvingUser{ id name }`
+
+// Reaction is an emoji reaction left on a comment
+type Reaction struct {
+    ID    string `json:"id"`
+    Emoji string `json:"emoji"`
+    User  *User  `json:"user,omitempty"`
 }
 
 type CommentResult struct {
@@ -972,7 +1837,7 @@ func (c *Client) CreateComment(issueID, body string) (*CommentResult, error) {
 // IssueComments fetches up to limit comments for an issue (minimal fields for compatibility)
 func (c *Client) IssueComments(issueID string, limit int) ([]Comment, error) {
     if limit <= 0 { limit = 20 }
-    const q = `query($id:String!,$first:Int!){ issue(id:$id){ comments(first:$first){ nodes{ id body } } } }`
+    const q = `query($id:String!,$first:Int!){ issue(id:$id){ comments(first:$first){ nodes{ ` + commentFields + ` } } } }`
     var resp struct {
         Issue *struct {
             Comments struct{
@@ -985,18 +1850,1641 @@ func (c *Client) IssueComments(issueID string, limit int) ([]Comment, error) {
     return resp.Issue.Comments.Nodes, nil
 }
 
-// ListTeams returns all teams the user has access to
-func (c *Client) ListTeams() ([]Team, error) {
-    const q = `query{ teams(first:100){ nodes{ id key name } } }`
+// ListIssueComments pages through an issue's comments created at or after since
+// (zero means no lower bound). limit <= 0 fetches every page; results are oldest first.
+func (c *Client) ListIssueComments(issueID string, since time.Time, limit int) ([]Comment, error) {
+    decl, filter := "", ""
+    vars := map[string]interface{}{"id": issueID}
+    if !since.IsZero() {
+        decl, filter = ",$since:DateTimeOrDuration", ", filter:{ createdAt:{ gte:$since } }"
+        vars["since"] = since.UTC().Format(time.RFC3339)
+    }
+    q := `query($id:String!,$first:Int!,$after:String` + decl + `){ issue(id:$id){ comments(first:$first, after:$after` + filter + `){ nodes{ ` + commentFields + ` } pageInfo{ hasNextPage endCursor } } } }`
+    var out []Comment
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        first := 100
+        if limit > 0 && limit-len(out) < first { first = limit - len(out) }
+        vars["first"], vars["after"] = first, after
+        var resp struct{ Issue *struct{ Comments struct{ Nodes []Comment `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"comments"` } `json:"issue"` }
+        if err := c.do(q, vars, &resp); err != nil { return nil, err }
+        if resp.Issue == nil { return nil, nil }
+        out = append(out, resp.Issue.Comments.Nodes...)
+        if !resp.Issue.Comments.PageInfo.HasNextPage || (limit > 0 && len(out) >= limit) { break }
+        after = resp.Issue.Comments.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+    return out, nil
+}
+
+// ResolveComment marks the thread started by commentID as resolved, or reopens
+// it when resolve is false
+func (c *Client) ResolveComment(commentID string, resolve bool) (*Comment, error) {
+    op := "commentResolve"
+    if !resolve { op = "commentUnresolve" }
+    q := `mutation($id:String!){ ` + op + `(id:$id){ success comment{ ` + commentFields + ` } } }`
+    var resp map[string]struct{ Success bool `json:"success"`; Comment *Comment `json:"comment"` }
+    if err := c.do(q, map[string]interface{}{"id": commentID}, &resp); err != nil { return nil, err }
+    if !resp[op].Success || resp[op].Comment == nil { return nil, fmt.Errorf("%s failed", op) }
+    return resp[op].Comment, nil
+}
+
+// ReactToComment adds an emoji reaction to a comment. Linear accepts the emoji
+// character itself or its shortcode name (e.g. "+1").
+func (c *Client) ReactToComment(commentID, emoji string) (*Reaction, error) {
+    const q = `mutation($input: ReactionCreateInput!){ reactionCreate(input:$input){ success reaction{ id emoji } } }`
+    vars := map[string]interface{}{"input": map[string]interface{}{"commentId": commentID, "emoji": emoji}}
     var resp struct {
-        Teams struct {
-            Nodes []Team `json:"nodes"`
-        } `json:"teams"`
+        ReactionCreate struct {
+            Success  bool      `json:"success"`
+            Reaction *Reaction `json:"reaction"`
+        } `json:"reactionCreate"`
     }
-    if err := c.do(q, nil, &resp); err != nil {
+    if err := c.do(q, vars, &resp); err != nil { return nil, err }
+    if !resp.ReactionCreate.Success || resp.ReactionCreate.Reaction == nil { return nil, errors.New("adding reaction failed") }
+    return resp.ReactionCreate.Reaction, nil
+}
+
+// CommentOnIssue is a comment with the issue it is on; Mine tells whether the
+// viewer wrote it
+type CommentOnIssue struct {
+    Comment
+    IssueKey string `json:"issue"`
+    Mine     bool   `json:"mine"`
+}
+
+// GetComment returns a comment by id, or nil when there is none
+func (c *Client) GetComment(id string) (*CommentOnIssue, error) {
+    const q = `query($id:String!){ comment(id:$id){ ` + commentFields + ` issue{ identifier } } viewer{ id } }`
+    var resp struct {
+        Comment *struct {
+            Comment
+            Issue *struct{ Identifier string `json:"identifier"` } `json:"issue"`
+        } `json:"comment"`
+        Viewer struct{ ID string `json:"id"` } `json:"viewer"`
+    }
+    if err := c.do(q, map[string]interface{}{"id": id}, &resp); err != nil {
+        if strings.Contains(strings.ToLower(err.Error()), "not found") { return nil, nil }
         return nil, err
     }
-    return resp.Teams.Nodes, nil
+    if resp.Comment == nil { return nil, nil }
+    out := &CommentOnIssue{Comment: resp.Comment.Comment}
+    if resp.Comment.Issue != nil { out.IssueKey = resp.Comment.Issue.Identifier }
+    out.Mine = out.User != nil && out.User.ID == resp.Viewer.ID
+    return out, nil
+}
+
+// UpdateComment replaces a comment's body
+func (c *Client) UpdateComment(id, body string) (*Comment, error) {
+    const q = `mutation($id:String!,$input:CommentUpdateInput!){ commentUpdate(id:$id, input:$input){ success comment{ ` + commentFields + ` } } }`
+    var resp struct {
+        CommentUpdate struct {
+            Success bool     `json:"success"`
+            Comment *Comment `json:"comment"`
+        } `json:"commentUpdate"`
+    }
+    if err := c.do(q, map[string]interface{}{"id": id, "input": map[string]interface{}{"body": body}}, &resp); err != nil { return nil, err }
+    if !resp.CommentUpdate.Success || resp.CommentUpdate.Comment == nil { return nil, errors.New("comment update failed") }
+    return resp.CommentUpdate.Comment, nil
+}
+
+// DeleteOwnComment deletes a comment the viewer wrote. It is the only delete
+// the client sends: the comment's author is checked first, and the guard lets
+// commentDelete through for that one id.
+func (c *Client) DeleteOwnComment(id string) error {
+    cm, err := c.GetComment(id)
+    if err != nil { return err }
+    if cm == nil { return fmt.Errorf("comment %s not found", id) }
+    if !cm.Mine { return fmt.Errorf("comment %s was not written by you; only your own comments can be deleted", id) }
+    const q = `mutation($id:String!){ commentDelete(id:$id){ success } }`
+    cp := *c
+    cp.ownComment = id
+    var resp struct{ CommentDelete struct{ Success bool `json:"success"` } `json:"commentDelete"` }
+    if err := cp.do(q, map[string]interface{}{"id": id}, &resp); err != nil { return err }
+    if !resp.CommentDelete.Success { return errors.New("comment deletion failed") }
+    return nil
 }
 
+// --- Subscriptions ---
+
+// IssueSubscribers lists the users following an issue
+func (c *Client) IssueSubscribers(issueID string) ([]User, error) {
+    const q = `query($id:String!){ issue(id:$id){ subscribers(first:100){ nodes{ id name email } } } }`
+    var resp struct{ Issue *struct{ Subscribers struct{ Nodes []User `json:"nodes"` } `json:"subscribers"` } `json:"issue"` }
+    if err := c.do(q, map[string]interface{}{"id": issueID}, &resp); err != nil { return nil, err }
+    if resp.Issue == nil { return nil, fmt.Errorf("issue %s not found", issueID) }
+    return resp.Issue.Subscribers.Nodes, nil
+}
 
+// SubscribeToIssue makes userID follow an issue; an empty userID means the viewer
+func (c *Client) SubscribeToIssue(issueID, userID string) error {
+    const q = `mutation($id:String!,$userId:String){ issueSubscribe(id:$id, userId:$userId){ success } }`
+    var resp struct{ IssueSubscribe struct{ Success bool `json:"success"` } `json:"issueSubscribe"` }
+    if err := c.do(q, subscriptionVars(issueID, userID), &resp); err != nil { return err }
+    if !resp.IssueSubscribe.Success { return errors.New("subscribing failed") }
+    return nil
+}
+
+// UnsubscribeFromIssue stops userID following an issue; an empty userID means the viewer
+func (c *Client) UnsubscribeFromIssue(issueID, userID string) error {
+    const q = `mutation($id:String!,$userId:String){ issueUnsubscribe(id:$id, userId:$userId){ success } }`
+    var resp struct{ IssueUnsubscribe struct{ Success bool `json:"success"` } `json:"issueUnsubscribe"` }
+    if err := c.do(q, subscriptionVars(issueID, userID), &resp); err != nil { return err }
+    if !resp.IssueUnsubscribe.Success { return errors.New("unsubscribing failed") }
+    return nil
+}
+
+func subscriptionVars(issueID, userID string) map[string]interface{} {
+    vars := map[string]interface{}{"id": issueID}
+    if userID != "" { vars["userId"] = userID }
+    return vars
+}
+
+// --- Favorites ---
+
+// Favorite is an entry of the viewer's sidebar favorites. Type is Linear's kind
+// ("issue", "project", "customView", ...); Name and URL describe the entity.
+type Favorite struct {
+    ID        string `json:"id"`
+    Type      string `json:"type"`
+    Name      string `json:"name"`
+    URL       string `json:"url,omitempty"`
+    IssueID   string `json:"issueId,omitempty"`
+    ProjectID string `json:"projectId,omitempty"`
+    sortOrder float64
+}
+
+// ListFavorites returns the viewer's favorites in sidebar order. Folders and
+// kinds the CLI does not know are listed by type only.
+func (c *Client) ListFavorites() ([]Favorite, error) {
+    const q = `query($after:String){
+favorites(first:100, after:$after){
+  nodes{ id type sortOrder folderName
+    issue{ id identifier title url }
+    project{ id name url }
+    customView{ id name }
+    document{ id title url }
+    label{ id name } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    type named struct{ ID, Name, Title, Identifier, URL string }
+    out := []Favorite{}
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Favorites struct{ Nodes []struct {
+            ID         string  `json:"id"`
+            Type       string  `json:"type"`
+            SortOrder  float64 `json:"sortOrder"`
+            FolderName string  `json:"folderName"`
+            Issue      *named  `json:"issue"`
+            Project    *named  `json:"project"`
+            CustomView *named  `json:"customView"`
+            Document   *named  `json:"document"`
+            Label      *named  `json:"label"`
+        } `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"favorites"` }
+        if err := c.do(q, map[string]interface{}{"after": after}, &resp); err != nil { return nil, err }
+        for _, n := range resp.Favorites.Nodes {
+            f := Favorite{ID: n.ID, Type: n.Type, Name: n.FolderName, sortOrder: n.SortOrder}
+            switch {
+            case n.Issue != nil:
+                f.Name, f.URL, f.IssueID = n.Issue.Identifier+" "+n.Issue.Title, n.Issue.URL, n.Issue.ID
+            case n.Project != nil:
+                f.Name, f.URL, f.ProjectID = n.Project.Name, n.Project.URL, n.Project.ID
+            case n.CustomView != nil:
+                f.Name = n.CustomView.Name
+            case n.Document != nil:
+                f.Name, f.URL = n.Document.Title, n.Document.URL
+            case n.Label != nil:
+                f.Name = n.Label.Name
+            }
+            out = append(out, f)
+        }
+        if !resp.Favorites.PageInfo.HasNextPage { break }
+        after = resp.Favorites.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].sortOrder < out[j].sortOrder })
+    return out, nil
+}
+
+// AddFavorite adds an issue or a project (exactly one id set) to the viewer's favorites
+func (c *Client) AddFavorite(issueID, projectID string) (*Favorite, error) {
+    input := map[string]interface{}{}
+    if issueID != "" { input["issueId"] = issueID }
+    if projectID != "" { input["projectId"] = projectID }
+    if len(input) != 1 { return nil, errors.New("favorite needs exactly one of an issue or a project") }
+    const q = `mutation($input:FavoriteCreateInput!){ favoriteCreate(input:$input){ success favorite{ id type } } }`
+    var resp struct{ FavoriteCreate struct{ Success bool `json:"success"`; Favorite *Favorite `json:"favorite"` } `json:"favoriteCreate"` }
+    if err := c.do(q, map[string]interface{}{"input": input}, &resp); err != nil { return nil, err }
+    if !resp.FavoriteCreate.Success || resp.FavoriteCreate.Favorite == nil { return nil, errors.New("adding the favorite failed") }
+    f := resp.FavoriteCreate.Favorite
+    f.IssueID, f.ProjectID = issueID, projectID
+    return f, nil
+}
+
+// --- Attachments ---
+
+type Attachment struct {
+    ID       string `json:"id"`
+    Title    string `json:"title"`
+    Subtitle string `json:"subtitle,omitempty"`
+    URL      string `json:"url"`
+}
+
+// LinkURL attaches an external URL (Sentry event, doc, PR...) to an issue
+func (c *Client) LinkURL(issueID, url, title string) (*Attachment, error) {
+    const q = `mutation($issueId:String!,$url:String!,$title:String){ attachmentLinkURL(issueId:$issueId, url:$url, title:$title){ success attachment{ id title subtitle url } } }`
+    vars := map[string]interface{}{"issueId": issueID, "url": url}
+    if strings.TrimSpace(title) != "" { vars["title"] = title }
+    var resp struct {
+        AttachmentLinkURL struct {
+            Success    bool        `json:"success"`
+            Attachment *Attachment `json:"attachment"`
+        } `json:"attachmentLinkURL"`
+    }
+    if err := c.do(q, vars, &resp); err != nil { return nil, err }
+    if !resp.AttachmentLinkURL.Success || resp.AttachmentLinkURL.Attachment == nil { return nil, errors.New("linking url failed") }
+    return resp.AttachmentLinkURL.Attachment, nil
+}
+
+// ListTeams returns all teams the user has access to
+func (c *Client) ListTeams() ([]Team, error) {
+    const q = `query{ teams(first:100){ nodes{ id key name } } }`
+    var resp struct {
+        Teams struct {
+            Nodes []Team `json:"nodes"`
+        } `json:"teams"`
+    }
+    if err := c.do(q, nil, &resp); err != nil {
+        return nil, err
+    }
+    return resp.Teams.Nodes, nil
+}
+
+
+// --- Batched resolution for issue creation ---
+
+// CreateContext bundles everything issue creation resolves for a team so it can be
+// fetched in a single round trip instead of one request per resolver.
+type CreateContext struct {
+    Team               Team            `json:"team"`
+    States             []State         `json:"states"`
+    Labels             []Label         `json:"labels"`
+    Members            []User          `json:"members"`
+    Templates          []IssueTemplate `json:"templates"`
+    SupportsTemplateID bool            `json:"supportsTemplateId"`
+}
+
+// CreateContextForTeam resolves a team by key together with its workflow states,
+// labels, members, templates and IssueCreateInput.templateId support in one query.
+// Returns nil, nil when the team does not exist. Callers should fall back to the
+// individual resolvers on error, since older schemas may reject parts of the query.
+func (c *Client) CreateContextForTeam(key string) (*CreateContext, error) {
+    const q = `query($key:String!){
+  teams(filter:{ key:{ eq:$key } }, first:1){
+    nodes{
+      id key name
+      states(first:100){ nodes{ id name type position } }
+      labels(first:200){ nodes{ id name } }
+      members(first:200){ nodes{ id name email } }
+      templates{ nodes{ id name description } }
+    }
+  }
+  __type(name:"IssueCreateInput"){ inputFields{ name } }
+}`
+    var resp struct {
+        Teams struct {
+            Nodes []struct {
+                ID        string `json:"id"`
+                Key       string `json:"key"`
+                Name      string `json:"name"`
+                States    struct{ Nodes []State `json:"nodes"` } `json:"states"`
+                Labels    struct{ Nodes []Label `json:"nodes"` } `json:"labels"`
+                Members   struct{ Nodes []User `json:"nodes"` } `json:"members"`
+                Templates struct{ Nodes []IssueTemplate `json:"nodes"` } `json:"templates"`
+            } `json:"nodes"`
+        } `json:"teams"`
+        Type *struct{ InputFields []struct{ Name string `json:"name"` } `json:"inputFields"` } `json:"__type"`
+    }
+    if err := c.do(q, map[string]interface{}{"key": key}, &resp); err != nil { return nil, err }
+    if len(resp.Teams.Nodes) == 0 { return nil, nil }
+    n := resp.Teams.Nodes[0]
+    out := &CreateContext{
+        Team:      Team{ID: n.ID, Key: n.Key, Name: n.Name},
+        States:    n.States.Nodes,
+        Labels:    n.Labels.Nodes,
+        Members:   n.Members.Nodes,
+        Templates: n.Templates.Nodes,
+    }
+    if resp.Type != nil {
+        for _, f := range resp.Type.InputFields { if strings.EqualFold(f.Name, "templateId") { out.SupportsTemplateID = true; break } }
+    }
+    return out, nil
+}
+
+// LabelByName finds a team label by case-insensitive exact name
+func (cc *CreateContext) LabelByName(name string) *Label {
+    target := strings.TrimSpace(name)
+    for i := range cc.Labels { if strings.EqualFold(strings.TrimSpace(cc.Labels[i].Name), target) { return &cc.Labels[i] } }
+    return nil
+}
+
+// MemberByName finds a single team member by id, email, or name (exact, then contains)
+func (cc *CreateContext) MemberByName(input string) (*User, error) {
+    target := strings.ToLower(strings.TrimSpace(input))
+    for i := range cc.Members {
+        u := &cc.Members[i]
+        if u.ID == input || strings.EqualFold(u.Email, target) || strings.ToLower(u.Name) == target { return u, nil }
+    }
+    var found *User
+    for i := range cc.Members {
+        if strings.Contains(strings.ToLower(cc.Members[i].Name), target) {
+            if found != nil { return nil, fmt.Errorf("multiple users match '%s'", input) }
+            found = &cc.Members[i]
+        }
+    }
+    return found, nil
+}
+
+// TemplateByName finds a team template by exact, then case-insensitive name
+func (cc *CreateContext) TemplateByName(name string) *IssueTemplate {
+    target := strings.TrimSpace(name)
+    for i := range cc.Templates { if strings.TrimSpace(cc.Templates[i].Name) == target { return &cc.Templates[i] } }
+    for i := range cc.Templates { if strings.EqualFold(strings.TrimSpace(cc.Templates[i].Name), target) { return &cc.Templates[i] } }
+    return nil
+}
+
+// --- Paginated workspace-wide listings ---
+
+// PageInfo is the Relay cursor info returned by Linear connections
+type PageInfo struct {
+    HasNextPage bool   `json:"hasNextPage"`
+    EndCursor   string `json:"endCursor"`
+}
+
+// maxPages bounds cursor loops so a misbehaving server cannot spin forever
+const maxPages = 100
+
+// ListAllTeams pages through every team visible to the token
+func (c *Client) ListAllTeams() ([]Team, error) {
+    const q = `query($after:String){ teams(first:100, after:$after){ nodes{ id key name } pageInfo{ hasNextPage endCursor } } }`
+    var out []Team
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Teams struct{ Nodes []Team `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"teams"` }
+        if err := c.do(q, map[string]interface{}{"after": after}, &resp); err != nil { return nil, err }
+        out = append(out, resp.Teams.Nodes...)
+        if !resp.Teams.PageInfo.HasNextPage { break }
+        after = resp.Teams.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// ListAllLabels pages through every issue label, including its color and owning team
+// (Team is nil for workspace-level labels)
+func (c *Client) ListAllLabels() ([]Label, error) {
+    const q = `query($after:String){ issueLabels(first:250, after:$after){ nodes{ id name color team{ id key name } } pageInfo{ hasNextPage endCursor } } }`
+    var out []Label
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ IssueLabels struct{ Nodes []Label `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"issueLabels"` }
+        if err := c.do(q, map[string]interface{}{"after": after}, &resp); err != nil { return nil, err }
+        out = append(out, resp.IssueLabels.Nodes...)
+        if !resp.IssueLabels.PageInfo.HasNextPage { break }
+        after = resp.IssueLabels.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// ListAllWorkflowStates pages through workflow states of every team
+func (c *Client) ListAllWorkflowStates() ([]State, error) {
+    const q = `query($after:String){ workflowStates(first:250, after:$after){ nodes{ id name type position color team{ id key name } } pageInfo{ hasNextPage endCursor } } }`
+    var out []State
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ WorkflowStates struct{ Nodes []State `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"workflowStates"` }
+        if err := c.do(q, map[string]interface{}{"after": after}, &resp); err != nil { return nil, err }
+        out = append(out, resp.WorkflowStates.Nodes...)
+        if !resp.WorkflowStates.PageInfo.HasNextPage { break }
+        after = resp.WorkflowStates.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// --- Issue history (analytics) ---
+
+// StateTransition is one workflow state change recorded in an issue's history
+type StateTransition struct {
+    At   time.Time `json:"at"`
+    From *State    `json:"from,omitempty"`
+    To   *State    `json:"to,omitempty"`
+}
+
+// IssueHistory is an issue's lifecycle timestamps plus its state transitions, oldest first
+type IssueHistory struct {
+    ID          string            `json:"id"`
+    Identifier  string            `json:"identifier"`
+    Title       string            `json:"title"`
+    CreatedAt   time.Time         `json:"createdAt"`
+    StartedAt   *time.Time        `json:"startedAt,omitempty"`
+    CompletedAt *time.Time        `json:"completedAt,omitempty"`
+    Transitions []StateTransition `json:"transitions"`
+}
+
+// ListCompletedIssueHistory pages through a team's issues completed at or after since,
+// including up to 100 state-change history entries per issue.
+func (c *Client) ListCompletedIssueHistory(teamID string, since time.Time) ([]IssueHistory, error) {
+    const q = `query($teamId:ID!,$since:DateTimeOrDuration!,$after:String){
+issues(first:50, after:$after, filter:{ team:{ id:{ eq:$teamId } }, completedAt:{ gte:$since } }){
+  nodes{ id identifier title createdAt startedAt completedAt history(first:100){ nodes{ createdAt fromState{ id name type } toState{ id name type } } } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    type histNode struct {
+        CreatedAt time.Time `json:"createdAt"`
+        FromState *State    `json:"fromState"`
+        ToState   *State    `json:"toState"`
+    }
+    var out []IssueHistory
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issues struct {
+                Nodes []struct {
+                    ID, Identifier, Title string
+                    CreatedAt   time.Time  `json:"createdAt"`
+                    StartedAt   *time.Time `json:"startedAt"`
+                    CompletedAt *time.Time `json:"completedAt"`
+                    History     struct{ Nodes []histNode `json:"nodes"` } `json:"history"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"issues"`
+        }
+        vars := map[string]interface{}{"teamId": teamID, "since": since.UTC().Format(time.RFC3339), "after": after}
+        if err := c.do(q, vars, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            h := IssueHistory{ID: n.ID, Identifier: n.Identifier, Title: n.Title, CreatedAt: n.CreatedAt, StartedAt: n.StartedAt, CompletedAt: n.CompletedAt}
+            for _, e := range n.History.Nodes {
+                // history also records title/assignee/label edits; keep only state changes
+                if e.ToState == nil { continue }
+                h.Transitions = append(h.Transitions, StateTransition{At: e.CreatedAt, From: e.FromState, To: e.ToState})
+            }
+            sort.SliceStable(h.Transitions, func(i, j int) bool { return h.Transitions[i].At.Before(h.Transitions[j].At) })
+            out = append(out, h)
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// --- Personal activity (standup) ---
+
+// RelatedIssue is the other side of an issue relation
+type RelatedIssue struct {
+    ID         string `json:"id,omitempty"`
+    Identifier string `json:"identifier"`
+    Title      string `json:"title"`
+    StateName  string `json:"stateName"`
+    StateType  string `json:"stateType"`
+}
+
+// ActivityIssue is an issue assigned to the viewer along with its open blockers
+type ActivityIssue struct {
+    ID          string         `json:"id"`
+    Identifier  string         `json:"identifier"`
+    Title       string         `json:"title"`
+    URL         string         `json:"url"`
+    StateName   string         `json:"stateName"`
+    StateType   string         `json:"stateType"`
+    CompletedAt *time.Time     `json:"completedAt,omitempty"`
+    BlockedBy   []RelatedIssue `json:"blockedBy,omitempty"`
+}
+
+// ListMyActivity returns the viewer's issues completed at or after since or currently
+// started, optionally limited to one team. BlockedBy lists blockers not yet completed or canceled.
+func (c *Client) ListMyActivity(teamID string, since time.Time) ([]ActivityIssue, error) {
+    decl, teamFilter := "", ""
+    vars := map[string]interface{}{"since": since.UTC().Format(time.RFC3339)}
+    if teamID != "" {
+        decl, teamFilter = ",$teamId:ID", " team:{ id:{ eq:$teamId } },"
+        vars["teamId"] = teamID
+    }
+    q := `query($since:DateTimeOrDuration!,$after:String` + decl + `){
+issues(first:100, after:$after, filter:{` + teamFilter + ` assignee:{ isMe:{ eq:true } }, or:[ { completedAt:{ gte:$since } }, { state:{ type:{ eq:"started" } } } ] }){
+  nodes{ id identifier title url completedAt state{ name type } inverseRelations{ nodes{ type issue{ identifier title state{ name type } } } } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    type stateRef struct{ Name, Type string }
+    var out []ActivityIssue
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issues struct {
+                Nodes []struct {
+                    ID, Identifier, Title, URL string
+                    CompletedAt      *time.Time `json:"completedAt"`
+                    State            stateRef   `json:"state"`
+                    InverseRelations struct {
+                        Nodes []struct {
+                            Type  string `json:"type"`
+                            Issue struct{ Identifier, Title string; State stateRef `json:"state"` } `json:"issue"`
+                        } `json:"nodes"`
+                    } `json:"inverseRelations"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"issues"`
+        }
+        vars["after"] = after
+        if err := c.do(q, vars, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            a := ActivityIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, URL: n.URL, StateName: n.State.Name, StateType: n.State.Type, CompletedAt: n.CompletedAt}
+            for _, r := range n.InverseRelations.Nodes {
+                // an inverse "blocks" relation means the related issue blocks this one
+                if r.Type != "blocks" || r.Issue.State.Type == "completed" || r.Issue.State.Type == "canceled" { continue }
+                a.BlockedBy = append(a.BlockedBy, RelatedIssue{Identifier: r.Issue.Identifier, Title: r.Issue.Title, StateName: r.Issue.State.Name, StateType: r.Issue.State.Type})
+            }
+            out = append(out, a)
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// HistoryEntry is one change recorded in an issue's audit trail. Only the fields
+// that changed in that entry are set.
+type HistoryEntry struct {
+    At            time.Time `json:"at"`
+    Actor         *User     `json:"actor,omitempty"`
+    FromState     string    `json:"fromState,omitempty"`
+    ToState       string    `json:"toState,omitempty"`
+    FromAssignee  string    `json:"fromAssignee,omitempty"`
+    ToAssignee    string    `json:"toAssignee,omitempty"`
+    AddedLabels   []string  `json:"addedLabels,omitempty"`
+    RemovedLabels []string  `json:"removedLabels,omitempty"`
+    FromPriority  *int      `json:"fromPriority,omitempty"`
+    ToPriority    *int      `json:"toPriority,omitempty"`
+    FromTitle     string    `json:"fromTitle,omitempty"`
+    ToTitle       string    `json:"toTitle,omitempty"`
+}
+
+// historyFields selects what HistoryEntry holds; historyNode decodes it
+const historyFields = `createdAt actor{ id name email } fromState{ name } toState{ name } fromAssignee{ name } toAssignee{ name } addedLabels{ name } removedLabels{ name } fromPriority toPriority fromTitle toTitle`
+
+type historyName struct{ Name string `json:"name"` }
+
+type historyNode struct {
+    CreatedAt     time.Time     `json:"createdAt"`
+    Actor         *User         `json:"actor"`
+    FromState     *historyName  `json:"fromState"`
+    ToState       *historyName  `json:"toState"`
+    FromAssignee  *historyName  `json:"fromAssignee"`
+    ToAssignee    *historyName  `json:"toAssignee"`
+    AddedLabels   []historyName `json:"addedLabels"`
+    RemovedLabels []historyName `json:"removedLabels"`
+    FromPriority  *float64      `json:"fromPriority"`
+    ToPriority    *float64      `json:"toPriority"`
+    FromTitle     string        `json:"fromTitle"`
+    ToTitle       string        `json:"toTitle"`
+}
+
+func (n historyNode) entry() HistoryEntry {
+    names := func(ns []historyName) []string {
+        out := make([]string, 0, len(ns))
+        for _, n := range ns { out = append(out, n.Name) }
+        return out
+    }
+    e := HistoryEntry{At: n.CreatedAt, Actor: n.Actor, AddedLabels: names(n.AddedLabels), RemovedLabels: names(n.RemovedLabels), FromTitle: n.FromTitle, ToTitle: n.ToTitle}
+    if n.FromState != nil { e.FromState = n.FromState.Name }
+    if n.ToState != nil { e.ToState = n.ToState.Name }
+    if n.FromAssignee != nil { e.FromAssignee = n.FromAssignee.Name }
+    if n.ToAssignee != nil { e.ToAssignee = n.ToAssignee.Name }
+    if n.FromPriority != nil && n.ToPriority != nil && *n.FromPriority != *n.ToPriority {
+        from, to := int(*n.FromPriority), int(*n.ToPriority)
+        e.FromPriority, e.ToPriority = &from, &to
+    }
+    return e
+}
+
+// IssueHistoryEntries pages through an issue's history connection, oldest first
+func (c *Client) IssueHistoryEntries(issueID string) ([]HistoryEntry, error) {
+    const q = `query($id:String!,$after:String){ issue(id:$id){ history(first:100, after:$after){
+  nodes{ ` + historyFields + ` }
+  pageInfo{ hasNextPage endCursor }
+} } }`
+    var out []HistoryEntry
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issue *struct {
+                History struct {
+                    Nodes    []historyNode `json:"nodes"`
+                    PageInfo PageInfo      `json:"pageInfo"`
+                } `json:"history"`
+            } `json:"issue"`
+        }
+        if err := c.do(q, map[string]interface{}{"id": issueID, "after": after}, &resp); err != nil { return nil, err }
+        if resp.Issue == nil { return nil, nil }
+        for _, n := range resp.Issue.History.Nodes { out = append(out, n.entry()) }
+        if !resp.Issue.History.PageInfo.HasNextPage { break }
+        after = resp.Issue.History.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].At.Before(out[j].At) })
+    return out, nil
+}
+
+// DueIssue is an open issue with a due date (YYYY-MM-DD, no time of day)
+type DueIssue struct {
+    ID         string `json:"id"`
+    Identifier string `json:"identifier"`
+    Title      string `json:"title"`
+    URL        string `json:"url"`
+    StateName  string `json:"stateName"`
+    DueDate    string `json:"dueDate"`
+    Priority   int    `json:"priority"`
+    Assignee   *User  `json:"assignee,omitempty"`
+}
+
+// ListDueIssues pages through a team's open issues due on or before through
+// (overdue ones included), optionally only those assigned to assigneeID, ordered
+// by due date and then priority.
+func (c *Client) ListDueIssues(teamID, assigneeID string, through time.Time) ([]DueIssue, error) {
+    const q = `query($filter:IssueFilter!,$after:String){
+issues(first:100, after:$after, filter:$filter){
+  nodes{ id identifier title url dueDate priority state{ name } assignee{ id name email } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    filter := map[string]interface{}{
+        "team":    map[string]interface{}{"id": map[string]interface{}{"eq": teamID}},
+        "dueDate": map[string]interface{}{"lte": through.Format("2006-01-02")},
+        "state":   map[string]interface{}{"type": map[string]interface{}{"nin": []string{"completed", "canceled"}}},
+    }
+    if assigneeID != "" { filter["assignee"] = map[string]interface{}{"id": map[string]interface{}{"eq": assigneeID}} }
+    var out []DueIssue
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issues struct {
+                Nodes []struct {
+                    ID, Identifier, Title, URL string
+                    DueDate  string `json:"dueDate"`
+                    Priority int    `json:"priority"`
+                    State    struct{ Name string `json:"name"` } `json:"state"`
+                    Assignee *User  `json:"assignee"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"issues"`
+        }
+        if err := c.do(q, map[string]interface{}{"filter": filter, "after": after}, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            if n.DueDate == "" { continue }
+            out = append(out, DueIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, URL: n.URL, StateName: n.State.Name, DueDate: n.DueDate, Priority: n.Priority, Assignee: n.Assignee})
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    // Priority 0 means none, so it sorts after low (4)
+    rank := func(p int) int { if p == 0 { return 5 }; return p }
+    sort.SliceStable(out, func(i, j int) bool {
+        if out[i].DueDate != out[j].DueDate { return out[i].DueDate < out[j].DueDate }
+        return rank(out[i].Priority) < rank(out[j].Priority)
+    })
+    return out, nil
+}
+
+// StaleIssue is an open issue with its last update time
+type StaleIssue struct {
+    ID         string    `json:"id"`
+    Identifier string    `json:"identifier"`
+    Title      string    `json:"title"`
+    URL        string    `json:"url"`
+    StateName  string    `json:"stateName"`
+    UpdatedAt  time.Time `json:"updatedAt"`
+    Assignee   *User     `json:"assignee,omitempty"`
+    Labels     []Label   `json:"labels"`
+}
+
+// ListStaleIssues pages through a team's issues that are not completed or canceled
+// and were last updated before the given time, least recently updated first.
+func (c *Client) ListStaleIssues(teamID string, before time.Time) ([]StaleIssue, error) {
+    const q = `query($teamId:ID!,$before:DateTimeOrDuration!,$after:String){
+issues(first:100, after:$after, filter:{ team:{ id:{ eq:$teamId } }, updatedAt:{ lt:$before }, state:{ type:{ nin:["completed","canceled"] } } }){
+  nodes{ id identifier title url updatedAt state{ name } assignee{ id name email } labels{ nodes{ id name } } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []StaleIssue
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issues struct {
+                Nodes []struct {
+                    ID, Identifier, Title, URL string
+                    UpdatedAt time.Time                      `json:"updatedAt"`
+                    State     struct{ Name string `json:"name"` } `json:"state"`
+                    Assignee  *User                          `json:"assignee"`
+                    Labels    struct{ Nodes []Label `json:"nodes"` } `json:"labels"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"issues"`
+        }
+        vars := map[string]interface{}{"teamId": teamID, "before": before.UTC().Format(time.RFC3339), "after": after}
+        if err := c.do(q, vars, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            out = append(out, StaleIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, URL: n.URL, StateName: n.State.Name, UpdatedAt: n.UpdatedAt, Assignee: n.Assignee, Labels: n.Labels.Nodes})
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].UpdatedAt.Before(out[j].UpdatedAt) })
+    return out, nil
+}
+
+// OpenIssueCountsByAssignee counts a team's open (not completed or canceled)
+// issues per assignee ID. Unassigned issues are not counted.
+func (c *Client) OpenIssueCountsByAssignee(teamID string) (map[string]int, error) {
+    const q = `query($teamId:ID!,$after:String){
+issues(first:250, after:$after, filter:{ team:{ id:{ eq:$teamId } }, assignee:{ null:false }, state:{ type:{ nin:["completed","canceled"] } } }){
+  nodes{ assignee{ id } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    out := map[string]int{}
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Issues struct{ Nodes []struct{ Assignee *struct{ ID string `json:"id"` } `json:"assignee"` } `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"issues"` }
+        if err := c.do(q, map[string]interface{}{"teamId": teamID, "after": after}, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            if n.Assignee != nil { out[n.Assignee.ID]++ }
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// TriageIssue is an issue waiting in a team's triage queue
+type TriageIssue struct {
+    ID               string    `json:"id"`
+    Identifier       string    `json:"identifier"`
+    Title            string    `json:"title"`
+    URL              string    `json:"url"`
+    CreatedAt        time.Time `json:"createdAt"`
+    Priority         int       `json:"priority"`
+    Creator          *User     `json:"creator,omitempty"`
+    CustomerRequests int       `json:"customerRequests"`
+}
+
+// ListTriageIssues pages through a team's issues in a triage-type state, oldest first.
+// CustomerRequests is Linear's customerTicketCount for the issue.
+func (c *Client) ListTriageIssues(teamID string) ([]TriageIssue, error) {
+    const q = `query($teamId:ID!,$after:String){
+issues(first:100, after:$after, filter:{ team:{ id:{ eq:$teamId } }, state:{ type:{ eq:"triage" } } }){
+  nodes{ id identifier title url createdAt priority customerTicketCount creator{ id name email } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []TriageIssue
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issues struct {
+                Nodes []struct {
+                    ID, Identifier, Title, URL string
+                    CreatedAt           time.Time `json:"createdAt"`
+                    Priority            int       `json:"priority"`
+                    CustomerTicketCount int       `json:"customerTicketCount"`
+                    Creator             *User     `json:"creator"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"issues"`
+        }
+        if err := c.do(q, map[string]interface{}{"teamId": teamID, "after": after}, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            out = append(out, TriageIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, URL: n.URL, CreatedAt: n.CreatedAt, Priority: n.Priority, Creator: n.Creator, CustomerRequests: n.CustomerTicketCount})
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+    return out, nil
+}
+
+// TriageActivity is a triage issue with the time someone last responded to it:
+// the latest comment by anyone but its creator, or the latest state change.
+// LastResponseAt is nil when nobody has responded yet.
+type TriageActivity struct {
+    TriageIssue
+    LastResponseAt *time.Time `json:"lastResponseAt"`
+}
+
+// ListTriageActivity pages through a team's triage issues with their comments
+// and state history, oldest first
+func (c *Client) ListTriageActivity(teamID string) ([]TriageActivity, error) {
+    const q = `query($teamId:ID!,$after:String){
+issues(first:50, after:$after, filter:{ team:{ id:{ eq:$teamId } }, state:{ type:{ eq:"triage" } } }){
+  nodes{ id identifier title url createdAt priority customerTicketCount creator{ id name email }
+    comments(first:50){ nodes{ createdAt user{ id } } }
+    history(first:50){ nodes{ createdAt fromState{ id } toState{ id } } } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []TriageActivity
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issues struct {
+                Nodes []struct {
+                    ID, Identifier, Title, URL string
+                    CreatedAt           time.Time `json:"createdAt"`
+                    Priority            int       `json:"priority"`
+                    CustomerTicketCount int       `json:"customerTicketCount"`
+                    Creator             *User     `json:"creator"`
+                    Comments            struct {
+                        Nodes []struct {
+                            CreatedAt time.Time `json:"createdAt"`
+                            User      *User     `json:"user"`
+                        } `json:"nodes"`
+                    } `json:"comments"`
+                    History struct {
+                        Nodes []struct {
+                            CreatedAt time.Time            `json:"createdAt"`
+                            FromState *struct{ ID string } `json:"fromState"`
+                            ToState   *struct{ ID string } `json:"toState"`
+                        } `json:"nodes"`
+                    } `json:"history"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"issues"`
+        }
+        if err := c.do(q, map[string]interface{}{"teamId": teamID, "after": after}, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            a := TriageActivity{TriageIssue: TriageIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, URL: n.URL, CreatedAt: n.CreatedAt, Priority: n.Priority, Creator: n.Creator, CustomerRequests: n.CustomerTicketCount}}
+            seen := func(t time.Time) {
+                if a.LastResponseAt == nil || t.After(*a.LastResponseAt) { t := t; a.LastResponseAt = &t }
+            }
+            for _, cm := range n.Comments.Nodes {
+                if cm.User != nil && (n.Creator == nil || cm.User.ID != n.Creator.ID) { seen(cm.CreatedAt) }
+            }
+            for _, h := range n.History.Nodes {
+                // the entry recording where the issue was created has no fromState
+                if h.FromState != nil && h.ToState != nil { seen(h.CreatedAt) }
+            }
+            out = append(out, a)
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+    return out, nil
+}
+
+// GraphIssue is an issue with the links needed to draw a dependency graph:
+// the issues it blocks and its parent.
+type GraphIssue struct {
+    ID         string         `json:"id"`
+    Identifier string         `json:"identifier"`
+    Title      string         `json:"title"`
+    StateName  string         `json:"stateName"`
+    StateType  string         `json:"stateType"`
+    Parent     *RelatedIssue  `json:"parent,omitempty"`
+    Blocks     []RelatedIssue `json:"blocks,omitempty"`
+}
+
+// ListProjectIssueGraph pages through a project's issues with their outgoing
+// "blocks" relations and parent links.
+func (c *Client) ListProjectIssueGraph(projectID string) ([]GraphIssue, error) {
+    const q = `query($projectId:ID!,$after:String){
+issues(first:100, after:$after, filter:{ project:{ id:{ eq:$projectId } } }){
+  nodes{ id identifier title state{ name type } parent{ identifier title state{ name type } } relations{ nodes{ type relatedIssue{ identifier title state{ name type } } } } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    type ref struct {
+        Identifier, Title string
+        State struct{ Name, Type string } `json:"state"`
+    }
+    toRelated := func(r ref) RelatedIssue { return RelatedIssue{Identifier: r.Identifier, Title: r.Title, StateName: r.State.Name, StateType: r.State.Type} }
+    var out []GraphIssue
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Issues struct {
+                Nodes []struct {
+                    ref
+                    ID        string `json:"id"`
+                    Parent    *ref   `json:"parent"`
+                    Relations struct {
+                        Nodes []struct {
+                            Type         string `json:"type"`
+                            RelatedIssue ref    `json:"relatedIssue"`
+                        } `json:"nodes"`
+                    } `json:"relations"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"issues"`
+        }
+        if err := c.do(q, map[string]interface{}{"projectId": projectID, "after": after}, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            g := GraphIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, StateName: n.State.Name, StateType: n.State.Type}
+            if n.Parent != nil { p := toRelated(*n.Parent); g.Parent = &p }
+            for _, r := range n.Relations.Nodes {
+                if r.Type == "blocks" { g.Blocks = append(g.Blocks, toRelated(r.RelatedIssue)) }
+            }
+            out = append(out, g)
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// ProjectMilestone is a milestone within a project
+type ProjectMilestone struct {
+    ID         string  `json:"id"`
+    Name       string  `json:"name"`
+    TargetDate string  `json:"targetDate,omitempty"`
+    SortOrder  float64 `json:"sortOrder"`
+}
+
+// ProjectIssue is an issue as listed under its project
+type ProjectIssue struct {
+    ID         string            `json:"id"`
+    Identifier string            `json:"identifier"`
+    Title      string            `json:"title"`
+    StateName  string            `json:"stateName"`
+    StateType  string            `json:"stateType"`
+    Priority   int               `json:"priority"`
+    Assignee   *User             `json:"assignee,omitempty"`
+    Milestone  *ProjectMilestone `json:"milestone,omitempty"`
+    TeamID     string            `json:"teamId,omitempty"`
+}
+
+// ListProjectIssues pages through the project's issues connection, with each
+// issue's state and milestone
+func (c *Client) ListProjectIssues(projectID string) ([]ProjectIssue, error) {
+    const q = `query($id:String!,$after:String){
+project(id:$id){
+  issues(first:100, after:$after){
+    nodes{ id identifier title priority state{ name type } assignee{ id name email } projectMilestone{ id name targetDate sortOrder } team{ id } }
+    pageInfo{ hasNextPage endCursor }
+  }
+} }`
+    var out []ProjectIssue
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Project *struct {
+                Issues struct {
+                    Nodes []struct {
+                        ID, Identifier, Title string
+                        Priority         int                         `json:"priority"`
+                        State            struct{ Name, Type string } `json:"state"`
+                        Assignee         *User                       `json:"assignee"`
+                        ProjectMilestone *ProjectMilestone           `json:"projectMilestone"`
+                        Team             *struct{ ID string }        `json:"team"`
+                    } `json:"nodes"`
+                    PageInfo PageInfo `json:"pageInfo"`
+                } `json:"issues"`
+            } `json:"project"`
+        }
+        if err := c.do(q, map[string]interface{}{"id": projectID, "after": after}, &resp); err != nil { return nil, err }
+        if resp.Project == nil { return nil, fmt.Errorf("project %s not found", projectID) }
+        for _, n := range resp.Project.Issues.Nodes {
+            it := ProjectIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, StateName: n.State.Name, StateType: n.State.Type, Priority: n.Priority, Assignee: n.Assignee, Milestone: n.ProjectMilestone}
+            if n.Team != nil { it.TeamID = n.Team.ID }
+            out = append(out, it)
+        }
+        if !resp.Project.Issues.PageInfo.HasNextPage { break }
+        after = resp.Project.Issues.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// Initiative groups projects, often across teams
+type Initiative struct {
+    ID       string    `json:"id"`
+    Name     string    `json:"name"`
+    Projects []Project `json:"projects"`
+}
+
+// ResolveInitiative resolves an initiative by id (exact) or by name (exact,
+// single), with its projects; nil when there is none
+func (c *Client) ResolveInitiative(input string) (*Initiative, error) {
+    type node struct {
+        ID, Name string
+        Projects struct{ Nodes []struct{ ID, Name, State, URL string } `json:"nodes"` } `json:"projects"`
+    }
+    toInitiative := func(n node) *Initiative {
+        in := &Initiative{ID: n.ID, Name: n.Name, Projects: []Project{}}
+        for _, p := range n.Projects.Nodes { in.Projects = append(in.Projects, Project{ID: p.ID, Name: p.Name, State: p.State, URL: p.URL}) }
+        return in
+    }
+    {
+        const q = `query($id:String!){ initiative(id:$id){ id name projects(first:100){ nodes{ id name state url } } } }`
+        var resp struct{ Initiative *node `json:"initiative"` }
+        if err := c.do(q, map[string]interface{}{"id": input}, &resp); err == nil && resp.Initiative != nil { return toInitiative(*resp.Initiative), nil }
+    }
+    const q = `query($name:String!){ initiatives(filter:{ name:{ eq:$name } }, first:2){ nodes{ id name projects(first:100){ nodes{ id name state url } } } } }`
+    var resp struct{ Initiatives struct{ Nodes []node `json:"nodes"` } `json:"initiatives"` }
+    if err := c.do(q, map[string]interface{}{"name": input}, &resp); err != nil { return nil, err }
+    if len(resp.Initiatives.Nodes) == 0 { return nil, nil }
+    if len(resp.Initiatives.Nodes) > 1 { return nil, fmt.Errorf("multiple initiatives named '%s'", input) }
+    return toInitiative(resp.Initiatives.Nodes[0]), nil
+}
+
+// --- Duplicates ---
+
+// CreateIssueRelation links two issues; relType is one of blocks, duplicate or related.
+// For duplicate, issueID is marked as a duplicate of relatedIssueID.
+func (c *Client) CreateIssueRelation(issueID, relatedIssueID, relType string) error {
+    const q = `mutation($input: IssueRelationCreateInput!){ issueRelationCreate(input:$input){ success issueRelation{ id type } } }`
+    var resp struct{ IssueRelationCreate struct{ Success bool `json:"success"` } `json:"issueRelationCreate"` }
+    input := map[string]interface{}{"issueId": issueID, "relatedIssueId": relatedIssueID, "type": relType}
+    if err := c.do(q, map[string]interface{}{"input": input}, &resp); err != nil { return err }
+    if !resp.IssueRelationCreate.Success { return errors.New("relation creation failed") }
+    return nil
+}
+
+// ListSubIssues returns the direct children of an issue
+func (c *Client) ListSubIssues(issueID string) ([]RelatedIssue, error) {
+    const q = `query($id:String!){ issue(id:$id){ children(first:250){ nodes{ id identifier title state{ name type } } } } }`
+    var resp struct{ Issue *struct{ Children struct{ Nodes []struct {
+        ID, Identifier, Title string
+        State struct{ Name, Type string } `json:"state"`
+    } `json:"nodes"` } `json:"children"` } `json:"issue"` }
+    if err := c.do(q, map[string]interface{}{"id": issueID}, &resp); err != nil { return nil, err }
+    if resp.Issue == nil { return nil, nil }
+    out := make([]RelatedIssue, 0, len(resp.Issue.Children.Nodes))
+    for _, n := range resp.Issue.Children.Nodes {
+        out = append(out, RelatedIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, StateName: n.State.Name, StateType: n.State.Type})
+    }
+    return out, nil
+}
+
+// DescendantIssue is an issue below another in the sub-issue tree; Depth is 1
+// for direct children
+type DescendantIssue struct {
+    ID         string   `json:"id"`
+    Identifier string   `json:"identifier"`
+    Title      string   `json:"title"`
+    ParentID   string   `json:"parentId"`
+    Depth      int      `json:"depth"`
+    Estimate   *float64 `json:"estimate,omitempty"`
+    StateName  string   `json:"stateName"`
+    StateType  string   `json:"stateType"`
+}
+
+// ListIssueDescendants walks the sub-issue tree below an issue breadth first,
+// paging through each level's children. Only issues that have children of
+// their own are queried, so a tree costs one request per parent (and per 100
+// children of it).
+func (c *Client) ListIssueDescendants(issueID string) ([]DescendantIssue, error) {
+    const q = `query($id:String!,$after:String){ issue(id:$id){ children(first:100, after:$after){
+  nodes{ id identifier title estimate state{ name type } children(first:1){ nodes{ id } } }
+  pageInfo{ hasNextPage endCursor }
+} } }`
+    type parent struct {
+        id    string
+        depth int
+    }
+    var out []DescendantIssue
+    seen := map[string]bool{issueID: true}
+    queue := []parent{{issueID, 0}}
+    for len(queue) > 0 {
+        par := queue[0]
+        queue = queue[1:]
+        var after interface{}
+        for page := 0; page < maxPages; page++ {
+            var resp struct{ Issue *struct{ Children struct {
+                Nodes []struct {
+                    ID, Identifier, Title string
+                    Estimate *float64 `json:"estimate"`
+                    State    struct{ Name, Type string } `json:"state"`
+                    Children struct{ Nodes []struct{ ID string `json:"id"` } `json:"nodes"` } `json:"children"`
+                } `json:"nodes"`
+                PageInfo PageInfo `json:"pageInfo"`
+            } `json:"children"` } `json:"issue"` }
+            if err := c.do(q, map[string]interface{}{"id": par.id, "after": after}, &resp); err != nil { return nil, err }
+            if resp.Issue == nil { break }
+            for _, n := range resp.Issue.Children.Nodes {
+                if seen[n.ID] { continue }
+                seen[n.ID] = true
+                out = append(out, DescendantIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, ParentID: par.id, Depth: par.depth + 1, Estimate: n.Estimate, StateName: n.State.Name, StateType: n.State.Type})
+                if len(n.Children.Nodes) > 0 { queue = append(queue, parent{n.ID, par.depth + 1}) }
+            }
+            if !resp.Issue.Children.PageInfo.HasNextPage { break }
+            after = resp.Issue.Children.PageInfo.EndCursor
+        }
+    }
+    return out, nil
+}
+
+// --- Cycles ---
+
+// Cycle is one of a team's iterations
+type Cycle struct {
+    ID          string     `json:"id"`
+    Number      int        `json:"number"`
+    Name        string     `json:"name,omitempty"`
+    StartsAt    time.Time  `json:"startsAt"`
+    EndsAt      time.Time  `json:"endsAt"`
+    CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// CycleIssue is an issue's scope-relevant fields within a cycle. Carried marks
+// issues that were still open when the cycle closed (Linear moves those on).
+type CycleIssue struct {
+    ID          string     `json:"id"`
+    Identifier  string     `json:"identifier"`
+    Estimate    float64    `json:"estimate"`
+    StateType   string     `json:"stateType"`
+    CompletedAt *time.Time `json:"completedAt,omitempty"`
+    Carried     bool       `json:"carried,omitempty"`
+}
+
+// ListTeamCycles pages through a team's cycles that started before now, newest first
+func (c *Client) ListTeamCycles(teamID string, now time.Time) ([]Cycle, error) {
+    const q = `query($teamId:ID!,$now:DateTimeOrDuration!,$after:String){
+cycles(first:100, after:$after, filter:{ team:{ id:{ eq:$teamId } }, startsAt:{ lt:$now } }){
+  nodes{ id number name startsAt endsAt completedAt }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []Cycle
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Cycles struct{ Nodes []Cycle `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"cycles"` }
+        vars := map[string]interface{}{"teamId": teamID, "now": now.UTC().Format(time.RFC3339), "after": after}
+        if err := c.do(q, vars, &resp); err != nil { return nil, err }
+        out = append(out, resp.Cycles.Nodes...)
+        if !resp.Cycles.PageInfo.HasNextPage { break }
+        after = resp.Cycles.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].Number > out[j].Number })
+    return out, nil
+}
+
+// NextCycle returns the team's earliest cycle starting after now, or nil when
+// none is scheduled
+func (c *Client) NextCycle(teamID string, now time.Time) (*Cycle, error) {
+    const q = `query($teamId:ID!,$now:DateTimeOrDuration!){
+cycles(first:20, filter:{ team:{ id:{ eq:$teamId } }, startsAt:{ gt:$now } }){ nodes{ id number name startsAt endsAt completedAt } }
+}`
+    var resp struct{ Cycles struct{ Nodes []Cycle `json:"nodes"` } `json:"cycles"` }
+    if err := c.do(q, map[string]interface{}{"teamId": teamID, "now": now.UTC().Format(time.RFC3339)}, &resp); err != nil { return nil, err }
+    var next *Cycle
+    for i := range resp.Cycles.Nodes {
+        if next == nil || resp.Cycles.Nodes[i].StartsAt.Before(next.StartsAt) { next = &resp.Cycles.Nodes[i] }
+    }
+    return next, nil
+}
+
+// AllTeamCycles pages through all of a team's cycles, past and upcoming, in
+// number order
+func (c *Client) AllTeamCycles(teamID string) ([]Cycle, error) {
+    const q = `query($teamId:ID!,$after:String){
+cycles(first:100, after:$after, filter:{ team:{ id:{ eq:$teamId } } }){
+  nodes{ id number name startsAt endsAt completedAt }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []Cycle
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Cycles struct{ Nodes []Cycle `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"cycles"` }
+        if err := c.do(q, map[string]interface{}{"teamId": teamID, "after": after}, &resp); err != nil { return nil, err }
+        out = append(out, resp.Cycles.Nodes...)
+        if !resp.Cycles.PageInfo.HasNextPage { break }
+        after = resp.Cycles.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].Number < out[j].Number })
+    return out, nil
+}
+
+// IssueCycle is an issue's team and the cycle it is in, if any
+type IssueCycle struct {
+    ID         string `json:"id"`
+    Identifier string `json:"identifier"`
+    Team       *Team  `json:"team,omitempty"`
+    Cycle      *Cycle `json:"cycle,omitempty"`
+}
+
+// GetIssueCycle returns the team and current cycle of an issue
+func (c *Client) GetIssueCycle(issueID string) (*IssueCycle, error) {
+    const q = `query($id:String!){ issue(id:$id){ id identifier team{ id key name } cycle{ id number name startsAt endsAt completedAt } } }`
+    var resp struct{ Issue *IssueCycle `json:"issue"` }
+    if err := c.do(q, map[string]interface{}{"id": issueID}, &resp); err != nil { return nil, err }
+    if resp.Issue == nil { return nil, fmt.Errorf("issue %s not found", issueID) }
+    return resp.Issue, nil
+}
+
+// BacklogIssue is an unscheduled issue in one of a team's backlog states
+type BacklogIssue struct {
+    ID         string  `json:"id"`
+    Identifier string  `json:"identifier"`
+    Title      string  `json:"title"`
+    Priority   int     `json:"priority"`
+    Estimate   float64 `json:"estimate"`
+    Estimated  bool    `json:"estimated"`
+    StateName  string  `json:"state"`
+}
+
+// ListBacklogIssues pages through a team's issues in backlog states that are not
+// in any cycle
+func (c *Client) ListBacklogIssues(teamID string) ([]BacklogIssue, error) {
+    const q = `query($teamId:ID!,$after:String){
+issues(first:100, after:$after, filter:{ team:{ id:{ eq:$teamId } }, state:{ type:{ eq:"backlog" } }, cycle:{ null:true } }){
+  nodes{ id identifier title priority estimate state{ name } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    type node struct {
+        ID, Identifier, Title string
+        Priority int      `json:"priority"`
+        Estimate *float64 `json:"estimate"`
+        State    struct{ Name string `json:"name"` } `json:"state"`
+    }
+    var out []BacklogIssue
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Issues struct{ Nodes []node `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"issues"` }
+        if err := c.do(q, map[string]interface{}{"teamId": teamID, "after": after}, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            bi := BacklogIssue{ID: n.ID, Identifier: n.Identifier, Title: n.Title, Priority: n.Priority, StateName: n.State.Name}
+            if n.Estimate != nil { bi.Estimate, bi.Estimated = *n.Estimate, true }
+            out = append(out, bi)
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        after = resp.Issues.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// ListCycleIssues returns the issues in a cycle plus, for closed cycles, the
+// issues that were carried over to the next one.
+func (c *Client) ListCycleIssues(cycleID string) ([]CycleIssue, error) {
+    const q = `query($id:String!,$after:String){ cycle(id:$id){
+  issues(first:100, after:$after){ nodes{ id identifier estimate completedAt state{ type } } pageInfo{ hasNextPage endCursor } }
+} }`
+    const qCarried = `query($id:String!,$after:String){ cycle(id:$id){
+  uncompletedIssuesUponClose(first:100, after:$after){ nodes{ id identifier estimate completedAt state{ type } } pageInfo{ hasNextPage endCursor } }
+} }`
+    type node struct {
+        ID, Identifier string
+        Estimate    *float64   `json:"estimate"`
+        CompletedAt *time.Time `json:"completedAt"`
+        State       struct{ Type string `json:"type"` } `json:"state"`
+    }
+    type conn struct{ Nodes []node `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` }
+    seen := map[string]bool{}
+    var out []CycleIssue
+    fetch := func(q string, carried bool) error {
+        var after interface{}
+        for page := 0; page < maxPages; page++ {
+            var resp struct{ Cycle *struct{ Issues *conn `json:"issues"`; Uncompleted *conn `json:"uncompletedIssuesUponClose"` } `json:"cycle"` }
+            if err := c.do(q, map[string]interface{}{"id": cycleID, "after": after}, &resp); err != nil { return err }
+            if resp.Cycle == nil { return nil }
+            cn := resp.Cycle.Issues
+            if carried { cn = resp.Cycle.Uncompleted }
+            if cn == nil { return nil }
+            for _, n := range cn.Nodes {
+                if seen[n.ID] { continue }
+                seen[n.ID] = true
+                ci := CycleIssue{ID: n.ID, Identifier: n.Identifier, StateType: n.State.Type, CompletedAt: n.CompletedAt, Carried: carried}
+                if n.Estimate != nil { ci.Estimate = *n.Estimate }
+                out = append(out, ci)
+            }
+            if !cn.PageInfo.HasNextPage { return nil }
+            after = cn.PageInfo.EndCursor
+        }
+        return nil
+    }
+    if err := fetch(q, false); err != nil { return nil, err }
+    if err := fetch(qCarried, true); err != nil { return nil, err }
+    return out, nil
+}
+
+// --- Notifications ---
+
+// ListMentionedIssueIDs returns the IDs of issues whose mention notifications
+// (in descriptions or comments) reached the viewer since the given time, most
+// recent first.
+func (c *Client) ListMentionedIssueIDs(since time.Time) ([]string, error) {
+    const q = `query($after:String){
+notifications(first:100, after:$after){
+  nodes{ type createdAt ... on IssueNotification{ issue{ id } } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []string
+    seen := map[string]bool{}
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Notifications struct{ Nodes []struct {
+            Type      string    `json:"type"`
+            CreatedAt time.Time `json:"createdAt"`
+            Issue     *struct{ ID string `json:"id"` } `json:"issue"`
+        } `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"notifications"` }
+        if err := c.do(q, map[string]interface{}{"after": after}, &resp); err != nil { return nil, err }
+        older := false
+        for _, n := range resp.Notifications.Nodes {
+            if n.CreatedAt.Before(since) { older = true; continue }
+            if n.Issue == nil || !strings.Contains(strings.ToLower(n.Type), "mention") || seen[n.Issue.ID] { continue }
+            seen[n.Issue.ID] = true
+            out = append(out, n.Issue.ID)
+        }
+        // Notifications arrive newest first, so an older one ends the window
+        if older || !resp.Notifications.PageInfo.HasNextPage { break }
+        after = resp.Notifications.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// Organization describes the workspace the API key belongs to. Fields the key
+// may not be allowed to read (admin-only settings, billing) are nil when the
+// API refused them.
+type Organization struct {
+    ID           string    `json:"id"`
+    Name         string    `json:"name"`
+    URLKey       string    `json:"urlKey"`
+    URL          string    `json:"url"`
+    CreatedAt    time.Time `json:"createdAt"`
+    UserCount    int       `json:"userCount"`
+    Plan         string    `json:"plan,omitempty"`
+    Seats        *int      `json:"seats"`
+    SAMLEnabled  *bool     `json:"samlEnabled"`
+    SCIMEnabled  *bool     `json:"scimEnabled"`
+    Integrations []string  `json:"integrations"`
+}
+
+// GetOrganization returns the viewer's workspace. The basics must load; billing,
+// SSO settings and integrations are each fetched separately and left out when
+// the key lacks access.
+func (c *Client) GetOrganization() (*Organization, error) {
+    const q = `query{ organization{ id name urlKey createdAt userCount } }`
+    var resp struct{ Organization Organization `json:"organization"` }
+    if err := c.do(q, nil, &resp); err != nil { return nil, err }
+    org := resp.Organization
+    if org.URLKey != "" { org.URL = "https://linear.app/" + org.URLKey }
+
+    var sub struct{ Organization struct{ Subscription *struct{ Type string `json:"type"`; Seats int `json:"seats"` } `json:"subscription"` } `json:"organization"` }
+    if err := c.do(`query{ organization{ subscription{ type seats } } }`, nil, &sub); err == nil && sub.Organization.Subscription != nil {
+        org.Plan = sub.Organization.Subscription.Type
+        org.Seats = &sub.Organization.Subscription.Seats
+    }
+    var sso struct{ Organization struct{ SAMLEnabled *bool `json:"samlEnabled"`; SCIMEnabled *bool `json:"scimEnabled"` } `json:"organization"` }
+    if err := c.do(`query{ organization{ samlEnabled scimEnabled } }`, nil, &sso); err == nil {
+        org.SAMLEnabled, org.SCIMEnabled = sso.Organization.SAMLEnabled, sso.Organization.SCIMEnabled
+    }
+    var integ struct{ Integrations struct{ Nodes []struct{ Service string `json:"service"` } `json:"nodes"` } `json:"integrations"` }
+    if err := c.do(`query{ integrations(first:100){ nodes{ service } } }`, nil, &integ); err == nil {
+        seen := map[string]bool{}
+        org.Integrations = []string{}
+        for _, n := range integ.Integrations.Nodes {
+            if n.Service == "" || seen[n.Service] { continue }
+            seen[n.Service] = true
+            org.Integrations = append(org.Integrations, n.Service)
+        }
+        sort.Strings(org.Integrations)
+    }
+    return &org, nil
+}
+
+// --- Workspace users ---
+
+// WorkspaceUser is a member of the workspace with what seat reviews need
+type WorkspaceUser struct {
+    User
+    Admin     bool       `json:"admin"`
+    Guest     bool       `json:"guest"`
+    CreatedAt time.Time  `json:"createdAt"`
+    LastSeen  *time.Time `json:"lastSeen,omitempty"`
+}
+
+// ListActiveUsers pages through the workspace's enabled users (those holding a seat)
+func (c *Client) ListActiveUsers() ([]WorkspaceUser, error) {
+    const q = `query($after:String){ users(first:100, after:$after, filter:{ active:{ eq:true } }){
+  nodes{ id name email admin guest createdAt lastSeen }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []WorkspaceUser
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Users struct{ Nodes []WorkspaceUser `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"users"` }
+        if err := c.do(q, map[string]interface{}{"after": after}, &resp); err != nil { return nil, err }
+        out = append(out, resp.Users.Nodes...)
+        if !resp.Users.PageInfo.HasNextPage { break }
+        after = resp.Users.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// MentionUser is a workspace user with the handle and profile URL that
+// @-mentions in comments resolve to
+type MentionUser struct {
+    ID          string `json:"id"`
+    Name        string `json:"name"`
+    DisplayName string `json:"displayName"`
+    Email       string `json:"email"`
+    URL         string `json:"url"`
+}
+
+// ListMentionUsers pages through the workspace's active users for mention lookup
+func (c *Client) ListMentionUsers() ([]MentionUser, error) {
+    const q = `query($after:String){ users(first:100, after:$after, filter:{ active:{ eq:true } }){
+  nodes{ id name displayName email url }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []MentionUser
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Users struct{ Nodes []MentionUser `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"users"` }
+        if err := c.do(q, map[string]interface{}{"after": after}, &resp); err != nil { return nil, err }
+        out = append(out, resp.Users.Nodes...)
+        if !resp.Users.PageInfo.HasNextPage { break }
+        after = resp.Users.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// UserActivity counts what one user did in a period; Last is their latest action
+type UserActivity struct {
+    IssuesCreated int        `json:"issuesCreated"`
+    IssuesUpdated int        `json:"issuesUpdated"`
+    Comments      int        `json:"comments"`
+    Last          *time.Time `json:"lastActivity,omitempty"`
+}
+
+func (a *UserActivity) note(at time.Time) {
+    if a.Last == nil || at.After(*a.Last) { a.Last = &at }
+}
+
+// UserActivitySince counts, per user ID, the issues created, issue changes
+// made (from issue history) and comments written since the given time. Only
+// the first 50 history entries of each issue are read, so heavily edited
+// issues may be undercounted.
+func (c *Client) UserActivitySince(since time.Time) (map[string]*UserActivity, error) {
+    const qIssues = `query($since:DateTimeOrDuration!,$after:String){
+issues(first:50, after:$after, includeArchived:true, filter:{ updatedAt:{ gt:$since } }){
+  nodes{ createdAt creator{ id } history(first:50){ nodes{ createdAt actor{ id } } } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    const qComments = `query($since:DateTimeOrDuration!,$after:String){
+comments(first:100, after:$after, filter:{ createdAt:{ gt:$since } }){
+  nodes{ createdAt user{ id } }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    type ref struct{ ID string `json:"id"` }
+    out := map[string]*UserActivity{}
+    get := func(id string) *UserActivity {
+        if out[id] == nil { out[id] = &UserActivity{} }
+        return out[id]
+    }
+    vars := map[string]interface{}{"since": since.UTC().Format(time.RFC3339), "after": nil}
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Issues struct{ Nodes []struct {
+            CreatedAt time.Time `json:"createdAt"`
+            Creator   *ref      `json:"creator"`
+            History   struct{ Nodes []struct{ CreatedAt time.Time `json:"createdAt"`; Actor *ref `json:"actor"` } `json:"nodes"` } `json:"history"`
+        } `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"issues"` }
+        if err := c.do(qIssues, vars, &resp); err != nil { return nil, err }
+        for _, n := range resp.Issues.Nodes {
+            if n.Creator != nil && n.CreatedAt.After(since) {
+                a := get(n.Creator.ID)
+                a.IssuesCreated++
+                a.note(n.CreatedAt)
+            }
+            for _, h := range n.History.Nodes {
+                if h.Actor == nil || !h.CreatedAt.After(since) { continue }
+                a := get(h.Actor.ID)
+                a.IssuesUpdated++
+                a.note(h.CreatedAt)
+            }
+        }
+        if !resp.Issues.PageInfo.HasNextPage { break }
+        vars["after"] = resp.Issues.PageInfo.EndCursor
+    }
+    vars["after"] = nil
+    for page := 0; page < maxPages; page++ {
+        var resp struct{ Comments struct{ Nodes []struct{ CreatedAt time.Time `json:"createdAt"`; User *ref `json:"user"` } `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` } `json:"comments"` }
+        if err := c.do(qComments, vars, &resp); err != nil { return nil, err }
+        for _, n := range resp.Comments.Nodes {
+            if n.User == nil { continue }
+            a := get(n.User.ID)
+            a.Comments++
+            a.note(n.CreatedAt)
+        }
+        if !resp.Comments.PageInfo.HasNextPage { break }
+        vars["after"] = resp.Comments.PageInfo.EndCursor
+    }
+    return out, nil
+}
+
+// --- Workspace export ---
+
+// ExportResource is one workspace collection dumped by 'export workspace'. Nodes
+// are passed through as the API returns them, so the export keeps every
+// selected field without a Go type per resource.
+type ExportResource struct {
+    Name  string
+    field string
+    query string
+}
+
+// ExportResources lists what a workspace export contains, in dependency order
+var ExportResources = []ExportResource{
+    {"teams", "teams", `query($after:String){ teams(first:100, after:$after){ nodes{ id key name description private createdAt } pageInfo{ hasNextPage endCursor } } }`},
+    {"users", "users", `query($after:String){ users(first:100, after:$after){ nodes{ id name displayName email active admin createdAt } pageInfo{ hasNextPage endCursor } } }`},
+    {"states", "workflowStates", `query($after:String){ workflowStates(first:250, after:$after){ nodes{ id name type position color team{ id key } } pageInfo{ hasNextPage endCursor } } }`},
+    {"labels", "issueLabels", `query($after:String){ issueLabels(first:250, after:$after){ nodes{ id name color description parent{ id } team{ id key } } pageInfo{ hasNextPage endCursor } } }`},
+    {"projects", "projects", `query($after:String){ projects(first:50, after:$after){ nodes{ id name description state url startDate targetDate createdAt updatedAt completedAt canceledAt lead{ id name } teams{ nodes{ id key } } } pageInfo{ hasNextPage endCursor } } }`},
+    {"issues", "issues", `query($after:String){ issues(first:50, after:$after, includeArchived:true){ nodes{ id identifier number title description url priority estimate dueDate createdAt updatedAt startedAt completedAt canceledAt archivedAt state{ id name type } team{ id key } assignee{ id name } creator{ id name } project{ id name } cycle{ id number } parent{ id identifier } labels(first:25){ nodes{ id name } } } pageInfo{ hasNextPage endCursor } } }`},
+    {"comments", "comments", `query($after:String){ comments(first:100, after:$after){ nodes{ id body createdAt updatedAt issue{ id identifier } user{ id name } parent{ id } } pageInfo{ hasNextPage endCursor } } }`},
+}
+
+// EachExportNode pages through a resource, calling fn with each raw node and
+// page reporting the running count; it returns how many nodes were seen.
+func (c *Client) EachExportNode(r ExportResource, fn func(node json.RawMessage) error, page func(count int)) (int, error) {
+    var after interface{}
+    count := 0
+    // Unlike listings there is no maxPages cap: stopping early would silently drop data
+    for {
+        var resp map[string]struct{ Nodes []json.RawMessage `json:"nodes"`; PageInfo PageInfo `json:"pageInfo"` }
+        if err := c.do(r.query, map[string]interface{}{"after": after}, &resp); err != nil { return count, fmt.Errorf("%s: %w", r.Name, err) }
+        conn := resp[r.field]
+        for _, n := range conn.Nodes {
+            if err := fn(n); err != nil { return count, err }
+            count++
+        }
+        if page != nil { page(count) }
+        if !conn.PageInfo.HasNextPage || len(conn.Nodes) == 0 { break }
+        after = conn.PageInfo.EndCursor
+    }
+    return count, nil
+}
+
+// --- Documents ---
+
+// Document is a Linear document (spec, RFC, notes), usually attached to a project
+type Document struct {
+    ID        string    `json:"id"`
+    Title     string    `json:"title"`
+    SlugID    string    `json:"slugId"`
+    URL       string    `json:"url"`
+    Content   string    `json:"content,omitempty"`
+    Project   *Project  `json:"project,omitempty"`
+    Creator   *User     `json:"creator,omitempty"`
+    UpdatedAt time.Time `json:"updatedAt"`
+}
+
+const documentFields = `id title slugId url updatedAt project{ id name } creator{ id name email }`
+
+// ListDocuments pages through the documents of a project, most recently updated first
+func (c *Client) ListDocuments(projectID string) ([]Document, error) {
+    const q = `query($projectId:ID!,$after:String){
+documents(first:100, after:$after, filter:{ project:{ id:{ eq:$projectId } } }){
+  nodes{ ` + documentFields + ` }
+  pageInfo{ hasNextPage endCursor }
+} }`
+    var out []Document
+    var after interface{}
+    for page := 0; page < maxPages; page++ {
+        var resp struct {
+            Documents struct {
+                Nodes    []Document `json:"nodes"`
+                PageInfo PageInfo   `json:"pageInfo"`
+            } `json:"documents"`
+        }
+        if err := c.do(q, map[string]interface{}{"projectId": projectID, "after": after}, &resp); err != nil { return nil, err }
+        out = append(out, resp.Documents.Nodes...)
+        if !resp.Documents.PageInfo.HasNextPage { break }
+        after = resp.Documents.PageInfo.EndCursor
+    }
+    sort.SliceStable(out, func(i, j int) bool { return out[i].UpdatedAt.After(out[j].UpdatedAt) })
+    return out, nil
+}
+
+// GetDocument returns a document with its content by id or slug id, or nil if
+// there is no such document
+func (c *Client) GetDocument(id string) (*Document, error) {
+    const q = `query($id:String!){ document(id:$id){ ` + documentFields + ` content } }`
+    var resp struct{ Document *Document `json:"document"` }
+    if err := c.do(q, map[string]interface{}{"id": id}, &resp); err != nil {
+        if strings.Contains(strings.ToLower(err.Error()), "not found") { return nil, nil }
+        return nil, err
+    }
+    return resp.Document, nil
+}
+
+// CreateDocument creates a markdown document in a project
+func (c *Client) CreateDocument(projectID, title, content string) (*Document, error) {
+    const q = `mutation($input:DocumentCreateInput!){ documentCreate(input:$input){ success document{ ` + documentFields + ` content } } }`
+    input := map[string]interface{}{"projectId": projectID, "title": title, "content": content}
+    var resp struct{ DocumentCreate struct{ Success bool `json:"success"`; Document *Document `json:"document"` } `json:"documentCreate"` }
+    if err := c.do(q, map[string]interface{}{"input": input}, &resp); err != nil { return nil, err }
+    if !resp.DocumentCreate.Success || resp.DocumentCreate.Document == nil { return nil, errors.New("document creation failed") }
+    return resp.DocumentCreate.Document, nil
+}
+
+// UpdateDocument changes a document's title and/or content; empty values are left as they are
+func (c *Client) UpdateDocument(id, title, content string) (*Document, error) {
+    const q = `mutation($id:String!,$input:DocumentUpdateInput!){ documentUpdate(id:$id, input:$input){ success document{ ` + documentFields + ` content } } }`
+    input := map[string]interface{}{}
+    if title != "" { input["title"] = title }
+    if content != "" { input["content"] = content }
+    var resp struct{ DocumentUpdate struct{ Success bool `json:"success"`; Document *Document `json:"document"` } `json:"documentUpdate"` }
+    if err := c.do(q, map[string]interface{}{"id": id, "input": input}, &resp); err != nil { return nil, err }
+    if !resp.DocumentUpdate.Success || resp.DocumentUpdate.Document == nil { return nil, errors.New("document update failed") }
+    return resp.DocumentUpdate.Document, nil
+}
@@ -1,11 +1,17 @@
 package api
 
 import (
+    "context"
     "encoding/json"
+    "errors"
+    "fmt"
     "net/http"
     "net/http/httptest"
+    "reflect"
     "regexp"
+    "strings"
     "testing"
+    "time"
 )
 
 type gqlPayload struct {
@@ -151,3 +157,504 @@ func TestIssueComments_UsesStringVarType(t *testing.T) {
     if err != nil { t.Fatalf("IssueComments error: %v", err) }
     if len(got) != 1 || got[0].ID != "c1" { t.Fatalf("IssueComments unexpected result: %+v", got) }
 }
+
+func TestCreateContextForTeam_SingleRoundTrip(t *testing.T) {
+    calls := 0
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        calls++
+        p := readGQL(t, r)
+        for _, field := range []string{"states(", "labels(", "members(", "templates{", `__type(name:"IssueCreateInput")`} {
+            if !regexp.MustCompile(regexp.QuoteMeta(field)).MatchString(p.Query) {
+                t.Fatalf("combined query missing %s: %s", field, p.Query)
+            }
+        }
+        respondJSON(w, map[string]any{
+            "data": map[string]any{
+                "teams": map[string]any{
+                    "nodes": []any{
+                        map[string]any{
+                            "id": "team_1", "key": "ENG", "name": "Engineering",
+                            "states":    map[string]any{"nodes": []any{map[string]any{"id": "s1", "name": "Backlog", "type": "backlog"}, map[string]any{"id": "s2", "name": "Todo", "type": "unstarted"}}},
+                            "labels":    map[string]any{"nodes": []any{map[string]any{"id": "l1", "name": "Bug"}}},
+                            "members":   map[string]any{"nodes": []any{map[string]any{"id": "u1", "name": "Ada Lovelace", "email": "ada@example.com"}}},
+                            "templates": map[string]any{"nodes": []any{map[string]any{"id": "t1", "name": "Feature Template"}}},
+                        },
+                    },
+                },
+                "__type": map[string]any{"inputFields": []any{map[string]any{"name": "title"}, map[string]any{"name": "templateId"}}},
+            },
+        })
+    })
+
+    cc, err := c.CreateContextForTeam("ENG")
+    if err != nil { t.Fatalf("CreateContextForTeam error: %v", err) }
+    if calls != 1 { t.Fatalf("expected 1 request, got %d", calls) }
+    if cc == nil || cc.Team.ID != "team_1" || len(cc.States) != 2 { t.Fatalf("unexpected context: %+v", cc) }
+    if !cc.SupportsTemplateID { t.Fatalf("expected templateId support to be detected") }
+    if l := cc.LabelByName("bug"); l == nil || l.ID != "l1" { t.Fatalf("LabelByName failed: %+v", l) }
+    if u, err := cc.MemberByName("ada"); err != nil || u == nil || u.ID != "u1" { t.Fatalf("MemberByName failed: %+v %v", u, err) }
+    if tpl := cc.TemplateByName("feature template"); tpl == nil || tpl.ID != "t1" { t.Fatalf("TemplateByName failed: %+v", tpl) }
+}
+
+func TestListIssueComments_PaginatesWithSinceFilter(t *testing.T) {
+    calls := 0
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        calls++
+        if !regexp.MustCompile(`createdAt:\s*\{\s*gte:\$since`).MatchString(p.Query) { t.Fatalf("expected createdAt filter: %s", p.Query) }
+        if p.Variables["since"] != "2024-01-01T00:00:00Z" { t.Fatalf("unexpected since var: %v", p.Variables["since"]) }
+        page := map[string]any{"nodes": []any{map[string]any{"id": "c2", "body": "later", "createdAt": "2024-02-01T00:00:00Z", "user": map[string]any{"name": "Ada"}}}, "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "cur1"}}
+        if calls == 2 {
+            if p.Variables["after"] != "cur1" { t.Fatalf("expected cursor on second page, got %v", p.Variables["after"]) }
+            page = map[string]any{"nodes": []any{map[string]any{"id": "c1", "body": "first", "createdAt": "2024-01-05T00:00:00Z"}}, "pageInfo": map[string]any{"hasNextPage": false}}
+        }
+        respondJSON(w, map[string]any{"data": map[string]any{"issue": map[string]any{"comments": page}}})
+    })
+
+    got, err := c.ListIssueComments("iss_1", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 0)
+    if err != nil { t.Fatalf("ListIssueComments error: %v", err) }
+    if calls != 2 || len(got) != 2 { t.Fatalf("expected 2 pages / 2 comments, got %d calls, %+v", calls, got) }
+    if got[0].ID != "c1" || got[1].User == nil || got[1].User.Name != "Ada" { t.Fatalf("expected oldest-first with authors, got %+v", got) }
+}
+
+func TestDo_RetriesTransientStatusWithFreshBody(t *testing.T) {
+    calls := 0
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        calls++
+        p := readGQL(t, r) // fails if the body was not recreated for the retry
+        if p.Query == "" { t.Fatalf("empty query on attempt %d", calls) }
+        if calls < 3 {
+            w.Header().Set("Retry-After", "0")
+            w.WriteHeader(http.StatusServiceUnavailable)
+            return
+        }
+        respondJSON(w, map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1", "name": "Ada"}}})
+    })
+    v, err := c.Viewer()
+    if err != nil { t.Fatalf("Viewer error: %v", err) }
+    if calls != 3 || v.ID != "u1" { t.Fatalf("expected success on third attempt, got %d calls, %+v", calls, v) }
+}
+
+func TestDo_StopsAtMaxAttemptsAndHonorsCancellation(t *testing.T) {
+    calls := 0
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        calls++
+        w.Header().Set("Retry-After", "0")
+        w.WriteHeader(http.StatusTooManyRequests)
+    })
+    c.maxAttempts = 2
+    if _, err := c.Viewer(); err == nil || calls != 2 { t.Fatalf("expected error after 2 attempts, got err=%v calls=%d", err, calls) }
+
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    start := time.Now()
+    if _, err := c.WithContext(ctx).Viewer(); !errors.Is(err, context.Canceled) { t.Fatalf("expected context.Canceled, got %v", err) }
+    if time.Since(start) > time.Second { t.Fatalf("cancelled request took too long") }
+}
+
+func TestWithRequestStats_ReportsAttemptsAndStatus(t *testing.T) {
+    calls := 0
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        calls++
+        if calls == 1 {
+            w.Header().Set("Retry-After", "0")
+            w.WriteHeader(http.StatusServiceUnavailable)
+            return
+        }
+        respondJSON(w, map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1"}}})
+    })
+    var stats []RequestStat
+    c = c.WithRequestStats(func(st RequestStat) { stats = append(stats, st) })
+    if _, err := c.Viewer(); err != nil { t.Fatalf("Viewer error: %v", err) }
+    if len(stats) != 1 { t.Fatalf("expected one stat per request, got %+v", stats) }
+    if st := stats[0]; st.Operation != "query viewer" || st.Attempts != 2 || st.Status != 200 || st.Error != "" || st.Duration <= 0 {
+        t.Fatalf("unexpected stat %+v", st)
+    }
+}
+
+func TestListStaleIssues_FiltersOpenAndInactive(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if !regexp.MustCompile(`updatedAt:\{\s*lt:\$before\s*\}`).MatchString(p.Query) || !regexp.MustCompile(`type:\{\s*nin:\["completed","canceled"\]`).MatchString(p.Query) {
+            t.Fatalf("expected open + inactive filters: %s", p.Query)
+        }
+        if p.Variables["before"] != "2025-01-01T00:00:00Z" { t.Fatalf("unexpected before var: %v", p.Variables["before"]) }
+        respondJSON(w, map[string]any{"data": map[string]any{"issues": map[string]any{
+            "nodes": []any{
+                map[string]any{"id": "i2", "identifier": "ENG-2", "updatedAt": "2024-12-01T00:00:00Z", "state": map[string]any{"name": "Todo"}, "labels": map[string]any{"nodes": []any{}}},
+                map[string]any{"id": "i1", "identifier": "ENG-1", "updatedAt": "2024-10-01T00:00:00Z", "state": map[string]any{"name": "Backlog"}, "labels": map[string]any{"nodes": []any{}}},
+            },
+            "pageInfo": map[string]any{"hasNextPage": false},
+        }}})
+    })
+    got, err := c.ListStaleIssues("team_1", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+    if err != nil { t.Fatalf("ListStaleIssues error: %v", err) }
+    if len(got) != 2 || got[0].Identifier != "ENG-1" { t.Fatalf("expected least recently updated first, got %+v", got) }
+}
+
+func TestListTriageIssues_FiltersTriageAndMapsRequests(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if !regexp.MustCompile(`state:\{\s*type:\{\s*eq:"triage"\s*\}`).MatchString(p.Query) { t.Fatalf("expected triage state filter: %s", p.Query) }
+        respondJSON(w, map[string]any{"data": map[string]any{"issues": map[string]any{
+            "nodes": []any{
+                map[string]any{"id": "i2", "identifier": "SUP-2", "createdAt": "2025-01-02T00:00:00Z", "customerTicketCount": 3},
+                map[string]any{"id": "i1", "identifier": "SUP-1", "createdAt": "2025-01-01T00:00:00Z", "customerTicketCount": 0},
+            },
+            "pageInfo": map[string]any{"hasNextPage": false},
+        }}})
+    })
+    got, err := c.ListTriageIssues("team_1")
+    if err != nil { t.Fatalf("ListTriageIssues error: %v", err) }
+    if len(got) != 2 || got[0].Identifier != "SUP-1" || got[1].CustomerRequests != 3 { t.Fatalf("unexpected result: %+v", got) }
+}
+
+func TestEachIssueFiltered_PagesUpToLimit(t *testing.T) {
+    var firsts []float64
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        first := p.Variables["first"].(float64)
+        firsts = append(firsts, first)
+        nodes := make([]any, int(first))
+        for i := range nodes { nodes[i] = map[string]any{"id": "i", "identifier": "ENG-1"} }
+        respondJSON(w, map[string]any{"data": map[string]any{"issues": map[string]any{"nodes": nodes, "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c"}}}})
+    })
+    var pages []int
+    err := c.EachIssueFiltered(IssueListFilter{Limit: 150}, func(page []IssueDetails) error { pages = append(pages, len(page)); return nil })
+    if err != nil { t.Fatalf("EachIssueFiltered error: %v", err) }
+    if len(pages) != 2 || pages[0] != 100 || pages[1] != 50 || firsts[1] != 50 { t.Fatalf("unexpected paging: pages=%v firsts=%v", pages, firsts) }
+}
+
+func TestListIssuesCounted_CountsWhatTheLimitLeftOut(t *testing.T) {
+    var counts []any
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if strings.Contains(p.Query, "first:250") {
+            counts = append(counts, p.Variables["after"])
+            more := p.Variables["after"] == "c2"
+            next := map[string]any{"hasNextPage": more, "endCursor": "c3"}
+            respondJSON(w, map[string]any{"data": map[string]any{"issues": map[string]any{"nodes": []any{map[string]any{"id": "x"}, map[string]any{"id": "y"}}, "pageInfo": next}}})
+            return
+        }
+        if p.Variables["first"].(float64) != 2 { t.Fatalf("first = %v", p.Variables["first"]) }
+        nodes := []any{map[string]any{"id": "a", "identifier": "ENG-1"}, map[string]any{"id": "b", "identifier": "ENG-2"}}
+        respondJSON(w, map[string]any{"data": map[string]any{"issues": map[string]any{"nodes": nodes, "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c2"}}}})
+    })
+    list, err := c.ListIssuesCounted(IssueListFilter{Limit: 2})
+    if err != nil { t.Fatal(err) }
+    if len(list.Issues) != 2 || list.TotalCount != 6 || !list.HasMore { t.Fatalf("list = %d issues, total %d, more %v", len(list.Issues), list.TotalCount, list.HasMore) }
+    if len(counts) != 2 || counts[0] != "c2" || counts[1] != "c3" { t.Fatalf("count pages after = %v", counts) }
+
+    // Limit 0 lists everything, 100 per page, without a count query
+    var firsts []float64
+    c = newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if strings.Contains(p.Query, "first:250") { t.Fatal("nothing left to count") }
+        firsts = append(firsts, p.Variables["first"].(float64))
+        respondJSON(w, map[string]any{"data": map[string]any{"issues": map[string]any{"nodes": []any{map[string]any{"id": "a"}}, "pageInfo": map[string]any{"hasNextPage": len(firsts) < 3, "endCursor": "c"}}}})
+    })
+    if list, err = c.ListIssuesCounted(IssueListFilter{}); err != nil { t.Fatal(err) }
+    if list.TotalCount != 3 || list.HasMore || len(firsts) != 3 || firsts[0] != 100 { t.Fatalf("total %d more %v firsts %v", list.TotalCount, list.HasMore, firsts) }
+}
+
+func TestListIssuesCounted_PageCapIsNeverReportedComplete(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        respondJSON(w, map[string]any{"data": map[string]any{"issues": map[string]any{"nodes": []any{map[string]any{"id": "a"}}, "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c"}}}})
+    })
+    list, err := c.ListIssuesCounted(IssueListFilter{})
+    if err != nil { t.Fatal(err) }
+    if len(list.Issues) != maxPages || !list.HasMore || !list.Truncated || list.TotalCount != 2*maxPages { t.Fatalf("issues %d total %d more %v truncated %v", len(list.Issues), list.TotalCount, list.HasMore, list.Truncated) }
+    err = c.EachIssueFiltered(IssueListFilter{}, func([]IssueDetails) error { return nil })
+    if !errors.Is(err, ErrPageCap) { t.Fatalf("EachIssueFiltered error = %v, want ErrPageCap", err) }
+}
+
+func TestUpdateIssueAdvanced_SendsIDAsItsOwnVariable(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if !strings.Contains(p.Query, "issueUpdate(id:$id, input:$input)") { t.Fatalf("query = %s", p.Query) }
+        in, _ := p.Variables["input"].(map[string]any)
+        if p.Variables["id"] != "i1" || in["id"] != nil || in["stateId"] != "s1" { t.Fatalf("variables = %v", p.Variables) }
+        respondJSON(w, map[string]any{"data": map[string]any{"issueUpdate": map[string]any{"success": true, "issue": map[string]any{"id": "i1", "identifier": "ENG-1"}}}})
+    })
+    if _, err := c.UpdateIssueAdvanced("i1", IssueUpdateInput{StateID: "s1"}); err != nil { t.Fatal(err) }
+}
+
+func TestSetProjectStatus_UsesStatusIDWhenAvailable(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if strings.Contains(p.Query, "projectStatuses") {
+            respondJSON(w, map[string]any{"data": map[string]any{"projectStatuses": map[string]any{"nodes": []any{
+                map[string]any{"id": "st_done2", "type": "completed", "position": 2},
+                map[string]any{"id": "st_done", "type": "completed", "position": 1},
+                map[string]any{"id": "st_pause", "type": "paused", "position": 0},
+            }}}})
+            return
+        }
+        input, _ := p.Variables["input"].(map[string]any)
+        if input["statusId"] != "st_done" || p.Variables["id"] != "proj_1" { t.Fatalf("unexpected mutation vars: %v", p.Variables) }
+        respondJSON(w, map[string]any{"data": map[string]any{"projectUpdate": map[string]any{"success": true, "project": map[string]any{"id": "proj_1", "name": "Q2", "state": "completed"}}}})
+    })
+    got, err := c.SetProjectStatus("proj_1", "completed")
+    if err != nil { t.Fatalf("SetProjectStatus error: %v", err) }
+    if got.State != "completed" { t.Fatalf("unexpected project: %+v", got) }
+}
+
+func TestResolveLabelForTeam_PrefersTeamThenWorkspace(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        respondJSON(w, map[string]any{"data": map[string]any{"issueLabels": map[string]any{"nodes": []any{
+            map[string]any{"id": "l_ws", "name": "bug"},
+            map[string]any{"id": "l_eng", "name": "bug", "team": map[string]any{"id": "t_eng", "key": "ENG"}},
+            map[string]any{"id": "l_des", "name": "bug", "team": map[string]any{"id": "t_des", "key": "DES"}},
+        }}}})
+    })
+    for teamID, want := range map[string]string{"t_eng": "l_eng", "t_ops": "l_ws", "": "l_ws"} {
+        l, err := c.ResolveLabelForTeam("bug", teamID)
+        if err != nil || l == nil || l.ID != want { t.Fatalf("team %q: want %s, got %+v (%v)", teamID, want, l, err) }
+    }
+
+    teamOnly := []Label{{ID: "a", Name: "x", Team: &Team{ID: "t1", Key: "ENG"}}, {ID: "b", Name: "x", Team: &Team{ID: "t2", Key: "DES"}}}
+    if _, err := pickLabel(teamOnly, "x", ""); err == nil || !strings.Contains(err.Error(), "ENG, DES") { t.Fatalf("expected ambiguity error, got %v", err) }
+    if l, err := pickLabel(teamOnly, "x", "t3"); err != nil || l != nil { t.Fatalf("other teams' labels should not resolve: %+v %v", l, err) }
+}
+
+func TestListMentionedIssueIDs_StopsAtWindow(t *testing.T) {
+    calls := 0
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        calls++
+        respondJSON(w, map[string]any{"data": map[string]any{"notifications": map[string]any{
+            "nodes": []any{
+                map[string]any{"type": "issueCommentMention", "createdAt": "2025-03-10T00:00:00Z", "issue": map[string]any{"id": "i1"}},
+                map[string]any{"type": "issueAssignedToYou", "createdAt": "2025-03-09T00:00:00Z", "issue": map[string]any{"id": "i2"}},
+                map[string]any{"type": "issueMention", "createdAt": "2025-03-08T00:00:00Z", "issue": map[string]any{"id": "i1"}},
+                map[string]any{"type": "issueMention", "createdAt": "2025-01-01T00:00:00Z", "issue": map[string]any{"id": "i3"}},
+            },
+            "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c"},
+        }}})
+    })
+    ids, err := c.ListMentionedIssueIDs(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+    if err != nil { t.Fatalf("ListMentionedIssueIDs error: %v", err) }
+    if strings.Join(ids, ",") != "i1" || calls != 1 { t.Fatalf("unexpected ids %v after %d call(s)", ids, calls) }
+}
+
+func TestIssueListFilter_VarsOnlySetClauses(t *testing.T) {
+    if v := (IssueListFilter{}).vars(); v != nil { t.Fatalf("empty filter should be null, got %v", v) }
+    b, _ := json.Marshal(IssueListFilter{SubscriberID: "u1", IssueIDs: []string{"i1"}}.vars())
+    if string(b) != `{"and":[{"subscribers":{"some":{"id":{"eq":"u1"}}}},{"id":{"in":["i1"]}}]}` { t.Fatalf("unexpected filter: %s", b) }
+    called := false
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) { called = true })
+    if err := c.EachIssueFiltered(IssueListFilter{IssueIDs: []string{}}, func([]IssueDetails) error { return nil }); err != nil || called { t.Fatalf("empty id list should not query (called=%v, err=%v)", called, err) }
+}
+
+func TestIssueListFilter_NegationsUseNullNinAndNeq(t *testing.T) {
+    b, _ := json.Marshal(IssueListFilter{NoProject: true, NoLabel: true, NotStateNames: []string{"Done", "Canceled"}, NotAssigneeID: "u1"}.vars())
+    want := `{"and":[{"project":{"null":true}},{"labels":{"length":{"eq":0}}},{"state":{"name":{"nin":["Done","Canceled"]}}},` +
+        `{"or":[{"assignee":{"null":true}},{"assignee":{"id":{"neq":"u1"}}}]}]}`
+    if string(b) != want { t.Fatalf("unexpected filter:\n got %s\nwant %s", b, want) }
+}
+
+func TestGetOrganization_SkipsFieldsTheKeyCannotRead(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        switch {
+        case strings.Contains(p.Query, "samlEnabled"), strings.Contains(p.Query, "subscription"):
+            respondJSON(w, map[string]any{"errors": []any{map[string]any{"message": "Only admins can read this field"}}})
+        case strings.Contains(p.Query, "integrations"):
+            respondJSON(w, map[string]any{"data": map[string]any{"integrations": map[string]any{"nodes": []any{
+                map[string]any{"service": "slack"}, map[string]any{"service": "github"}, map[string]any{"service": "slack"},
+            }}}})
+        default:
+            respondJSON(w, map[string]any{"data": map[string]any{"organization": map[string]any{"id": "o1", "name": "Acme", "urlKey": "acme", "createdAt": "2023-05-01T00:00:00Z", "userCount": 12}}})
+        }
+    })
+    org, err := c.GetOrganization()
+    if err != nil { t.Fatalf("GetOrganization error: %v", err) }
+    if org.Name != "Acme" || org.URL != "https://linear.app/acme" || org.UserCount != 12 { t.Fatalf("unexpected basics: %+v", org) }
+    if org.SAMLEnabled != nil || org.Seats != nil || org.Plan != "" { t.Fatalf("admin-only fields should be unset: %+v", org) }
+    if strings.Join(org.Integrations, ",") != "github,slack" { t.Fatalf("unexpected integrations: %v", org.Integrations) }
+}
+
+func TestSubscribeToIssue_AllowedAndDefaultsToViewer(t *testing.T) {
+    var vars []map[string]any
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        vars = append(vars, p.Variables)
+        if strings.Contains(p.Query, "issueUnsubscribe") {
+            respondJSON(w, map[string]any{"data": map[string]any{"issueUnsubscribe": map[string]any{"success": true}}})
+            return
+        }
+        respondJSON(w, map[string]any{"data": map[string]any{"issueSubscribe": map[string]any{"success": true}}})
+    })
+    if err := c.SubscribeToIssue("i1", ""); err != nil { t.Fatalf("subscribe: %v", err) }
+    if err := c.UnsubscribeFromIssue("i1", "u2"); err != nil { t.Fatalf("unsubscribe: %v", err) }
+    if _, ok := vars[0]["userId"]; ok { t.Fatalf("userId should be omitted for the viewer: %v", vars[0]) }
+    if vars[1]["userId"] != "u2" { t.Fatalf("unexpected unsubscribe vars: %v", vars[1]) }
+}
+
+func TestScopeError_MappedAndCapabilities(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        switch {
+        case strings.Contains(p.Query, "issueUpdate"):
+            respondJSON(w, map[string]any{"errors": []any{map[string]any{"message": "Entity not found: Issue"}}})
+        case strings.Contains(p.Query, "auditEntries"):
+            respondJSON(w, map[string]any{"errors": []any{map[string]any{"message": "Invalid scope: `admin` required", "extensions": map[string]any{"type": "forbidden", "code": "FORBIDDEN"}}}})
+        case strings.Contains(p.Query, "templates"):
+            respondJSON(w, map[string]any{"errors": []any{map[string]any{"message": "forbidden", "extensions": map[string]any{"type": "forbidden"}}}})
+        default:
+            respondJSON(w, map[string]any{"data": map[string]any{}})
+        }
+    })
+    caps := c.Capabilities()
+    got := map[string]bool{}
+    for _, cp := range caps {
+        if cp.Error != "" { t.Fatalf("%s probe error: %s", cp.Name, cp.Error) }
+        got[cp.Name] = cp.Allowed
+    }
+    want := map[string]bool{"issues": true, "projects": true, "templates": false, "write": true, "admin": false}
+    if !reflect.DeepEqual(got, want) { t.Fatalf("capabilities = %v, want %v", got, want) }
+
+    err := c.do(`query{ auditEntries(first:1){ nodes{ id } } }`, nil, nil)
+    if !IsScopeError(err) || !strings.Contains(err.Error(), "lacks the admin scope needed for query auditEntries") { t.Fatalf("err = %v", err) }
+}
+
+func TestCreateDocument_SendsProjectTitleContent(t *testing.T) {
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if !strings.Contains(p.Query, "documentCreate(input:$input)") { t.Fatalf("query = %s", p.Query) }
+        in, _ := p.Variables["input"].(map[string]any)
+        if in["projectId"] != "p1" || in["title"] != "Spec" || in["content"] != "# Spec\n" { t.Fatalf("input = %v", in) }
+        respondJSON(w, map[string]any{"data": map[string]any{"documentCreate": map[string]any{"success": true, "document": map[string]any{"id": "d1", "title": "Spec", "slugId": "abc123", "url": "https://linear.app/acme/document/spec-abc123", "project": map[string]any{"id": "p1", "name": "Mobile"}}}}})
+    })
+    doc, err := c.CreateDocument("p1", "Spec", "# Spec\n")
+    if err != nil { t.Fatal(err) }
+    if doc.SlugID != "abc123" || doc.Project == nil || doc.Project.Name != "Mobile" { t.Fatalf("doc = %+v", doc) }
+}
+
+func TestWithDryRun_ShowsMutationWithoutSending(t *testing.T) {
+    var sent []string
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        sent = append(sent, p.Query)
+        respondJSON(w, map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1", "name": "Ada"}}})
+    })
+    var shown []string
+    c = c.WithDryRun(func(op, query string, vars map[string]interface{}) { shown = append(shown, fmt.Sprintf("%s %v", op, vars["id"])) })
+    if _, err := c.Viewer(); err != nil { t.Fatal(err) }
+    err := c.SubscribeToIssue("i1", "")
+    if !errors.Is(err, ErrDryRun) { t.Fatalf("err = %v, want ErrDryRun", err) }
+    if len(sent) != 1 || strings.Contains(sent[0], "mutation") { t.Fatalf("sent = %v", sent) }
+    if len(shown) != 1 || shown[0] != "mutation issueSubscribe i1" { t.Fatalf("shown = %v", shown) }
+}
+
+func TestWithReadOnly_RefusesMutationsAndReportsWriteDenial(t *testing.T) {
+    var sent []string
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        sent = append(sent, p.Query)
+        if strings.Contains(p.Query, "mutation") {
+            respondJSON(w, map[string]any{"errors": []any{map[string]any{"message": "forbidden", "extensions": map[string]any{"type": "forbidden"}}}})
+            return
+        }
+        respondJSON(w, map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1", "name": "Ada"}}})
+    })
+    ro := c.WithReadOnly("read-only mode is on")
+    if _, err := ro.Viewer(); err != nil { t.Fatal(err) }
+    err := ro.SubscribeToIssue("i1", "")
+    if !IsReadOnlyError(err) || !strings.Contains(err.Error(), "issueSubscribe was not sent: read-only mode is on") { t.Fatalf("err = %v", err) }
+    if len(sent) != 1 { t.Fatalf("sent = %v", sent) }
+    for _, cp := range ro.Capabilities() {
+        if cp.Name == "write" && (cp.Allowed || cp.Error != "not probed in read-only mode") { t.Fatalf("write capability = %+v", cp) }
+    }
+
+    denied := 0
+    rw := ro.WithReadOnly("").WithWriteDeniedHook(func() { denied++ })
+    if err := rw.SubscribeToIssue("i1", ""); !IsScopeError(err) { t.Fatalf("err = %v, want a ScopeError", err) }
+    if denied != 1 { t.Fatalf("write-denied hook called %d times", denied) }
+}
+
+func TestFavorites_ListInSidebarOrderAndAdd(t *testing.T) {
+    var added map[string]any
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if strings.Contains(p.Query, "favoriteCreate") {
+            added, _ = p.Variables["input"].(map[string]any)
+            respondJSON(w, map[string]any{"data": map[string]any{"favoriteCreate": map[string]any{"success": true, "favorite": map[string]any{"id": "f9", "type": "project"}}}})
+            return
+        }
+        respondJSON(w, map[string]any{"data": map[string]any{"favorites": map[string]any{"nodes": []any{
+            map[string]any{"id": "f2", "type": "project", "sortOrder": 2, "project": map[string]any{"id": "p1", "name": "Website", "url": "https://linear.app/p/1"}},
+            map[string]any{"id": "f1", "type": "issue", "sortOrder": 1, "issue": map[string]any{"id": "i1", "identifier": "ENG-10", "title": "Fix login", "url": "https://linear.app/i/1"}},
+            map[string]any{"id": "f3", "type": "folder", "sortOrder": 3, "folderName": "Later"},
+        }, "pageInfo": map[string]any{"hasNextPage": false}}}})
+    })
+    favs, err := c.ListFavorites()
+    if err != nil { t.Fatalf("ListFavorites error: %v", err) }
+    if len(favs) != 3 || favs[0].Name != "ENG-10 Fix login" || favs[0].IssueID != "i1" || favs[1].ProjectID != "p1" || favs[2].Name != "Later" {
+        t.Fatalf("unexpected favorites: %+v", favs)
+    }
+    if _, err := c.AddFavorite("i1", "p1"); err == nil { t.Fatal("expected an error with both an issue and a project") }
+    f, err := c.AddFavorite("", "p1")
+    if err != nil || f.ID != "f9" || f.ProjectID != "p1" { t.Fatalf("AddFavorite = %+v, %v", f, err) }
+    if fmt.Sprint(added) != "map[projectId:p1]" { t.Fatalf("unexpected input %v", added) }
+}
+
+func TestGetIssueFull_OneRequestWithAllSections(t *testing.T) {
+    requests := 0
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        requests++
+        p := readGQL(t, r)
+        for _, want := range []string{"children(first:250)", "relations(first:100)", "inverseRelations(first:100)", "attachments(first:100)", "comments(first:$comments)", "history(first:$history)"} {
+            if !strings.Contains(p.Query, want) { t.Fatalf("query missing %q: %s", want, p.Query) }
+        }
+        if p.Variables["comments"] != float64(10) || p.Variables["history"] != float64(50) { t.Fatalf("unexpected vars %v", p.Variables) }
+        ref := func(key string) map[string]any { return map[string]any{"id": key, "identifier": key, "title": "T " + key, "state": map[string]any{"name": "Todo", "type": "unstarted"}} }
+        respondJSON(w, map[string]any{"data": map[string]any{"issue": map[string]any{
+            "id": "i1", "identifier": "ENG-1", "title": "Parent", "state": map[string]any{"name": "In Progress"}, "labels": map[string]any{"nodes": []any{}},
+            "children":         map[string]any{"nodes": []any{ref("ENG-2")}},
+            "relations":        map[string]any{"nodes": []any{map[string]any{"type": "blocks", "relatedIssue": ref("ENG-3")}, map[string]any{"type": "duplicate", "relatedIssue": ref("ENG-4")}}},
+            "inverseRelations": map[string]any{"nodes": []any{map[string]any{"type": "blocks", "issue": ref("ENG-5")}, map[string]any{"type": "related", "issue": ref("ENG-6")}}},
+            "attachments":      map[string]any{"nodes": []any{map[string]any{"id": "a1", "title": "PR #12", "url": "https://github.com/x/y/pull/12"}}},
+            "comments":         map[string]any{"nodes": []any{map[string]any{"id": "c1", "body": "hi", "createdAt": "2026-10-01T10:00:00Z"}}},
+            "history": map[string]any{
+                "nodes":    []any{map[string]any{"createdAt": "2026-10-02T10:00:00Z", "toState": map[string]any{"name": "Done"}}, map[string]any{"createdAt": "2026-10-01T10:00:00Z", "fromTitle": "a", "toTitle": "b"}},
+                "pageInfo": map[string]any{"hasNextPage": true, "endCursor": "x"},
+            },
+        }}})
+    })
+    det, ex, err := c.GetIssueFull("ENG-1", IssueParts{Children: true, Relations: true, Attachments: true, Comments: 10, History: 50})
+    if err != nil { t.Fatal(err) }
+    if requests != 1 { t.Fatalf("expected one request, got %d", requests) }
+    if det.Identifier != "ENG-1" || det.StateName != "In Progress" || len(det.Comments) != 1 { t.Fatalf("unexpected details %+v", det) }
+    if len(ex.Children) != 1 || ex.Children[0].Identifier != "ENG-2" || len(ex.Attachments) != 1 { t.Fatalf("unexpected extras %+v", ex) }
+    var types []string
+    for _, r := range ex.Relations { types = append(types, r.Type+" "+r.Issue.Identifier) }
+    if want := []string{"blocks ENG-3", "duplicate of ENG-4", "blocked by ENG-5", "related ENG-6"}; !reflect.DeepEqual(types, want) { t.Fatalf("relations = %v, want %v", types, want) }
+    if len(ex.History) != 2 || ex.History[0].ToTitle != "b" || !ex.HistoryTruncated { t.Fatalf("history should be oldest first and truncated: %+v", ex) }
+}
+
+func TestDeleteOwnComment_OnlyDeletesTheViewersComment(t *testing.T) {
+    var deleted []string
+    c := newTestClient(t, func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+        p := readGQL(t, r)
+        if strings.Contains(p.Query, "commentDelete") {
+            deleted = append(deleted, p.Variables["id"].(string))
+            respondJSON(w, map[string]any{"data": map[string]any{"commentDelete": map[string]any{"success": true}}})
+            return
+        }
+        author := "u2"
+        if p.Variables["id"] == "mine" { author = "u1" }
+        respondJSON(w, map[string]any{"data": map[string]any{
+            "comment": map[string]any{"id": p.Variables["id"], "body": "hi", "user": map[string]any{"id": author, "name": "X"}, "issue": map[string]any{"identifier": "ENG-1"}},
+            "viewer":  map[string]any{"id": "u1"},
+        }})
+    })
+    if err := c.DeleteOwnComment("theirs"); err == nil || !strings.Contains(err.Error(), "not written by you") { t.Fatalf("err = %v", err) }
+    if err := c.DeleteOwnComment("mine"); err != nil { t.Fatal(err) }
+    if len(deleted) != 1 || deleted[0] != "mine" { t.Fatalf("deleted = %v", deleted) }
+
+    // The guard still refuses any other delete, including a bare commentDelete
+    for _, q := range []string{`mutation($id:String!){ commentDelete(id:$id){ success } }`, `mutation($id:String!){ issueDelete(id:$id){ success } }`} {
+        if err := c.do(q, map[string]interface{}{"id": "mine"}, nil); err == nil || !strings.Contains(err.Error(), "delete/archive") { t.Fatalf("%s: err = %v", q, err) }
+    }
+    if len(deleted) != 1 { t.Fatalf("deleted = %v", deleted) }
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+    "bytes"
+    "context"
+    "io"
+    "math/rand/v2"
+    "net/http"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// Retry policy for transient failures (network errors, 429 and 5xx responses).
+// LINEAR_MAX_ATTEMPTS overrides the number of attempts per request.
+const (
+    defaultMaxAttempts = 4
+    backoffBase        = 250 * time.Millisecond
+    backoffCap         = 8 * time.Second
+    maxRetryAfter      = 60 * time.Second
+)
+
+// send POSTs body to the endpoint, retrying transient failures with exponential
+// backoff and jitter. A fresh body reader is created per attempt, and ctx cancellation
+// (e.g. Ctrl-C) aborts both in-flight requests and pending backoff sleeps. The final
+// response is returned as-is, even when it is a retryable status, so callers can
+// report the server's error. tries is the number of attempts made.
+func (c *Client) send(ctx context.Context, body []byte) (resp *http.Response, tries int, err error) {
+    attempts := c.maxAttempts
+    if attempts < 1 { attempts = defaultMaxAttempts }
+    for attempt := 0; ; attempt++ {
+        req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+        if err != nil { return nil, attempt, err }
+        req.Header.Set("Content-Type", "application/json")
+        // Linear expects raw API key in the Authorization header
+        req.Header.Set("Authorization", c.apiKey)
+
+        last := attempt+1 >= attempts
+        resp, err := c.httpClient.Do(req)
+        if err != nil {
+            if ctx.Err() != nil { return nil, attempt + 1, ctx.Err() }
+            if last { return nil, attempt + 1, err }
+            delay := backoffDelay(attempt)
+            c.debug("attempt %d failed (%v); retrying in %s", attempt+1, err, delay.Round(time.Millisecond))
+            if err := sleepContext(ctx, delay); err != nil { return nil, attempt + 1, err }
+            continue
+        }
+        if last || !retryableStatus(resp.StatusCode) { return resp, attempt + 1, nil }
+        delay := retryAfterDelay(resp.Header.Get("Retry-After"), attempt)
+        c.debug("attempt %d got %s; retrying in %s", attempt+1, resp.Status, delay.Round(time.Millisecond))
+        _, _ = io.Copy(io.Discard, resp.Body)
+        resp.Body.Close()
+        if err := sleepContext(ctx, delay); err != nil { return nil, attempt + 1, err }
+    }
+}
+
+func retryableStatus(code int) bool {
+    return code == http.StatusTooManyRequests || (code >= 500 && code < 600)
+}
+
+// backoffDelay is exponential (250ms, 500ms, 1s, ... capped at 8s) with equal
+// jitter: a random delay in [d/2, d] so concurrent clients do not retry in lockstep.
+func backoffDelay(attempt int) time.Duration {
+    d := backoffBase << attempt
+    if d <= 0 || d > backoffCap { d = backoffCap }
+    half := d / 2
+    return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// retryAfterDelay honors a Retry-After header given in seconds or as an HTTP date,
+// bounded by maxRetryAfter, and falls back to backoffDelay.
+func retryAfterDelay(retryAfter string, attempt int) time.Duration {
+    v := strings.TrimSpace(retryAfter)
+    if v == "" { return backoffDelay(attempt) }
+    if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+        return min(time.Duration(secs)*time.Second, maxRetryAfter)
+    }
+    if t, err := http.ParseTime(v); err == nil {
+        return min(max(time.Until(t), 0), maxRetryAfter)
+    }
+    return backoffDelay(attempt)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+    if d <= 0 { return ctx.Err() }
+    t := time.NewTimer(d)
+    defer t.Stop()
+    select {
+    case <-ctx.Done():
+        return ctx.Err()
+    case <-t.C:
+        return nil
+    }
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "regexp"
+    "strings"
+)
+
+// ScopeError reports a request the token is not permitted to make, in place of
+// Linear's raw FORBIDDEN error. Scope is the missing scope when it is known
+// (Linear names it, or the request is a mutation and so needs "write").
+type ScopeError struct {
+    Scope     string
+    Operation string
+    Message   string
+}
+
+func (e *ScopeError) Error() string {
+    if e.Scope == "" { return fmt.Sprintf("your token is not permitted to run %s: %s", e.Operation, e.Message) }
+    return fmt.Sprintf("your token lacks the %s scope needed for %s; create a key with it and run 'linear-cli auth login'", e.Scope, e.Operation)
+}
+
+// ReadOnlyError is a mutation the client refused to send in read-only mode
+type ReadOnlyError struct {
+    Operation string
+    Reason    string
+}
+
+func (e *ReadOnlyError) Error() string { return fmt.Sprintf("%s was not sent: %s", e.Operation, e.Reason) }
+
+// IsReadOnlyError reports whether err is (or wraps) a ReadOnlyError
+func IsReadOnlyError(err error) bool {
+    var ro *ReadOnlyError
+    return errors.As(err, &ro)
+}
+
+// scopeDenied tells the write-denied hook about a mutation rejected for the
+// write scope and passes se through
+func (c *Client) scopeDenied(se *ScopeError) *ScopeError {
+    if se.Scope == "write" && c.onWriteDenied != nil { c.onWriteDenied() }
+    return se
+}
+
+// IsScopeError reports whether err is (or wraps) a ScopeError
+func IsScopeError(err error) bool {
+    var se *ScopeError
+    return errors.As(err, &se)
+}
+
+var reMissingScope = regexp.MustCompile("(?i)\\bscope\\W+([a-z][a-z:_-]*)\\W+required")
+
+// scopeError turns a permission error for query into a ScopeError; other errors give nil
+func scopeError(query string, e gqlError, status int) *ScopeError {
+    m := reMissingScope.FindStringSubmatch(e.Message)
+    forbidden := strings.EqualFold(e.Extensions.Type, "forbidden") || strings.EqualFold(e.Extensions.Code, "FORBIDDEN") || status == http.StatusForbidden
+    if m == nil && !forbidden { return nil }
+    se := &ScopeError{Operation: operationName(query), Message: e.Message}
+    switch {
+    case m != nil:
+        se.Scope = strings.ToLower(m[1])
+    case isMutation(query):
+        se.Scope = "write"
+    }
+    return se
+}
+
+// Capability is one row of the token's capability matrix
+type Capability struct {
+    Name    string `json:"name"`
+    Scope   string `json:"scope"`
+    Allowed bool   `json:"allowed"`
+    // Error is set when the probe failed for a reason other than permissions,
+    // in which case Allowed is unknown and reported as false
+    Error string `json:"error,omitempty"`
+}
+
+// nilUUID names no entity: a write probe against it fails with "not found" when
+// the token may write, and with a scope error when it may not, changing nothing
+const nilUUID = "00000000-0000-0000-0000-000000000000"
+
+var capabilityProbes = []struct {
+    name, scope, query string
+    vars               map[string]interface{}
+}{
+    {"issues", "read", `query{ issues(first:1){ nodes{ id } } }`, nil},
+    {"projects", "read", `query{ projects(first:1){ nodes{ id } } }`, nil},
+    {"templates", "read", `query{ templates{ id } }`, nil},
+    {"write", "write", `mutation($id:String!){ issueUpdate(id:$id, input:{}){ success } }`, map[string]interface{}{"id": nilUUID}},
+    {"admin", "admin", `query{ auditEntries(first:1){ nodes{ id } } }`, nil},
+}
+
+// Capabilities probes what the token can read and write. A probe rejected for
+// permissions is reported as not allowed; the write probe targets no entity, so
+// its expected "not found" error means writes are allowed.
+func (c *Client) Capabilities() []Capability {
+    out := make([]Capability, 0, len(capabilityProbes))
+    for _, p := range capabilityProbes {
+        cp := Capability{Name: p.name, Scope: p.scope}
+        err := c.do(p.query, p.vars, nil)
+        switch {
+        case err == nil:
+            cp.Allowed = true
+        case IsScopeError(err):
+        case IsReadOnlyError(err):
+            cp.Error = "not probed in read-only mode"
+        case p.scope == "write" && strings.Contains(strings.ToLower(err.Error()), "not found"):
+            cp.Allowed = true
+        default:
+            cp.Error = err.Error()
+        }
+        out = append(out, cp)
+    }
+    return out
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+    "crypto/tls"
+    "net"
+    "net/http"
+    "time"
+)
+
+// Timeouts for the shared transport. The overall client timeout bounds a single
+// attempt; dial/TLS/header timeouts fail fast on unreachable or stalled hosts.
+const (
+    dialTimeout           = 5 * time.Second
+    tlsHandshakeTimeout   = 5 * time.Second
+    responseHeaderTimeout = 15 * time.Second
+    requestTimeout        = 30 * time.Second
+)
+
+// sharedTransport is reused by every Client so that commands issuing several
+// requests (e.g. create with resolvers) keep one pooled HTTP/2 connection and
+// resume TLS sessions instead of handshaking per call.
+// Proxies are honored via HTTPS_PROXY/HTTP_PROXY/NO_PROXY.
+var sharedTransport = &http.Transport{
+    Proxy: http.ProxyFromEnvironment,
+    DialContext: (&net.Dialer{
+        Timeout:   dialTimeout,
+        KeepAlive: 30 * time.Second,
+    }).DialContext,
+    ForceAttemptHTTP2:     true,
+    MaxIdleConns:          10,
+    MaxIdleConnsPerHost:   4,
+    IdleConnTimeout:       90 * time.Second,
+    TLSHandshakeTimeout:   tlsHandshakeTimeout,
+    ResponseHeaderTimeout: responseHeaderTimeout,
+    ExpectContinueTimeout: 1 * time.Second,
+    TLSClientConfig: &tls.Config{
+        MinVersion:         tls.VersionTLS12,
+        ClientSessionCache: tls.NewLRUClientSessionCache(16),
+    },
+}
+
+func newHTTPClient() *http.Client {
+    return &http.Client{Transport: sharedTransport, Timeout: requestTimeout}
+}
+
+// newHTTPClientWithTimeout bounds each attempt by timeout. Timeouts longer than
+// the shared transport's header timeout get their own transport so slow
+// networks are not cut off waiting for response headers.
+func newHTTPClientWithTimeout(timeout time.Duration) *http.Client {
+    t := sharedTransport
+    if timeout > responseHeaderTimeout {
+        t = sharedTransport.Clone()
+        t.ResponseHeaderTimeout = timeout
+    }
+    return &http.Client{Transport: t, Timeout: timeout}
+}
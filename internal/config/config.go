@@ -2,8 +2,12 @@ package config
 
 import (
     "errors"
+    "fmt"
     "os"
     "path/filepath"
+    "strconv"
+    "strings"
+    "time"
 
     "github.com/BurntSushi/toml"
 )
@@ -12,7 +16,73 @@ import (
 // and environment variables. Environment variables always take precedence.
 type Config struct {
     APIKey string `toml:"api_key"`
+    // APIKeyCmd is a shell command that prints the API key, e.g. a password
+    // manager lookup; when set it is used instead of api_key
+    APIKeyCmd string `toml:"api_key_cmd,omitempty"`
+    // KeyInfo describes APIKey when it was added with 'auth login --sso'
+    KeyInfo *KeyInfo `toml:"key_info,omitempty"`
     TeamPrefs map[string]TeamPrefs `toml:"team_prefs"`
+    // NotifyReminders prints due snoozed-issue reminders on every command invocation
+    NotifyReminders bool `toml:"notify_reminders,omitempty"`
+    // GitHubUsers maps GitHub handles ("@octocat", "@org/team") and CODEOWNERS
+    // emails to Linear users (name or email), for 'issues assign-from-codeowners'
+    GitHubUsers map[string]string `toml:"github_users,omitempty"`
+    // LabelKeywords adds words that make 'issues create' suggest a label, keyed
+    // by label name, e.g. performance = ["slow", "timeout"]
+    LabelKeywords map[string][]string `toml:"label_keywords,omitempty"`
+    // Profiles holds named credentials selected with --profile or LINEAR_PROFILE
+    Profiles map[string]Profile `toml:"profiles,omitempty"`
+    // APIEndpoint and Timeout (a duration such as "60s") tune the connection to
+    // Linear, e.g. for a self-hosted proxy or a slow network
+    APIEndpoint string `toml:"api_endpoint,omitempty"`
+    Timeout     string `toml:"timeout,omitempty"`
+
+    // Profile is the active profile name. It is not persisted; when set, APIKey
+    // reflects the profile's key and Save writes it back to that profile.
+    Profile string `toml:"-"`
+    baseAPIKey string
+    baseKeyInfo *KeyInfo
+    // cmdKey is the key api_key_cmd printed and fileKey the stored api_key it
+    // replaced, so Save never writes the fetched key to disk
+    cmdKey, fileKey string
+    keyCmdErr       error
+    // endpoint and timeout are the effective settings after profile and
+    // environment overrides; the persisted fields above stay untouched.
+    endpoint string
+    timeout  string
+}
+
+// Profile is a named set of credentials and, optionally, connection settings
+type Profile struct {
+    APIKey      string   `toml:"api_key"`
+    APIKeyCmd   string   `toml:"api_key_cmd,omitempty"`
+    KeyInfo     *KeyInfo `toml:"key_info,omitempty"`
+    APIEndpoint string   `toml:"api_endpoint,omitempty"`
+    Timeout     string   `toml:"timeout,omitempty"`
+}
+
+// KeyInfo is what 'auth login --sso' learned about a key when it was stored.
+// Fingerprint ties it to that key, so a key replaced by other means (or
+// overridden by LINEAR_API_KEY) is not described by stale metadata.
+type KeyInfo struct {
+    Fingerprint  string    `toml:"fingerprint" json:"-"`
+    Workspace    string    `toml:"workspace" json:"workspace"`
+    WorkspaceKey string    `toml:"workspace_key" json:"workspaceKey"`
+    User         string    `toml:"user" json:"user"`
+    Scopes       []string  `toml:"scopes" json:"scopes"`
+    CreatedAt    time.Time `toml:"created_at" json:"createdAt"`
+}
+
+// KeyFingerprint identifies a key without storing it twice: its last 6 characters
+func KeyFingerprint(key string) string {
+    if len(key) <= 6 { return key }
+    return key[len(key)-6:]
+}
+
+// CurrentKeyInfo returns KeyInfo when it describes the key in use, else nil
+func (c *Config) CurrentKeyInfo() *KeyInfo {
+    if c.KeyInfo == nil || c.APIKey == "" || c.KeyInfo.Fingerprint != KeyFingerprint(c.APIKey) { return nil }
+    return c.KeyInfo
 }
 
 // TeamPrefs stores last-used selections per team (keyed by team key, e.g., ENG)
@@ -23,8 +93,14 @@ type TeamPrefs struct {
     LastStateID    string   `toml:"last_state_id"`
     LastTemplate   string   `toml:"last_template"`
     LastLabels     []string `toml:"last_labels"`
+    // DefaultTemplate is pre-selected by 'issues create --team KEY' when no
+    // template or description is given. Set with 'config set-team'.
+    DefaultTemplate string `toml:"default_template,omitempty"`
 }
 
+// Path returns the location of config.toml
+func Path() (string, error) { return configTomlPath() }
+
 func configTomlPath() (string, error) {
     dir, err := os.UserConfigDir()
     if err != nil {
@@ -44,13 +120,17 @@ func legacyJSONPath() (string, error) {
 }
 
 // Load reads configuration from TOML, falling back to legacy JSON if present,
-// and finally overlaying environment variables. Missing files are fine.
+// and finally overlaying environment variables. Missing files are fine. An
+// encrypted config.toml is decrypted (see encrypt.go).
 func Load() (*Config, error) {
     cfg := &Config{}
 
     // Preferred: TOML at ~/.config/linear/config.toml
     if p, err := configTomlPath(); err == nil {
         if b, err := os.ReadFile(p); err == nil {
+            if isEncryptedData(b) {
+                if b, err = decryptData(b); err != nil { return nil, err }
+            }
             if err := toml.Unmarshal(b, cfg); err != nil {
                 return nil, err
             }
@@ -81,13 +161,74 @@ func Load() (*Config, error) {
         }
     }
 
-    // Environment override
+    // Named profile selection
+    cfg.baseAPIKey, cfg.baseKeyInfo = cfg.APIKey, cfg.KeyInfo
+    cfg.endpoint, cfg.timeout = cfg.APIEndpoint, cfg.Timeout
+    keyCmd := cfg.APIKeyCmd
+    if name := os.Getenv("LINEAR_PROFILE"); name != "" {
+        cfg.Profile = name
+        prof := cfg.Profiles[name]
+        cfg.APIKey, cfg.KeyInfo = prof.APIKey, prof.KeyInfo
+        keyCmd = prof.APIKeyCmd
+        if prof.APIEndpoint != "" { cfg.endpoint = prof.APIEndpoint }
+        if prof.Timeout != "" { cfg.timeout = prof.Timeout }
+    }
+
+    // Environment override, then the key command
     if v := os.Getenv("LINEAR_API_KEY"); v != "" {
         cfg.APIKey = v
+    } else if strings.TrimSpace(keyCmd) != "" {
+        // A failing command leaves no key rather than failing Load, so settings
+        // such as the endpoint still apply; KeyCommandError reports why
+        key, err := runKeyCommand("api_key_cmd", keyCmd)
+        cfg.fileKey, cfg.APIKey, cfg.cmdKey, cfg.keyCmdErr = cfg.APIKey, key, key, err
+    }
+    if v := strings.TrimSpace(os.Getenv("LINEAR_API_ENDPOINT")); v != "" {
+        cfg.endpoint = v
+    }
+    if v := strings.TrimSpace(os.Getenv("LINEAR_TIMEOUT")); v != "" {
+        cfg.timeout = v
     }
     return cfg, nil
 }
 
+// KeySource names where APIKey came from: "env" (LINEAR_API_KEY), "command"
+// (api_key_cmd), "config" or "" when there is no key
+func (c *Config) KeySource() string {
+    switch {
+    case c.APIKey == "":
+        return ""
+    case os.Getenv("LINEAR_API_KEY") != "":
+        return "env"
+    case c.cmdKey != "" && c.APIKey == c.cmdKey:
+        return "command"
+    }
+    return "config"
+}
+
+// KeyCommandError is why api_key_cmd produced no key, or nil
+func (c *Config) KeyCommandError() error { return c.keyCmdErr }
+
+// Endpoint returns the effective API endpoint (LINEAR_API_ENDPOINT, then the
+// active profile's api_endpoint, then the top-level one), or "" for the default.
+func (c *Config) Endpoint() string { return strings.TrimSpace(c.endpoint) }
+
+// RequestTimeout returns the effective per-request timeout from LINEAR_TIMEOUT
+// or the timeout config keys, or 0 for the default. Bare numbers are seconds.
+func (c *Config) RequestTimeout() (time.Duration, error) {
+    return ParseTimeout(c.timeout)
+}
+
+// ParseTimeout parses a timeout such as "90s", "2m" or "45" (seconds)
+func ParseTimeout(s string) (time.Duration, error) {
+    s = strings.TrimSpace(s)
+    if s == "" { return 0, nil }
+    if n, err := strconv.Atoi(s); err == nil { s = strconv.Itoa(n) + "s" }
+    d, err := time.ParseDuration(s)
+    if err != nil || d <= 0 { return 0, fmt.Errorf("invalid timeout %q: use a duration like 60s or 2m", s) }
+    return d, nil
+}
+
 // Save writes the configuration to TOML at the preferred path. File mode 0600.
 func Save(cfg *Config) error {
     p, err := configTomlPath()
@@ -97,14 +238,53 @@ func Save(cfg *Config) error {
     if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
         return err
     }
+    out := *cfg
+    key := cfg.APIKey
+    if cfg.cmdKey != "" && key == cfg.cmdKey { key = cfg.fileKey }
+    out.APIKey = key
+    if cfg.Profile != "" {
+        // Keep the default key untouched and store the active key under its profile
+        out.Profiles = make(map[string]Profile, len(cfg.Profiles)+1)
+        for k, v := range cfg.Profiles { out.Profiles[k] = v }
+        prof := out.Profiles[cfg.Profile]
+        prof.APIKey, prof.KeyInfo = key, cfg.KeyInfo
+        if prof == (Profile{}) { delete(out.Profiles, cfg.Profile) } else { out.Profiles[cfg.Profile] = prof }
+        out.APIKey, out.KeyInfo = cfg.baseAPIKey, cfg.baseKeyInfo
+    }
     var buf []byte
-    buf, err = toml.Marshal(*cfg)
+    buf, err = toml.Marshal(out)
     if err != nil {
         return err
     }
+    return writeConfigFile(p, buf)
+}
+
+// writeConfigFile writes plain TOML to p, re-encrypting it when the file there
+// is encrypted
+func writeConfigFile(p string, buf []byte) error {
+    if cur, err := os.ReadFile(p); err == nil && isEncryptedData(cur) && !isEncryptedData(buf) {
+        pass, err := passphrase()
+        if err != nil { return err }
+        if buf, err = reencryptData(buf, cur, pass); err != nil { return err }
+    }
     return os.WriteFile(p, buf, 0o600)
 }
 
+// RemoveLegacy deletes the legacy JSON config so its api_key no longer acts as a
+// fallback. Reports whether a file was removed.
+func RemoveLegacy() (bool, error) {
+    p, err := legacyJSONPath()
+    if err != nil { return false, err }
+    if err := os.Remove(p); err != nil {
+        if errors.Is(err, os.ErrNotExist) { return false, nil }
+        return false, err
+    }
+    return true, nil
+}
+
+// LegacyPath returns the location of the legacy JSON config
+func LegacyPath() (string, error) { return legacyJSONPath() }
+
 // indexInsensitive finds the index of sub in s ignoring ASCII case.
 func indexInsensitive(s, sub string) int {
     ls, lsub := len(s), len(sub)
@@ -0,0 +1,231 @@
+package config
+
+import (
+    "bytes"
+    "crypto/aes"
+    "crypto/cipher"
+    "crypto/pbkdf2"
+    "crypto/rand"
+    "crypto/sha256"
+    "encoding/base64"
+    "errors"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strconv"
+    "strings"
+    "sync"
+
+    "github.com/BurntSushi/toml"
+)
+
+// Encrypted config.toml, for shared machines without an OS keyring. The file
+// holds a comment line, a parameter line and the TOML sealed with AES-256-GCM
+// under a key derived from a passphrase with PBKDF2-SHA256. The parameter line
+// is authenticated too, so a file with altered parameters fails to open.
+//
+// The passphrase comes from LINEAR_CONFIG_PASSPHRASE, from the command in
+// LINEAR_CONFIG_KEY_CMD (e.g. a keychain lookup), or from PassphrasePrompt.
+// It is asked for once per process and reused to re-encrypt on Save, which
+// keeps the file's salt and work factor (only the nonce is new), so the key
+// derived when the file was opened is reused instead of derived again.
+
+const (
+    encryptedHeader = "# linear-cli encrypted config; run 'linear-cli config decrypt' to restore plain TOML\n"
+    encryptedFormat = "$linear-cli$v1$pbkdf2-sha256"
+    kdfSaltSize     = 16
+)
+
+// kdfIterations is the PBKDF2 work factor for newly encrypted files; files
+// record their own count. Tests lower it.
+var kdfIterations = 600000
+
+// ErrLocked means config.toml is encrypted and no passphrase was available
+var ErrLocked = errors.New("config.toml is encrypted: set LINEAR_CONFIG_PASSPHRASE or LINEAR_CONFIG_KEY_CMD, or run in a terminal to enter the passphrase")
+
+// ErrWrongPassphrase means the passphrase did not open config.toml
+var ErrWrongPassphrase = errors.New("wrong passphrase for encrypted config.toml (or the file was modified)")
+
+// PassphrasePrompt asks the user for the config passphrase. The CLI sets it
+// when it may prompt; left nil, an encrypted config without
+// LINEAR_CONFIG_PASSPHRASE or LINEAR_CONFIG_KEY_CMD fails with ErrLocked.
+var PassphrasePrompt func() (string, error)
+
+// The passphrase and derived key of this process, so an encrypted config is
+// unlocked once however often it is loaded
+var (
+    unlockMu   sync.Mutex
+    unlockPass string
+    unlockKeys = map[string][]byte{}
+)
+
+// IsEncrypted reports whether config.toml exists and is encrypted
+func IsEncrypted() (bool, error) {
+    p, err := configTomlPath()
+    if err != nil { return false, err }
+    b, err := os.ReadFile(p)
+    if errors.Is(err, os.ErrNotExist) { return false, nil }
+    if err != nil { return false, err }
+    return isEncryptedData(b), nil
+}
+
+// PassphraseFromEnv returns the passphrase from LINEAR_CONFIG_PASSPHRASE or
+// LINEAR_CONFIG_KEY_CMD, or "" when neither is set
+func PassphraseFromEnv() (string, error) {
+    if v := os.Getenv("LINEAR_CONFIG_PASSPHRASE"); v != "" { return v, nil }
+    if c := strings.TrimSpace(os.Getenv("LINEAR_CONFIG_KEY_CMD")); c != "" { return runKeyCommand("LINEAR_CONFIG_KEY_CMD", c) }
+    return "", nil
+}
+
+// Encrypt encrypts the plain config.toml in place with passphrase. An absent
+// file is created empty, so settings saved later are encrypted too.
+func Encrypt(passphrase string) error {
+    if passphrase == "" { return errors.New("the passphrase cannot be empty") }
+    p, err := configTomlPath()
+    if err != nil { return err }
+    b, err := os.ReadFile(p)
+    if err != nil && !errors.Is(err, os.ErrNotExist) { return err }
+    if isEncryptedData(b) { return errors.New("config.toml is already encrypted") }
+    // Refuse to seal something Load could not read back
+    var probe Config
+    if err := toml.Unmarshal(b, &probe); err != nil { return fmt.Errorf("config.toml is not valid TOML: %w", err) }
+    sealed, err := encryptData(b, passphrase)
+    if err != nil { return err }
+    if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil { return err }
+    if err := os.WriteFile(p, sealed, 0o600); err != nil { return err }
+    rememberPassphrase(passphrase)
+    return nil
+}
+
+// Decrypt turns an encrypted config.toml back into plain TOML
+func Decrypt() error {
+    p, err := configTomlPath()
+    if err != nil { return err }
+    b, err := os.ReadFile(p)
+    if err != nil { return err }
+    if !isEncryptedData(b) { return errors.New("config.toml is not encrypted") }
+    plain, err := decryptData(b)
+    if err != nil { return err }
+    return os.WriteFile(p, plain, 0o600)
+}
+
+func isEncryptedData(b []byte) bool { return bytes.HasPrefix(b, []byte(encryptedHeader)) }
+
+// passphrase returns the process passphrase, asking for it the first time
+func passphrase() (string, error) {
+    unlockMu.Lock()
+    pass := unlockPass
+    unlockMu.Unlock()
+    if pass != "" { return pass, nil }
+    pass, err := PassphraseFromEnv()
+    if err != nil { return "", err }
+    if pass == "" && PassphrasePrompt != nil {
+        if pass, err = PassphrasePrompt(); err != nil { return "", err }
+    }
+    if pass == "" { return "", ErrLocked }
+    return pass, nil
+}
+
+func rememberPassphrase(pass string) {
+    unlockMu.Lock()
+    unlockPass = pass
+    unlockMu.Unlock()
+}
+
+// derivedKey returns the AES key for pass, salt and iterations, deriving it once
+func derivedKey(pass string, salt []byte, iter int) ([]byte, error) {
+    unlockMu.Lock()
+    defer unlockMu.Unlock()
+    id := fmt.Sprintf("%x:%d:%s", salt, iter, pass)
+    if k, ok := unlockKeys[id]; ok { return k, nil }
+    k, err := pbkdf2.Key(sha256.New, pass, salt, iter, 32)
+    if err != nil { return nil, err }
+    unlockKeys[id] = k
+    return k, nil
+}
+
+// encryptData seals plain TOML into a newly encrypted file with a fresh salt
+func encryptData(plain []byte, pass string) ([]byte, error) {
+    salt := make([]byte, kdfSaltSize)
+    if _, err := rand.Read(salt); err != nil { return nil, err }
+    return sealData(plain, pass, salt, kdfIterations)
+}
+
+// reencryptData seals plain TOML to replace the encrypted file cur, keeping
+// its salt and iterations
+func reencryptData(plain, cur []byte, pass string) ([]byte, error) {
+    ef, err := parseEncryptedFile(cur)
+    if err != nil { return nil, err }
+    return sealData(plain, pass, ef.salt, ef.iter)
+}
+
+func sealData(plain []byte, pass string, salt []byte, iter int) ([]byte, error) {
+    key, err := derivedKey(pass, salt, iter)
+    if err != nil { return nil, err }
+    gcm, err := newGCM(key)
+    if err != nil { return nil, err }
+    nonce := make([]byte, gcm.NonceSize())
+    if _, err := rand.Read(nonce); err != nil { return nil, err }
+    enc := base64.StdEncoding
+    params := fmt.Sprintf("%s$%d$%s$%s", encryptedFormat, iter, enc.EncodeToString(salt), enc.EncodeToString(nonce))
+    sealed := enc.EncodeToString(gcm.Seal(nil, nonce, plain, []byte(params)))
+
+    var out bytes.Buffer
+    out.WriteString(encryptedHeader)
+    out.WriteString(params + "\n")
+    for len(sealed) > 76 {
+        out.WriteString(sealed[:76] + "\n")
+        sealed = sealed[76:]
+    }
+    if sealed != "" { out.WriteString(sealed + "\n") }
+    return out.Bytes(), nil
+}
+
+// encryptedFile is the parsed parameter line and body of an encrypted file
+type encryptedFile struct {
+    params              string
+    iter                int
+    salt, nonce, sealed []byte
+}
+
+func parseEncryptedFile(b []byte) (*encryptedFile, error) {
+    rest := strings.TrimPrefix(strings.ReplaceAll(string(b), "\r\n", "\n"), encryptedHeader)
+    params, body, _ := strings.Cut(rest, "\n")
+    fields := strings.Split(params, "$")
+    // "", "linear-cli", "v1", "pbkdf2-sha256", iterations, salt, nonce
+    if len(fields) != 7 || strings.Join(fields[:4], "$") != encryptedFormat { return nil, errors.New("config.toml: unsupported encrypted format") }
+    enc := base64.StdEncoding
+    iter, err := strconv.Atoi(fields[4])
+    salt, err2 := enc.DecodeString(fields[5])
+    nonce, err3 := enc.DecodeString(fields[6])
+    sealed, err4 := enc.DecodeString(strings.Join(strings.Fields(body), ""))
+    if err != nil || err2 != nil || err3 != nil || err4 != nil || iter < 1 { return nil, errors.New("config.toml: corrupt encrypted file") }
+    return &encryptedFile{params: params, iter: iter, salt: salt, nonce: nonce, sealed: sealed}, nil
+}
+
+// decryptData opens an encrypted file with the process passphrase. A wrong
+// passphrase is forgotten, so the next attempt asks again.
+func decryptData(b []byte) ([]byte, error) {
+    ef, err := parseEncryptedFile(b)
+    if err != nil { return nil, err }
+    pass, err := passphrase()
+    if err != nil { return nil, err }
+    key, err := derivedKey(pass, ef.salt, ef.iter)
+    if err != nil { return nil, err }
+    gcm, err := newGCM(key)
+    if err != nil { return nil, err }
+    if len(ef.nonce) != gcm.NonceSize() { return nil, errors.New("config.toml: corrupt encrypted file") }
+    plain, err := gcm.Open(nil, ef.nonce, ef.sealed, []byte(ef.params))
+    if err != nil {
+        rememberPassphrase("")
+        return nil, ErrWrongPassphrase
+    }
+    rememberPassphrase(pass)
+    return plain, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+    block, err := aes.NewCipher(key)
+    if err != nil { return nil, err }
+    return cipher.NewGCM(block)
+}
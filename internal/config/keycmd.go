@@ -0,0 +1,50 @@
+package config
+
+import (
+    "bytes"
+    "fmt"
+    "os"
+    "os/exec"
+    "runtime"
+    "strings"
+    "sync"
+)
+
+// Results of api_key_cmd, so a command that prompts (e.g. a password manager
+// unlock) runs once per process however often the config is loaded
+var (
+    keyCmdMu    sync.Mutex
+    keyCmdCache = map[string]keyCmdResult{}
+)
+
+type keyCmdResult struct {
+    key string
+    err error
+}
+
+// runKeyCommand runs command through the shell and returns its trimmed stdout.
+// Stdin and stderr stay attached to the terminal for interactive unlocks. what
+// names the setting in errors, e.g. api_key_cmd.
+func runKeyCommand(what, command string) (string, error) {
+    keyCmdMu.Lock()
+    defer keyCmdMu.Unlock()
+    if r, ok := keyCmdCache[command]; ok { return r.key, r.err }
+    var c *exec.Cmd
+    if runtime.GOOS == "windows" {
+        c = exec.Command("cmd", "/C", command)
+    } else {
+        c = exec.Command("sh", "-c", command)
+    }
+    var out bytes.Buffer
+    c.Stdin, c.Stdout, c.Stderr = os.Stdin, &out, os.Stderr
+    var r keyCmdResult
+    if err := c.Run(); err != nil {
+        r.err = fmt.Errorf("%s failed: %w", what, err)
+    } else if r.key = strings.TrimSpace(out.String()); r.key == "" {
+        r.err = fmt.Errorf("%s printed nothing", what)
+    } else if strings.ContainsAny(r.key, "\n\r") {
+        r.key, r.err = "", fmt.Errorf("%s printed more than one line; it should print only the key", what)
+    }
+    keyCmdCache[command] = r
+    return r.key, r.err
+}
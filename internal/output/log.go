@@ -0,0 +1,106 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Side-channel messages (what a command is doing, warnings, debug traces) go
+// through a leveled logger on stderr so stdout only ever carries results. A
+// message is shown when its level is at or above the printer's Level; the zero
+// value is LevelInfo. Under JSON output each message is one NDJSON event, like
+// progress events:
+//
+//	{"event":"log","level":"warn","message":"...","time":"..."}
+
+// Level is a log severity. Higher is more severe; the values follow log/slog.
+type Level int
+
+const (
+	LevelDebug Level = -4
+	LevelInfo  Level = 0
+	LevelWarn  Level = 4
+	LevelError Level = 8
+)
+
+func (l Level) String() string {
+	switch {
+	case l <= LevelDebug:
+		return "debug"
+	case l <= LevelInfo:
+		return "info"
+	case l <= LevelWarn:
+		return "warn"
+	default:
+		return "error"
+	}
+}
+
+// ParseLevel accepts debug, info, warn (or warning) and error, case-insensitively
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return LevelDebug, nil
+	case "info", "":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	}
+	return LevelInfo, fmt.Errorf("invalid log level '%s': use debug, info, warn or error", s)
+}
+
+// LogEvent is the NDJSON shape of a log message under --json
+type LogEvent struct {
+	Event   string    `json:"event"`
+	Level   string    `json:"level"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// logMu keeps concurrent messages from interleaving
+var logMu sync.Mutex
+
+// Enabled reports whether messages at level l are shown; Quiet hides info and debug
+func (p Printer) Enabled(l Level) bool {
+	if p.Quiet && l < LevelWarn {
+		return false
+	}
+	return l >= p.Level
+}
+
+// Logf writes one message at level l; a trailing newline is added if missing
+func (p Printer) Logf(l Level, format string, a ...interface{}) {
+	if !p.Enabled(l) {
+		return
+	}
+	msg := strings.TrimRight(fmt.Sprintf(format, a...), "\n")
+	if p.Plain {
+		msg = StripEmoji(msg)
+	}
+	logMu.Lock()
+	defer logMu.Unlock()
+	if p.JSON || p.JSONLines {
+		b, _ := json.Marshal(LogEvent{Event: "log", Level: l.String(), Message: msg, Time: time.Now().UTC()})
+		fmt.Fprintf(os.Stderr, "%s\n", b)
+		return
+	}
+	switch {
+	case l >= LevelError:
+		msg = "Error: " + msg
+	case l >= LevelWarn:
+		msg = "Warning: " + msg
+	case l < LevelInfo:
+		msg = "debug: " + msg
+	}
+	fmt.Fprintln(os.Stderr, msg)
+}
+
+func (p Printer) Debugf(format string, a ...interface{}) { p.Logf(LevelDebug, format, a...) }
+func (p Printer) Infof(format string, a ...interface{})  { p.Logf(LevelInfo, format, a...) }
+func (p Printer) Warnf(format string, a ...interface{})  { p.Logf(LevelWarn, format, a...) }
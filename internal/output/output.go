@@ -4,25 +4,92 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
+	"strings"
 	"text/tabwriter"
+
+	"golang.org/x/term"
 )
 
 // Printer controls output format.
 // When JSON is true, PrintJSON will be used; otherwise tabular output.
+// JSONLines selects NDJSON: one compact object per line, so lists can be streamed.
+// Template, when set, renders results with a Go text/template (see template.go);
+// it takes the machine-readable code paths, so it counts as JSONEnabled.
 // Errors should be printed via Error to ensure non-zero exit semantics upstream.
+// Level filters side-channel messages (see log.go); Quiet suppresses decorative
+// output such as progress and is implied by levels above info.
+// Plain keeps the output but drops emojis and box drawing (see plain.go).
+// UTC shows times in UTC instead of the local timezone (see time.go).
 
 type Printer struct {
-	JSON bool
+	JSON      bool
+	JSONLines bool
+	Template  string
+	Quiet     bool
+	Plain     bool
+	UTC       bool
+	Level     Level
 }
 
-func (p Printer) JSONEnabled() bool { return p.JSON }
+func (p Printer) JSONEnabled() bool { return p.JSON || p.JSONLines || p.Template != "" }
 
 func (p Printer) PrintJSON(v interface{}) error {
+	if p.Template != "" {
+		return p.printTemplate(v)
+	}
+	if p.JSONLines {
+		return p.printJSONLines(v)
+	}
 	enc := json.NewEncoder(os.Stdout)
 	enc.SetIndent("", "  ")
 	return enc.Encode(v)
 }
 
+// StreamJSON writes one compact JSON value per line as soon as it is available.
+// Commands that page through results call it per item under JSONLines.
+func (p Printer) StreamJSON(v interface{}) error {
+	if p.Template != "" {
+		return p.printTemplate(v)
+	}
+	return json.NewEncoder(os.Stdout).Encode(v)
+}
+
+// printJSONLines writes each element of a slice or array on its own line; any
+// other value is written as a single line.
+func (p Printer) printJSONLines(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return p.StreamJSON(v)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := p.StreamJSON(rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ColorEnabled reports whether stdout is a terminal that should get ANSI colors:
+// not quiet or plain, not machine-readable, and NO_COLOR unset.
+func (p Printer) ColorEnabled() bool {
+	return !p.Quiet && !p.Plain && !p.JSONEnabled() && os.Getenv("NO_COLOR") == "" && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// Swatch returns a colored "●" for a hex color like "#5e6ad2" followed by a
+// space, or "" when colors are off. Tables count escape sequences as width, so
+// put swatches in the last column.
+func (p Printer) Swatch(hex string) string {
+	if !p.ColorEnabled() {
+		return ""
+	}
+	var r, g, b uint8
+	if _, err := fmt.Sscanf(strings.TrimPrefix(hex, "#"), "%02x%02x%02x", &r, &g, &b); err != nil {
+		r, g, b = 136, 136, 136
+	}
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm●\x1b[0m ", r, g, b)
+}
+
 func (p Printer) Table(header []string, rows [][]string) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
 	// header
@@ -46,14 +113,14 @@ func (p Printer) Table(header []string, rows [][]string) error {
 }
 
 func (p Printer) PrintOrTable(header []string, rows [][]string, jsonValue interface{}) error {
-	if p.JSON {
+	if p.JSONEnabled() {
 		return p.PrintJSON(jsonValue)
 	}
 	return p.Table(header, rows)
 }
 
 func (p Printer) PrintError(err error) {
-	if p.JSON {
+	if p.JSON || p.JSONLines {
 		_ = p.PrintJSON(map[string]interface{}{"error": err.Error()})
 		return
 	}
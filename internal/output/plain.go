@@ -0,0 +1,51 @@
+package output
+
+import "strings"
+
+// Plain output drops emojis and box-drawing decoration so text stays greppable
+// and readable in logs and CI. Commands pick their decorated or plain form with
+// Symbol; log messages are stripped automatically.
+
+// Symbol returns fancy, or plain when the printer is in plain mode
+func (p Printer) Symbol(fancy, plain string) string {
+	if p.Plain {
+		return plain
+	}
+	return fancy
+}
+
+// isEmoji reports whether r is a pictograph, dingbat or emoji modifier
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // emoticons, pictographs, symbols
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // misc technical (⏰ ⌛ ⏳)
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // misc symbols and dingbats (✅ ❌ ⚠ ✓ ✨)
+		return true
+	case r >= 0x2B50 && r <= 0x2B55, r == 0x25B3: // ⭐ ⭕ △
+		return true
+	case r == 0xFE0F || r == 0x200D: // variation selector, zero-width joiner
+		return true
+	}
+	return false
+}
+
+// StripEmoji removes emojis and the space that follows each one
+func StripEmoji(s string) string {
+	var b strings.Builder
+	skipSpace := false
+	for _, r := range s {
+		if isEmoji(r) {
+			skipSpace = true
+			continue
+		}
+		if skipSpace && r == ' ' {
+			skipSpace = false
+			continue
+		}
+		skipSpace = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
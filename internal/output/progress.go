@@ -0,0 +1,245 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// Progress reports the advancement of a long-running operation.
+// Rendering depends on the printer and the terminal:
+//   - Quiet: nothing is printed
+//   - JSON: one NDJSON event per line on stderr ({"event":"progress",...})
+//   - Plain: plain lines, as when stderr is not a TTY, with emojis stripped
+//   - stderr is a TTY: an animated spinner, or a bar when the total is known;
+//     finished steps stay listed above it
+//   - otherwise: plain "label [n/total] message" lines on stderr
+//
+// Progress output never goes to stdout, so command results stay pipeable.
+type Progress struct {
+	label   string
+	total   int
+	current int
+	message string
+	mode    progressMode
+	w       io.Writer
+	start   time.Time
+	// hideWarnings is set when the log level is above warn
+	hideWarnings bool
+	// plain strips emojis from messages
+	plain bool
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+	tick int
+}
+
+type progressMode int
+
+const (
+	progressOff progressMode = iota
+	progressLines
+	progressNDJSON
+	progressTTY
+)
+
+// ProgressEvent is the NDJSON shape emitted under --json
+type ProgressEvent struct {
+	Event     string `json:"event"`
+	Label     string `json:"label"`
+	Current   int    `json:"current"`
+	Total     int    `json:"total,omitempty"`
+	Message   string `json:"message,omitempty"`
+	ElapsedMs int64  `json:"elapsedMs"`
+}
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// stderrIsTerminal reports whether progress can animate; tests replace it
+var stderrIsTerminal = func() bool { return term.IsTerminal(int(os.Stderr.Fd())) }
+
+// StartProgress begins reporting an operation. total may be 0 when unknown.
+func (p Printer) StartProgress(label string, total int) *Progress {
+	pr := &Progress{label: label, total: total, w: os.Stderr, start: time.Now(), hideWarnings: !p.Enabled(LevelWarn), plain: p.Plain}
+	switch {
+	case !p.Enabled(LevelInfo):
+		pr.mode = progressOff
+	case p.JSON || p.JSONLines:
+		pr.mode = progressNDJSON
+	case p.Plain:
+		pr.mode = progressLines
+	case stderrIsTerminal():
+		pr.mode = progressTTY
+	default:
+		pr.mode = progressLines
+	}
+	switch pr.mode {
+	case progressNDJSON:
+		pr.emit("start")
+	case progressTTY:
+		pr.stop, pr.done = make(chan struct{}), make(chan struct{})
+		go pr.animate()
+	case progressLines:
+		if total > 0 {
+			fmt.Fprintf(pr.w, "%s (%d)...\n", label, total)
+		} else {
+			fmt.Fprintf(pr.w, "%s...\n", label)
+		}
+	}
+	return pr
+}
+
+// Step marks one unit of work as finished with an optional message.
+// All Progress methods are no-ops on a nil receiver.
+func (pr *Progress) Step(format string, a ...interface{}) {
+	if pr == nil {
+		return
+	}
+	pr.mu.Lock()
+	pr.current++
+	pr.message = pr.format(format, a...)
+	if pr.mode == progressTTY && pr.message != "" {
+		// keep a record of finished steps above the spinner line
+		fmt.Fprintf(pr.w, "\r\033[K  %s\n", pr.message)
+	}
+	pr.mu.Unlock()
+	pr.report()
+}
+
+// Update changes the current message without advancing the count.
+func (pr *Progress) Update(format string, a ...interface{}) {
+	if pr == nil {
+		return
+	}
+	pr.mu.Lock()
+	pr.message = pr.format(format, a...)
+	pr.mu.Unlock()
+	pr.report()
+}
+
+// Done finishes the operation, clearing any spinner and printing the summary.
+func (pr *Progress) Done(format string, a ...interface{}) {
+	if pr == nil {
+		return
+	}
+	pr.mu.Lock()
+	pr.message = pr.format(format, a...)
+	pr.mu.Unlock()
+	switch pr.mode {
+	case progressNDJSON:
+		pr.emit("done")
+	case progressTTY:
+		close(pr.stop)
+		<-pr.done
+		fmt.Fprintf(pr.w, "\r\033[K✓ %s\n", pr.summary())
+	case progressLines:
+		fmt.Fprintf(pr.w, "%s\n", pr.summary())
+	}
+}
+
+// Warnf prints a warning without corrupting the spinner line. Warnings are shown
+// even in quiet mode (unless the log level is error); under JSON they become
+// "warning" events.
+func (pr *Progress) Warnf(format string, a ...interface{}) {
+	msg := fmt.Sprintf(format, a...)
+	if pr == nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
+		return
+	}
+	if pr.hideWarnings {
+		return
+	}
+	if pr.mode == progressNDJSON {
+		pr.mu.Lock()
+		pr.message = msg
+		pr.mu.Unlock()
+		pr.emit("warning")
+		return
+	}
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	if pr.mode == progressTTY {
+		fmt.Fprint(pr.w, "\r\033[K")
+	}
+	fmt.Fprintf(pr.w, "Warning: %s\n", msg)
+}
+
+// format renders a message, without emojis in plain mode
+func (pr *Progress) format(format string, a ...interface{}) string {
+	msg := fmt.Sprintf(format, a...)
+	if pr.plain {
+		return StripEmoji(msg)
+	}
+	return msg
+}
+
+func (pr *Progress) summary() string {
+	if pr.message != "" {
+		return pr.message
+	}
+	return pr.label + " done"
+}
+
+func (pr *Progress) report() {
+	switch pr.mode {
+	case progressNDJSON:
+		pr.emit("progress")
+	case progressTTY:
+		pr.render()
+	case progressLines:
+		pr.mu.Lock()
+		defer pr.mu.Unlock()
+		if pr.total > 0 {
+			fmt.Fprintf(pr.w, "%s [%d/%d] %s\n", pr.label, pr.current, pr.total, pr.message)
+		} else {
+			fmt.Fprintf(pr.w, "%s [%d] %s\n", pr.label, pr.current, pr.message)
+		}
+	}
+}
+
+func (pr *Progress) emit(event string) {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	b, _ := json.Marshal(ProgressEvent{Event: event, Label: pr.label, Current: pr.current, Total: pr.total, Message: pr.message, ElapsedMs: time.Since(pr.start).Milliseconds()})
+	fmt.Fprintf(pr.w, "%s\n", b)
+}
+
+func (pr *Progress) animate() {
+	t := time.NewTicker(100 * time.Millisecond)
+	defer t.Stop()
+	defer close(pr.done)
+	for {
+		select {
+		case <-pr.stop:
+			return
+		case <-t.C:
+			pr.render()
+		}
+	}
+}
+
+func (pr *Progress) render() {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	pr.tick++
+	line := spinnerFrames[pr.tick%len(spinnerFrames)] + " " + pr.label
+	if pr.total > 0 {
+		const width = 20
+		filled := pr.current * width / pr.total
+		if filled > width {
+			filled = width
+		}
+		line += fmt.Sprintf(" [%s%s] %d/%d", strings.Repeat("█", filled), strings.Repeat("░", width-filled), pr.current, pr.total)
+	}
+	if pr.message != "" {
+		line += " " + pr.message
+	}
+	fmt.Fprintf(pr.w, "\r\033[K%s", line)
+}
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStartProgress_PlainUsesLinesOnATerminal(t *testing.T) {
+	old := stderrIsTerminal
+	stderrIsTerminal = func() bool { return true }
+	t.Cleanup(func() { stderrIsTerminal = old })
+
+	if pr := (Printer{}).StartProgress("Syncing", 0); pr.mode != progressTTY {
+		t.Fatalf("default mode on a terminal = %v, want the spinner", pr.mode)
+	} else {
+		pr.Done("done")
+	}
+
+	var buf bytes.Buffer
+	pr := Printer{Plain: true}.StartProgress("Syncing", 2)
+	if pr.mode != progressLines {
+		t.Fatalf("--plain mode = %v, want lines", pr.mode)
+	}
+	pr.w = &buf
+	pr.Step("✅ ENG")
+	pr.Done("Synced 2 teams")
+	if got, want := buf.String(), "Syncing [1/2] ENG\nSynced 2 teams\n"; got != want {
+		t.Fatalf("plain progress = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// Template output renders results with a Go text/template instead of JSON, e.g.
+// --template '{{.Identifier}} {{.Title}} ({{.StateName}})'. Fields use the Go
+// names of the result structs. Lists are rendered once per element, and each
+// rendering ends with a newline unless the template already printed one.
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"join":  func(sep string, v []string) string { return strings.Join(v, sep) },
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"truncate": func(n int, s string) string {
+		r := []rune(s)
+		if len(r) <= n {
+			return s
+		}
+		if n <= 1 {
+			return string(r[:n])
+		}
+		return string(r[:n-1]) + "…"
+	},
+}
+
+// ParseTemplate compiles an output template with the helper functions
+// json, join, upper, lower and truncate available.
+func ParseTemplate(text string) (*template.Template, error) {
+	t, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+	return t, nil
+}
+
+func (p Printer) printTemplate(v interface{}) error {
+	t, err := ParseTemplate(p.Template)
+	if err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return executeTemplate(os.Stdout, t, v)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := executeTemplate(os.Stdout, t, rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func executeTemplate(w io.Writer, t *template.Template, v interface{}) error {
+	var b strings.Builder
+	if err := t.Execute(&b, v); err != nil {
+		return fmt.Errorf("--template: %w", err)
+	}
+	out := b.String()
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+	_, err := io.WriteString(w, out)
+	return err
+}
@@ -0,0 +1,87 @@
+package output
+
+import (
+	"fmt"
+	"time"
+)
+
+// Times in text output are shown in the local timezone (TZ decides it), or in
+// UTC when UTC is set (--utc), as "2006-01-02 15:04", often followed by how far
+// off they are ("2h ago", "in 3d"). JSON output keeps RFC 3339 timestamps and
+// is not affected by --utc, so scripts parse one format.
+
+// Location is the zone times are shown in
+func (p Printer) Location() *time.Location {
+	if p.UTC {
+		return time.UTC
+	}
+	return time.Local
+}
+
+// In returns t in the zone times are shown in
+func (p Printer) In(t time.Time) time.Time { return t.In(p.Location()) }
+
+// Time formats t as date and minute, marked "UTC" under --utc; "-" when zero
+func (p Printer) Time(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	s := p.In(t).Format("2006-01-02 15:04")
+	if p.UTC {
+		s += " UTC"
+	}
+	return s
+}
+
+// Date formats the calendar day of t in the zone times are shown in
+func (p Printer) Date(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return p.In(t).Format("2006-01-02")
+}
+
+// When formats t with how long ago or ahead of now it is,
+// e.g. "2026-03-02 14:05 (2h ago)"
+func (p Printer) When(t, now time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return p.Time(t) + " (" + Relative(t, now) + ")"
+}
+
+// Relative describes t from now in the largest whole unit: "just now",
+// "5m ago", "2h ago", "3d ago", "in 2w", "4mo ago", "1y ago"
+func Relative(t, now time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	if d < time.Minute {
+		return "just now"
+	}
+	var n int
+	var unit string
+	switch day := 24 * time.Hour; {
+	case d < time.Hour:
+		n, unit = int(d/time.Minute), "m"
+	case d < day:
+		n, unit = int(d/time.Hour), "h"
+	case d < 14*day:
+		n, unit = int(d/day), "d"
+	case d < 60*day:
+		n, unit = int(d/(7*day)), "w"
+	case d < 365*day:
+		n, unit = int(d/(30*day)), "mo"
+	default:
+		n, unit = int(d/(365*day)), "y"
+	}
+	if future {
+		return fmt.Sprintf("in %d%s", n, unit)
+	}
+	return fmt.Sprintf("%d%s ago", n, unit)
+}